@@ -14,13 +14,147 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	AdminApiKeyScopes = "adminApiKey.Scopes"
+	BearerAuthScopes  = "bearerAuth.Scopes"
+)
+
+// Defines values for DataExportStatus.
+const (
+	Pending DataExportStatus = "pending"
+	Ready   DataExportStatus = "ready"
+)
+
+// Defines values for MFARequiredMethods.
+const (
+	RecoveryCode MFARequiredMethods = "recovery_code"
+	Totp         MFARequiredMethods = "totp"
+	Webauthn     MFARequiredMethods = "webauthn"
+)
+
+// Defines values for PhoneType.
+const (
+	FixedLine         PhoneType = "fixed_line"
+	FixedLineOrMobile PhoneType = "fixed_line_or_mobile"
+	Mobile            PhoneType = "mobile"
+	Pager             PhoneType = "pager"
+	PersonalNumber    PhoneType = "personal_number"
+	PremiumRate       PhoneType = "premium_rate"
+	SharedCost        PhoneType = "shared_cost"
+	TollFree          PhoneType = "toll_free"
+	Uan               PhoneType = "uan"
+	Unknown           PhoneType = "unknown"
+	Voicemail         PhoneType = "voicemail"
+	Voip              PhoneType = "voip"
+)
+
+// Defines values for UserStatus.
+const (
+	Active      UserStatus = "active"
+	Blocked     UserStatus = "blocked"
+	Deactivated UserStatus = "deactivated"
+	Suspended   UserStatus = "suspended"
+)
+
+// Defines values for WebAuthnAssertionType.
+const (
+	WebAuthnAssertionTypePublicKey WebAuthnAssertionType = "public-key"
+)
+
+// Defines values for WebAuthnAttestationType.
+const (
+	WebAuthnAttestationTypePublicKey WebAuthnAttestationType = "public-key"
+)
+
+// Defines values for WebAuthnCreationOptionsPubKeyCredParamsType.
+const (
+	WebAuthnCreationOptionsPubKeyCredParamsTypePublicKey WebAuthnCreationOptionsPubKeyCredParamsType = "public-key"
+)
+
+// Defines values for WebAuthnCredentialDescriptorType.
+const (
+	WebAuthnCredentialDescriptorTypePublicKey WebAuthnCredentialDescriptorType = "public-key"
+)
+
+// AccountDeletionResponse defines model for AccountDeletionResponse.
+type AccountDeletionResponse struct {
+	// DeletionScheduledAt When the account will be deleted unless the deletion is cancelled
+	DeletionScheduledAt time.Time `json:"deletion_scheduled_at"`
+}
+
+// AccountLocked defines model for AccountLocked.
+type AccountLocked struct {
+	Detail string `json:"detail"`
+
+	// RetryAfter Seconds until the lock expires
+	RetryAfter int    `json:"retry_after"`
+	Status     int    `json:"status"`
+	Title      string `json:"title"`
+	Type       string `json:"type"`
+}
+
+// ArchivedEvent defines model for ArchivedEvent.
+type ArchivedEvent struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Data Event payload as stored
+	Data map[string]interface{} `json:"data"`
+	Id   string                 `json:"id"`
+	Type string                 `json:"type"`
+}
+
+// ArchivedLogin defines model for ArchivedLogin.
+type ArchivedLogin struct {
+	At        time.Time `json:"at"`
+	City      *string   `json:"city,omitempty"`
+	Country   *string   `json:"country,omitempty"`
+	Ip        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+}
+
+// ArchivedPasskey defines model for ArchivedPasskey.
+type ArchivedPasskey struct {
+	CreatedAt  time.Time          `json:"created_at"`
+	Id         openapi_types.UUID `json:"id"`
+	LastUsedAt *time.Time         `json:"last_used_at,omitempty"`
+	Name       string             `json:"name"`
+}
+
+// ArchivedProfile defines model for ArchivedProfile.
+type ArchivedProfile struct {
+	AvatarUrl           *string             `json:"avatar_url,omitempty"`
+	BirthDate           *openapi_types.Date `json:"birth_date,omitempty"`
+	CreatedAt           time.Time           `json:"created_at"`
+	DeletionScheduledAt *time.Time          `json:"deletion_scheduled_at,omitempty"`
+	Email               string              `json:"email"`
+	EmailVerifiedAt     *time.Time          `json:"email_verified_at,omitempty"`
+	Id                  openapi_types.UUID  `json:"id"`
+	Locale              *string             `json:"locale,omitempty"`
+
+	// Metadata Application data about the user. Checked against the JSON Schema in USER_METADATA_SCHEMA_FILE when it is set; at most 16 KiB of JSON
+	Metadata          *UserMetadata `json:"metadata,omitempty"`
+	MfaEnabled        bool          `json:"mfa_enabled"`
+	Name              string        `json:"name"`
+	PasswordChangedAt time.Time     `json:"password_changed_at"`
+	Phone             string        `json:"phone"`
+	PhoneVerifiedAt   *time.Time    `json:"phone_verified_at,omitempty"`
+
+	// Status Account status. Only active accounts can log in and use their tokens
+	Status    UserStatus `json:"status"`
+	Timezone  *string    `json:"timezone,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	Username  *string    `json:"username,omitempty"`
+}
+
 // BadRequest defines model for BadRequest.
 type BadRequest struct {
 	Detail string `json:"detail"`
@@ -29,10 +163,122 @@ type BadRequest struct {
 	Type   string `json:"type"`
 }
 
-// LoginRequest defines model for LoginRequest.
+// CaptchaRequired defines model for CaptchaRequired.
+type CaptchaRequired struct {
+	Detail string `json:"detail"`
+	Status int    `json:"status"`
+	Title  string `json:"title"`
+	Type   string `json:"type"`
+}
+
+// ChangeExpiredPasswordRequest defines model for ChangeExpiredPasswordRequest.
+type ChangeExpiredPasswordRequest struct {
+	// NewPassword New password (8-128 chars), must not match recently used passwords
+	NewPassword string `json:"new_password"`
+
+	// PasswordChangeToken Token from the password expired login response
+	PasswordChangeToken string `json:"password_change_token"`
+}
+
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	// CurrentPassword Current password (1-128 chars)
+	CurrentPassword string `json:"current_password"`
+
+	// NewPassword New password (8-128 chars), must not match recently used passwords
+	NewPassword string `json:"new_password"`
+}
+
+// ChangeUserStatusRequest defines model for ChangeUserStatusRequest.
+type ChangeUserStatusRequest struct {
+	// Reason Why the status is changed (stored with the user and in the event)
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Account status. Only active accounts can log in and use their tokens
+	Status UserStatus `json:"status"`
+}
+
+// ConfirmEmailChangeRequest defines model for ConfirmEmailChangeRequest.
+type ConfirmEmailChangeRequest struct {
+	// Token Token from the email sent to the new address
+	Token string `json:"token"`
+}
+
+// ConfirmPhoneChangeRequest defines model for ConfirmPhoneChangeRequest.
+type ConfirmPhoneChangeRequest struct {
+	// Code 6-digit code from the SMS sent to the new number
+	Code string `json:"code"`
+}
+
+// ConfirmTOTPRequest defines model for ConfirmTOTPRequest.
+type ConfirmTOTPRequest struct {
+	// Code 6-digit code from the authenticator app
+	Code string `json:"code"`
+}
+
+// Conflict defines model for Conflict.
+type Conflict struct {
+	Detail string `json:"detail"`
+	Status int    `json:"status"`
+	Title  string `json:"title"`
+	Type   string `json:"type"`
+}
+
+// DataExport defines model for DataExport.
+type DataExport struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpiresAt When the export is deleted
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Id Download the archive from /me/exports/{id} (or the admin equivalent)
+	Id     openapi_types.UUID `json:"id"`
+	Status DataExportStatus   `json:"status"`
+}
+
+// DataExportStatus defines model for DataExport.Status.
+type DataExportStatus string
+
+// FinishWebAuthnLoginRequest defines model for FinishWebAuthnLoginRequest.
+type FinishWebAuthnLoginRequest struct {
+	// Credential Result of navigator.credentials.get() serialized with PublicKeyCredential.toJSON()
+	Credential WebAuthnAssertion `json:"credential"`
+}
+
+// FinishWebAuthnRegistrationRequest defines model for FinishWebAuthnRegistrationRequest.
+type FinishWebAuthnRegistrationRequest struct {
+	// Credential Result of navigator.credentials.create() serialized with PublicKeyCredential.toJSON()
+	Credential WebAuthnAttestation `json:"credential"`
+
+	// Name Label shown in the passkey list (default "Passkey")
+	Name *string `json:"name,omitempty"`
+}
+
+// LoginForbidden Problem details for a refused login. `type` is "password-expired" (password change token fields are set), "email-not-verified", "login-verification-required" (sign-in from a new device or location with LOGIN_RISK_STEP_UP on: it is completed by the sign-in link sent to the user's email), "account-suspended", "account-blocked" or "account-deactivated".
+type LoginForbidden struct {
+	Detail string `json:"detail"`
+
+	// ExpiresIn Password change token expiration time in seconds (password-expired only)
+	ExpiresIn *int `json:"expires_in,omitempty"`
+
+	// PasswordChangeToken Short-lived token accepted only by POST /auth/password/expired (password-expired only)
+	PasswordChangeToken *string `json:"password_change_token,omitempty"`
+	Status              int     `json:"status"`
+	Title               string  `json:"title"`
+	Type                string  `json:"type"`
+}
+
+// LoginRequest Exactly one of identifier or email must be given
 type LoginRequest struct {
-	// Email Valid email address (5-255 chars)
-	Email openapi_types.Email `json:"email"`
+	// CaptchaToken Token from a solved CAPTCHA widget. Required only after the server answered 428 captcha-required
+	CaptchaToken *string `json:"captcha_token,omitempty"`
+
+	// Email Valid email address (5-255 chars). Use identifier instead
+	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// Identifier Email, phone number or username. A value that starts with +, a digit or ( is read as a phone number: with the country code, or in the national format of PHONE_DEFAULT_REGION
+	Identifier *string `json:"identifier,omitempty"`
 
 	// Password Password (1-128 chars)
 	Password string `json:"password"`
@@ -49,18 +295,96 @@ type LoginResponse struct {
 	User         User   `json:"user"`
 }
 
+// MFARequired defines model for MFARequired.
+type MFARequired struct {
+	// ExpiresIn MFA token expiration time in seconds
+	ExpiresIn int `json:"expires_in"`
+
+	// Methods Accepted second factors
+	Methods []MFARequiredMethods `json:"methods"`
+
+	// MfaToken Short-lived token accepted only by POST /auth/mfa/verify
+	MfaToken string `json:"mfa_token"`
+
+	// Webauthn Options for PublicKeyCredential.parseRequestOptionsFromJSON(); binary fields are base64url
+	Webauthn *WebAuthnRequestOptions `json:"webauthn,omitempty"`
+}
+
+// MFARequiredMethods defines model for MFARequired.Methods.
+type MFARequiredMethods string
+
+// MagicLinkRequest defines model for MagicLinkRequest.
+type MagicLinkRequest struct {
+	// Email Email address to send the sign-in link to (5-255 chars)
+	Email openapi_types.Email `json:"email"`
+}
+
+// NotFound defines model for NotFound.
+type NotFound struct {
+	Detail string `json:"detail"`
+	Status int    `json:"status"`
+	Title  string `json:"title"`
+	Type   string `json:"type"`
+}
+
+// PhoneLoginCodeRequest defines model for PhoneLoginCodeRequest.
+type PhoneLoginCodeRequest struct {
+	// Phone Verified phone number of the account, in any format accepted at registration
+	Phone string `json:"phone"`
+}
+
+// PhoneLoginRequest defines model for PhoneLoginRequest.
+type PhoneLoginRequest struct {
+	// Code Code from the SMS
+	Code  string `json:"code"`
+	Phone string `json:"phone"`
+}
+
+// PhoneType Type of the phone number by the country's numbering plan. fixed_line_or_mobile is used where the plan does not tell them apart (US, Canada); unknown for numbers stored before the plan changed.
+type PhoneType string
+
+// PhoneVerificationSent defines model for PhoneVerificationSent.
+type PhoneVerificationSent struct {
+	// ExpiresIn Code expiration time in seconds
+	ExpiresIn int `json:"expires_in"`
+
+	// ResendAfter Seconds until a new code can be requested
+	ResendAfter int `json:"resend_after"`
+}
+
+// RecoveryCodes defines model for RecoveryCodes.
+type RecoveryCodes struct {
+	// RecoveryCodes One-time codes for logging in without the authenticator app
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// RedeemMagicLinkRequest defines model for RedeemMagicLinkRequest.
+type RedeemMagicLinkRequest struct {
+	// Token Token from the sign-in link
+	Token string `json:"token"`
+}
+
+// RefreshTokensRequest defines model for RefreshTokensRequest.
+type RefreshTokensRequest struct {
+	// RefreshToken Refresh token from a previous login or refresh
+	RefreshToken string `json:"refresh_token"`
+}
+
 // RegisterRequest defines model for RegisterRequest.
 type RegisterRequest struct {
-	// Email Valid email address (5-255 chars, must contain @ and domain)
+	// CaptchaToken Token from a solved CAPTCHA widget. Required only after the server answered 428 captcha-required
+	CaptchaToken *string `json:"captcha_token,omitempty"`
+
+	// Email Valid email address (5-255 chars, must contain @ and domain). May be internationalized (RFC 6531, Unicode or punycode domain). Uniqueness is checked on the canonical form of the address; the address is kept as entered.
 	Email openapi_types.Email `json:"email"`
 
-	// Name User name (1-100 chars, cannot be only whitespace)
+	// Name User name. Normalized to NFC, with whitespace runs collapsed to one space and trimmed; must have 1-100 characters after that. Control, bidirectional and invisible formatting characters are rejected.
 	Name string `json:"name"`
 
 	// Password Password (8-128 chars)
 	Password string `json:"password"`
 
-	// Phone International phone number format (8-16 chars, starts with +)
+	// Phone Phone number with the country code, or in the national format of PHONE_DEFAULT_REGION when it is set. Spaces, dashes, dots and parentheses are allowed. Stored in E.164.
 	Phone string `json:"phone"`
 }
 
@@ -75,6 +399,53 @@ type RegisterResponse struct {
 	User         User   `json:"user"`
 }
 
+// RequestAccountDeletionRequest defines model for RequestAccountDeletionRequest.
+type RequestAccountDeletionRequest struct {
+	// CurrentPassword Current password (1-128 chars)
+	CurrentPassword string `json:"current_password"`
+}
+
+// RequestEmailChangeRequest defines model for RequestEmailChangeRequest.
+type RequestEmailChangeRequest struct {
+	// CurrentPassword Current password (1-128 chars)
+	CurrentPassword string `json:"current_password"`
+
+	// NewEmail New email address (5-255 chars)
+	NewEmail openapi_types.Email `json:"new_email"`
+}
+
+// RequestPhoneChangeRequest defines model for RequestPhoneChangeRequest.
+type RequestPhoneChangeRequest struct {
+	// NewPhone New phone with the country code or in the national format of PHONE_DEFAULT_REGION
+	NewPhone string `json:"new_phone"`
+}
+
+// SendEmailVerificationRequest defines model for SendEmailVerificationRequest.
+type SendEmailVerificationRequest struct {
+	// Email Email address to verify (5-255 chars)
+	Email openapi_types.Email `json:"email"`
+}
+
+// TOTPEnrollment defines model for TOTPEnrollment.
+type TOTPEnrollment struct {
+	// OtpauthUri URI to render as a QR code
+	OtpauthUri string `json:"otpauth_uri"`
+
+	// Secret Base32 secret for manual entry in the authenticator app
+	Secret string `json:"secret"`
+}
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests struct {
+	Detail string `json:"detail"`
+
+	// RetryAfter Seconds to wait before retrying
+	RetryAfter int    `json:"retry_after"`
+	Status     int    `json:"status"`
+	Title      string `json:"title"`
+	Type       string `json:"type"`
+}
+
 // Unauthorized defines model for Unauthorized.
 type Unauthorized struct {
 	Detail string `json:"detail"`
@@ -83,327 +454,4318 @@ type Unauthorized struct {
 	Type   string `json:"type"`
 }
 
-// User defines model for User.
-type User struct {
-	Email string             `json:"email"`
-	Id    openapi_types.UUID `json:"id"`
-	Name  string             `json:"name"`
-	Phone *string            `json:"phone,omitempty"`
-}
+// UpdateProfileRequest defines model for UpdateProfileRequest.
+type UpdateProfileRequest struct {
+	// AvatarUrl Absolute http or https URL; empty string clears it
+	AvatarUrl *string `json:"avatar_url,omitempty"`
 
-// LoginJSONRequestBody defines body for Login for application/json ContentType.
-type LoginJSONRequestBody = LoginRequest
+	// BirthDate Past date in YYYY-MM-DD format; empty string clears it
+	BirthDate *string `json:"birth_date,omitempty"`
 
-// RegisterJSONRequestBody defines body for Register for application/json ContentType.
-type RegisterJSONRequestBody = RegisterRequest
+	// Locale BCP 47 language tag; empty string clears it
+	Locale *string `json:"locale,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// User login
-	// (POST /auth/login)
-	Login(w http.ResponseWriter, r *http.Request)
-	// Register a new user
-	// (POST /auth/register)
-	Register(w http.ResponseWriter, r *http.Request)
-}
+	// Metadata Application data about the user. Checked against the JSON Schema in USER_METADATA_SCHEMA_FILE when it is set; at most 16 KiB of JSON
+	Metadata *UserMetadata `json:"metadata,omitempty"`
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+	// Name New user name. Normalized to NFC, with whitespace runs collapsed to one space and trimmed; must have 1-100 characters after that. Control, bidirectional and invisible formatting characters are rejected.
+	Name *string `json:"name,omitempty"`
 
-type Unimplemented struct{}
+	// Timezone IANA time zone name; empty string clears it
+	Timezone *string `json:"timezone,omitempty"`
 
-// User login
-// (POST /auth/login)
-func (_ Unimplemented) Login(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// Username Public handle used for login (3-30 letters of one script, digits 0-9, '_' and '.'; starts with a letter). Stored case-folded; must not be taken; empty string removes it
+	Username *string `json:"username,omitempty"`
 }
 
-// Register a new user
-// (POST /auth/register)
-func (_ Unimplemented) Register(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// User defines model for User.
+type User struct {
+	// AvatarUrl Profile picture; absent when not set
+	AvatarUrl *string `json:"avatar_url,omitempty"`
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// BirthDate Absent when not set
+	BirthDate *openapi_types.Date `json:"birth_date,omitempty"`
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	// DeletionScheduledAt When the account will be deleted at the user's request; absent when no deletion is scheduled
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	Email               string     `json:"email"`
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+	// EmailVerified Whether the user has confirmed the email address
+	EmailVerified bool               `json:"email_verified"`
+	Id            openapi_types.UUID `json:"id"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Login(w, r)
-	}))
+	// Locale Preferred language as a BCP 47 tag; absent when not set
+	Locale *string `json:"locale,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Metadata Application data about the user. Checked against the JSON Schema in USER_METADATA_SCHEMA_FILE when it is set; at most 16 KiB of JSON
+	Metadata *UserMetadata `json:"metadata,omitempty"`
+	Name     string        `json:"name"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Phone Phone number in E.164 format
+	Phone *string `json:"phone,omitempty"`
 
-// Register operation middleware
-func (siw *ServerInterfaceWrapper) Register(w http.ResponseWriter, r *http.Request) {
+	// PhoneType Type of the phone number by the country's numbering plan. fixed_line_or_mobile is used where the plan does not tell them apart (US, Canada); unknown for numbers stored before the plan changed.
+	PhoneType *PhoneType `json:"phone_type,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Register(w, r)
-	}))
+	// PhoneVerified Whether the user has confirmed the phone with an SMS code
+	PhoneVerified bool `json:"phone_verified"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Status Account status. Only active accounts can log in and use their tokens
+	Status UserStatus `json:"status"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Timezone IANA time zone; absent when not set
+	Timezone *string `json:"timezone,omitempty"`
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+	// Username Public handle, case-folded; absent when not set
+	Username *string `json:"username,omitempty"`
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// UserDataArchive defines model for UserDataArchive.
+type UserDataArchive struct {
+	// Events Every stored event of the user, oldest first
+	Events      []ArchivedEvent `json:"events"`
+	GeneratedAt time.Time       `json:"generated_at"`
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// Logins Every login, newest first; each login started a session
+	Logins []ArchivedLogin `json:"logins"`
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+	// Passkeys Linked passkeys and security keys
+	Passkeys []ArchivedPasskey `json:"passkeys"`
+	Profile  ArchivedProfile   `json:"profile"`
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+// UserMetadata Application data about the user. Checked against the JSON Schema in USER_METADATA_SCHEMA_FILE when it is set; at most 16 KiB of JSON
+type UserMetadata map[string]interface{}
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+// UserStatus Account status. Only active accounts can log in and use their tokens
+type UserStatus string
 
-type RequiredParamError struct {
-	ParamName string
+// VerifyEmailRequest defines model for VerifyEmailRequest.
+type VerifyEmailRequest struct {
+	// Token Token from the verification email
+	Token string `json:"token"`
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+// VerifyMFARequest Exactly one of code, recovery_code and webauthn must be set
+type VerifyMFARequest struct {
+	// Code 6-digit code from the authenticator app
+	Code *string `json:"code,omitempty"`
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	// MfaToken Token from the 202 response of POST /auth/login
+	MfaToken string `json:"mfa_token"`
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// RecoveryCode One of the recovery codes issued when MFA was enabled
+	RecoveryCode *string `json:"recovery_code,omitempty"`
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+	// Webauthn Result of navigator.credentials.get() serialized with PublicKeyCredential.toJSON()
+	Webauthn *WebAuthnAssertion `json:"webauthn,omitempty"`
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+// VerifyPhoneRequest defines model for VerifyPhoneRequest.
+type VerifyPhoneRequest struct {
+	// Code 6-digit code from SMS
+	Code string `json:"code"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// WebAuthnAssertion Result of navigator.credentials.get() serialized with PublicKeyCredential.toJSON()
+type WebAuthnAssertion struct {
+	// Id Credential ID, base64url
+	Id       string `json:"id"`
+	Response struct {
+		// AuthenticatorData base64url
+		AuthenticatorData string `json:"authenticatorData"`
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+		// ClientDataJSON base64url
+		ClientDataJSON string `json:"clientDataJSON"`
+
+		// Signature base64url
+		Signature string `json:"signature"`
+
+		// UserHandle base64url
+		UserHandle *string `json:"userHandle,omitempty"`
+	} `json:"response"`
+	Type WebAuthnAssertionType `json:"type"`
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+// WebAuthnAssertionType defines model for WebAuthnAssertion.Type.
+type WebAuthnAssertionType string
+
+// WebAuthnAttestation Result of navigator.credentials.create() serialized with PublicKeyCredential.toJSON()
+type WebAuthnAttestation struct {
+	// Id Credential ID, base64url
+	Id       string `json:"id"`
+	Response struct {
+		// AttestationObject base64url
+		AttestationObject string `json:"attestationObject"`
+
+		// ClientDataJSON base64url
+		ClientDataJSON string `json:"clientDataJSON"`
+	} `json:"response"`
+	Type WebAuthnAttestationType `json:"type"`
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// WebAuthnAttestationType defines model for WebAuthnAttestation.Type.
+type WebAuthnAttestationType string
+
+// WebAuthnCreationOptions Options for PublicKeyCredential.parseCreationOptionsFromJSON(); binary fields are base64url
+type WebAuthnCreationOptions struct {
+	Attestation            string `json:"attestation"`
+	AuthenticatorSelection struct {
+		ResidentKey      string `json:"residentKey"`
+		UserVerification string `json:"userVerification"`
+	} `json:"authenticatorSelection"`
+	Challenge          string                         `json:"challenge"`
+	ExcludeCredentials []WebAuthnCredentialDescriptor `json:"excludeCredentials"`
+	PubKeyCredParams   []struct {
+		Alg  int                                         `json:"alg"`
+		Type WebAuthnCreationOptionsPubKeyCredParamsType `json:"type"`
+	} `json:"pubKeyCredParams"`
+	Rp struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"rp"`
+
+	// Timeout Milliseconds
+	Timeout int `json:"timeout"`
+	User    struct {
+		DisplayName string `json:"displayName"`
+		Id          string `json:"id"`
+		Name        string `json:"name"`
+	} `json:"user"`
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+// WebAuthnCreationOptionsPubKeyCredParamsType defines model for WebAuthnCreationOptions.PubKeyCredParams.Type.
+type WebAuthnCreationOptionsPubKeyCredParamsType string
+
+// WebAuthnCredential defines model for WebAuthnCredential.
+type WebAuthnCredential struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// CredentialId Credential ID, base64url
+	CredentialId string             `json:"credential_id"`
+	Id           openapi_types.UUID `json:"id"`
+	Name         string             `json:"name"`
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// WebAuthnCredentialDescriptor defines model for WebAuthnCredentialDescriptor.
+type WebAuthnCredentialDescriptor struct {
+	// Id Credential ID, base64url
+	Id   string                           `json:"id"`
+	Type WebAuthnCredentialDescriptorType `json:"type"`
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// WebAuthnCredentialDescriptorType defines model for WebAuthnCredentialDescriptor.Type.
+type WebAuthnCredentialDescriptorType string
+
+// WebAuthnRequestOptions Options for PublicKeyCredential.parseRequestOptionsFromJSON(); binary fields are base64url
+type WebAuthnRequestOptions struct {
+	AllowCredentials []WebAuthnCredentialDescriptor `json:"allowCredentials"`
+	Challenge        string                         `json:"challenge"`
+	RpId             string                         `json:"rpId"`
+
+	// Timeout Milliseconds
+	Timeout          int    `json:"timeout"`
+	UserVerification string `json:"userVerification"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
+// ExportID defines model for ExportID.
+type ExportID = openapi_types.UUID
+
+// UserID defines model for UserID.
+type UserID = openapi_types.UUID
+
+// ChangeUserStatusJSONRequestBody defines body for ChangeUserStatus for application/json ContentType.
+type ChangeUserStatusJSONRequestBody = ChangeUserStatusRequest
+
+// RequestEmailChangeJSONRequestBody defines body for RequestEmailChange for application/json ContentType.
+type RequestEmailChangeJSONRequestBody = RequestEmailChangeRequest
+
+// ConfirmEmailChangeJSONRequestBody defines body for ConfirmEmailChange for application/json ContentType.
+type ConfirmEmailChangeJSONRequestBody = ConfirmEmailChangeRequest
+
+// SendEmailVerificationJSONRequestBody defines body for SendEmailVerification for application/json ContentType.
+type SendEmailVerificationJSONRequestBody = SendEmailVerificationRequest
+
+// VerifyEmailJSONRequestBody defines body for VerifyEmail for application/json ContentType.
+type VerifyEmailJSONRequestBody = VerifyEmailRequest
+
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody = LoginRequest
+
+// LoginWithPhoneCodeJSONRequestBody defines body for LoginWithPhoneCode for application/json ContentType.
+type LoginWithPhoneCodeJSONRequestBody = PhoneLoginRequest
+
+// RequestPhoneLoginCodeJSONRequestBody defines body for RequestPhoneLoginCode for application/json ContentType.
+type RequestPhoneLoginCodeJSONRequestBody = PhoneLoginCodeRequest
+
+// RequestMagicLinkJSONRequestBody defines body for RequestMagicLink for application/json ContentType.
+type RequestMagicLinkJSONRequestBody = MagicLinkRequest
+
+// RedeemMagicLinkJSONRequestBody defines body for RedeemMagicLink for application/json ContentType.
+type RedeemMagicLinkJSONRequestBody = RedeemMagicLinkRequest
+
+// ConfirmTOTPJSONRequestBody defines body for ConfirmTOTP for application/json ContentType.
+type ConfirmTOTPJSONRequestBody = ConfirmTOTPRequest
+
+// VerifyMFAJSONRequestBody defines body for VerifyMFA for application/json ContentType.
+type VerifyMFAJSONRequestBody = VerifyMFARequest
+
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody = ChangePasswordRequest
+
+// ChangeExpiredPasswordJSONRequestBody defines body for ChangeExpiredPassword for application/json ContentType.
+type ChangeExpiredPasswordJSONRequestBody = ChangeExpiredPasswordRequest
+
+// RequestPhoneChangeJSONRequestBody defines body for RequestPhoneChange for application/json ContentType.
+type RequestPhoneChangeJSONRequestBody = RequestPhoneChangeRequest
+
+// ConfirmPhoneChangeJSONRequestBody defines body for ConfirmPhoneChange for application/json ContentType.
+type ConfirmPhoneChangeJSONRequestBody = ConfirmPhoneChangeRequest
+
+// VerifyPhoneJSONRequestBody defines body for VerifyPhone for application/json ContentType.
+type VerifyPhoneJSONRequestBody = VerifyPhoneRequest
+
+// RefreshTokensJSONRequestBody defines body for RefreshTokens for application/json ContentType.
+type RefreshTokensJSONRequestBody = RefreshTokensRequest
+
+// RegisterJSONRequestBody defines body for Register for application/json ContentType.
+type RegisterJSONRequestBody = RegisterRequest
+
+// FinishWebAuthnLoginJSONRequestBody defines body for FinishWebAuthnLogin for application/json ContentType.
+type FinishWebAuthnLoginJSONRequestBody = FinishWebAuthnLoginRequest
+
+// FinishWebAuthnRegistrationJSONRequestBody defines body for FinishWebAuthnRegistration for application/json ContentType.
+type FinishWebAuthnRegistrationJSONRequestBody = FinishWebAuthnRegistrationRequest
+
+// RequestAccountDeletionJSONRequestBody defines body for RequestAccountDeletion for application/json ContentType.
+type RequestAccountDeletionJSONRequestBody = RequestAccountDeletionRequest
+
+// UpdateProfileJSONRequestBody defines body for UpdateProfile for application/json ContentType.
+type UpdateProfileJSONRequestBody = UpdateProfileRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Delete an account
+	// (DELETE /admin/users/{user_id})
+	DeleteUser(w http.ResponseWriter, r *http.Request, userId UserID)
+	// Export a user's data
+	// (GET /admin/users/{user_id}/export)
+	ExportUserData(w http.ResponseWriter, r *http.Request, userId UserID)
+	// Download a data export of a user
+	// (GET /admin/users/{user_id}/exports/{export_id})
+	GetUserDataExport(w http.ResponseWriter, r *http.Request, userId UserID, exportId ExportID)
+	// Force the user to change the password on next login
+	// (POST /admin/users/{user_id}/password/require-change)
+	RequirePasswordChange(w http.ResponseWriter, r *http.Request, userId UserID)
+	// Activate, suspend, block or deactivate an account
+	// (POST /admin/users/{user_id}/status)
+	ChangeUserStatus(w http.ResponseWriter, r *http.Request, userId UserID)
+	// Lift the login lock and reset failed login attempts
+	// (POST /admin/users/{user_id}/unlock)
+	UnlockUser(w http.ResponseWriter, r *http.Request, userId UserID)
+	// Request a change of the authenticated user's email
+	// (POST /auth/email/change)
+	RequestEmailChange(w http.ResponseWriter, r *http.Request)
+	// Confirm email change with the token sent to the new address
+	// (POST /auth/email/change/confirm)
+	ConfirmEmailChange(w http.ResponseWriter, r *http.Request)
+	// Send an email verification link
+	// (POST /auth/email/verification)
+	SendEmailVerification(w http.ResponseWriter, r *http.Request)
+	// Confirm email with the token from the verification email
+	// (POST /auth/email/verify)
+	VerifyEmail(w http.ResponseWriter, r *http.Request)
+	// User login
+	// (POST /auth/login)
+	Login(w http.ResponseWriter, r *http.Request)
+	// Log in with a phone number and the code from the SMS
+	// (POST /auth/login/phone)
+	LoginWithPhoneCode(w http.ResponseWriter, r *http.Request)
+	// Send a one-time sign-in code by SMS
+	// (POST /auth/login/phone/code)
+	RequestPhoneLoginCode(w http.ResponseWriter, r *http.Request)
+	// Send a one-time sign-in link by email
+	// (POST /auth/magic-link)
+	RequestMagicLink(w http.ResponseWriter, r *http.Request)
+	// Log in with the token from a sign-in link
+	// (POST /auth/magic-link/redeem)
+	RedeemMagicLink(w http.ResponseWriter, r *http.Request)
+	// Enable two-factor authentication with the first code from the authenticator app
+	// (POST /auth/mfa/totp/confirm)
+	ConfirmTOTP(w http.ResponseWriter, r *http.Request)
+	// Start connecting an authenticator app
+	// (POST /auth/mfa/totp/enroll)
+	EnrollTOTP(w http.ResponseWriter, r *http.Request)
+	// Complete login with a code from the authenticator app, a recovery code or a passkey
+	// (POST /auth/mfa/verify)
+	VerifyMFA(w http.ResponseWriter, r *http.Request)
+	// Change password of the authenticated user
+	// (POST /auth/password/change)
+	ChangePassword(w http.ResponseWriter, r *http.Request)
+	// Replace an expired password using the password change token issued at login
+	// (POST /auth/password/expired)
+	ChangeExpiredPassword(w http.ResponseWriter, r *http.Request)
+	// Request a change of the authenticated user's phone
+	// (POST /auth/phone/change)
+	RequestPhoneChange(w http.ResponseWriter, r *http.Request)
+	// Confirm phone change with the code sent to the new number
+	// (POST /auth/phone/change/confirm)
+	ConfirmPhoneChange(w http.ResponseWriter, r *http.Request)
+	// Send a one-time verification code to the phone of the authenticated user
+	// (POST /auth/phone/verification)
+	SendPhoneVerification(w http.ResponseWriter, r *http.Request)
+	// Confirm the phone of the authenticated user with the code from SMS
+	// (POST /auth/phone/verify)
+	VerifyPhone(w http.ResponseWriter, r *http.Request)
+	// Exchange a refresh token for a new token pair
+	// (POST /auth/refresh)
+	RefreshTokens(w http.ResponseWriter, r *http.Request)
+	// Register a new user
+	// (POST /auth/register)
+	Register(w http.ResponseWriter, r *http.Request)
+	// Start passwordless login with a passkey
+	// (POST /auth/webauthn/login/begin)
+	BeginWebAuthnLogin(w http.ResponseWriter, r *http.Request)
+	// Complete passwordless login with a passkey
+	// (POST /auth/webauthn/login/finish)
+	FinishWebAuthnLogin(w http.ResponseWriter, r *http.Request)
+	// Start registering a passkey
+	// (POST /auth/webauthn/register/begin)
+	BeginWebAuthnRegistration(w http.ResponseWriter, r *http.Request)
+	// Store a passkey created by the authenticator
+	// (POST /auth/webauthn/register/finish)
+	FinishWebAuthnRegistration(w http.ResponseWriter, r *http.Request)
+	// Delete the authenticated user's account
+	// (DELETE /me)
+	RequestAccountDeletion(w http.ResponseWriter, r *http.Request)
+	// Get the authenticated user's profile
+	// (GET /me)
+	GetMe(w http.ResponseWriter, r *http.Request)
+	// Update the authenticated user's profile
+	// (PATCH /me)
+	UpdateProfile(w http.ResponseWriter, r *http.Request)
+	// Cancel the scheduled deletion of the authenticated user's account
+	// (POST /me/deletion/cancel)
+	CancelAccountDeletion(w http.ResponseWriter, r *http.Request)
+	// Export the authenticated user's data
+	// (GET /me/export)
+	ExportMyData(w http.ResponseWriter, r *http.Request)
+	// Download a data export of the authenticated user
+	// (GET /me/exports/{export_id})
+	GetMyDataExport(w http.ResponseWriter, r *http.Request, exportId ExportID)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Delete an account
+// (DELETE /admin/users/{user_id})
+func (_ Unimplemented) DeleteUser(w http.ResponseWriter, r *http.Request, userId UserID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export a user's data
+// (GET /admin/users/{user_id}/export)
+func (_ Unimplemented) ExportUserData(w http.ResponseWriter, r *http.Request, userId UserID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a data export of a user
+// (GET /admin/users/{user_id}/exports/{export_id})
+func (_ Unimplemented) GetUserDataExport(w http.ResponseWriter, r *http.Request, userId UserID, exportId ExportID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Force the user to change the password on next login
+// (POST /admin/users/{user_id}/password/require-change)
+func (_ Unimplemented) RequirePasswordChange(w http.ResponseWriter, r *http.Request, userId UserID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Activate, suspend, block or deactivate an account
+// (POST /admin/users/{user_id}/status)
+func (_ Unimplemented) ChangeUserStatus(w http.ResponseWriter, r *http.Request, userId UserID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Lift the login lock and reset failed login attempts
+// (POST /admin/users/{user_id}/unlock)
+func (_ Unimplemented) UnlockUser(w http.ResponseWriter, r *http.Request, userId UserID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Request a change of the authenticated user's email
+// (POST /auth/email/change)
+func (_ Unimplemented) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Confirm email change with the token sent to the new address
+// (POST /auth/email/change/confirm)
+func (_ Unimplemented) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send an email verification link
+// (POST /auth/email/verification)
+func (_ Unimplemented) SendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Confirm email with the token from the verification email
+// (POST /auth/email/verify)
+func (_ Unimplemented) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// User login
+// (POST /auth/login)
+func (_ Unimplemented) Login(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log in with a phone number and the code from the SMS
+// (POST /auth/login/phone)
+func (_ Unimplemented) LoginWithPhoneCode(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send a one-time sign-in code by SMS
+// (POST /auth/login/phone/code)
+func (_ Unimplemented) RequestPhoneLoginCode(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send a one-time sign-in link by email
+// (POST /auth/magic-link)
+func (_ Unimplemented) RequestMagicLink(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log in with the token from a sign-in link
+// (POST /auth/magic-link/redeem)
+func (_ Unimplemented) RedeemMagicLink(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Enable two-factor authentication with the first code from the authenticator app
+// (POST /auth/mfa/totp/confirm)
+func (_ Unimplemented) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start connecting an authenticator app
+// (POST /auth/mfa/totp/enroll)
+func (_ Unimplemented) EnrollTOTP(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete login with a code from the authenticator app, a recovery code or a passkey
+// (POST /auth/mfa/verify)
+func (_ Unimplemented) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change password of the authenticated user
+// (POST /auth/password/change)
+func (_ Unimplemented) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace an expired password using the password change token issued at login
+// (POST /auth/password/expired)
+func (_ Unimplemented) ChangeExpiredPassword(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Request a change of the authenticated user's phone
+// (POST /auth/phone/change)
+func (_ Unimplemented) RequestPhoneChange(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Confirm phone change with the code sent to the new number
+// (POST /auth/phone/change/confirm)
+func (_ Unimplemented) ConfirmPhoneChange(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send a one-time verification code to the phone of the authenticated user
+// (POST /auth/phone/verification)
+func (_ Unimplemented) SendPhoneVerification(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Confirm the phone of the authenticated user with the code from SMS
+// (POST /auth/phone/verify)
+func (_ Unimplemented) VerifyPhone(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Exchange a refresh token for a new token pair
+// (POST /auth/refresh)
+func (_ Unimplemented) RefreshTokens(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register a new user
+// (POST /auth/register)
+func (_ Unimplemented) Register(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start passwordless login with a passkey
+// (POST /auth/webauthn/login/begin)
+func (_ Unimplemented) BeginWebAuthnLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete passwordless login with a passkey
+// (POST /auth/webauthn/login/finish)
+func (_ Unimplemented) FinishWebAuthnLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start registering a passkey
+// (POST /auth/webauthn/register/begin)
+func (_ Unimplemented) BeginWebAuthnRegistration(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Store a passkey created by the authenticator
+// (POST /auth/webauthn/register/finish)
+func (_ Unimplemented) FinishWebAuthnRegistration(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete the authenticated user's account
+// (DELETE /me)
+func (_ Unimplemented) RequestAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the authenticated user's profile
+// (GET /me)
+func (_ Unimplemented) GetMe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the authenticated user's profile
+// (PATCH /me)
+func (_ Unimplemented) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel the scheduled deletion of the authenticated user's account
+// (POST /me/deletion/cancel)
+func (_ Unimplemented) CancelAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export the authenticated user's data
+// (GET /me/export)
+func (_ Unimplemented) ExportMyData(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a data export of the authenticated user
+// (GET /me/exports/{export_id})
+func (_ Unimplemented) GetMyDataExport(w http.ResponseWriter, r *http.Request, exportId ExportID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUser(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportUserData operation middleware
+func (siw *ServerInterfaceWrapper) ExportUserData(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportUserData(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserDataExport operation middleware
+func (siw *ServerInterfaceWrapper) GetUserDataExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "export_id" -------------
+	var exportId ExportID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "export_id", chi.URLParam(r, "export_id"), &exportId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "export_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserDataExport(w, r, userId, exportId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequirePasswordChange operation middleware
+func (siw *ServerInterfaceWrapper) RequirePasswordChange(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequirePasswordChange(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ChangeUserStatus operation middleware
+func (siw *ServerInterfaceWrapper) ChangeUserStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangeUserStatus(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnlockUser operation middleware
+func (siw *ServerInterfaceWrapper) UnlockUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnlockUser(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequestEmailChange operation middleware
+func (siw *ServerInterfaceWrapper) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestEmailChange(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ConfirmEmailChange operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfirmEmailChange(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SendEmailVerification operation middleware
+func (siw *ServerInterfaceWrapper) SendEmailVerification(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendEmailVerification(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyEmail operation middleware
+func (siw *ServerInterfaceWrapper) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyEmail(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LoginWithPhoneCode operation middleware
+func (siw *ServerInterfaceWrapper) LoginWithPhoneCode(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LoginWithPhoneCode(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequestPhoneLoginCode operation middleware
+func (siw *ServerInterfaceWrapper) RequestPhoneLoginCode(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestPhoneLoginCode(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequestMagicLink operation middleware
+func (siw *ServerInterfaceWrapper) RequestMagicLink(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestMagicLink(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RedeemMagicLink operation middleware
+func (siw *ServerInterfaceWrapper) RedeemMagicLink(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RedeemMagicLink(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ConfirmTOTP operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfirmTOTP(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EnrollTOTP operation middleware
+func (siw *ServerInterfaceWrapper) EnrollTOTP(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EnrollTOTP(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyMFA operation middleware
+func (siw *ServerInterfaceWrapper) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyMFA(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ChangePassword operation middleware
+func (siw *ServerInterfaceWrapper) ChangePassword(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangePassword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ChangeExpiredPassword operation middleware
+func (siw *ServerInterfaceWrapper) ChangeExpiredPassword(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangeExpiredPassword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequestPhoneChange operation middleware
+func (siw *ServerInterfaceWrapper) RequestPhoneChange(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestPhoneChange(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ConfirmPhoneChange operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmPhoneChange(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfirmPhoneChange(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SendPhoneVerification operation middleware
+func (siw *ServerInterfaceWrapper) SendPhoneVerification(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendPhoneVerification(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyPhone operation middleware
+func (siw *ServerInterfaceWrapper) VerifyPhone(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyPhone(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RefreshTokens operation middleware
+func (siw *ServerInterfaceWrapper) RefreshTokens(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RefreshTokens(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Register operation middleware
+func (siw *ServerInterfaceWrapper) Register(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Register(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BeginWebAuthnLogin operation middleware
+func (siw *ServerInterfaceWrapper) BeginWebAuthnLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BeginWebAuthnLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FinishWebAuthnLogin operation middleware
+func (siw *ServerInterfaceWrapper) FinishWebAuthnLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FinishWebAuthnLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BeginWebAuthnRegistration operation middleware
+func (siw *ServerInterfaceWrapper) BeginWebAuthnRegistration(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BeginWebAuthnRegistration(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FinishWebAuthnRegistration operation middleware
+func (siw *ServerInterfaceWrapper) FinishWebAuthnRegistration(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FinishWebAuthnRegistration(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequestAccountDeletion operation middleware
+func (siw *ServerInterfaceWrapper) RequestAccountDeletion(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestAccountDeletion(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMe operation middleware
+func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProfile operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProfile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelAccountDeletion operation middleware
+func (siw *ServerInterfaceWrapper) CancelAccountDeletion(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelAccountDeletion(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportMyData operation middleware
+func (siw *ServerInterfaceWrapper) ExportMyData(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportMyData(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMyDataExport operation middleware
+func (siw *ServerInterfaceWrapper) GetMyDataExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "export_id" -------------
+	var exportId ExportID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "export_id", chi.URLParam(r, "export_id"), &exportId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "export_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyDataExport(w, r, exportId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
 		BaseURL:    baseURL,
 		BaseRouter: r,
 	})
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/users/{user_id}", wrapper.DeleteUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users/{user_id}/export", wrapper.ExportUserData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users/{user_id}/exports/{export_id}", wrapper.GetUserDataExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users/{user_id}/password/require-change", wrapper.RequirePasswordChange)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users/{user_id}/status", wrapper.ChangeUserStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users/{user_id}/unlock", wrapper.UnlockUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/email/change", wrapper.RequestEmailChange)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/email/change/confirm", wrapper.ConfirmEmailChange)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/email/verification", wrapper.SendEmailVerification)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/email/verify", wrapper.VerifyEmail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/login", wrapper.Login)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/login/phone", wrapper.LoginWithPhoneCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/login/phone/code", wrapper.RequestPhoneLoginCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/magic-link", wrapper.RequestMagicLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/magic-link/redeem", wrapper.RedeemMagicLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/mfa/totp/confirm", wrapper.ConfirmTOTP)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/mfa/totp/enroll", wrapper.EnrollTOTP)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/mfa/verify", wrapper.VerifyMFA)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/password/change", wrapper.ChangePassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/password/expired", wrapper.ChangeExpiredPassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/phone/change", wrapper.RequestPhoneChange)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/phone/change/confirm", wrapper.ConfirmPhoneChange)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/phone/verification", wrapper.SendPhoneVerification)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/phone/verify", wrapper.VerifyPhone)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/refresh", wrapper.RefreshTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/register", wrapper.Register)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/webauthn/login/begin", wrapper.BeginWebAuthnLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/webauthn/login/finish", wrapper.FinishWebAuthnLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/webauthn/register/begin", wrapper.BeginWebAuthnRegistration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/webauthn/register/finish", wrapper.FinishWebAuthnRegistration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/me", wrapper.RequestAccountDeletion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me", wrapper.GetMe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/me", wrapper.UpdateProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/me/deletion/cancel", wrapper.CancelAccountDeletion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/export", wrapper.ExportMyData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/me/exports/{export_id}", wrapper.GetMyDataExport)
+	})
+
+	return r
+}
+
+type DeleteUserRequestObject struct {
+	UserId UserID `json:"user_id"`
+}
+
+type DeleteUserResponseObject interface {
+	VisitDeleteUserResponse(w http.ResponseWriter) error
+}
+
+type DeleteUser204Response struct {
+}
+
+func (response DeleteUser204Response) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUser401JSONResponse Unauthorized
+
+func (response DeleteUser401JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser404JSONResponse NotFound
+
+func (response DeleteUser404JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser500Response struct {
+}
+
+func (response DeleteUser500Response) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ExportUserDataRequestObject struct {
+	UserId UserID `json:"user_id"`
+}
+
+type ExportUserDataResponseObject interface {
+	VisitExportUserDataResponse(w http.ResponseWriter) error
+}
+
+type ExportUserData200JSONResponse UserDataArchive
+
+func (response ExportUserData200JSONResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportUserData202JSONResponse DataExport
+
+func (response ExportUserData202JSONResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportUserData401JSONResponse Unauthorized
+
+func (response ExportUserData401JSONResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportUserData404JSONResponse NotFound
+
+func (response ExportUserData404JSONResponse) VisitExportUserDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportUserData500Response struct {
+}
+
+func (response ExportUserData500Response) VisitExportUserDataResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetUserDataExportRequestObject struct {
+	UserId   UserID   `json:"user_id"`
+	ExportId ExportID `json:"export_id"`
+}
+
+type GetUserDataExportResponseObject interface {
+	VisitGetUserDataExportResponse(w http.ResponseWriter) error
+}
+
+type GetUserDataExport200JSONResponse UserDataArchive
+
+func (response GetUserDataExport200JSONResponse) VisitGetUserDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserDataExport202JSONResponse DataExport
+
+func (response GetUserDataExport202JSONResponse) VisitGetUserDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserDataExport401JSONResponse Unauthorized
+
+func (response GetUserDataExport401JSONResponse) VisitGetUserDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserDataExport404JSONResponse NotFound
+
+func (response GetUserDataExport404JSONResponse) VisitGetUserDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserDataExport500Response struct {
+}
+
+func (response GetUserDataExport500Response) VisitGetUserDataExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RequirePasswordChangeRequestObject struct {
+	UserId UserID `json:"user_id"`
+}
+
+type RequirePasswordChangeResponseObject interface {
+	VisitRequirePasswordChangeResponse(w http.ResponseWriter) error
+}
+
+type RequirePasswordChange204Response struct {
+}
+
+func (response RequirePasswordChange204Response) VisitRequirePasswordChangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RequirePasswordChange401JSONResponse Unauthorized
+
+func (response RequirePasswordChange401JSONResponse) VisitRequirePasswordChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequirePasswordChange404JSONResponse NotFound
+
+func (response RequirePasswordChange404JSONResponse) VisitRequirePasswordChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequirePasswordChange500Response struct {
+}
+
+func (response RequirePasswordChange500Response) VisitRequirePasswordChangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ChangeUserStatusRequestObject struct {
+	UserId UserID `json:"user_id"`
+	Body   *ChangeUserStatusJSONRequestBody
+}
+
+type ChangeUserStatusResponseObject interface {
+	VisitChangeUserStatusResponse(w http.ResponseWriter) error
+}
+
+type ChangeUserStatus204Response struct {
+}
+
+func (response ChangeUserStatus204Response) VisitChangeUserStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ChangeUserStatus400JSONResponse BadRequest
+
+func (response ChangeUserStatus400JSONResponse) VisitChangeUserStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeUserStatus401JSONResponse Unauthorized
+
+func (response ChangeUserStatus401JSONResponse) VisitChangeUserStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeUserStatus404JSONResponse NotFound
+
+func (response ChangeUserStatus404JSONResponse) VisitChangeUserStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeUserStatus500Response struct {
+}
+
+func (response ChangeUserStatus500Response) VisitChangeUserStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type UnlockUserRequestObject struct {
+	UserId UserID `json:"user_id"`
+}
+
+type UnlockUserResponseObject interface {
+	VisitUnlockUserResponse(w http.ResponseWriter) error
+}
+
+type UnlockUser204Response struct {
+}
+
+func (response UnlockUser204Response) VisitUnlockUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnlockUser401JSONResponse Unauthorized
+
+func (response UnlockUser401JSONResponse) VisitUnlockUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlockUser404JSONResponse NotFound
+
+func (response UnlockUser404JSONResponse) VisitUnlockUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlockUser500Response struct {
+}
+
+func (response UnlockUser500Response) VisitUnlockUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RequestEmailChangeRequestObject struct {
+	Body *RequestEmailChangeJSONRequestBody
+}
+
+type RequestEmailChangeResponseObject interface {
+	VisitRequestEmailChangeResponse(w http.ResponseWriter) error
+}
+
+type RequestEmailChange202Response struct {
+}
+
+func (response RequestEmailChange202Response) VisitRequestEmailChangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type RequestEmailChange400JSONResponse BadRequest
+
+func (response RequestEmailChange400JSONResponse) VisitRequestEmailChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEmailChange401JSONResponse Unauthorized
+
+func (response RequestEmailChange401JSONResponse) VisitRequestEmailChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEmailChange409JSONResponse Conflict
+
+func (response RequestEmailChange409JSONResponse) VisitRequestEmailChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEmailChange500Response struct {
+}
+
+func (response RequestEmailChange500Response) VisitRequestEmailChangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ConfirmEmailChangeRequestObject struct {
+	Body *ConfirmEmailChangeJSONRequestBody
+}
+
+type ConfirmEmailChangeResponseObject interface {
+	VisitConfirmEmailChangeResponse(w http.ResponseWriter) error
+}
+
+type ConfirmEmailChange204Response struct {
+}
+
+func (response ConfirmEmailChange204Response) VisitConfirmEmailChangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ConfirmEmailChange400JSONResponse BadRequest
+
+func (response ConfirmEmailChange400JSONResponse) VisitConfirmEmailChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmEmailChange409JSONResponse Conflict
+
+func (response ConfirmEmailChange409JSONResponse) VisitConfirmEmailChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmEmailChange500Response struct {
+}
+
+func (response ConfirmEmailChange500Response) VisitConfirmEmailChangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type SendEmailVerificationRequestObject struct {
+	Body *SendEmailVerificationJSONRequestBody
+}
+
+type SendEmailVerificationResponseObject interface {
+	VisitSendEmailVerificationResponse(w http.ResponseWriter) error
+}
+
+type SendEmailVerification202Response struct {
+}
+
+func (response SendEmailVerification202Response) VisitSendEmailVerificationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type SendEmailVerification400JSONResponse BadRequest
+
+func (response SendEmailVerification400JSONResponse) VisitSendEmailVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendEmailVerification500Response struct {
+}
+
+func (response SendEmailVerification500Response) VisitSendEmailVerificationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type VerifyEmailRequestObject struct {
+	Body *VerifyEmailJSONRequestBody
+}
+
+type VerifyEmailResponseObject interface {
+	VisitVerifyEmailResponse(w http.ResponseWriter) error
+}
+
+type VerifyEmail204Response struct {
+}
+
+func (response VerifyEmail204Response) VisitVerifyEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type VerifyEmail400JSONResponse BadRequest
+
+func (response VerifyEmail400JSONResponse) VisitVerifyEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyEmail500Response struct {
+}
+
+func (response VerifyEmail500Response) VisitVerifyEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(w http.ResponseWriter) error
+}
+
+type Login200JSONResponse LoginResponse
+
+func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login202JSONResponse MFARequired
+
+func (response Login202JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login400JSONResponse BadRequest
+
+func (response Login400JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login401JSONResponse Unauthorized
+
+func (response Login401JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login403JSONResponse LoginForbidden
+
+func (response Login403JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login423ResponseHeaders struct {
+	RetryAfter int
+}
+
+type Login423JSONResponse struct {
+	Body    AccountLocked
+	Headers Login423ResponseHeaders
+}
+
+func (response Login423JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(423)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type Login428JSONResponse CaptchaRequired
+
+func (response Login428JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login500Response struct {
+}
+
+func (response Login500Response) VisitLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type LoginWithPhoneCodeRequestObject struct {
+	Body *LoginWithPhoneCodeJSONRequestBody
+}
+
+type LoginWithPhoneCodeResponseObject interface {
+	VisitLoginWithPhoneCodeResponse(w http.ResponseWriter) error
+}
+
+type LoginWithPhoneCode200JSONResponse LoginResponse
+
+func (response LoginWithPhoneCode200JSONResponse) VisitLoginWithPhoneCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginWithPhoneCode202JSONResponse MFARequired
+
+func (response LoginWithPhoneCode202JSONResponse) VisitLoginWithPhoneCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginWithPhoneCode400JSONResponse BadRequest
+
+func (response LoginWithPhoneCode400JSONResponse) VisitLoginWithPhoneCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginWithPhoneCode401JSONResponse Unauthorized
+
+func (response LoginWithPhoneCode401JSONResponse) VisitLoginWithPhoneCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginWithPhoneCode403JSONResponse LoginForbidden
+
+func (response LoginWithPhoneCode403JSONResponse) VisitLoginWithPhoneCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginWithPhoneCode500Response struct {
+}
+
+func (response LoginWithPhoneCode500Response) VisitLoginWithPhoneCodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RequestPhoneLoginCodeRequestObject struct {
+	Body *RequestPhoneLoginCodeJSONRequestBody
+}
+
+type RequestPhoneLoginCodeResponseObject interface {
+	VisitRequestPhoneLoginCodeResponse(w http.ResponseWriter) error
+}
+
+type RequestPhoneLoginCode202Response struct {
+}
+
+func (response RequestPhoneLoginCode202Response) VisitRequestPhoneLoginCodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type RequestPhoneLoginCode400JSONResponse BadRequest
+
+func (response RequestPhoneLoginCode400JSONResponse) VisitRequestPhoneLoginCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestPhoneLoginCode500Response struct {
+}
+
+func (response RequestPhoneLoginCode500Response) VisitRequestPhoneLoginCodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RequestMagicLinkRequestObject struct {
+	Body *RequestMagicLinkJSONRequestBody
+}
+
+type RequestMagicLinkResponseObject interface {
+	VisitRequestMagicLinkResponse(w http.ResponseWriter) error
+}
+
+type RequestMagicLink202Response struct {
+}
+
+func (response RequestMagicLink202Response) VisitRequestMagicLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type RequestMagicLink400JSONResponse BadRequest
+
+func (response RequestMagicLink400JSONResponse) VisitRequestMagicLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestMagicLink500Response struct {
+}
+
+func (response RequestMagicLink500Response) VisitRequestMagicLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RedeemMagicLinkRequestObject struct {
+	Body *RedeemMagicLinkJSONRequestBody
+}
+
+type RedeemMagicLinkResponseObject interface {
+	VisitRedeemMagicLinkResponse(w http.ResponseWriter) error
+}
+
+type RedeemMagicLink200JSONResponse LoginResponse
+
+func (response RedeemMagicLink200JSONResponse) VisitRedeemMagicLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemMagicLink202JSONResponse MFARequired
+
+func (response RedeemMagicLink202JSONResponse) VisitRedeemMagicLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemMagicLink401JSONResponse Unauthorized
+
+func (response RedeemMagicLink401JSONResponse) VisitRedeemMagicLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemMagicLink403JSONResponse LoginForbidden
+
+func (response RedeemMagicLink403JSONResponse) VisitRedeemMagicLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeemMagicLink500Response struct {
+}
+
+func (response RedeemMagicLink500Response) VisitRedeemMagicLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ConfirmTOTPRequestObject struct {
+	Body *ConfirmTOTPJSONRequestBody
+}
+
+type ConfirmTOTPResponseObject interface {
+	VisitConfirmTOTPResponse(w http.ResponseWriter) error
+}
+
+type ConfirmTOTP200JSONResponse RecoveryCodes
+
+func (response ConfirmTOTP200JSONResponse) VisitConfirmTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTOTP400JSONResponse BadRequest
+
+func (response ConfirmTOTP400JSONResponse) VisitConfirmTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTOTP401JSONResponse Unauthorized
+
+func (response ConfirmTOTP401JSONResponse) VisitConfirmTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTOTP500Response struct {
+}
+
+func (response ConfirmTOTP500Response) VisitConfirmTOTPResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type EnrollTOTPRequestObject struct {
+}
+
+type EnrollTOTPResponseObject interface {
+	VisitEnrollTOTPResponse(w http.ResponseWriter) error
+}
+
+type EnrollTOTP200JSONResponse TOTPEnrollment
+
+func (response EnrollTOTP200JSONResponse) VisitEnrollTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrollTOTP400JSONResponse BadRequest
+
+func (response EnrollTOTP400JSONResponse) VisitEnrollTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrollTOTP401JSONResponse Unauthorized
+
+func (response EnrollTOTP401JSONResponse) VisitEnrollTOTPResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrollTOTP500Response struct {
+}
+
+func (response EnrollTOTP500Response) VisitEnrollTOTPResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type VerifyMFARequestObject struct {
+	Body *VerifyMFAJSONRequestBody
+}
+
+type VerifyMFAResponseObject interface {
+	VisitVerifyMFAResponse(w http.ResponseWriter) error
+}
+
+type VerifyMFA200JSONResponse LoginResponse
+
+func (response VerifyMFA200JSONResponse) VisitVerifyMFAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyMFA400JSONResponse BadRequest
+
+func (response VerifyMFA400JSONResponse) VisitVerifyMFAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyMFA401JSONResponse Unauthorized
+
+func (response VerifyMFA401JSONResponse) VisitVerifyMFAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyMFA403JSONResponse LoginForbidden
+
+func (response VerifyMFA403JSONResponse) VisitVerifyMFAResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyMFA500Response struct {
+}
+
+func (response VerifyMFA500Response) VisitVerifyMFAResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ChangePasswordRequestObject struct {
+	Body *ChangePasswordJSONRequestBody
+}
+
+type ChangePasswordResponseObject interface {
+	VisitChangePasswordResponse(w http.ResponseWriter) error
+}
+
+type ChangePassword204Response struct {
+}
+
+func (response ChangePassword204Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ChangePassword400JSONResponse BadRequest
+
+func (response ChangePassword400JSONResponse) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePassword401JSONResponse Unauthorized
+
+func (response ChangePassword401JSONResponse) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePassword500Response struct {
+}
+
+func (response ChangePassword500Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ChangeExpiredPasswordRequestObject struct {
+	Body *ChangeExpiredPasswordJSONRequestBody
+}
+
+type ChangeExpiredPasswordResponseObject interface {
+	VisitChangeExpiredPasswordResponse(w http.ResponseWriter) error
+}
+
+type ChangeExpiredPassword200JSONResponse LoginResponse
+
+func (response ChangeExpiredPassword200JSONResponse) VisitChangeExpiredPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeExpiredPassword400JSONResponse BadRequest
+
+func (response ChangeExpiredPassword400JSONResponse) VisitChangeExpiredPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeExpiredPassword401JSONResponse Unauthorized
+
+func (response ChangeExpiredPassword401JSONResponse) VisitChangeExpiredPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeExpiredPassword403JSONResponse LoginForbidden
+
+func (response ChangeExpiredPassword403JSONResponse) VisitChangeExpiredPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeExpiredPassword500Response struct {
+}
+
+func (response ChangeExpiredPassword500Response) VisitChangeExpiredPasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RequestPhoneChangeRequestObject struct {
+	Body *RequestPhoneChangeJSONRequestBody
+}
+
+type RequestPhoneChangeResponseObject interface {
+	VisitRequestPhoneChangeResponse(w http.ResponseWriter) error
+}
+
+type RequestPhoneChange202JSONResponse PhoneVerificationSent
+
+func (response RequestPhoneChange202JSONResponse) VisitRequestPhoneChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestPhoneChange400JSONResponse BadRequest
+
+func (response RequestPhoneChange400JSONResponse) VisitRequestPhoneChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestPhoneChange401JSONResponse Unauthorized
+
+func (response RequestPhoneChange401JSONResponse) VisitRequestPhoneChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestPhoneChange409JSONResponse Conflict
+
+func (response RequestPhoneChange409JSONResponse) VisitRequestPhoneChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestPhoneChange429JSONResponse TooManyRequests
+
+func (response RequestPhoneChange429JSONResponse) VisitRequestPhoneChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestPhoneChange500Response struct {
+}
+
+func (response RequestPhoneChange500Response) VisitRequestPhoneChangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ConfirmPhoneChangeRequestObject struct {
+	Body *ConfirmPhoneChangeJSONRequestBody
+}
+
+type ConfirmPhoneChangeResponseObject interface {
+	VisitConfirmPhoneChangeResponse(w http.ResponseWriter) error
+}
+
+type ConfirmPhoneChange200JSONResponse User
+
+func (response ConfirmPhoneChange200JSONResponse) VisitConfirmPhoneChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmPhoneChange400JSONResponse BadRequest
+
+func (response ConfirmPhoneChange400JSONResponse) VisitConfirmPhoneChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmPhoneChange401JSONResponse Unauthorized
+
+func (response ConfirmPhoneChange401JSONResponse) VisitConfirmPhoneChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmPhoneChange409JSONResponse Conflict
+
+func (response ConfirmPhoneChange409JSONResponse) VisitConfirmPhoneChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmPhoneChange500Response struct {
+}
+
+func (response ConfirmPhoneChange500Response) VisitConfirmPhoneChangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type SendPhoneVerificationRequestObject struct {
+}
+
+type SendPhoneVerificationResponseObject interface {
+	VisitSendPhoneVerificationResponse(w http.ResponseWriter) error
+}
+
+type SendPhoneVerification202JSONResponse PhoneVerificationSent
+
+func (response SendPhoneVerification202JSONResponse) VisitSendPhoneVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendPhoneVerification400JSONResponse BadRequest
+
+func (response SendPhoneVerification400JSONResponse) VisitSendPhoneVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendPhoneVerification401JSONResponse Unauthorized
+
+func (response SendPhoneVerification401JSONResponse) VisitSendPhoneVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendPhoneVerification429JSONResponse TooManyRequests
+
+func (response SendPhoneVerification429JSONResponse) VisitSendPhoneVerificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendPhoneVerification500Response struct {
+}
+
+func (response SendPhoneVerification500Response) VisitSendPhoneVerificationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type VerifyPhoneRequestObject struct {
+	Body *VerifyPhoneJSONRequestBody
+}
+
+type VerifyPhoneResponseObject interface {
+	VisitVerifyPhoneResponse(w http.ResponseWriter) error
+}
+
+type VerifyPhone204Response struct {
+}
+
+func (response VerifyPhone204Response) VisitVerifyPhoneResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type VerifyPhone400JSONResponse BadRequest
+
+func (response VerifyPhone400JSONResponse) VisitVerifyPhoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyPhone401JSONResponse Unauthorized
+
+func (response VerifyPhone401JSONResponse) VisitVerifyPhoneResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyPhone500Response struct {
+}
+
+func (response VerifyPhone500Response) VisitVerifyPhoneResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RefreshTokensRequestObject struct {
+	Body *RefreshTokensJSONRequestBody
+}
+
+type RefreshTokensResponseObject interface {
+	VisitRefreshTokensResponse(w http.ResponseWriter) error
+}
+
+type RefreshTokens200JSONResponse LoginResponse
+
+func (response RefreshTokens200JSONResponse) VisitRefreshTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTokens400JSONResponse BadRequest
+
+func (response RefreshTokens400JSONResponse) VisitRefreshTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTokens401JSONResponse Unauthorized
+
+func (response RefreshTokens401JSONResponse) VisitRefreshTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTokens403JSONResponse LoginForbidden
+
+func (response RefreshTokens403JSONResponse) VisitRefreshTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTokens500Response struct {
+}
+
+func (response RefreshTokens500Response) VisitRefreshTokensResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RegisterRequestObject struct {
+	Body *RegisterJSONRequestBody
+}
+
+type RegisterResponseObject interface {
+	VisitRegisterResponse(w http.ResponseWriter) error
+}
+
+type Register201JSONResponse RegisterResponse
+
+func (response Register201JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Register202Response struct {
+}
+
+func (response Register202Response) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type Register400JSONResponse BadRequest
+
+func (response Register400JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Register428JSONResponse CaptchaRequired
+
+func (response Register428JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Register500Response struct {
+}
+
+func (response Register500Response) VisitRegisterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type BeginWebAuthnLoginRequestObject struct {
+}
+
+type BeginWebAuthnLoginResponseObject interface {
+	VisitBeginWebAuthnLoginResponse(w http.ResponseWriter) error
+}
+
+type BeginWebAuthnLogin200JSONResponse WebAuthnRequestOptions
+
+func (response BeginWebAuthnLogin200JSONResponse) VisitBeginWebAuthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginWebAuthnLogin500Response struct {
+}
+
+func (response BeginWebAuthnLogin500Response) VisitBeginWebAuthnLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type FinishWebAuthnLoginRequestObject struct {
+	Body *FinishWebAuthnLoginJSONRequestBody
+}
+
+type FinishWebAuthnLoginResponseObject interface {
+	VisitFinishWebAuthnLoginResponse(w http.ResponseWriter) error
+}
+
+type FinishWebAuthnLogin200JSONResponse LoginResponse
+
+func (response FinishWebAuthnLogin200JSONResponse) VisitFinishWebAuthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebAuthnLogin400JSONResponse BadRequest
+
+func (response FinishWebAuthnLogin400JSONResponse) VisitFinishWebAuthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebAuthnLogin401JSONResponse Unauthorized
+
+func (response FinishWebAuthnLogin401JSONResponse) VisitFinishWebAuthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebAuthnLogin403JSONResponse LoginForbidden
+
+func (response FinishWebAuthnLogin403JSONResponse) VisitFinishWebAuthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebAuthnLogin500Response struct {
+}
+
+func (response FinishWebAuthnLogin500Response) VisitFinishWebAuthnLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type BeginWebAuthnRegistrationRequestObject struct {
+}
+
+type BeginWebAuthnRegistrationResponseObject interface {
+	VisitBeginWebAuthnRegistrationResponse(w http.ResponseWriter) error
+}
+
+type BeginWebAuthnRegistration200JSONResponse WebAuthnCreationOptions
+
+func (response BeginWebAuthnRegistration200JSONResponse) VisitBeginWebAuthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginWebAuthnRegistration401JSONResponse Unauthorized
+
+func (response BeginWebAuthnRegistration401JSONResponse) VisitBeginWebAuthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginWebAuthnRegistration500Response struct {
+}
+
+func (response BeginWebAuthnRegistration500Response) VisitBeginWebAuthnRegistrationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type FinishWebAuthnRegistrationRequestObject struct {
+	Body *FinishWebAuthnRegistrationJSONRequestBody
+}
+
+type FinishWebAuthnRegistrationResponseObject interface {
+	VisitFinishWebAuthnRegistrationResponse(w http.ResponseWriter) error
+}
+
+type FinishWebAuthnRegistration201JSONResponse WebAuthnCredential
+
+func (response FinishWebAuthnRegistration201JSONResponse) VisitFinishWebAuthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebAuthnRegistration400JSONResponse BadRequest
+
+func (response FinishWebAuthnRegistration400JSONResponse) VisitFinishWebAuthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebAuthnRegistration401JSONResponse Unauthorized
+
+func (response FinishWebAuthnRegistration401JSONResponse) VisitFinishWebAuthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebAuthnRegistration500Response struct {
+}
+
+func (response FinishWebAuthnRegistration500Response) VisitFinishWebAuthnRegistrationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type RequestAccountDeletionRequestObject struct {
+	Body *RequestAccountDeletionJSONRequestBody
+}
+
+type RequestAccountDeletionResponseObject interface {
+	VisitRequestAccountDeletionResponse(w http.ResponseWriter) error
+}
+
+type RequestAccountDeletion202JSONResponse AccountDeletionResponse
+
+func (response RequestAccountDeletion202JSONResponse) VisitRequestAccountDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestAccountDeletion400JSONResponse BadRequest
+
+func (response RequestAccountDeletion400JSONResponse) VisitRequestAccountDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestAccountDeletion401JSONResponse Unauthorized
+
+func (response RequestAccountDeletion401JSONResponse) VisitRequestAccountDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestAccountDeletion500Response struct {
+}
+
+func (response RequestAccountDeletion500Response) VisitRequestAccountDeletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetMeRequestObject struct {
+}
+
+type GetMeResponseObject interface {
+	VisitGetMeResponse(w http.ResponseWriter) error
+}
+
+type GetMe200JSONResponse User
+
+func (response GetMe200JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMe401JSONResponse Unauthorized
+
+func (response GetMe401JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMe500Response struct {
+}
+
+func (response GetMe500Response) VisitGetMeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type UpdateProfileRequestObject struct {
+	Body *UpdateProfileJSONRequestBody
+}
+
+type UpdateProfileResponseObject interface {
+	VisitUpdateProfileResponse(w http.ResponseWriter) error
+}
+
+type UpdateProfile200JSONResponse User
+
+func (response UpdateProfile200JSONResponse) VisitUpdateProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProfile400JSONResponse BadRequest
+
+func (response UpdateProfile400JSONResponse) VisitUpdateProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProfile401JSONResponse Unauthorized
+
+func (response UpdateProfile401JSONResponse) VisitUpdateProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProfile500Response struct {
+}
+
+func (response UpdateProfile500Response) VisitUpdateProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type CancelAccountDeletionRequestObject struct {
+}
+
+type CancelAccountDeletionResponseObject interface {
+	VisitCancelAccountDeletionResponse(w http.ResponseWriter) error
+}
+
+type CancelAccountDeletion204Response struct {
+}
+
+func (response CancelAccountDeletion204Response) VisitCancelAccountDeletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelAccountDeletion400JSONResponse BadRequest
+
+func (response CancelAccountDeletion400JSONResponse) VisitCancelAccountDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelAccountDeletion401JSONResponse Unauthorized
+
+func (response CancelAccountDeletion401JSONResponse) VisitCancelAccountDeletionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelAccountDeletion500Response struct {
+}
+
+func (response CancelAccountDeletion500Response) VisitCancelAccountDeletionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ExportMyDataRequestObject struct {
+}
+
+type ExportMyDataResponseObject interface {
+	VisitExportMyDataResponse(w http.ResponseWriter) error
+}
+
+type ExportMyData200JSONResponse UserDataArchive
+
+func (response ExportMyData200JSONResponse) VisitExportMyDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportMyData202JSONResponse DataExport
+
+func (response ExportMyData202JSONResponse) VisitExportMyDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportMyData401JSONResponse Unauthorized
+
+func (response ExportMyData401JSONResponse) VisitExportMyDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportMyData500Response struct {
+}
+
+func (response ExportMyData500Response) VisitExportMyDataResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GetMyDataExportRequestObject struct {
+	ExportId ExportID `json:"export_id"`
+}
+
+type GetMyDataExportResponseObject interface {
+	VisitGetMyDataExportResponse(w http.ResponseWriter) error
+}
+
+type GetMyDataExport200JSONResponse UserDataArchive
+
+func (response GetMyDataExport200JSONResponse) VisitGetMyDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyDataExport202JSONResponse DataExport
+
+func (response GetMyDataExport202JSONResponse) VisitGetMyDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyDataExport401JSONResponse Unauthorized
+
+func (response GetMyDataExport401JSONResponse) VisitGetMyDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyDataExport404JSONResponse NotFound
+
+func (response GetMyDataExport404JSONResponse) VisitGetMyDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyDataExport500Response struct {
+}
+
+func (response GetMyDataExport500Response) VisitGetMyDataExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Delete an account
+	// (DELETE /admin/users/{user_id})
+	DeleteUser(ctx context.Context, request DeleteUserRequestObject) (DeleteUserResponseObject, error)
+	// Export a user's data
+	// (GET /admin/users/{user_id}/export)
+	ExportUserData(ctx context.Context, request ExportUserDataRequestObject) (ExportUserDataResponseObject, error)
+	// Download a data export of a user
+	// (GET /admin/users/{user_id}/exports/{export_id})
+	GetUserDataExport(ctx context.Context, request GetUserDataExportRequestObject) (GetUserDataExportResponseObject, error)
+	// Force the user to change the password on next login
+	// (POST /admin/users/{user_id}/password/require-change)
+	RequirePasswordChange(ctx context.Context, request RequirePasswordChangeRequestObject) (RequirePasswordChangeResponseObject, error)
+	// Activate, suspend, block or deactivate an account
+	// (POST /admin/users/{user_id}/status)
+	ChangeUserStatus(ctx context.Context, request ChangeUserStatusRequestObject) (ChangeUserStatusResponseObject, error)
+	// Lift the login lock and reset failed login attempts
+	// (POST /admin/users/{user_id}/unlock)
+	UnlockUser(ctx context.Context, request UnlockUserRequestObject) (UnlockUserResponseObject, error)
+	// Request a change of the authenticated user's email
+	// (POST /auth/email/change)
+	RequestEmailChange(ctx context.Context, request RequestEmailChangeRequestObject) (RequestEmailChangeResponseObject, error)
+	// Confirm email change with the token sent to the new address
+	// (POST /auth/email/change/confirm)
+	ConfirmEmailChange(ctx context.Context, request ConfirmEmailChangeRequestObject) (ConfirmEmailChangeResponseObject, error)
+	// Send an email verification link
+	// (POST /auth/email/verification)
+	SendEmailVerification(ctx context.Context, request SendEmailVerificationRequestObject) (SendEmailVerificationResponseObject, error)
+	// Confirm email with the token from the verification email
+	// (POST /auth/email/verify)
+	VerifyEmail(ctx context.Context, request VerifyEmailRequestObject) (VerifyEmailResponseObject, error)
+	// User login
+	// (POST /auth/login)
+	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// Log in with a phone number and the code from the SMS
+	// (POST /auth/login/phone)
+	LoginWithPhoneCode(ctx context.Context, request LoginWithPhoneCodeRequestObject) (LoginWithPhoneCodeResponseObject, error)
+	// Send a one-time sign-in code by SMS
+	// (POST /auth/login/phone/code)
+	RequestPhoneLoginCode(ctx context.Context, request RequestPhoneLoginCodeRequestObject) (RequestPhoneLoginCodeResponseObject, error)
+	// Send a one-time sign-in link by email
+	// (POST /auth/magic-link)
+	RequestMagicLink(ctx context.Context, request RequestMagicLinkRequestObject) (RequestMagicLinkResponseObject, error)
+	// Log in with the token from a sign-in link
+	// (POST /auth/magic-link/redeem)
+	RedeemMagicLink(ctx context.Context, request RedeemMagicLinkRequestObject) (RedeemMagicLinkResponseObject, error)
+	// Enable two-factor authentication with the first code from the authenticator app
+	// (POST /auth/mfa/totp/confirm)
+	ConfirmTOTP(ctx context.Context, request ConfirmTOTPRequestObject) (ConfirmTOTPResponseObject, error)
+	// Start connecting an authenticator app
+	// (POST /auth/mfa/totp/enroll)
+	EnrollTOTP(ctx context.Context, request EnrollTOTPRequestObject) (EnrollTOTPResponseObject, error)
+	// Complete login with a code from the authenticator app, a recovery code or a passkey
+	// (POST /auth/mfa/verify)
+	VerifyMFA(ctx context.Context, request VerifyMFARequestObject) (VerifyMFAResponseObject, error)
+	// Change password of the authenticated user
+	// (POST /auth/password/change)
+	ChangePassword(ctx context.Context, request ChangePasswordRequestObject) (ChangePasswordResponseObject, error)
+	// Replace an expired password using the password change token issued at login
+	// (POST /auth/password/expired)
+	ChangeExpiredPassword(ctx context.Context, request ChangeExpiredPasswordRequestObject) (ChangeExpiredPasswordResponseObject, error)
+	// Request a change of the authenticated user's phone
+	// (POST /auth/phone/change)
+	RequestPhoneChange(ctx context.Context, request RequestPhoneChangeRequestObject) (RequestPhoneChangeResponseObject, error)
+	// Confirm phone change with the code sent to the new number
+	// (POST /auth/phone/change/confirm)
+	ConfirmPhoneChange(ctx context.Context, request ConfirmPhoneChangeRequestObject) (ConfirmPhoneChangeResponseObject, error)
+	// Send a one-time verification code to the phone of the authenticated user
+	// (POST /auth/phone/verification)
+	SendPhoneVerification(ctx context.Context, request SendPhoneVerificationRequestObject) (SendPhoneVerificationResponseObject, error)
+	// Confirm the phone of the authenticated user with the code from SMS
+	// (POST /auth/phone/verify)
+	VerifyPhone(ctx context.Context, request VerifyPhoneRequestObject) (VerifyPhoneResponseObject, error)
+	// Exchange a refresh token for a new token pair
+	// (POST /auth/refresh)
+	RefreshTokens(ctx context.Context, request RefreshTokensRequestObject) (RefreshTokensResponseObject, error)
+	// Register a new user
+	// (POST /auth/register)
+	Register(ctx context.Context, request RegisterRequestObject) (RegisterResponseObject, error)
+	// Start passwordless login with a passkey
+	// (POST /auth/webauthn/login/begin)
+	BeginWebAuthnLogin(ctx context.Context, request BeginWebAuthnLoginRequestObject) (BeginWebAuthnLoginResponseObject, error)
+	// Complete passwordless login with a passkey
+	// (POST /auth/webauthn/login/finish)
+	FinishWebAuthnLogin(ctx context.Context, request FinishWebAuthnLoginRequestObject) (FinishWebAuthnLoginResponseObject, error)
+	// Start registering a passkey
+	// (POST /auth/webauthn/register/begin)
+	BeginWebAuthnRegistration(ctx context.Context, request BeginWebAuthnRegistrationRequestObject) (BeginWebAuthnRegistrationResponseObject, error)
+	// Store a passkey created by the authenticator
+	// (POST /auth/webauthn/register/finish)
+	FinishWebAuthnRegistration(ctx context.Context, request FinishWebAuthnRegistrationRequestObject) (FinishWebAuthnRegistrationResponseObject, error)
+	// Delete the authenticated user's account
+	// (DELETE /me)
+	RequestAccountDeletion(ctx context.Context, request RequestAccountDeletionRequestObject) (RequestAccountDeletionResponseObject, error)
+	// Get the authenticated user's profile
+	// (GET /me)
+	GetMe(ctx context.Context, request GetMeRequestObject) (GetMeResponseObject, error)
+	// Update the authenticated user's profile
+	// (PATCH /me)
+	UpdateProfile(ctx context.Context, request UpdateProfileRequestObject) (UpdateProfileResponseObject, error)
+	// Cancel the scheduled deletion of the authenticated user's account
+	// (POST /me/deletion/cancel)
+	CancelAccountDeletion(ctx context.Context, request CancelAccountDeletionRequestObject) (CancelAccountDeletionResponseObject, error)
+	// Export the authenticated user's data
+	// (GET /me/export)
+	ExportMyData(ctx context.Context, request ExportMyDataRequestObject) (ExportMyDataResponseObject, error)
+	// Download a data export of the authenticated user
+	// (GET /me/exports/{export_id})
+	GetMyDataExport(ctx context.Context, request GetMyDataExportRequestObject) (GetMyDataExportResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// DeleteUser operation middleware
+func (sh *strictHandler) DeleteUser(w http.ResponseWriter, r *http.Request, userId UserID) {
+	var request DeleteUserRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUser(ctx, request.(DeleteUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUserResponseObject); ok {
+		if err := validResponse.VisitDeleteUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportUserData operation middleware
+func (sh *strictHandler) ExportUserData(w http.ResponseWriter, r *http.Request, userId UserID) {
+	var request ExportUserDataRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportUserData(ctx, request.(ExportUserDataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportUserData")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportUserDataResponseObject); ok {
+		if err := validResponse.VisitExportUserDataResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserDataExport operation middleware
+func (sh *strictHandler) GetUserDataExport(w http.ResponseWriter, r *http.Request, userId UserID, exportId ExportID) {
+	var request GetUserDataExportRequestObject
+
+	request.UserId = userId
+	request.ExportId = exportId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserDataExport(ctx, request.(GetUserDataExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserDataExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserDataExportResponseObject); ok {
+		if err := validResponse.VisitGetUserDataExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequirePasswordChange operation middleware
+func (sh *strictHandler) RequirePasswordChange(w http.ResponseWriter, r *http.Request, userId UserID) {
+	var request RequirePasswordChangeRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequirePasswordChange(ctx, request.(RequirePasswordChangeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequirePasswordChange")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequirePasswordChangeResponseObject); ok {
+		if err := validResponse.VisitRequirePasswordChangeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ChangeUserStatus operation middleware
+func (sh *strictHandler) ChangeUserStatus(w http.ResponseWriter, r *http.Request, userId UserID) {
+	var request ChangeUserStatusRequestObject
+
+	request.UserId = userId
+
+	var body ChangeUserStatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangeUserStatus(ctx, request.(ChangeUserStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangeUserStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ChangeUserStatusResponseObject); ok {
+		if err := validResponse.VisitChangeUserStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UnlockUser operation middleware
+func (sh *strictHandler) UnlockUser(w http.ResponseWriter, r *http.Request, userId UserID) {
+	var request UnlockUserRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UnlockUser(ctx, request.(UnlockUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnlockUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UnlockUserResponseObject); ok {
+		if err := validResponse.VisitUnlockUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequestEmailChange operation middleware
+func (sh *strictHandler) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	var request RequestEmailChangeRequestObject
+
+	var body RequestEmailChangeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestEmailChange(ctx, request.(RequestEmailChangeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestEmailChange")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequestEmailChangeResponseObject); ok {
+		if err := validResponse.VisitRequestEmailChangeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConfirmEmailChange operation middleware
+func (sh *strictHandler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	var request ConfirmEmailChangeRequestObject
+
+	var body ConfirmEmailChangeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfirmEmailChange(ctx, request.(ConfirmEmailChangeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfirmEmailChange")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConfirmEmailChangeResponseObject); ok {
+		if err := validResponse.VisitConfirmEmailChangeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SendEmailVerification operation middleware
+func (sh *strictHandler) SendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	var request SendEmailVerificationRequestObject
+
+	var body SendEmailVerificationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SendEmailVerification(ctx, request.(SendEmailVerificationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SendEmailVerification")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SendEmailVerificationResponseObject); ok {
+		if err := validResponse.VisitSendEmailVerificationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifyEmail operation middleware
+func (sh *strictHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var request VerifyEmailRequestObject
+
+	var body VerifyEmailJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyEmail(ctx, request.(VerifyEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyEmail")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyEmailResponseObject); ok {
+		if err := validResponse.VisitVerifyEmailResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Login operation middleware
+func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var request LoginRequestObject
+
+	var body LoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Login")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoginResponseObject); ok {
+		if err := validResponse.VisitLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LoginWithPhoneCode operation middleware
+func (sh *strictHandler) LoginWithPhoneCode(w http.ResponseWriter, r *http.Request) {
+	var request LoginWithPhoneCodeRequestObject
+
+	var body LoginWithPhoneCodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LoginWithPhoneCode(ctx, request.(LoginWithPhoneCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LoginWithPhoneCode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoginWithPhoneCodeResponseObject); ok {
+		if err := validResponse.VisitLoginWithPhoneCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequestPhoneLoginCode operation middleware
+func (sh *strictHandler) RequestPhoneLoginCode(w http.ResponseWriter, r *http.Request) {
+	var request RequestPhoneLoginCodeRequestObject
+
+	var body RequestPhoneLoginCodeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestPhoneLoginCode(ctx, request.(RequestPhoneLoginCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestPhoneLoginCode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequestPhoneLoginCodeResponseObject); ok {
+		if err := validResponse.VisitRequestPhoneLoginCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequestMagicLink operation middleware
+func (sh *strictHandler) RequestMagicLink(w http.ResponseWriter, r *http.Request) {
+	var request RequestMagicLinkRequestObject
+
+	var body RequestMagicLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestMagicLink(ctx, request.(RequestMagicLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestMagicLink")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequestMagicLinkResponseObject); ok {
+		if err := validResponse.VisitRequestMagicLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RedeemMagicLink operation middleware
+func (sh *strictHandler) RedeemMagicLink(w http.ResponseWriter, r *http.Request) {
+	var request RedeemMagicLinkRequestObject
 
-	if r == nil {
-		r = chi.NewRouter()
+	var body RedeemMagicLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RedeemMagicLink(ctx, request.(RedeemMagicLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RedeemMagicLink")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RedeemMagicLinkResponseObject); ok {
+		if err := validResponse.VisitRedeemMagicLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConfirmTOTP operation middleware
+func (sh *strictHandler) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	var request ConfirmTOTPRequestObject
+
+	var body ConfirmTOTPJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfirmTOTP(ctx, request.(ConfirmTOTPRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfirmTOTP")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConfirmTOTPResponseObject); ok {
+		if err := validResponse.VisitConfirmTOTPResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// EnrollTOTP operation middleware
+func (sh *strictHandler) EnrollTOTP(w http.ResponseWriter, r *http.Request) {
+	var request EnrollTOTPRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EnrollTOTP(ctx, request.(EnrollTOTPRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EnrollTOTP")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EnrollTOTPResponseObject); ok {
+		if err := validResponse.VisitEnrollTOTPResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifyMFA operation middleware
+func (sh *strictHandler) VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	var request VerifyMFARequestObject
+
+	var body VerifyMFAJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyMFA(ctx, request.(VerifyMFARequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyMFA")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyMFAResponseObject); ok {
+		if err := validResponse.VisitVerifyMFAResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ChangePassword operation middleware
+func (sh *strictHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var request ChangePasswordRequestObject
+
+	var body ChangePasswordJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangePassword(ctx, request.(ChangePasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangePassword")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ChangePasswordResponseObject); ok {
+		if err := validResponse.VisitChangePasswordResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ChangeExpiredPassword operation middleware
+func (sh *strictHandler) ChangeExpiredPassword(w http.ResponseWriter, r *http.Request) {
+	var request ChangeExpiredPasswordRequestObject
+
+	var body ChangeExpiredPasswordJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangeExpiredPassword(ctx, request.(ChangeExpiredPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangeExpiredPassword")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ChangeExpiredPasswordResponseObject); ok {
+		if err := validResponse.VisitChangeExpiredPasswordResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequestPhoneChange operation middleware
+func (sh *strictHandler) RequestPhoneChange(w http.ResponseWriter, r *http.Request) {
+	var request RequestPhoneChangeRequestObject
+
+	var body RequestPhoneChangeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestPhoneChange(ctx, request.(RequestPhoneChangeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestPhoneChange")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequestPhoneChangeResponseObject); ok {
+		if err := validResponse.VisitRequestPhoneChangeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConfirmPhoneChange operation middleware
+func (sh *strictHandler) ConfirmPhoneChange(w http.ResponseWriter, r *http.Request) {
+	var request ConfirmPhoneChangeRequestObject
+
+	var body ConfirmPhoneChangeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfirmPhoneChange(ctx, request.(ConfirmPhoneChangeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfirmPhoneChange")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConfirmPhoneChangeResponseObject); ok {
+		if err := validResponse.VisitConfirmPhoneChangeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SendPhoneVerification operation middleware
+func (sh *strictHandler) SendPhoneVerification(w http.ResponseWriter, r *http.Request) {
+	var request SendPhoneVerificationRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SendPhoneVerification(ctx, request.(SendPhoneVerificationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SendPhoneVerification")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SendPhoneVerificationResponseObject); ok {
+		if err := validResponse.VisitSendPhoneVerificationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifyPhone operation middleware
+func (sh *strictHandler) VerifyPhone(w http.ResponseWriter, r *http.Request) {
+	var request VerifyPhoneRequestObject
+
+	var body VerifyPhoneJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyPhone(ctx, request.(VerifyPhoneRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyPhone")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyPhoneResponseObject); ok {
+		if err := validResponse.VisitVerifyPhoneResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RefreshTokens operation middleware
+func (sh *strictHandler) RefreshTokens(w http.ResponseWriter, r *http.Request) {
+	var request RefreshTokensRequestObject
+
+	var body RefreshTokensJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RefreshTokens(ctx, request.(RefreshTokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RefreshTokens")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RefreshTokensResponseObject); ok {
+		if err := validResponse.VisitRefreshTokensResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Register operation middleware
+func (sh *strictHandler) Register(w http.ResponseWriter, r *http.Request) {
+	var request RegisterRequestObject
+
+	var body RegisterJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Register(ctx, request.(RegisterRequestObject))
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Register")
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/auth/login", wrapper.Login)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/auth/register", wrapper.Register)
-	})
-
-	return r
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type LoginRequestObject struct {
-	Body *LoginJSONRequestBody
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RegisterResponseObject); ok {
+		if err := validResponse.VisitRegisterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type LoginResponseObject interface {
-	VisitLoginResponse(w http.ResponseWriter) error
-}
+// BeginWebAuthnLogin operation middleware
+func (sh *strictHandler) BeginWebAuthnLogin(w http.ResponseWriter, r *http.Request) {
+	var request BeginWebAuthnLoginRequestObject
 
-type Login200JSONResponse LoginResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BeginWebAuthnLogin(ctx, request.(BeginWebAuthnLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BeginWebAuthnLogin")
+	}
 
-func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BeginWebAuthnLoginResponseObject); ok {
+		if err := validResponse.VisitBeginWebAuthnLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Login400JSONResponse BadRequest
-
-func (response Login400JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// FinishWebAuthnLogin operation middleware
+func (sh *strictHandler) FinishWebAuthnLogin(w http.ResponseWriter, r *http.Request) {
+	var request FinishWebAuthnLoginRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body FinishWebAuthnLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type Login401JSONResponse Unauthorized
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FinishWebAuthnLogin(ctx, request.(FinishWebAuthnLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FinishWebAuthnLogin")
+	}
 
-func (response Login401JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FinishWebAuthnLoginResponseObject); ok {
+		if err := validResponse.VisitFinishWebAuthnLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Login500Response struct {
-}
+// BeginWebAuthnRegistration operation middleware
+func (sh *strictHandler) BeginWebAuthnRegistration(w http.ResponseWriter, r *http.Request) {
+	var request BeginWebAuthnRegistrationRequestObject
 
-func (response Login500Response) VisitLoginResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BeginWebAuthnRegistration(ctx, request.(BeginWebAuthnRegistrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BeginWebAuthnRegistration")
+	}
 
-type RegisterRequestObject struct {
-	Body *RegisterJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type RegisterResponseObject interface {
-	VisitRegisterResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BeginWebAuthnRegistrationResponseObject); ok {
+		if err := validResponse.VisitBeginWebAuthnRegistrationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Register201JSONResponse RegisterResponse
-
-func (response Register201JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// FinishWebAuthnRegistration operation middleware
+func (sh *strictHandler) FinishWebAuthnRegistration(w http.ResponseWriter, r *http.Request) {
+	var request FinishWebAuthnRegistrationRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body FinishWebAuthnRegistrationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type Register400JSONResponse BadRequest
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FinishWebAuthnRegistration(ctx, request.(FinishWebAuthnRegistrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FinishWebAuthnRegistration")
+	}
 
-func (response Register400JSONResponse) VisitRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FinishWebAuthnRegistrationResponseObject); ok {
+		if err := validResponse.VisitFinishWebAuthnRegistrationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Register500Response struct {
-}
+// RequestAccountDeletion operation middleware
+func (sh *strictHandler) RequestAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	var request RequestAccountDeletionRequestObject
 
-func (response Register500Response) VisitRegisterResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	var body RequestAccountDeletionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// User login
-	// (POST /auth/login)
-	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
-	// Register a new user
-	// (POST /auth/register)
-	Register(ctx context.Context, request RegisterRequestObject) (RegisterResponseObject, error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestAccountDeletion(ctx, request.(RequestAccountDeletionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestAccountDeletion")
+	}
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	response, err := handler(r.Context(), w, r, request)
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequestAccountDeletionResponseObject); ok {
+		if err := validResponse.VisitRequestAccountDeletionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+// GetMe operation middleware
+func (sh *strictHandler) GetMe(w http.ResponseWriter, r *http.Request) {
+	var request GetMeRequestObject
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMe(ctx, request.(GetMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMe")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMeResponseObject); ok {
+		if err := validResponse.VisitGetMeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Login operation middleware
-func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
-	var request LoginRequestObject
+// UpdateProfile operation middleware
+func (sh *strictHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	var request UpdateProfileRequestObject
 
-	var body LoginJSONRequestBody
+	var body UpdateProfileJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -411,18 +4773,18 @@ func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+		return sh.ssi.UpdateProfile(ctx, request.(UpdateProfileRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Login")
+		handler = middleware(handler, "UpdateProfile")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LoginResponseObject); ok {
-		if err := validResponse.VisitLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateProfileResponseObject); ok {
+		if err := validResponse.VisitUpdateProfileResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -430,30 +4792,73 @@ func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Register operation middleware
-func (sh *strictHandler) Register(w http.ResponseWriter, r *http.Request) {
-	var request RegisterRequestObject
+// CancelAccountDeletion operation middleware
+func (sh *strictHandler) CancelAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	var request CancelAccountDeletionRequestObject
 
-	var body RegisterJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelAccountDeletion(ctx, request.(CancelAccountDeletionRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelAccountDeletion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelAccountDeletionResponseObject); ok {
+		if err := validResponse.VisitCancelAccountDeletionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportMyData operation middleware
+func (sh *strictHandler) ExportMyData(w http.ResponseWriter, r *http.Request) {
+	var request ExportMyDataRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Register(ctx, request.(RegisterRequestObject))
+		return sh.ssi.ExportMyData(ctx, request.(ExportMyDataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Register")
+		handler = middleware(handler, "ExportMyData")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RegisterResponseObject); ok {
-		if err := validResponse.VisitRegisterResponse(w); err != nil {
+	} else if validResponse, ok := response.(ExportMyDataResponseObject); ok {
+		if err := validResponse.VisitExportMyDataResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMyDataExport operation middleware
+func (sh *strictHandler) GetMyDataExport(w http.ResponseWriter, r *http.Request, exportId ExportID) {
+	var request GetMyDataExportRequestObject
+
+	request.ExportId = exportId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMyDataExport(ctx, request.(GetMyDataExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMyDataExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMyDataExportResponseObject); ok {
+		if err := validResponse.VisitGetMyDataExportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -464,25 +4869,157 @@ func (sh *strictHandler) Register(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xXYW/bNhP+KwTfAO27yLLkSK6jT2k6YHBQbF2KFAPiNKDFc8RWIlWSSuJm/u8DScmx",
-	"bDkxWhcbsH2yZPJ4zz139/D0gFNRlIID1wonD1ilGRTEPp4Seg5fKlDavJVSlCA1A7tGQROWmye4J0WZ",
-	"A07wLckZJZoJjmaE5UATNGOQU/QCCsLyF4gpJOFLxSRQ7GE9L42V0pLxG7zwsNJEV6p1ZhQEy42Ma7gB",
-	"aXZqps3qqvNTQlGDtuNs98eqwZTQntxmsPDwEmly6VYbt0ugXsPC1dJeTD9Bqo3Dt+KG8a30WUIcjyqV",
-	"rDSk4QR/MAwiu4gIpRKUQi/j3iCOUZoRqf6PvZUIKgXypH71U1FgD8+ELIjGSe3AwwW5fwv8Rmc4GcSx",
-	"hwvGm/fYwyXRGqTx/PHy42Sirg5P6t/JxK+fDrroLIlSd0LSzRDe1SvoZdgLB6Mu3ArSSkJzRDg4auMM",
-	"B6MWzvC57DSxLkE9kQ9VCq5gMyEkTUGpay0+A2/XCczPsukvKfuNnY0vvo7DX9lYjfl5nL4ZD8efyz8+",
-	"vDk79n2/iyW4L5kEdc34Jk+vrUNkHSK70XWOZgUgxpGCVHCqVok7GnZ3g4SZBJXtGbw97Xqzb06BSJBd",
-	"FqYezd4DCTOc4P/1H5WlX8tK/8LsWU+gNfTaOVgPqwWoRW1Xts/hhikNct8N6KGiUhqlgmvCODpBhFNE",
-	"RUEY/8f0JicFbEZmiEdmyTZmEDTxpIRzodEUkOD5HN1lTIMqSQrteM5ExtHPAtZa1RRku1VXYE8m7/2f",
-	"Dv40v98sIqM9icioy38meAdTY24CsN1IcmQ3IV4VU5DIpdCiGjYEKk2kVuiO6QwdtjEehoOjKB6+Gh0H",
-	"a+iG6+BatB1ehr3jq8mEPgy9MFoc4J0V0EZUl8AzgvjYIv9p4r9CEy84qXQmJPsKdLeBjnE70qFUAgWu",
-	"GcnVznNbuOPc1kK10+BWPWnx/ZPbRZ2yLRfGkxq/AZ/Rtk0cBzCKgqAHg+NpLwpp1COvwmEviobDOI6i",
-	"IAiC1buiqhh9SuQ7FXq70m3Tpu+THwux0SCLbJNXY8P4THR0/buxEVZk6EQmtabUUtf55nqVVqicFPjL",
-	"XCb4d3Ozo9eVztB7kLcsNaHfglTu2NAP/MAEL0rgpGQ4wUd+4B+5aDOb1L5x18/NaGgzLtyoYPJu3Y0p",
-	"TtzkiF3EoPSpoHOzycwAwO1+UpZ5jbj/SQn++B31XOu3vhIWbV61rMD+4fTZAh4Ewb591+pvnbcTYzcg",
-	"VVkJmlW5ITPaI4CV78sO7+NafBgvK40o0cT5D/fmvyU9HQja6x6OXeyd00KOFMhbkAikFE7NVVUURM6b",
-	"2ctVmVlwVSfr+3d74TU39A+qvfUZeafyC3+A++0VaIl7LMB8jhrWXEb+7mr85opogkcEcbiz0ue8OhuF",
-	"k8sHXMkcJzjTukz6/VykJM+E0skoGAV4cbX4KwAA//8ixC9WuhEAAA==",
+	"H4sIAAAAAAAC/+x9+1MbObrov6Ly3VtJam1jCLAZUlNnHSAZZoBwgMzjDnN85G7Z1qYt9UpqiDeV//2W",
+	"Pkn9VLcbsIHM5KcEd7ce31vfS587AZ/HnBGmZGfvcyfGAs+JIgL+OvwUc6GODvT/KevsdWKsZp1uh+E5",
+	"6ex1CDwe0bDT7Qjy74QKEnb2lEhItyODGZlj/eGEizlWnb1OksCbahHrj6USlE07X750Ox8kEbWTJJKI",
+	"+07xxb0MuxoGAU+YOiARUZSzcyJjziSB7QseE6EogRdD+8ZIfxwmEQlHWJkHMhA01s86e51fZoQhNSMI",
+	"m4HRDY0iNCYIvichSlhEpIRX3JCIShRgFpAoInrF5BOex5Fe9NZga7s32OoNNi83t/YGg73B4P91utkW",
+	"Q6xIT9E58YIyg9HvNcv/I/2Mj/9FAtX50nUQOebBRxL64KAwjfT/smW6vVKJFJnHXGBBowWKYAiEJ4oI",
+	"pDhHc8wWaIJpREIU8SllCCv9gZLV9evlK7EYwddVOF+QgLNQooQpGgE09WyIfIqpIDIPxJeDQTo4ZYpM",
+	"idCjS4VVIgv72N566XtTURWR4oYtjJAFkmfx5gcPkHpRzTclhMFTN3m63K6DfxE8XjyKYEavSXh4TZiq",
+	"4jEQBKuUiNtQVFc/BBbDYUg1HnB0lhvTMGIRTTA5ivEi4jhEWCKpuMjvPlsvBWJrAUgtIc7JlEpFRAtA",
+	"ZkKgm9+03U0T5I41iVYhdxuIBVQtvNsCWhD+ZzT2/gzCD08tNps3DRukcafwVdNez7CUH8liNXRCw8K7",
+	"fkHc7URYqlEibzm4UQWf22AdXi1gvREEgk9o5BH9+BorLEaJiLx4GVOhZiO95somvCRxF8arUz7tPidz",
+	"K7L9T0bXRNAJXROSeYAj4p18ThR2IuVvgkw6e53/s5GZIBtWTW9ohj9x7+rvJnhEGB5HJC8yxpxHBLMG",
+	"Eul2YizlDRfhKJhhNr3lhuMZZzXD6id3g2KmhpZB4MK8CQppTv5Tt5YkDm9NXlpG3IKtDDk5gKRs5gNu",
+	"EVk5NVaQxLk1+xj0DQ7Pyb8TIlU7c+QaRzTEYFkZY2MPTSiJQvQMVv5M2ynpphpxkpkGfiPCYxq8wSFy",
+	"q21lF4xx2BN1H9zOKPABbx/HKpjh83SUNhCUPLomYFXtD88u938YIsxCJEhMsIKf7YLRDVUzFJgpRop/",
+	"JKwtQLdetQSoW8F5A8aqULVr6tXjeQWgBTo/BJMzPLPUX0upjNyMHItU7dlTcoPcU/T8VW9z6xUKZljI",
+	"F100T6RCjCs0xyqYIUECwlS0QFp1ph8VbN4OuSZszgWRJEgE2d7Z1ZyIPx0TNlWzzt6mhv6cMvf3q+5S",
+	"WWmxW1n4pf4ZTQSfA2GkezCWuLPzhTtXFabdXIYU/xq6RVjWo2YpToJECMJUA172zRs53GzmcFOAuYG1",
+	"e3Fz62UzzDd9ts1XRSMlZFWA2RpPmXqrxZQgWHLmO28vgO4Mv8JJ2mgf9NwcNYyI0q9oLQdyjJoTut6/",
+	"KqJwf4bFlIxx8BElLCQCUXZNpKJT0CZF+OwMBhWI3EWfl6BoB/ACi7MJFfNDrcQM4GrB1Y5ZQR0iqelb",
+	"cfiFkRuEw1AQKYu73drZvR3rmhU0bONMWw9LthHwkFR3sdsL6ZQqpJ9mm7k4uahshSXzMREFFG9uvTSk",
+	"HmOliNDj/c/VVfh598vfluoIWE7Dli7fX56tZC84UTPCFA2w4gLhOH6YLUQ0aGliAQ0iHAmCwwUin6j0",
+	"+3C8ptR3bTW/W1I7jV/79v01/QFW2Pg/V3NAtm6qZg+i8ahqkWZ9h53urY5mxWEP+A0DJwzQlznyGoLb",
+	"mJMNM5fc+EzDL+g5F+atcE4Z0pC7xpGVlUvPezmMs2QOSpywUD/UWMDhQgM4w1r2sMXBw396yAHTh7u3",
+	"lFE5+4WMh4maMfDp1POoIKHmOxwtk+BuvKGU+nPOqpyWjbV8WcafJbDxQa9udUoRDTRYX3YuLlLGMR6T",
+	"CMkZv2FONcbGG4QiKhV6HpIJTiKFrjrWS3TVKarNExy84fxjUWPsbi+VRc0QAlS95WJMw9CnzM4EH0dk",
+	"jgzfSjTRohIJMkmkszv76H/1sP+rmegqtSZ71ja96qDn7jdrNiBlNKQ+LkqEBUGSqBdddGUOvT3GVc+d",
+	"8686+neYx/4WAKTTM4ceX9Ip61GrczEopZBc04AgLrTLGr4wNsrx+3dHp6Pzo4ufRheXh2ejD2eIsz1E",
+	"QQRoJJsAwtgaPHbgiLKPBb2nzZxn0uh2WLlzPMtEam5zC3c/j41D+qqjV5T9HBIcKHqt2eyq079inW4L",
+	"tZBCc4ZlegLQJheYp2PijLMmmUh9qPZiCb4wANSSUBOvtGGB52VcI86iRYFqd/1H+pbHnosZF6oXab+h",
+	"XQ0OAhIrO5PG0tn7i0u0oVX5hht0w62mfn3tVGjbQEUKOHtKbadKy4tbh0otC+JS3OATDvTxhTOC+ARR",
+	"kBMTSoQmUmO2OpKa0mtSpc6iT6LJEMYIvB1h6uq4oeGUqH7qcDAItVEszXlEXMNJQt4Q/XhbH8NK7oar",
+	"0mFhe/DdbpNjNiSxIIFmNn8c5Wft0rIbt/Y5er7T29rZsSfAPvogSR5OlElFcDGcqEXDP+2f/YDP8/rc",
+	"OfUKNv9OwebfKVicv//P1ZX84+//tP9eXfXt//7mN0ncyjzI1lN3EbgTrcmu0ey8kn00RNc4SghSM6z0",
+	"SU8oaUTm37sII2NAc4GeG8eeCTThwnh72THQRl7A4u7qz6zOY9iEtJABiSa7sx/enx6ODg7fDj8cX47O",
+	"D98dvT8FzGYg/fsm2hps9nZ2dnqDypG/DMDNBjdLg9Bbo8uhxt/SxLF1AXIt/6TMGC5bJVn8OBu/C+h7",
+	"+uPRh/8cbZ7SI3nEzneC/aPdo4/xrz/v//hdv9+/rVIYwoRLdUEhFlwj9AWZCCJnK148jDaqitc3BAs4",
+	"lXod8W18BxXEwYfdIg7K2yosqABaH7ZP3g7rfcZNaDl5O7wdTvwomRM146H0ox30rBkLTXCguNBDUkXm",
+	"hZOH4ioGMAT8mojFCA6/3c4NGWutnN93WRt2sBB44YJNK7EB5hO8AWbiwof5dE0tLXurOd/DWqpepGzV",
+	"BURnYPWiHE9pcEzZx9oTSE5fVQR4qpcU1/ZoWLVSFS/qrKehm0qQM3P5wHPK1VuesJYhFL0fo3RoiJ7t",
+	"7AzIq+3BoEe2vhv3tjfD7R7+x+Zub3t7d3dnZ3t7MBgMnoH/dgJTtLUDt1vagadcobd1I1cllD7m1Czk",
+	"/pYfOP5AmezzsN73l4ZbS5aQPX2VzIVJPumqq0UN5BoZVZ6yJVZI5E7a7TX5y60CtS092prFN+/+lp7C",
+	"/bK38+4+wVwse63779a7GwEMl4vYs1H9q0NoAcn25GsNuGfS/k7ZFMURZn00oZ9IOIooIyMuRnM+phHR",
+	"NiE4BG5mRJiYpn4ZhZxIYDhFIkggmyMcY6HQ8w8XXbSPGQ7xi9coYR+Zdoxo54KZzuUwoTGZ8PyI9mxr",
+	"zspOBWVL0gCFFXW6uV+zhYJ+jqLRRBACxxkyp8l8JEwaiZxhQcJRwCFKfM0htycmQmqTdZT6uWM8hX8T",
+	"zMxrgZOddiNF71s2c5lAAD8/57waF94ssiZDAAj2XjaAIFqRtEsBNL4VcKMHmOmDoY1RF1Mqdz0TlTVA",
+	"Xl8WluAj5HNrXOjdSl/cKmd7eKyZ98y4cWHlxocV8elU0zQ1fiGeqOUxgd87eByEPTKZznr0Xx+j3pzx",
+	"uPNHziZaYuiUYFBatn/jISHz5TZDu1BU3lBYW+Tp3NjDMHVTqLF0GCgu3A6CVLYBjGJBrilPpA13c4Hs",
+	"KLcMeBfn9u/BZD7Wa4+vzfNxGz+HDXQHnClMGfonOBdDPseUveijE7zQjK8ZW7jjPP2Pdredv91Huzsv",
+	"N7voA6MgJLhAccIW8P90gA+M/jshTM8KkWQCCczcOAgCzDijgfUQpCaHWeXr/B/6448kVtoPQfRiUq3w",
+	"FFwx/iiAPlYi42451QsxgFMcnb7d7xpD9mZGFZExDggSCdM+6SjCsTSvaT1tnmmUKEHncxK+Nuia4WuC",
+	"NnubgwFgEQdKq1FHV1j10T5nSvCoi8Y0pIIE1hVjovXXVNJxRKw1p7RszA8jCBJEs0cVyj/yGUMHnJTc",
+	"IoNBAZh3dcu8WpFb5lWTgVaaPG8PrcqlpU0jZuMMUvP/hcaj7KIQyxn8y5UEXMRYEKZmRBIDdxxF/IaE",
+	"fXRhTCLK0GF/c3e7f7VG23pJ+uESqfnNffWXcF9Z9Vgp7/kqEsGWJVY1bLhNctBTzHqrsQd0yltD1KOw",
+	"EkZunoIDqSYNrt6vZPHUJh9KD1SjGTSk4JFfLdxeK1QF+JYV4Nv3FODZLnzguCAsBCrOnz7v7ZE0ntev",
+	"2v+os8sOmeBRNPeexbmK9RlxlAjqMe/OjzQYBIG0RoiP/fc5sg7xDAp2jL2NDcVVvPHfGuj/d2ugXc57",
+	"ZQj9F46mXFA1m39/8cNw8yoZDLZ2IRgnv981f1EpEyK+h2H+rgcxP8dEUB5+/3Jg/pQkEER9/+Obi19+",
+	"e3lwdvjD2U8vz349K//tdYrCp9XtvsGSvNxC5jGcqueYJThCBDjCckJzmt3t11NCpV1ct4AZL2Y5P8Fs",
+	"YYlctqxyyLGHYfEbXV+nxbbL/r1zVaXi6AZT5Vxc8I3J0sol8Leuqmyb73fJOdJwQCkgWjmrFec9XVna",
+	"E7Wfrbq28gPT+ORCn5HaYYsyqEpBWc5T+3zJzZbwK6yqFeiSxi/u7+r/AIU9trCuVoYX6+tKVvJY8ihR",
+	"BM2UirUS0/9K9OH8+DUi81gtkFktCiKChURUFXgY3t7b2AhC1s+Jrg0zpdxwcZl+zKYlAT/YftVdVuxX",
+	"ORkqpB9p+fLbb7/91js56R0cWD3bar2b33036A22e1ubRVf+c+3L3/7S0/9suX9e/NffmivuSjJx/wxt",
+	"/wNFmE0TPCVI4WmrNcWq9+a8pPF3uqsr5/P7I7Q9k3zzSRT4N1f3VwTW0fB0aA6O+jmArBVqDxPNiBtv",
+	"iIgoW5q+WSwULNF+Mo5ogGaYhRExARfry6YMPX/ZezlAEVEAHD4xiIGvuyZ9R6JB77suejZ6BvB91n/2",
+	"upDog+3XL1JXQ4Al6U14FKYY1cGcsSbrj4SVdi/InF+Tyvb/xWesH3pRURWFVeFmT7vthZkVhCimgUoE",
+	"eY3wGPQ1+F/08iW5l/jKkrMF7dxSeA2XLKUgmZYWOa+qW4YtN7Q5rVbBl+FWaKSRTriqRho5X3XjaWFJ",
+	"bbV382pmPesg6XTSbGAqSUiYK9DJCnLSBZgcwWrls8n8z9bZJu+gTVZ/nU45E2RCBNT5ObUCZwura0DF",
+	"LCNyp19WrlC8MvdOnlbn17TivnQ03hps7uzsWMdmIf7+9983e9/9AXH47uZ2Qyg+dbM17TOLmVdqzu9E",
+	"XTl3AWZQP1U+D05wJL10dt+y9Sb1tZxiymrrrnqqW9Qiy6bNqYtbVMlb93RJGlQQ2G2q/NOA1DVItlVF",
+	"VetALaMnvHyog7guYQFecnErDaIu0juXCk2ogLyCNFrchNViYxlPytyUMCJuXRMFpkLtFuBpV8f40/W+",
+	"RgQHM/PEGAtaYSBJpKSc3XY3ptmLZze2FsazMh32ttW1+gWwXMAdStUCwSe3XINrwuJbRdacpNVI9vUy",
+	"ZRZQk42aQj+33a4jqjqCPMmJ5/YdgYZxHDmvhf4a4bHLcNAk2Uf7NuqKp5gyaZ78ePH+FF3AHrUs/nBx",
+	"eD46ObwcHgwvh6OL/R8OT4ajt0fHh6VI1mttP8y5VGhzF/1E32ja10MVLfLPHZ2+09nT0NBsSLDmpJ06",
+	"k+8iFX2VeA6YMIaN++g9xM51DU1q3kCbL02wJj8t1DvW+6PChIFkLnHIfKnX48p2Ot3OOG0elSvPKab0",
+	"pN9VGAz8qQvwjN4zWaPgevI6R1eWsmEWbZOR25SKmBhoIX8FQO0SbNO6ESPdn0SNbmOWcQn2W4OttJsC",
+	"uO6zDGPgYb/PLwcNbw6S0wruTZuOBP7b0HCVzuu+gYQG18wlR3Se5KMVJDnXF1dm8KonGjCX7l2Ofa9E",
+	"y7bF19UNe9KOpK7B5BPE8DWdaqLr55yJ/SlRz18gSQS1bhKw64y58xNZ7Kev9hXXMvD5iwr1+8qGs+/Q",
+	"0UEXjbEku9v6cOsls9rgep5XDqzOKE7UOHIQUcKU/lAv/ZYf69QyrBJBbvmdVkc/gJ14qw/LOC8uveuB",
+	"RX6JPupI/bauoBqQ2tOWwh+36X6X4qeRBHMlw7cmQlOX/YTpMNvce7PzB6PDpWRRWdqjk8K+IGY9tsik",
+	"qjjMA/D0+TAcYyFJaZS3gs8N3l+jMWVYLPLV1nkA1qKuXKvAvOZOgc8uSEQC921xYEEkFCf+RBbFgZta",
+	"hGnZkI9PF7+MnVekszzfM5vcM6oPO8EMRxFhU3/7N/IpiJKQZEgwHNXmFJJDu/32wGKbC++RJBlbdJ9h",
+	"gefFiUrIi6YFCPX+4Q1p3YO4LV3rier5Jlu7iKuLLPvOlrj3ql4mCHMjDcN2HgIYwbtaOic88QinExpF",
+	"1J8/P/CnhiVeJ3VIZRzhxemtHGU1DVqrcFjuHG1o1plfWhU2pQ8zXgCUdl0iWYU0M5h6WaRWWhTk8jJh",
+	"mWvOcf8WMZlaHd1XH7bs1FlFZNbXowX+igtu23y1UejUsOjdIbECCUPDxn2U6jLvpjKLg9xZY+rk3IdQ",
+	"BM0aScRHtxOsK5Z+9Wq6dW/Iopg5CgvipALmVnrc5C6BqxD8WhZl4ZyyYUytLUL1rmcEh0Q4htrr/Nob",
+	"6rd6w5j2jNng8GG+03E+ggURoIj2Ptu/3jr2//GXy45tgQ9O/VI2rw45mi75lE24x811dgTEa7rpZVJT",
+	"u4JMk9CsvrKfpovklSO6IOKaBqTT7VwTIc2wm/1Bf6DXzmPCcEw7e52X/UHfRlRmAJ0NAM+GnllufLZ3",
+	"AXxJm/P7SglzoUXtEuQT1TPvhtBYR7vjGEcRZ1Mi8o45qmxmtovlQ1OhLhpbVyV4Ll2VhwYHuCTPPpy/",
+	"OxydH14enl4evT8dHQx/u+ijYZpTUAh1umkEibSABNeLCN18Ol5tWue4Ir90TiIwhNe1d0IPkPfuyz6y",
+	"qY/MVchKhRcmKJ41y2fgAdXCAvCkGbQDOdLQirHTLVz98LtfWmSvbNg7G778kR0sAGFbg+16P6nFg8b5",
+	"9mDTuGSYspmNOPMSb/zLNn7MrnhojDnlU5qAjstCREqdEgD5sCYnC8gKacK2zvftwfbK1pNWjXvWYmpu",
+	"XNG3XpLrqJcDzs5g4ImYmRKnyJVkESG4KEgVwFtBnvz+h0aQTOZzLBYpwnXoz5IkfO9nMtsgTi9k6su3",
+	"vMDzrKsclujd4WWur1wXWMSUjWnYAyXLBEQhMkn/LrYvPZRpOu+5GNgKqXOwOqorBeg8yLaP8vG3ZxK5",
+	"mPXWYGtli8l1K/Ss4zLX/49KuJcjwgKaaqFxQqMQCTqdKYRv8MIIKYnGRKMtDR5941nHs2tlUINDhIu0",
+	"soxH5cbn9C6eLzmGLfLUO5IylJnmzmzVXfpmenPQNxb0sqBUJuvpG4+lIT0g/IJmdK3o1qoRXY9UbFSU",
+	"4SNNLoYHm5gvbfFnDw490w4CzrBcejjQFlm7clJT+bNe06vcRtFl24ms3hsx8kmZnIpvUv5BpPxbLgKS",
+	"pWkp7rBTaKdfwkw9HWaZWY7sSnnVnPU8WQl6r/b8kzUvsEegrkmfhjwFnKtaNWeV7Pzk2uz00Rt39Vdu",
+	"BtsTi6A0ccGkeHgMvnJX+PtxBdiVb3i4WBnl1HWt/1J0HCiRkC9t+NKMkjZHBTpfnWLMXZ/io3Tb4MbQ",
+	"DbKtn5XATFKXU6uJw5Z6Z4kIts7RfvhNWDyIsBha3ukimxbURZATpOGQpQS1PNMlTH9ar6E+wPP1ewQA",
+	"frnMKJvxn8o+EBPfCOxBCOyYTpS9TlGnVQJtGbeeJKrm7kZDYjoDCXLBNqqWT0ncEV1jiF02svEdug6B",
+	"pVsoYHKsN08D4p470cMZ6aPLNE8+d/lHqXENDE611902rPAonWoBfWc92qO+Ur+V/tjyddrKAbL5Uo8H",
+	"1S1HlnEoixOojMNddCM4m6YYzOwbkSt4oLqpV0EbPhzfF5/rub9bnd3grqjwnXnczp0LEGqoxtoFwyGR",
+	"PxXo9+P+fGygzPwWlZo1jQnqehxlbn4SFhrM1/D+hmXtet1SvctmTdxWf2nOXa01g6lHMdZMLiiVTiV1",
+	"0yb7XBQI5+EpV6eGmihDlWZdvf2cYAbx7jvQcEqkFqGI5NCQ9bww7XRqpV+JWq9LsUG/vhpGN3ghbdZt",
+	"aCsSdR6uNDMQFsacMggnPYPc4sQWoEIHMZ0+a6DBMvkm0gtsdW2rmkHDP1u+f5PeAJNJQ2Ok57DsCkd8",
+	"qszbQmNN/NXYruOuCu3nSoJ56qsACNFCu1d7/5BzVmtDyUHnsfXdvShdgzYjmkLSvTZo/NS8qJe5uQKA",
+	"NRGDp8TgflL2cfBYID91G5m7IqlWEmdNhRcZEUTpddVe7JsCp/XgvdDPuBXGB6ue24zuw+axKRBLwHM1",
+	"SaJV+/rznfI906dOV+ces1kEN7xnWteXsyhoWmOhexCYa39yRzKTT+3rK3/FnoK4e1xj/eVq6Sq7fqqW",
+	"sHLOsb3q9afPzUkU+e+Zoq7E5kU3U/Z5/YUWRHULDXKbL5JK71lKC33HCzsyFwWdSaVzIRHnRCJh0Y0U",
+	"WoLaWh1QbfLHMczWyC2G2KlEisxjLrCg0QLZVdqDPee6q9PCeSWcP6KPTMmo9ZcHcPJXNxpUWIKvWv8L",
+	"3nXXtZczAmaUybUCEXVOlFj0hm1aTxveDD5apMtONweNSrPpLwDSV6szzUsXPHuPDX5IOcVCJTo6Sz0u",
+	"XEC6BvxsqaWPLu5+I/QVu5dmBOdXPuyR6rqNtHTfb7dfzkxbbb3MCAdElkMq0eK1VbW1wthK4pz81Uij",
+	"LCGyQRL7jHKQFr9QNTON/kyl/TqUcfWGgW8audqY/ps2Xrk2BsLLHVe7tgtlCD8ax18WyYdGxakkwoIg",
+	"8mmGE6m+qfK1qfI7C+HjYmQm3xvFxoartzH7xfWGK7z1y+x9qDs2F2+Chz9apO07y7e+yD5amWvGDtzW",
+	"MxNXSL2Aak3ZpZ6MEYTNZ5jZZqvvL89G54cXh6cHo6PTy8Pzn4fHo4vD/fenBxcIT3lDhKJ4f87alUj+",
+	"kp67+nMuLMUbkHg8OQaeY6KtM2g/mY9gfvUuHG1fmls+ZB4Q40WJTeb6Mo0e+Ha+Emekiax5iPxk+O5o",
+	"f3R8dPpTI5WjIYg/GMX6V7AiVetcNvBDegXJmlihcsXJfbnAwGypP/NPSfiwd6fIvKS/IUhIyLzZrE91",
+	"bu7GvYDYpitgjoFSjGMuwMaTHJmwqE51gg6NLDRrASojN9IUaPDYUjs8MyyUSIKoQkm8Z88PUy2pZpSF",
+	"2ZugrGUynlOV89vpkhhbKAPlhCZYDR88iSNJ6QqftcW7vRcFfTuUVJpJPdyh5OEOBcc29aJdxPIhbf6z",
+	"uxr4j2x/l+ICuCBb8yJ1gk0X97bBeN1gfr1ReD3DI/F/8Z42n5NsmcDtIzcGCrJTygxMIQYNqALysDp7",
+	"3x6tM95KrwcAmwyaGNrGeFxAB6dcgond1mP77NeVzHII22sQoikzQTfBpd29PIxlwF1vqRxpeSHtEV/T",
+	"vr2QoI8uW4j2chJbbpk038rTJ+3zfO/T/OYeiZTh18RzpRsrPMRwAfCwgvWBc2maUPAX4ZELLRw0KTES",
+	"QA9zzPLA8FJ+uyyDk7fDteYY5DoCPjVDsuRedia6sdLCh9cQ3WJXP7j50DT5RGnfsYIW4SbZmBQbK8IV",
+	"uVqrTOk1efh07PRWeY8t2S0mTT4hv/LXZGOmB4ncIQIvU4tdhKvkhR2B5URHWh+3rC7OJGmeZbdnra+C",
+	"x01y31ylFMvZ6TFaPE6GaNWN4/gdFqj5F3gkvSLoT6rZDIKzjddmMvtI1DLrMho9NK89CKmW5nqquq9U",
+	"YfrY6u+pc8NZrRZ4ct6S4S0U0b3U0LnxjEKAwm4+RVgCJVkFl2kJctCvGKtKwoiNPbasTOL5W9nzieQm",
+	"8Gj8uGkMsKboqHJSWxbTe4iqI88tk+3DGauLLOYzbC9qzoY/Vy64K6f1G2w8BZFSign/xWqX0sSPFrVL",
+	"21urW1P53kSf2Kq7GvGplFEB1dQIqtae2/WLj+pEj2SC2Puja2jwUWzuqhv2LsfSv4qYWHehWOsTgq24",
+	"iHOEk787uUHflJi1roqsWpRV0X2dp6toH5aHKiqkWPnziMzxNPRV11zCiyKsiFgnW5QTN6rXDFuGMHzT",
+	"6lydcclSvzHQwVo9x4VrQe7s8YHNP051WkndlFNq07KIVNncEEHQHIfkT+vssaK8BVmWJHx6xUtGrbb3",
+	"UUP+ERw1gwjTuevWqvsmqmprHJhQHxF06DjfUUmvb+kpOuuelGtB6z1CwtCX7gap9Zwec3M8VeeTvjLY",
+	"+AFiTMWjBBUfPwm/QGhNYsI1HMv6d+XTDp+qawnWTpWsybG/mdGopLOoTPvb9VF64WzqP9LXYuadbKkl",
+	"iLPvTNr+mIcLFGAhKJGoMYzzXC3ibIqeHf5FV9edQVOdKb1vVdThJzsjLjZrM512wVrNWKEg3Uyqb1Nf",
+	"QvvGuiSJGf5WQmRzDdPXyxGoOCuEcrIE6VxWYImImaI9wpK5hSWa85DsFUrj8umqOv+U8XwfPSOuXEa0",
+	"OxBNiZb/nsLv1zA0v2FEQINKZthX+0fTIhGjC+0QtoUSZVIRrFlBK0IHeF+PAVxYiHZkmqscYO216zKp",
+	"i9nWshLJlGNTh+gTKZH6Vox5C+e8YQRLHCVD393vZ+t9xqTUkKCsqVQitC2UuxKj4W67PhqyRZq5YA08",
+	"SRXJ81W/Yh290YtwN1pkPRDWZKPUXANSm27M3Qv3SbWHJB6nbaAEoxDBr8biS3iaUEabDN5hCnUYEayG",
+	"sorFaYjPTG4kn8t54yxEUpHY6WtLn/6QyFtYThVlq9dFnpm+puz0hxOc55kGm+NI3yT08I6ZfXcJSz6B",
+	"KV+BZ41aX07Tn7ag9DHThO4kcZwltTrl4O6ctEHZjDDsvSi5qGwuSba6ICMFfQKpoELOc/fbPIQmKV/C",
+	"6OXObEl5jfLnzVh1SIOU1VbkVtVxTUqnguR16578hI90MvJcZFeTsaL5q3giekDnZ6oF8qK/Jkum68lZ",
+	"dPAruUZUUXa4UbJtPnzl1MOxFBckY6P0mDdeVMFneGxOmi7dsocfWXCFOmVhpHROXZlRXE8fuEbr4PD4",
+	"EO7Penc+3D+EW7Re5+6uylxXpdv0A8wCAu+YUaHiMvP4EEF5iCDDJ6cyu/5LuGxxZ+nyLVa9cMuEcuFK",
+	"rob8HuviOrDLWm+OT2myR8rzqayi3rh17yD9bZhEJHzKfYodbeXlRGnhfz4hYS8Lq01jyWX4eC8IOyc4",
+	"dGX9fEKjXE65hre+xRKqtI0JLKGuDiUxGpOJlk6WYdOrJ4xXF/yefr57R9QJ6TxGHordnnVQmNWCm+7P",
+	"ShrviGpIbzLg0PPHWAUzT8cXi3HT6gXq3OBy01gQ0xuBpd7316ZrRawWyNzUiYKIYKjmt3YvjsznfTQn",
+	"CoPQLlTauzOBvXpO+y1uZjwi1WsTtVh3iZ1wrKl2uYa3q1lbXR2FCGbOzZlmj1zjKLFVfD6K/RCHWBFL",
+	"PmtSEIU5nloCl2WcBNYY/tVbWa6LWw0JtGBYY+htOG23YayrhlREeO4zdJYlU7iXrQH34Or/IKfQoWT6",
+	"z67M9zNDOd1rZtbwSQs1b6ljybWgzouDkb7AOr3zzs5gSc3WiMiuO4EY87toZeeu7OsjLUJsr5O5sQ4w",
+	"QwfDy+Ho8Nez9+eXo5Phr6OL3073R4c/H55eXrhBpkTpcw5hIYTJYO0uIPYaUTiPpBfwpYoHBx+nAq6h",
+	"08tKb/zNz3d5eTz64f2H84t+7fWlJwt7eem3CxC/yjtIH6xtgaHKWg7MLgGdk9ve+Glo8I73fX67xfMr",
+	"uMWzmtr91d/Y2Xgqrb2wszYpFYYX147oExHZS+/3NjZ0781oxqXaezV4Neh8+ePL/x8AJ4UcIRPfAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
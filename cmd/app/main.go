@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"os"
@@ -14,6 +15,58 @@ import (
 	grpcAdapter "github.com/Vi-72/quest-auth/internal/adapters/in/grpc"
 )
 
+// Значения по умолчанию для необязательных настроек
+const (
+	defaultPasswordHistoryDepth      = 5
+	defaultPasswordHistoryMaxAgeDays = 365
+
+	defaultPasswordMaxAgeDays            = 0
+	defaultPasswordChangeTokenTTLMinutes = 10
+
+	defaultEmailVerificationTokenTTLMinutes = 24 * 60
+	defaultEmailChangeTokenTTLMinutes       = 60
+
+	defaultLoginLockoutThreshold   = 5
+	defaultLoginLockoutBaseSeconds = 60
+	defaultLoginLockoutMaxSeconds  = 3600
+
+	defaultCaptchaThreshold     = 3
+	defaultCaptchaWindowSeconds = 3600
+
+	defaultPhoneOTPTTLSeconds            = 300
+	defaultPhoneOTPMaxAttempts           = 5
+	defaultPhoneOTPResendIntervalSeconds = 60
+
+	defaultMFAIssuer              = "Quest Auth"
+	defaultMFAChallengeTTLSeconds = 300
+	defaultMFAMaxAttempts         = 5
+
+	defaultWebAuthnRPID                = "localhost"
+	defaultWebAuthnOrigins             = "http://localhost:8080"
+	defaultWebAuthnChallengeTTLSeconds = 300
+
+	defaultMagicLinkTTLSeconds            = 600
+	defaultMagicLinkResendIntervalSeconds = 60
+
+	defaultLoginRiskHistorySize = 20
+
+	defaultUserPurgeRetentionDays   = 30
+	defaultUserPurgeIntervalMinutes = 60
+	defaultUserDeletionGraceDays    = 14
+
+	defaultDataExportTTLHours        = 72
+	defaultDataExportMaxSyncEvents   = 1000
+	defaultDataExportIntervalSeconds = 60
+
+	// Регистрация — по IP в скользящем окне, остальные POST /auth — общий лимит на IP,
+	// вход — по аккаунту, чтобы перебор паролей с разных адресов тоже упирался в лимит
+	defaultRateLimits = "POST /api/v1/auth/register ip 10/1h sliding_window;" +
+		"POST /api/v1/auth/* ip 60/1m;" +
+		"POST /api/v1/auth/login account 10/15m sliding_window;" +
+		"/auth.v1.AuthService/Authenticate ip 200/1s"
+	defaultRateLimitStore = "memory"
+)
+
 func main() {
 	_ = godotenv.Load(".env")
 	configs := getConfigs()
@@ -44,6 +97,14 @@ func main() {
 	)
 	defer compositionRoot.CloseAll()
 
+	// Канонические формы email пересчитываются, если правила сравнения адресов поменялись
+	cmd.MustCanonicalizeEmails(gormDB, compositionRoot.EmailCanonicalization())
+
+	// Удаление аккаунтов и сборка выгрузок работают в фоне рядом с серверами
+	for _, job := range compositionRoot.NewBackgroundJobs() {
+		go job.Run(context.Background())
+	}
+
 	// Создаем WaitGroup для ожидания обоих серверов
 	const numServers = 2 // HTTP и gRPC серверы
 	var wg sync.WaitGroup
@@ -79,7 +140,7 @@ func main() {
 	go func() {
 		defer wg.Done()
 		authHandler := compositionRoot.NewGRPCAuthHandler()
-		err := grpcAdapter.StartServer(configs.GrpcPort, authHandler)
+		err := grpcAdapter.StartServer(configs.GrpcPort, authHandler, compositionRoot.GRPCInterceptors()...)
 		if err != nil {
 			log.Fatalf("failed to start gRPC server: %v", err)
 		}
@@ -103,6 +164,75 @@ func getConfigs() cmd.Config {
 		JWTSecretKey:            getEnv("JWT_SECRET_KEY"),
 		JWTAccessTokenDuration:  getEnvInt("JWT_ACCESS_TOKEN_DURATION"),
 		JWTRefreshTokenDuration: getEnvInt("JWT_REFRESH_TOKEN_DURATION"),
+
+		PasswordHistoryDepth:      getEnvIntOrDefault("PASSWORD_HISTORY_DEPTH", defaultPasswordHistoryDepth),
+		PasswordHistoryMaxAgeDays: getEnvIntOrDefault("PASSWORD_HISTORY_MAX_AGE_DAYS", defaultPasswordHistoryMaxAgeDays),
+
+		PasswordMaxAgeDays:            getEnvIntOrDefault("PASSWORD_MAX_AGE_DAYS", defaultPasswordMaxAgeDays),
+		PasswordChangeTokenTTLMinutes: getEnvIntOrDefault("PASSWORD_CHANGE_TOKEN_TTL_MINUTES", defaultPasswordChangeTokenTTLMinutes),
+
+		AdminAPIKey: os.Getenv("ADMIN_API_KEY"),
+
+		LoginLockoutThreshold:   getEnvIntOrDefault("LOGIN_LOCKOUT_THRESHOLD", defaultLoginLockoutThreshold),
+		LoginLockoutBaseSeconds: getEnvIntOrDefault("LOGIN_LOCKOUT_BASE_SECONDS", defaultLoginLockoutBaseSeconds),
+		LoginLockoutMaxSeconds:  getEnvIntOrDefault("LOGIN_LOCKOUT_MAX_SECONDS", defaultLoginLockoutMaxSeconds),
+
+		AntiEnumeration: getEnvBoolOrDefault("ANTI_ENUMERATION", false),
+
+		CaptchaProvider:      os.Getenv("CAPTCHA_PROVIDER"),
+		CaptchaSecret:        os.Getenv("CAPTCHA_SECRET"),
+		CaptchaVerifyURL:     os.Getenv("CAPTCHA_VERIFY_URL"),
+		CaptchaThreshold:     getEnvIntOrDefault("CAPTCHA_THRESHOLD", defaultCaptchaThreshold),
+		CaptchaWindowSeconds: getEnvIntOrDefault("CAPTCHA_WINDOW_SECONDS", defaultCaptchaWindowSeconds),
+
+		EmailVerificationTokenTTLMinutes: getEnvIntOrDefault("EMAIL_VERIFICATION_TOKEN_TTL_MINUTES", defaultEmailVerificationTokenTTLMinutes),
+		EmailVerificationURL:             os.Getenv("EMAIL_VERIFICATION_URL"),
+		EmailVerificationRequired:        getEnvBoolOrDefault("EMAIL_VERIFICATION_REQUIRED", false),
+
+		EmailChangeTokenTTLMinutes: getEnvIntOrDefault("EMAIL_CHANGE_TOKEN_TTL_MINUTES", defaultEmailChangeTokenTTLMinutes),
+		EmailChangeURL:             os.Getenv("EMAIL_CHANGE_URL"),
+
+		PhoneOTPTTLSeconds:            getEnvIntOrDefault("PHONE_OTP_TTL_SECONDS", defaultPhoneOTPTTLSeconds),
+		PhoneOTPMaxAttempts:           getEnvIntOrDefault("PHONE_OTP_MAX_ATTEMPTS", defaultPhoneOTPMaxAttempts),
+		PhoneOTPResendIntervalSeconds: getEnvIntOrDefault("PHONE_OTP_RESEND_INTERVAL_SECONDS", defaultPhoneOTPResendIntervalSeconds),
+		SMSOutboxFile:                 os.Getenv("SMS_OUTBOX_FILE"),
+
+		MFAIssuer:              getEnvOrDefault("MFA_ISSUER", defaultMFAIssuer),
+		MFAChallengeTTLSeconds: getEnvIntOrDefault("MFA_CHALLENGE_TTL_SECONDS", defaultMFAChallengeTTLSeconds),
+		MFAMaxAttempts:         getEnvIntOrDefault("MFA_MAX_ATTEMPTS", defaultMFAMaxAttempts),
+
+		WebAuthnRPID:                getEnvOrDefault("WEBAUTHN_RP_ID", defaultWebAuthnRPID),
+		WebAuthnRPName:              getEnvOrDefault("WEBAUTHN_RP_NAME", defaultMFAIssuer),
+		WebAuthnOrigins:             getEnvOrDefault("WEBAUTHN_ORIGINS", defaultWebAuthnOrigins),
+		WebAuthnChallengeTTLSeconds: getEnvIntOrDefault("WEBAUTHN_CHALLENGE_TTL_SECONDS", defaultWebAuthnChallengeTTLSeconds),
+
+		MagicLinkTTLSeconds:            getEnvIntOrDefault("MAGIC_LINK_TTL_SECONDS", defaultMagicLinkTTLSeconds),
+		MagicLinkURL:                   os.Getenv("MAGIC_LINK_URL"),
+		MagicLinkResendIntervalSeconds: getEnvIntOrDefault("MAGIC_LINK_RESEND_INTERVAL_SECONDS", defaultMagicLinkResendIntervalSeconds),
+
+		LoginRiskGeoIPDB:     os.Getenv("LOGIN_RISK_GEOIP_DB"),
+		LoginRiskHistorySize: getEnvIntOrDefault("LOGIN_RISK_HISTORY_SIZE", defaultLoginRiskHistorySize),
+		LoginRiskStepUp:      getEnvBoolOrDefault("LOGIN_RISK_STEP_UP", false),
+
+		UserPurgeRetentionDays:   getEnvIntOrDefault("USER_PURGE_RETENTION_DAYS", defaultUserPurgeRetentionDays),
+		UserPurgeIntervalMinutes: getEnvIntOrDefault("USER_PURGE_INTERVAL_MINUTES", defaultUserPurgeIntervalMinutes),
+		UserDeletionGraceDays:    getEnvIntOrDefault("USER_DELETION_GRACE_DAYS", defaultUserDeletionGraceDays),
+
+		UserMetadataSchemaFile: os.Getenv("USER_METADATA_SCHEMA_FILE"),
+
+		EmailStripPlusTags:         getEnvBoolOrDefault("EMAIL_STRIP_PLUS_TAGS", false),
+		EmailDotInsensitiveDomains: os.Getenv("EMAIL_DOT_INSENSITIVE_DOMAINS"),
+		EmailDomainAliases:         os.Getenv("EMAIL_DOMAIN_ALIASES"),
+
+		PhoneDefaultRegion: os.Getenv("PHONE_DEFAULT_REGION"),
+
+		DataExportTTLHours:        getEnvIntOrDefault("DATA_EXPORT_TTL_HOURS", defaultDataExportTTLHours),
+		DataExportMaxSyncEvents:   getEnvIntOrDefault("DATA_EXPORT_MAX_SYNC_EVENTS", defaultDataExportMaxSyncEvents),
+		DataExportIntervalSeconds: getEnvIntOrDefault("DATA_EXPORT_INTERVAL_SECONDS", defaultDataExportIntervalSeconds),
+
+		RateLimits:                 getEnvOrDefault("RATE_LIMITS", defaultRateLimits),
+		RateLimitStore:             getEnvOrDefault("RATE_LIMIT_STORE", defaultRateLimitStore),
+		RateLimitTrustForwardedFor: getEnvBoolOrDefault("RATE_LIMIT_TRUST_FORWARDED_FOR", false),
 	}
 }
 
@@ -114,6 +244,35 @@ func getEnv(key string) string {
 	return val
 }
 
+// getEnvOrDefault читает необязательную строковую переменную окружения
+func getEnvOrDefault(key, defaultValue string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+	return defaultValue
+}
+
+// getEnvIntOrDefault читает необязательную целочисленную переменную окружения
+func getEnvIntOrDefault(key string, defaultValue int) int {
+	if os.Getenv(key) == "" {
+		return defaultValue
+	}
+	return getEnvInt(key)
+}
+
+// getEnvBoolOrDefault читает необязательную логическую переменную окружения
+func getEnvBoolOrDefault(key string, defaultValue bool) bool {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultValue
+	}
+	boolVal, err := strconv.ParseBool(val)
+	if err != nil {
+		log.Fatalf("Invalid boolean value for env var %s: %s", key, val)
+	}
+	return boolVal
+}
+
 func getEnvInt(key string) int {
 	val := os.Getenv(key)
 	if val == "" {
@@ -2,31 +2,52 @@ package cmd
 
 import (
 	"log"
+	"strings"
 	"time"
 
 	openapihttp "github.com/Vi-72/quest-auth/api/http/auth/v1"
 
 	"github.com/Vi-72/quest-auth/internal/adapters/in/grpc"
 	adapterhttp "github.com/Vi-72/quest-auth/internal/adapters/in/http"
+	httpmiddleware "github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/jobs"
 	bcryptadapter "github.com/Vi-72/quest-auth/internal/adapters/out/bcrypt"
+	captchaadapter "github.com/Vi-72/quest-auth/internal/adapters/out/captcha"
+	emailadapter "github.com/Vi-72/quest-auth/internal/adapters/out/email"
+	geoipadapter "github.com/Vi-72/quest-auth/internal/adapters/out/geoip"
+	jsonschemaadapter "github.com/Vi-72/quest-auth/internal/adapters/out/jsonschema"
 	"github.com/Vi-72/quest-auth/internal/adapters/out/jwt"
 	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/ratelimitrepo"
+	smsadapter "github.com/Vi-72/quest-auth/internal/adapters/out/sms"
 	timeadapter "github.com/Vi-72/quest-auth/internal/adapters/out/time"
 	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
 	"github.com/Vi-72/quest-auth/internal/core/application/usecases/queries"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
 	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/ratelimit"
+	"github.com/Vi-72/quest-auth/internal/pkg/webauthn"
 
+	grpcgo "google.golang.org/grpc"
 	"gorm.io/gorm"
 )
 
 type CompositionRoot struct {
-	configs        Config
-	db             *gorm.DB
-	txManager      ports.TransactionManager
-	jwtService     ports.JWTService
-	passwordHasher ports.PasswordHasher
-	clock          ports.Clock
-	closers        []Closer
+	configs           Config
+	db                *gorm.DB
+	txManager         ports.TransactionManager
+	jwtService        ports.JWTService
+	passwordHasher    ports.PasswordHasher
+	clock             ports.Clock
+	emailSender       ports.EmailSender
+	smsSender         ports.SMSSender
+	rateLimitStore    ratelimit.Store
+	rateLimiter       *ratelimit.Limiter
+	geoLocator        ports.GeoLocator
+	metadataValidator ports.MetadataValidator
+	emailRules        kernel.EmailCanonicalization
+	phoneRules        kernel.PhoneNumbering
+	closers           []Closer
 }
 
 func NewCompositionRoot(configs Config, db *gorm.DB) *CompositionRoot {
@@ -43,15 +64,115 @@ func NewCompositionRoot(configs Config, db *gorm.DB) *CompositionRoot {
 	passwordHasher := bcryptadapter.NewHasher()
 	clock := timeadapter.NewClock()
 
-	return &CompositionRoot{
+	// Письма пока пишутся в лог приложения
+	emailSender := emailadapter.NewLogSender()
+
+	// SMS пишутся в файл, если он задан, иначе в лог приложения
+	var smsSender ports.SMSSender = smsadapter.NewLogSender()
+	if configs.SMSOutboxFile != "" {
+		smsSender = smsadapter.NewFileSender(configs.SMSOutboxFile)
+	}
+
+	// Счётчики лимитов и неудачных попыток живут в одном хранилище
+	rateLimitStore := newRateLimitStore(configs, db)
+	rateLimiter := newRateLimiter(configs, rateLimitStore, clock)
+
+	cr := &CompositionRoot{
 		configs:        configs,
 		db:             db,
 		txManager:      txManager,
 		jwtService:     jwtService,
 		passwordHasher: passwordHasher,
 		clock:          clock,
+		emailSender:    emailSender,
+		smsSender:      smsSender,
+		rateLimitStore: rateLimitStore,
+		rateLimiter:    rateLimiter,
 		closers:        []Closer{},
 	}
+
+	// База MaxMind читается один раз и открыта до остановки сервиса
+	if configs.LoginRiskGeoIPDB != "" {
+		locator, err := geoipadapter.NewMMDBLocator(configs.LoginRiskGeoIPDB)
+		if err != nil {
+			log.Fatalf("invalid LOGIN_RISK_GEOIP_DB: %v", err)
+		}
+		cr.geoLocator = locator
+		cr.RegisterCloser(locator)
+	}
+
+	// Схема metadata проверяется при старте, чтобы ошибка в ней не всплыла на первом запросе
+	if configs.UserMetadataSchemaFile != "" {
+		validator, err := jsonschemaadapter.NewMetadataValidatorFromFile(configs.UserMetadataSchemaFile)
+		if err != nil {
+			log.Fatalf("invalid USER_METADATA_SCHEMA_FILE: %v", err)
+		}
+		cr.metadataValidator = validator
+	}
+
+	cr.emailRules = newEmailCanonicalization(configs)
+
+	phoneRules, err := kernel.NewPhoneNumbering(configs.PhoneDefaultRegion)
+	if err != nil {
+		log.Fatalf("invalid PHONE_DEFAULT_REGION: %v", err)
+	}
+	cr.phoneRules = phoneRules
+
+	return cr
+}
+
+// newEmailCanonicalization parses the rules that decide when two email spellings are the same mailbox
+func newEmailCanonicalization(configs Config) kernel.EmailCanonicalization {
+	var dotInsensitive []string
+	for _, domain := range strings.Split(configs.EmailDotInsensitiveDomains, ",") {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			dotInsensitive = append(dotInsensitive, domain)
+		}
+	}
+
+	aliases := map[string]string{}
+	for _, pair := range strings.Split(configs.EmailDomainAliases, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		alias, domain, ok := strings.Cut(pair, "=")
+		if !ok {
+			log.Fatalf("invalid EMAIL_DOMAIN_ALIASES %q: expected alias=domain", pair)
+		}
+		aliases[alias] = domain
+	}
+
+	rules, err := kernel.NewEmailCanonicalization(configs.EmailStripPlusTags, dotInsensitive, aliases)
+	if err != nil {
+		log.Fatalf("invalid email canonicalization rules: %v", err)
+	}
+	return rules
+}
+
+// newRateLimitStore creates the counter store: in-process memory or a table shared by all replicas
+func newRateLimitStore(configs Config, db *gorm.DB) ratelimit.Store {
+	switch configs.RateLimitStore {
+	case "", "memory":
+		return ratelimit.NewMemoryStore()
+	case "postgres":
+		return ratelimitrepo.NewStore(db)
+	default:
+		log.Fatalf("invalid RATE_LIMIT_STORE %q: expected memory or postgres", configs.RateLimitStore)
+		return nil
+	}
+}
+
+// newRateLimiter builds the limiter shared by HTTP and gRPC; nil when no rules are configured
+func newRateLimiter(configs Config, store ratelimit.Store, clock ports.Clock) *ratelimit.Limiter {
+	rules, err := ratelimit.ParseRules(configs.RateLimits)
+	if err != nil {
+		log.Fatalf("invalid RATE_LIMITS: %v", err)
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	return ratelimit.NewLimiter(store, clock, rules)
 }
 
 // TransactionManager returns the transaction manager
@@ -74,6 +195,28 @@ func (cr *CompositionRoot) Clock() ports.Clock {
 	return cr.clock
 }
 
+// EmailSender returns email sender
+func (cr *CompositionRoot) EmailSender() ports.EmailSender {
+	return cr.emailSender
+}
+
+// WithEmailSender replaces the email sender (e.g. with an in-memory one in tests)
+func (cr *CompositionRoot) WithEmailSender(sender ports.EmailSender) *CompositionRoot {
+	cr.emailSender = sender
+	return cr
+}
+
+// SMSSender returns SMS sender
+func (cr *CompositionRoot) SMSSender() ports.SMSSender {
+	return cr.smsSender
+}
+
+// WithSMSSender replaces the SMS sender (e.g. with an in-memory one in tests)
+func (cr *CompositionRoot) WithSMSSender(sender ports.SMSSender) *CompositionRoot {
+	cr.smsSender = sender
+	return cr
+}
+
 // Auth Use Case Handlers
 
 // NewRegisterUserHandler creates a handler for user registration
@@ -83,6 +226,12 @@ func (cr *CompositionRoot) NewRegisterUserHandler() *commands.RegisterUserHandle
 		cr.JWTService(),
 		cr.PasswordHasher(),
 		cr.Clock(),
+		cr.EmailSender(),
+		cr.EmailVerificationPolicy(),
+		cr.AntiEnumerationPolicy(),
+		cr.CaptchaPolicy(),
+		cr.EmailCanonicalization(),
+		cr.PhoneNumbering(),
 	)
 }
 
@@ -93,9 +242,562 @@ func (cr *CompositionRoot) NewLoginUserHandler() *commands.LoginUserHandler {
 		cr.JWTService(),
 		cr.PasswordHasher(),
 		cr.Clock(),
+		cr.PasswordExpiryPolicy(),
+		cr.EmailVerificationPolicy(),
+		cr.MFAPolicy(),
+		cr.WebAuthnPolicy(),
+		cr.LockoutPolicy(),
+		cr.AntiEnumerationPolicy(),
+		cr.CaptchaPolicy(),
+		cr.LoginRiskPolicy(),
+		cr.EmailCanonicalization(),
+		cr.PhoneNumbering(),
 	)
 }
 
+// LockoutPolicy returns login lockout rules from config
+func (cr *CompositionRoot) LockoutPolicy() commands.LockoutPolicy {
+	return commands.LockoutPolicy{
+		Threshold:    cr.configs.LoginLockoutThreshold,
+		BaseDuration: time.Duration(cr.configs.LoginLockoutBaseSeconds) * time.Second,
+		MaxDuration:  time.Duration(cr.configs.LoginLockoutMaxSeconds) * time.Second,
+	}
+}
+
+// AntiEnumerationPolicy returns whether login and registration hide which accounts exist
+func (cr *CompositionRoot) AntiEnumerationPolicy() commands.AntiEnumerationPolicy {
+	return commands.AntiEnumerationPolicy{Enabled: cr.configs.AntiEnumeration}
+}
+
+// CaptchaPolicy returns when login and registration require a CAPTCHA; disabled without a provider
+func (cr *CompositionRoot) CaptchaPolicy() commands.CaptchaPolicy {
+	verifier := cr.CaptchaVerifier()
+	if verifier == nil {
+		return commands.CaptchaPolicy{}
+	}
+
+	return commands.CaptchaPolicy{
+		Verifier: verifier,
+		Attempts: ratelimit.NewCounter(
+			cr.rateLimitStore, cr.clock, "captcha", time.Duration(cr.configs.CaptchaWindowSeconds)*time.Second,
+		),
+		Threshold: cr.configs.CaptchaThreshold,
+	}
+}
+
+// CaptchaVerifier returns the verifier for CAPTCHA_PROVIDER; nil when CAPTCHA is off
+func (cr *CompositionRoot) CaptchaVerifier() ports.CaptchaVerifier {
+	verifyURL := cr.configs.CaptchaVerifyURL
+
+	switch cr.configs.CaptchaProvider {
+	case "":
+		return nil
+	case "noop":
+		return captchaadapter.NewNoopVerifier()
+	case "hcaptcha":
+		if verifyURL == "" {
+			verifyURL = captchaadapter.HCaptchaVerifyURL
+		}
+	case "turnstile":
+		if verifyURL == "" {
+			verifyURL = captchaadapter.TurnstileVerifyURL
+		}
+	default:
+		log.Fatalf("invalid CAPTCHA_PROVIDER %q: expected noop, hcaptcha or turnstile", cr.configs.CaptchaProvider)
+	}
+
+	return captchaadapter.NewHTTPVerifier(verifyURL, cr.configs.CaptchaSecret, nil)
+}
+
+// NewChangePasswordHandler creates a handler for password change
+func (cr *CompositionRoot) NewChangePasswordHandler() *commands.ChangePasswordHandler {
+	return commands.NewChangePasswordHandler(
+		cr.TransactionManager(),
+		cr.PasswordHasher(),
+		cr.Clock(),
+		cr.PasswordHistoryPolicy(),
+	)
+}
+
+// PasswordHistoryPolicy returns password history retention rules from config
+func (cr *CompositionRoot) PasswordHistoryPolicy() commands.PasswordHistoryPolicy {
+	return commands.PasswordHistoryPolicy{
+		Depth:  cr.configs.PasswordHistoryDepth,
+		MaxAge: time.Duration(cr.configs.PasswordHistoryMaxAgeDays) * 24 * time.Hour,
+	}
+}
+
+// NewChangeExpiredPasswordHandler creates a handler for changing an expired password
+func (cr *CompositionRoot) NewChangeExpiredPasswordHandler() *commands.ChangeExpiredPasswordHandler {
+	return commands.NewChangeExpiredPasswordHandler(
+		cr.TransactionManager(),
+		cr.JWTService(),
+		cr.PasswordHasher(),
+		cr.Clock(),
+		cr.PasswordHistoryPolicy(),
+		cr.PasswordExpiryPolicy(),
+	)
+}
+
+// NewRequirePasswordChangeHandler creates a handler for forcing a password change
+func (cr *CompositionRoot) NewRequirePasswordChangeHandler() *commands.RequirePasswordChangeHandler {
+	return commands.NewRequirePasswordChangeHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+	)
+}
+
+// NewUnlockUserHandler creates a handler for lifting a login lock
+func (cr *CompositionRoot) NewUnlockUserHandler() *commands.UnlockUserHandler {
+	return commands.NewUnlockUserHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+	)
+}
+
+// NewChangeUserStatusHandler creates a handler for activating, suspending, blocking and deactivating accounts
+func (cr *CompositionRoot) NewChangeUserStatusHandler() *commands.ChangeUserStatusHandler {
+	return commands.NewChangeUserStatusHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+	)
+}
+
+// NewRefreshTokensHandler creates a handler for exchanging a refresh token for a new token pair
+func (cr *CompositionRoot) NewRefreshTokensHandler() *commands.RefreshTokensHandler {
+	return commands.NewRefreshTokensHandler(
+		cr.TransactionManager(),
+		cr.JWTService(),
+		cr.Clock(),
+		cr.PasswordExpiryPolicy(),
+		cr.EmailVerificationPolicy(),
+	)
+}
+
+// NewDeleteUserHandler creates a handler for soft-deleting accounts
+func (cr *CompositionRoot) NewDeleteUserHandler() *commands.DeleteUserHandler {
+	return commands.NewDeleteUserHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+	)
+}
+
+// NewPurgeDeletedUsersHandler creates a handler for hard-deleting accounts after the retention period
+func (cr *CompositionRoot) NewPurgeDeletedUsersHandler() *commands.PurgeDeletedUsersHandler {
+	return commands.NewPurgeDeletedUsersHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+		cr.UserPurgePolicy(),
+	)
+}
+
+// NewUpdateProfileHandler creates a handler for profile changes made by the user
+func (cr *CompositionRoot) NewUpdateProfileHandler() *commands.UpdateProfileHandler {
+	return commands.NewUpdateProfileHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+		cr.metadataValidator,
+	)
+}
+
+// NewRequestAccountDeletionHandler creates a handler for scheduling deletion of the user's own account
+func (cr *CompositionRoot) NewRequestAccountDeletionHandler() *commands.RequestAccountDeletionHandler {
+	return commands.NewRequestAccountDeletionHandler(
+		cr.TransactionManager(),
+		cr.PasswordHasher(),
+		cr.Clock(),
+		cr.UserPurgePolicy(),
+	)
+}
+
+// NewCancelAccountDeletionHandler creates a handler for cancelling a scheduled account deletion
+func (cr *CompositionRoot) NewCancelAccountDeletionHandler() *commands.CancelAccountDeletionHandler {
+	return commands.NewCancelAccountDeletionHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+	)
+}
+
+// NewDeleteScheduledUsersHandler creates a handler for deleting accounts whose grace period has ended
+func (cr *CompositionRoot) NewDeleteScheduledUsersHandler() *commands.DeleteScheduledUsersHandler {
+	return commands.NewDeleteScheduledUsersHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+		cr.UserPurgePolicy(),
+	)
+}
+
+// UserPurgePolicy returns account deletion periods from config
+func (cr *CompositionRoot) UserPurgePolicy() commands.UserPurgePolicy {
+	return commands.UserPurgePolicy{
+		Retention:           time.Duration(cr.configs.UserPurgeRetentionDays) * 24 * time.Hour,
+		DeletionGracePeriod: time.Duration(cr.configs.UserDeletionGraceDays) * 24 * time.Hour,
+	}
+}
+
+// NewExportUserDataHandler creates a handler for exporting a user's data
+func (cr *CompositionRoot) NewExportUserDataHandler() *commands.ExportUserDataHandler {
+	return commands.NewExportUserDataHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+		cr.DataExportPolicy(),
+	)
+}
+
+// NewGenerateDataExportsHandler creates a handler for generating queued data exports
+func (cr *CompositionRoot) NewGenerateDataExportsHandler() *commands.GenerateDataExportsHandler {
+	return commands.NewGenerateDataExportsHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+		cr.DataExportPolicy(),
+	)
+}
+
+// NewGetDataExportHandler creates a handler for downloading data exports
+func (cr *CompositionRoot) NewGetDataExportHandler() *queries.GetDataExportHandler {
+	return queries.NewGetDataExportHandler(cr.TransactionManager(), cr.Clock())
+}
+
+// EmailCanonicalization returns the rules under which different email spellings are one mailbox
+func (cr *CompositionRoot) EmailCanonicalization() kernel.EmailCanonicalization {
+	return cr.emailRules
+}
+
+// PhoneNumbering returns how phone numbers without a country code are read
+func (cr *CompositionRoot) PhoneNumbering() kernel.PhoneNumbering {
+	return cr.phoneRules
+}
+
+// DataExportPolicy returns data export rules from config
+func (cr *CompositionRoot) DataExportPolicy() commands.DataExportPolicy {
+	return commands.DataExportPolicy{
+		TTL:           time.Duration(cr.configs.DataExportTTLHours) * time.Hour,
+		MaxSyncEvents: int64(cr.configs.DataExportMaxSyncEvents),
+	}
+}
+
+// NewBackgroundJobs creates the background jobs; a job is left out when its interval is 0
+func (cr *CompositionRoot) NewBackgroundJobs() []*jobs.BatchJob {
+	var backgroundJobs []*jobs.BatchJob
+	if cr.configs.UserPurgeIntervalMinutes > 0 {
+		interval := time.Duration(cr.configs.UserPurgeIntervalMinutes) * time.Minute
+		backgroundJobs = append(backgroundJobs,
+			jobs.NewBatchJob("deleting scheduled users", cr.NewDeleteScheduledUsersHandler(), interval),
+			jobs.NewBatchJob("purging deleted users", cr.NewPurgeDeletedUsersHandler(), interval),
+		)
+	}
+	if cr.configs.DataExportIntervalSeconds > 0 {
+		interval := time.Duration(cr.configs.DataExportIntervalSeconds) * time.Second
+		backgroundJobs = append(backgroundJobs,
+			jobs.NewBatchJob("generating data exports", cr.NewGenerateDataExportsHandler(), interval),
+		)
+	}
+	return backgroundJobs
+}
+
+// PasswordExpiryPolicy returns password expiration rules from config
+func (cr *CompositionRoot) PasswordExpiryPolicy() commands.PasswordExpiryPolicy {
+	return commands.PasswordExpiryPolicy{
+		MaxAge:         time.Duration(cr.configs.PasswordMaxAgeDays) * 24 * time.Hour,
+		ChangeTokenTTL: time.Duration(cr.configs.PasswordChangeTokenTTLMinutes) * time.Minute,
+	}
+}
+
+// NewSendEmailVerificationHandler creates a handler for sending email verification links
+func (cr *CompositionRoot) NewSendEmailVerificationHandler() *commands.SendEmailVerificationHandler {
+	return commands.NewSendEmailVerificationHandler(
+		cr.TransactionManager(),
+		cr.EmailSender(),
+		cr.Clock(),
+		cr.EmailVerificationPolicy(),
+		cr.EmailCanonicalization(),
+	)
+}
+
+// NewVerifyEmailHandler creates a handler for email confirmation
+func (cr *CompositionRoot) NewVerifyEmailHandler() *commands.VerifyEmailHandler {
+	return commands.NewVerifyEmailHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+	)
+}
+
+// EmailVerificationPolicy returns email verification rules from config
+func (cr *CompositionRoot) EmailVerificationPolicy() commands.EmailVerificationPolicy {
+	return commands.EmailVerificationPolicy{
+		TokenTTL:         time.Duration(cr.configs.EmailVerificationTokenTTLMinutes) * time.Minute,
+		LinkURL:          cr.configs.EmailVerificationURL,
+		RequiredForLogin: cr.configs.EmailVerificationRequired,
+	}
+}
+
+// NewRequestEmailChangeHandler creates a handler for email change requests
+func (cr *CompositionRoot) NewRequestEmailChangeHandler() *commands.RequestEmailChangeHandler {
+	return commands.NewRequestEmailChangeHandler(
+		cr.TransactionManager(),
+		cr.EmailSender(),
+		cr.PasswordHasher(),
+		cr.Clock(),
+		cr.EmailChangePolicy(),
+		cr.EmailCanonicalization(),
+	)
+}
+
+// NewConfirmEmailChangeHandler creates a handler for email change confirmation
+func (cr *CompositionRoot) NewConfirmEmailChangeHandler() *commands.ConfirmEmailChangeHandler {
+	return commands.NewConfirmEmailChangeHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+		cr.EmailCanonicalization(),
+	)
+}
+
+// EmailChangePolicy returns email change rules from config
+func (cr *CompositionRoot) EmailChangePolicy() commands.EmailChangePolicy {
+	return commands.EmailChangePolicy{
+		TokenTTL: time.Duration(cr.configs.EmailChangeTokenTTLMinutes) * time.Minute,
+		LinkURL:  cr.configs.EmailChangeURL,
+	}
+}
+
+// NewSendPhoneVerificationHandler creates a handler for sending phone verification codes
+func (cr *CompositionRoot) NewSendPhoneVerificationHandler() *commands.SendPhoneVerificationHandler {
+	return commands.NewSendPhoneVerificationHandler(
+		cr.TransactionManager(),
+		cr.SMSSender(),
+		cr.PasswordHasher(),
+		cr.Clock(),
+		cr.PhoneVerificationPolicy(),
+	)
+}
+
+// NewVerifyPhoneHandler creates a handler for phone confirmation
+func (cr *CompositionRoot) NewVerifyPhoneHandler() *commands.VerifyPhoneHandler {
+	return commands.NewVerifyPhoneHandler(
+		cr.TransactionManager(),
+		cr.PasswordHasher(),
+		cr.Clock(),
+		cr.PhoneVerificationPolicy(),
+	)
+}
+
+// NewRequestPhoneChangeHandler creates a handler for phone change requests
+func (cr *CompositionRoot) NewRequestPhoneChangeHandler() *commands.RequestPhoneChangeHandler {
+	return commands.NewRequestPhoneChangeHandler(
+		cr.TransactionManager(),
+		cr.SMSSender(),
+		cr.PasswordHasher(),
+		cr.Clock(),
+		cr.PhoneVerificationPolicy(),
+		cr.PhoneNumbering(),
+	)
+}
+
+// NewConfirmPhoneChangeHandler creates a handler for phone change confirmation
+func (cr *CompositionRoot) NewConfirmPhoneChangeHandler() *commands.ConfirmPhoneChangeHandler {
+	return commands.NewConfirmPhoneChangeHandler(
+		cr.TransactionManager(),
+		cr.PasswordHasher(),
+		cr.Clock(),
+		cr.PhoneVerificationPolicy(),
+	)
+}
+
+// PhoneVerificationPolicy returns phone verification rules from config
+func (cr *CompositionRoot) PhoneVerificationPolicy() commands.PhoneVerificationPolicy {
+	return commands.PhoneVerificationPolicy{
+		CodeTTL:        time.Duration(cr.configs.PhoneOTPTTLSeconds) * time.Second,
+		MaxAttempts:    cr.configs.PhoneOTPMaxAttempts,
+		ResendInterval: time.Duration(cr.configs.PhoneOTPResendIntervalSeconds) * time.Second,
+	}
+}
+
+// NewEnrollTOTPHandler creates a handler for starting TOTP enrollment
+func (cr *CompositionRoot) NewEnrollTOTPHandler() *commands.EnrollTOTPHandler {
+	return commands.NewEnrollTOTPHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+		cr.MFAPolicy(),
+	)
+}
+
+// NewConfirmTOTPHandler creates a handler for enabling MFA with the first TOTP code
+func (cr *CompositionRoot) NewConfirmTOTPHandler() *commands.ConfirmTOTPHandler {
+	return commands.NewConfirmTOTPHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+	)
+}
+
+// NewVerifyMFAHandler creates a handler for the second login step
+func (cr *CompositionRoot) NewVerifyMFAHandler() *commands.VerifyMFAHandler {
+	return commands.NewVerifyMFAHandler(
+		cr.TransactionManager(),
+		cr.JWTService(),
+		cr.Clock(),
+		cr.MFAPolicy(),
+		cr.PasswordExpiryPolicy(),
+		cr.WebAuthnPolicy(),
+		cr.LoginRiskPolicy(),
+	)
+}
+
+// MFAPolicy returns two-factor authentication rules from config
+func (cr *CompositionRoot) MFAPolicy() commands.MFAPolicy {
+	return commands.MFAPolicy{
+		Issuer:       cr.configs.MFAIssuer,
+		ChallengeTTL: time.Duration(cr.configs.MFAChallengeTTLSeconds) * time.Second,
+		MaxAttempts:  cr.configs.MFAMaxAttempts,
+	}
+}
+
+// NewBeginWebAuthnRegistrationHandler creates a handler for starting passkey registration
+func (cr *CompositionRoot) NewBeginWebAuthnRegistrationHandler() *commands.BeginWebAuthnRegistrationHandler {
+	return commands.NewBeginWebAuthnRegistrationHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+		cr.WebAuthnPolicy(),
+	)
+}
+
+// NewFinishWebAuthnRegistrationHandler creates a handler for storing a verified passkey
+func (cr *CompositionRoot) NewFinishWebAuthnRegistrationHandler() *commands.FinishWebAuthnRegistrationHandler {
+	return commands.NewFinishWebAuthnRegistrationHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+		cr.WebAuthnPolicy(),
+	)
+}
+
+// NewBeginWebAuthnLoginHandler creates a handler for starting passkey login
+func (cr *CompositionRoot) NewBeginWebAuthnLoginHandler() *commands.BeginWebAuthnLoginHandler {
+	return commands.NewBeginWebAuthnLoginHandler(
+		cr.TransactionManager(),
+		cr.Clock(),
+		cr.WebAuthnPolicy(),
+	)
+}
+
+// NewFinishWebAuthnLoginHandler creates a handler for passwordless passkey login
+func (cr *CompositionRoot) NewFinishWebAuthnLoginHandler() *commands.FinishWebAuthnLoginHandler {
+	return commands.NewFinishWebAuthnLoginHandler(
+		cr.TransactionManager(),
+		cr.JWTService(),
+		cr.Clock(),
+		cr.WebAuthnPolicy(),
+		cr.PasswordExpiryPolicy(),
+		cr.EmailVerificationPolicy(),
+		cr.LoginRiskPolicy(),
+	)
+}
+
+// WebAuthnPolicy returns passkey relying party settings from config
+func (cr *CompositionRoot) WebAuthnPolicy() commands.WebAuthnPolicy {
+	var origins []string
+	for _, origin := range strings.Split(cr.configs.WebAuthnOrigins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+
+	return commands.WebAuthnPolicy{
+		RelyingParty: webauthn.RelyingParty{
+			ID:      cr.configs.WebAuthnRPID,
+			Name:    cr.configs.WebAuthnRPName,
+			Origins: origins,
+		},
+		ChallengeTTL: time.Duration(cr.configs.WebAuthnChallengeTTLSeconds) * time.Second,
+	}
+}
+
+// NewRequestPhoneLoginCodeHandler creates a handler for sending SMS sign-in codes
+func (cr *CompositionRoot) NewRequestPhoneLoginCodeHandler() *commands.RequestPhoneLoginCodeHandler {
+	return commands.NewRequestPhoneLoginCodeHandler(
+		cr.TransactionManager(),
+		cr.SMSSender(),
+		cr.PasswordHasher(),
+		cr.Clock(),
+		cr.PhoneVerificationPolicy(),
+		cr.PhoneNumbering(),
+	)
+}
+
+// NewLoginWithPhoneCodeHandler creates a handler for login with a phone number and an SMS code
+func (cr *CompositionRoot) NewLoginWithPhoneCodeHandler() *commands.LoginWithPhoneCodeHandler {
+	return commands.NewLoginWithPhoneCodeHandler(
+		cr.TransactionManager(),
+		cr.JWTService(),
+		cr.PasswordHasher(),
+		cr.Clock(),
+		cr.PasswordExpiryPolicy(),
+		cr.EmailVerificationPolicy(),
+		cr.PhoneVerificationPolicy(),
+		cr.MFAPolicy(),
+		cr.WebAuthnPolicy(),
+		cr.LoginRiskPolicy(),
+		cr.PhoneNumbering(),
+	)
+}
+
+// NewRequestMagicLinkHandler creates a handler for sending sign-in links
+func (cr *CompositionRoot) NewRequestMagicLinkHandler() *commands.RequestMagicLinkHandler {
+	return commands.NewRequestMagicLinkHandler(
+		cr.TransactionManager(),
+		cr.EmailSender(),
+		cr.Clock(),
+		cr.MagicLinkPolicy(),
+		cr.EmailCanonicalization(),
+	)
+}
+
+// NewRedeemMagicLinkHandler creates a handler for passwordless login with a sign-in link
+func (cr *CompositionRoot) NewRedeemMagicLinkHandler() *commands.RedeemMagicLinkHandler {
+	return commands.NewRedeemMagicLinkHandler(
+		cr.TransactionManager(),
+		cr.JWTService(),
+		cr.Clock(),
+		cr.PasswordExpiryPolicy(),
+		cr.MFAPolicy(),
+		cr.WebAuthnPolicy(),
+		cr.LoginRiskPolicy(),
+	)
+}
+
+// MagicLinkPolicy returns sign-in link rules from config
+func (cr *CompositionRoot) MagicLinkPolicy() commands.MagicLinkPolicy {
+	return commands.MagicLinkPolicy{
+		TokenTTL:       time.Duration(cr.configs.MagicLinkTTLSeconds) * time.Second,
+		LinkURL:        cr.configs.MagicLinkURL,
+		ResendInterval: time.Duration(cr.configs.MagicLinkResendIntervalSeconds) * time.Second,
+	}
+}
+
+// LoginRiskPolicy returns the new-device and unusual-location detection rules from config
+func (cr *CompositionRoot) LoginRiskPolicy() commands.LoginRiskPolicy {
+	return commands.LoginRiskPolicy{
+		Locator:     cr.geoLocator,
+		Notifier:    cr.SecurityNotifier(),
+		HistorySize: cr.configs.LoginRiskHistorySize,
+		StepUp:      cr.configs.LoginRiskStepUp,
+		StepUpLink:  cr.MagicLinkPolicy(),
+	}
+}
+
+// SecurityNotifier returns the notifier that emails account security alerts
+func (cr *CompositionRoot) SecurityNotifier() ports.SecurityNotifier {
+	return emailadapter.NewSecurityNotifier(cr.EmailSender())
+}
+
+// NewAuthenticateByTokenHandler creates a query handler for access token authentication
+func (cr *CompositionRoot) NewAuthenticateByTokenHandler() *queries.AuthenticateByTokenHandler {
+	return queries.NewAuthenticateByTokenHandler(cr.JWTService(), cr.TransactionManager())
+}
+
+// NewGetMeHandler creates a query handler for the profile of the token owner
+func (cr *CompositionRoot) NewGetMeHandler() *queries.GetMeHandler {
+	return queries.NewGetMeHandler(cr.TransactionManager())
+}
+
 // HTTP Handlers
 
 // NewAPIHandler creates OpenAPI handler
@@ -103,6 +805,38 @@ func (cr *CompositionRoot) NewAPIHandler() openapihttp.StrictServerInterface {
 	handlers, err := adapterhttp.NewAPIHandler(
 		cr.NewRegisterUserHandler(),
 		cr.NewLoginUserHandler(),
+		cr.NewChangePasswordHandler(),
+		cr.NewChangeExpiredPasswordHandler(),
+		cr.NewRequirePasswordChangeHandler(),
+		cr.NewSendEmailVerificationHandler(),
+		cr.NewVerifyEmailHandler(),
+		cr.NewSendPhoneVerificationHandler(),
+		cr.NewVerifyPhoneHandler(),
+		cr.NewRequestEmailChangeHandler(),
+		cr.NewConfirmEmailChangeHandler(),
+		cr.NewRequestPhoneChangeHandler(),
+		cr.NewConfirmPhoneChangeHandler(),
+		cr.NewEnrollTOTPHandler(),
+		cr.NewConfirmTOTPHandler(),
+		cr.NewVerifyMFAHandler(),
+		cr.NewBeginWebAuthnRegistrationHandler(),
+		cr.NewFinishWebAuthnRegistrationHandler(),
+		cr.NewBeginWebAuthnLoginHandler(),
+		cr.NewFinishWebAuthnLoginHandler(),
+		cr.NewRequestMagicLinkHandler(),
+		cr.NewRedeemMagicLinkHandler(),
+		cr.NewRequestPhoneLoginCodeHandler(),
+		cr.NewLoginWithPhoneCodeHandler(),
+		cr.NewUnlockUserHandler(),
+		cr.NewRefreshTokensHandler(),
+		cr.NewChangeUserStatusHandler(),
+		cr.NewDeleteUserHandler(),
+		cr.NewRequestAccountDeletionHandler(),
+		cr.NewCancelAccountDeletionHandler(),
+		cr.NewExportUserDataHandler(),
+		cr.NewGetDataExportHandler(),
+		cr.NewGetMeHandler(),
+		cr.NewUpdateProfileHandler(),
 	)
 	if err != nil {
 		log.Fatalf("Error initializing HTTP Server: %v", err)
@@ -110,8 +844,51 @@ func (cr *CompositionRoot) NewAPIHandler() openapihttp.StrictServerInterface {
 	return handlers
 }
 
+// NewBearerAuthMiddleware creates HTTP middleware for operations secured with bearer tokens
+func (cr *CompositionRoot) NewBearerAuthMiddleware() *httpmiddleware.BearerAuthMiddleware {
+	return httpmiddleware.NewBearerAuthMiddleware(cr.NewAuthenticateByTokenHandler())
+}
+
+// NewAdminAPIKeyMiddleware creates HTTP middleware for administrative operations
+func (cr *CompositionRoot) NewAdminAPIKeyMiddleware() *httpmiddleware.AdminAPIKeyMiddleware {
+	return httpmiddleware.NewAdminAPIKeyMiddleware(cr.configs.AdminAPIKey)
+}
+
+// NewClientIPMiddleware creates HTTP middleware that puts the client address into the request context
+func (cr *CompositionRoot) NewClientIPMiddleware() *httpmiddleware.ClientIPMiddleware {
+	return httpmiddleware.NewClientIPMiddleware(cr.configs.RateLimitTrustForwardedFor)
+}
+
+// NewRateLimitMiddleware creates HTTP rate limit middleware; nil when rate limiting is off
+func (cr *CompositionRoot) NewRateLimitMiddleware() *httpmiddleware.RateLimitMiddleware {
+	if cr.rateLimiter == nil {
+		return nil
+	}
+	return httpmiddleware.NewRateLimitMiddleware(cr.rateLimiter, cr.configs.RateLimitTrustForwardedFor, cr.AccountKeys())
+}
+
+// AccountKeys returns the normalization of account identifiers for per-account rate limits
+func (cr *CompositionRoot) AccountKeys() commands.AccountKeys {
+	return commands.AccountKeys{EmailRules: cr.EmailCanonicalization(), PhoneRules: cr.PhoneNumbering()}
+}
+
 // NewGRPCAuthHandler creates gRPC auth handler
 func (cr *CompositionRoot) NewGRPCAuthHandler() *grpc.AuthHandler {
-	authenticateByToken := queries.NewAuthenticateByTokenHandler(cr.JWTService())
-	return grpc.NewAuthHandler(authenticateByToken)
+	return grpc.NewAuthHandler(
+		cr.NewAuthenticateByTokenHandler(),
+		cr.NewRequestPhoneChangeHandler(),
+		cr.NewConfirmPhoneChangeHandler(),
+		cr.NewGetMeHandler(),
+		cr.NewUpdateProfileHandler(),
+	)
+}
+
+// GRPCInterceptors returns unary interceptors for the gRPC server
+func (cr *CompositionRoot) GRPCInterceptors() []grpcgo.UnaryServerInterceptor {
+	if cr.rateLimiter == nil {
+		return nil
+	}
+	return []grpcgo.UnaryServerInterceptor{
+		grpc.RateLimitInterceptor(cr.rateLimiter, cr.configs.RateLimitTrustForwardedFor, cr.AccountKeys(), cr.JWTService()),
+	}
 }
@@ -13,4 +13,73 @@ type Config struct {
 	JWTSecretKey            string
 	JWTAccessTokenDuration  int // в минутах
 	JWTRefreshTokenDuration int // в часах
+
+	PasswordHistoryDepth      int // сколько последних паролей нельзя переиспользовать
+	PasswordHistoryMaxAgeDays int // сколько дней хранить историю паролей (0 — без ограничения)
+
+	PasswordMaxAgeDays            int // срок действия пароля в днях (0 — бессрочно)
+	PasswordChangeTokenTTLMinutes int // время жизни токена смены просроченного пароля
+
+	AdminAPIKey string // ключ административного API (пусто — админ-API отключён)
+
+	LoginLockoutThreshold   int // неверных паролей подряд до блокировки входа (0 — без блокировки)
+	LoginLockoutBaseSeconds int // длительность первой блокировки, следующие вдвое длиннее
+	LoginLockoutMaxSeconds  int // максимальная длительность блокировки
+
+	AntiEnumeration bool // не выдавать во входе и регистрации, существует ли аккаунт
+
+	CaptchaProvider      string // noop, hcaptcha или turnstile (пусто — CAPTCHA выключена)
+	CaptchaSecret        string // секретный ключ сайта у провайдера
+	CaptchaVerifyURL     string // адрес проверки токена (пусто — адрес провайдера)
+	CaptchaThreshold     int    // неудачных попыток с IP или для аккаунта до требования CAPTCHA
+	CaptchaWindowSeconds int    // за какой период считаются неудачные попытки
+
+	EmailVerificationTokenTTLMinutes int    // время жизни токена подтверждения email
+	EmailVerificationURL             string // страница подтверждения email (пусто — в письме только токен)
+	EmailVerificationRequired        bool   // запрещать вход до подтверждения email
+
+	EmailChangeTokenTTLMinutes int    // время жизни ссылки подтверждения нового email
+	EmailChangeURL             string // страница подтверждения смены email (пусто — в письме только токен)
+
+	PhoneOTPTTLSeconds            int    // время жизни кода подтверждения телефона
+	PhoneOTPMaxAttempts           int    // число попыток ввода кода (0 — без ограничения)
+	PhoneOTPResendIntervalSeconds int    // минимальный интервал между отправками кода
+	SMSOutboxFile                 string // файл для SMS (пусто — SMS пишутся в лог)
+
+	MFAIssuer              string // название сервиса в приложении-аутентификаторе
+	MFAChallengeTTLSeconds int    // время жизни токена второго шага входа
+	MFAMaxAttempts         int    // число попыток ввода кода на втором шаге (0 — без ограничения)
+
+	WebAuthnRPID                string // домен, к которому привязываются passkey
+	WebAuthnRPName              string // название сервиса в диалоге браузера
+	WebAuthnOrigins             string // допустимые origin через запятую
+	WebAuthnChallengeTTLSeconds int    // время жизни challenge регистрации и входа
+
+	MagicLinkTTLSeconds            int    // время жизни ссылки для входа без пароля
+	MagicLinkURL                   string // страница входа по ссылке (пусто — в письме только токен)
+	MagicLinkResendIntervalSeconds int    // минимальный интервал между письмами со ссылкой
+
+	LoginRiskGeoIPDB     string // файл базы MaxMind MMDB для определения места входа (пусто — место не учитывается)
+	LoginRiskHistorySize int    // с каким числом последних входов сравнивается новый
+	LoginRiskStepUp      bool   // подтверждать подозрительный вход по паролю или коду из SMS ссылкой из письма
+
+	UserPurgeRetentionDays   int // сколько дней хранятся данные удалённого аккаунта
+	UserPurgeIntervalMinutes int // как часто запускается удаление аккаунтов (0 — не запускается)
+	UserDeletionGraceDays    int // через сколько дней удаляется аккаунт, удаление которого запросил пользователь
+
+	UserMetadataSchemaFile string // JSON Schema для metadata пользователя (пусто — принимается любой JSON-объект)
+
+	EmailStripPlusTags         bool   // адреса с меткой после «+» считаются одним ящиком (john+news@ и john@)
+	EmailDotInsensitiveDomains string // домены через запятую, где точки в локальной части не значимы
+	EmailDomainAliases         string // пары alias=domain через запятую: один почтовый сервис под разными доменами
+
+	PhoneDefaultRegion string // регион ISO 3166-1 для номеров без кода страны (пусто — только международный формат)
+
+	DataExportTTLHours        int // сколько часов хранится выгрузка данных, собранная в фоне
+	DataExportMaxSyncEvents   int // выгрузка пользователя с большим числом событий собирается в фоне
+	DataExportIntervalSeconds int // как часто собираются выгрузки из очереди (0 — не собираются)
+
+	RateLimits                 string // правила ограничения частоты запросов (пусто или off — без ограничений)
+	RateLimitStore             string // хранилище счетчиков: memory или postgres
+	RateLimitTrustForwardedFor bool   // брать IP клиента из X-Forwarded-For (только за доверенным прокси)
 }
@@ -2,14 +2,23 @@ package cmd
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/dataexportrepo"
 	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/eventrepo"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/loginhistoryrepo"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/passwordhistoryrepo"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/ratelimitrepo"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/recoverycoderepo"
 	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/userrepo"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/verificationtokenrepo"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/webauthncredentialrepo"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
 	"github.com/Vi-72/quest-auth/internal/pkg/errs"
 
 	_ "github.com/lib/pq"
@@ -109,8 +118,62 @@ func MustAutoMigrate(db *gorm.DB) {
 	if err != nil {
 		log.Fatalf("Ошибка миграции UserDTO: %v", err)
 	}
+	// Неуникальный индекс канонических email заменён уникальным idx_users_email_canonical_unique
+	if db.Migrator().HasIndex(&userrepo.UserDTO{}, "idx_users_email_canonical") {
+		if err := db.Migrator().DropIndex(&userrepo.UserDTO{}, "idx_users_email_canonical"); err != nil {
+			log.Fatalf("Ошибка удаления индекса idx_users_email_canonical: %v", err)
+		}
+	}
 	err = db.AutoMigrate(&eventrepo.EventDTO{})
 	if err != nil {
 		log.Fatalf("Ошибка миграции EventDTO: %v", err)
 	}
+	err = db.AutoMigrate(&passwordhistoryrepo.PasswordHistoryDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции PasswordHistoryDTO: %v", err)
+	}
+	err = db.AutoMigrate(&verificationtokenrepo.VerificationTokenDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции VerificationTokenDTO: %v", err)
+	}
+	err = db.AutoMigrate(&recoverycoderepo.RecoveryCodeDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции RecoveryCodeDTO: %v", err)
+	}
+	err = db.AutoMigrate(&webauthncredentialrepo.WebAuthnCredentialDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции WebAuthnCredentialDTO: %v", err)
+	}
+	err = db.AutoMigrate(&ratelimitrepo.RateLimitDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции RateLimitDTO: %v", err)
+	}
+	err = db.AutoMigrate(&loginhistoryrepo.LoginRecordDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции LoginRecordDTO: %v", err)
+	}
+	err = db.AutoMigrate(&dataexportrepo.DataExportDTO{})
+	if err != nil {
+		log.Fatalf("Ошибка миграции DataExportDTO: %v", err)
+	}
+}
+
+// MustCanonicalizeEmails пересчитывает канонические формы email по текущим правилам.
+// Если по новым правилам адреса разных аккаунтов совпадают, сервис не запускается:
+// совпадения перечисляются в логе, и оператор решает, какой аккаунт оставить за адресом.
+func MustCanonicalizeEmails(db *gorm.DB, rules kernel.EmailCanonicalization) {
+	updated, err := userrepo.CanonicalizeEmails(db, rules)
+	var collisions *userrepo.EmailCollisionError
+	if errors.As(err, &collisions) {
+		for canonical, ids := range collisions.Collisions {
+			log.Printf("Email %s совпадает у аккаунтов %v", canonical, ids)
+		}
+		log.Fatalf("Правила сравнения email сливают разные аккаунты: %v", err)
+	}
+	if err != nil {
+		log.Fatalf("Ошибка пересчёта канонических email: %v", err)
+	}
+	if updated > 0 {
+		log.Printf("Канонические формы email пересчитаны: %d", updated)
+	}
 }
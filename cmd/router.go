@@ -24,6 +24,13 @@ func NewRouter(root *CompositionRoot) stdhttp.Handler {
 	router.Use(chimiddleware.Recoverer)
 	router.Use(chimiddleware.Logger)
 
+	router.Use(root.NewClientIPMiddleware().Resolve)
+
+	// --- Ограничение частоты запросов ---
+	if rateLimit := root.NewRateLimitMiddleware(); rateLimit != nil {
+		router.Use(rateLimit.Limit)
+	}
+
 	// Load OpenAPI spec
 	swagger, err := openapihttp.GetSwagger()
 	if err != nil {
@@ -127,7 +134,18 @@ func NewRouter(root *CompositionRoot) stdhttp.Handler {
 		apiRouter.Use(validationMW.Validate)
 	}
 
-	openapihttp.HandlerFromMux(apiHandler, apiRouter)
+	// Bearer authentication for operations secured in OpenAPI
+	bearerAuthMW := root.NewBearerAuthMiddleware()
+	// Admin API key for administrative operations
+	adminAPIKeyMW := root.NewAdminAPIKeyMiddleware()
+
+	openapihttp.HandlerWithOptions(apiHandler, openapihttp.ChiServerOptions{
+		BaseRouter: apiRouter,
+		Middlewares: []openapihttp.MiddlewareFunc{
+			bearerAuthMW.Authenticate,
+			adminAPIKeyMW.Authenticate,
+		},
+	})
 
 	router.Mount(apiV1Prefix, apiRouter)
 
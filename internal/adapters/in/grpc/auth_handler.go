@@ -6,26 +6,39 @@ import (
 
 	authv1 "github.com/Vi-72/quest-auth/api/grpc/sdk/go/auth/v1"
 
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
 	"github.com/Vi-72/quest-auth/internal/core/application/usecases/queries"
 	"github.com/Vi-72/quest-auth/internal/pkg/errs"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // AuthHandler реализует gRPC сервис аутентификации
 type AuthHandler struct {
 	authv1.UnimplementedAuthServiceServer
 	authenticateByToken *queries.AuthenticateByTokenHandler
+	requestPhoneChange  *commands.RequestPhoneChangeHandler
+	confirmPhoneChange  *commands.ConfirmPhoneChangeHandler
+	getMe               *queries.GetMeHandler
+	updateProfile       *commands.UpdateProfileHandler
 }
 
 // NewAuthHandler создает новый gRPC handler для аутентификации
 func NewAuthHandler(
 	authenticateByToken *queries.AuthenticateByTokenHandler,
+	requestPhoneChange *commands.RequestPhoneChangeHandler,
+	confirmPhoneChange *commands.ConfirmPhoneChangeHandler,
+	getMe *queries.GetMeHandler,
+	updateProfile *commands.UpdateProfileHandler,
 ) *AuthHandler {
 	return &AuthHandler{
 		authenticateByToken: authenticateByToken,
+		requestPhoneChange:  requestPhoneChange,
+		confirmPhoneChange:  confirmPhoneChange,
+		getMe:               getMe,
+		updateProfile:       updateProfile,
 	}
 }
 
@@ -41,24 +54,164 @@ func (h *AuthHandler) Authenticate(
 		return nil, status.Error(codes.InvalidArgument, "jwt_token is required")
 	}
 
-	// Валидация JWT токена и извлечение данных из клеймов без обращения к БД
+	// Валидация JWT токена и загрузка пользователя из БД: неактивные аккаунты отклоняются
 	info, err := h.authenticateByToken.Handle(ctx, queries.AuthenticateByTokenQuery{RawToken: req.JwtToken})
 	if err != nil {
 		return nil, h.convertErrorToGRPCStatus(err)
 	}
 
-	// Формируем ответ из данных клеймов
-	response := &authv1.AuthenticateResponse{
-		User: &authv1.User{
-			Id:        info.ID.String(),
-			Name:      info.Name,
-			Email:     info.Email,
-			Phone:     info.Phone,
-			CreatedAt: timestamppb.New(info.CreatedAt),
-		},
+	// Формируем ответ из актуальных данных пользователя
+	user, err := toProtoUserFromInfo(info)
+	if err != nil {
+		return nil, h.convertErrorToGRPCStatus(err)
+	}
+
+	return &authv1.AuthenticateResponse{User: user}, nil
+}
+
+// RequestPhoneChange отправляет код подтверждения на новый номер владельца токена
+func (h *AuthHandler) RequestPhoneChange(
+	ctx context.Context,
+	req *authv1.RequestPhoneChangeRequest,
+) (*authv1.RequestPhoneChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if strings.TrimSpace(req.NewPhone) == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_phone is required")
+	}
+
+	userID, err := h.authenticate(ctx, req.JwtToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.requestPhoneChange.Handle(ctx, commands.RequestPhoneChangeCommand{
+		UserID:   userID,
+		NewPhone: req.NewPhone,
+	})
+	if err != nil {
+		return nil, h.convertErrorToGRPCStatus(err)
+	}
+
+	return &authv1.RequestPhoneChangeResponse{
+		ExpiresIn:   int64(result.ExpiresIn.Seconds()),
+		ResendAfter: int64(result.ResendAfter.Seconds()),
+	}, nil
+}
+
+// ConfirmPhoneChange меняет телефон владельца токена после проверки кода
+func (h *AuthHandler) ConfirmPhoneChange(
+	ctx context.Context,
+	req *authv1.ConfirmPhoneChangeRequest,
+) (*authv1.ConfirmPhoneChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if strings.TrimSpace(req.Code) == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	userID, err := h.authenticate(ctx, req.JwtToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.confirmPhoneChange.Handle(ctx, commands.ConfirmPhoneChangeCommand{
+		UserID: userID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		return nil, h.convertErrorToGRPCStatus(err)
 	}
 
-	return response, nil
+	user, err := toProtoUser(result.User)
+	if err != nil {
+		return nil, h.convertErrorToGRPCStatus(err)
+	}
+
+	return &authv1.ConfirmPhoneChangeResponse{User: user}, nil
+}
+
+// GetMe возвращает профиль владельца токена
+func (h *AuthHandler) GetMe(
+	ctx context.Context,
+	req *authv1.GetMeRequest,
+) (*authv1.GetMeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	userID, err := h.authenticate(ctx, req.JwtToken)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.getMe.Handle(ctx, queries.GetMeQuery{UserID: userID})
+	if err != nil {
+		return nil, h.convertErrorToGRPCStatus(err)
+	}
+
+	protoUser, err := toProtoUserFromAggregate(user)
+	if err != nil {
+		return nil, h.convertErrorToGRPCStatus(err)
+	}
+
+	return &authv1.GetMeResponse{User: protoUser}, nil
+}
+
+// UpdateProfile меняет переданные поля профиля владельца токена
+func (h *AuthHandler) UpdateProfile(
+	ctx context.Context,
+	req *authv1.UpdateProfileRequest,
+) (*authv1.UpdateProfileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	userID, err := h.authenticate(ctx, req.JwtToken)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := commands.UpdateProfileCommand{
+		UserID:   userID,
+		Name:     req.Name,
+		Username: req.Username,
+
+		Locale:    req.Locale,
+		Timezone:  req.Timezone,
+		AvatarURL: req.AvatarUrl,
+		BirthDate: req.BirthDate,
+	}
+	if req.Metadata != nil {
+		cmd.Metadata = req.Metadata.AsMap()
+	}
+
+	result, err := h.updateProfile.Handle(ctx, cmd)
+	if err != nil {
+		return nil, h.convertErrorToGRPCStatus(err)
+	}
+
+	user, err := toProtoUser(result.User)
+	if err != nil {
+		return nil, h.convertErrorToGRPCStatus(err)
+	}
+
+	return &authv1.UpdateProfileResponse{User: user}, nil
+}
+
+// authenticate проверяет access токен и возвращает ID его владельца
+func (h *AuthHandler) authenticate(ctx context.Context, rawToken string) (uuid.UUID, error) {
+	if strings.TrimSpace(rawToken) == "" {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "jwt_token is required")
+	}
+
+	info, err := h.authenticateByToken.Handle(ctx, queries.AuthenticateByTokenQuery{RawToken: rawToken})
+	if err != nil {
+		return uuid.Nil, h.convertErrorToGRPCStatus(err)
+	}
+	return info.ID, nil
 }
 
 // convertErrorToGRPCStatus конвертирует доменные ошибки в gRPC статусы
@@ -74,7 +227,9 @@ func (h *AuthHandler) convertErrorToGRPCStatus(err error) error {
 		return status.Error(code, "invalid or expired JWT token")
 	case codes.NotFound:
 		return status.Error(code, "user not found")
-	case codes.InvalidArgument:
+	case codes.PermissionDenied:
+		return status.Error(code, err.Error())
+	case codes.InvalidArgument, codes.ResourceExhausted:
 		return status.Error(code, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal server error")
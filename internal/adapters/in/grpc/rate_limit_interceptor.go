@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	authv1 "github.com/Vi-72/quest-auth/api/grpc/sdk/go/auth/v1"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/ratelimit"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimitInterceptor ограничивает частоту вызовов по IP клиента, аккаунту и методу.
+// Маршрут — полное имя метода (/auth.v1.AuthService/Authenticate); при отказе возвращается
+// ResourceExhausted и заголовок retry-after в секундах. Если ограничитель недоступен, вызов пропускается.
+// Аккаунт берётся из запроса (см. accountFromRequest) и нормализуется accountKeys так же, как в HTTP.
+func RateLimitInterceptor(
+	limiter *ratelimit.Limiter,
+	trustForwardedFor bool,
+	accountKeys commands.AccountKeys,
+	jwtService ports.JWTService,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		keys := map[ratelimit.KeyKind]string{
+			ratelimit.KeyIP: clientIP(ctx, trustForwardedFor),
+		}
+		if limiter.Needs(info.FullMethod, ratelimit.KeyAccount) {
+			keys[ratelimit.KeyAccount] = accountFromRequest(req, accountKeys, jwtService)
+		}
+
+		result, err := limiter.Allow(ctx, info.FullMethod, keys)
+		if err != nil {
+			log.Printf("rate limit check failed for %s: %v", info.FullMethod, err)
+			return handler(ctx, req)
+		}
+
+		if !result.Allowed {
+			seconds := int(math.Max(1, math.Ceil(result.RetryAfter.Seconds())))
+			_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(seconds)))
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %d seconds", seconds)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// accountFromRequest — аккаунт, к которому относится вызов: для смены телефона — новый номер, на который
+// уйдёт SMS, для остальных методов — владелец jwt_token. Подпись токена проверяется, чтобы поддельным
+// токеном нельзя было израсходовать чужой лимит; без аккаунта правила по аккаунту пропускаются
+func accountFromRequest(req any, accountKeys commands.AccountKeys, jwtService ports.JWTService) string {
+	switch r := req.(type) {
+	case *authv1.RequestPhoneChangeRequest:
+		if strings.TrimSpace(r.GetNewPhone()) == "" {
+			return ""
+		}
+		return accountKeys.Key(r.GetNewPhone())
+	case interface{ GetJwtToken() string }:
+		claims, err := jwtService.ValidateAccessToken(strings.TrimSpace(r.GetJwtToken()))
+		if err != nil {
+			return ""
+		}
+		return claims.UserID.String()
+	}
+	return ""
+}
+
+// clientIP — адрес клиента: от ближайшего доверенного прокси (x-forwarded-for) или адрес соединения
+func clientIP(ctx context.Context, trustForwardedFor bool) string {
+	if trustForwardedFor {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+				hops := strings.Split(forwarded[len(forwarded)-1], ",")
+				if ip := strings.TrimSpace(hops[len(hops)-1]); ip != "" {
+					return ip
+				}
+			}
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
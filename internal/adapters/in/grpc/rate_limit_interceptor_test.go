@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	authv1 "github.com/Vi-72/quest-auth/api/grpc/sdk/go/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/jwt"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/ratelimit"
+)
+
+const (
+	authenticateMethod       = "/auth.v1.AuthService/Authenticate"
+	requestPhoneChangeMethod = "/auth.v1.AuthService/RequestPhoneChange"
+)
+
+type fixedClock struct{ t time.Time }
+
+func (c fixedClock) Now() time.Time { return c.t }
+
+// headerStream запоминает заголовки, которые обработчик отправил бы клиенту
+type headerStream struct {
+	method string
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string { return s.method }
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerStream) SetTrailer(metadata.MD) error { return nil }
+
+type interceptorFixture struct {
+	interceptor grpc.UnaryServerInterceptor
+	jwtService  *jwt.Service
+}
+
+func newInterceptorFixture(t *testing.T, spec string) interceptorFixture {
+	t.Helper()
+	rules, err := ratelimit.ParseRules(spec)
+	if err != nil {
+		t.Fatalf("ParseRules() error = %v", err)
+	}
+	phoneRules, err := kernel.NewPhoneNumbering("US")
+	if err != nil {
+		t.Fatalf("NewPhoneNumbering() error = %v", err)
+	}
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), fixedClock{t: time.Now()}, rules)
+	jwtService := jwt.NewService("secret", time.Minute, time.Hour)
+	return interceptorFixture{
+		interceptor: RateLimitInterceptor(limiter, false, commands.AccountKeys{PhoneRules: phoneRules}, jwtService),
+		jwtService:  jwtService,
+	}
+}
+
+// call вызывает перехватчик с запросом от клиента ip и возвращает отправленные заголовки и ошибку
+func (f interceptorFixture) call(method, ip string, req any) (metadata.MD, error) {
+	stream := &headerStream{method: method}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+	_, err := f.interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	return stream.header, err
+}
+
+func (f interceptorFixture) accessToken(t *testing.T, userID uuid.UUID) string {
+	t.Helper()
+	pair, err := f.jwtService.GenerateTokenPair(ports.TokenSubject{UserID: userID, CreatedAt: time.Now()})
+	if err != nil {
+		t.Fatalf("GenerateTokenPair() error = %v", err)
+	}
+	return pair.AccessToken
+}
+
+func assertRateLimited(t *testing.T, header metadata.MD, err error) {
+	t.Helper()
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	retryAfter := header.Get("retry-after")
+	if len(retryAfter) != 1 {
+		t.Fatalf("expected one retry-after header, got %v", retryAfter)
+	}
+	if seconds, convErr := strconv.Atoi(retryAfter[0]); convErr != nil || seconds < 1 {
+		t.Fatalf("expected retry-after in whole seconds, got %q", retryAfter[0])
+	}
+}
+
+func TestRateLimitInterceptorLimitsByIP(t *testing.T) {
+	f := newInterceptorFixture(t, authenticateMethod+" ip 2/1m")
+	req := &authv1.AuthenticateRequest{JwtToken: "not-a-token"}
+
+	for i := 0; i < 2; i++ {
+		if _, err := f.call(authenticateMethod, "10.0.0.1", req); err != nil {
+			t.Fatalf("call %d: unexpected error %v", i+1, err)
+		}
+	}
+
+	header, err := f.call(authenticateMethod, "10.0.0.1", req)
+	assertRateLimited(t, header, err)
+
+	if _, err := f.call(authenticateMethod, "10.0.0.2", req); err != nil {
+		t.Fatalf("another client must not be limited, got %v", err)
+	}
+}
+
+func TestRateLimitInterceptorLimitsByTokenOwner(t *testing.T) {
+	f := newInterceptorFixture(t, authenticateMethod+" account 2/1m sliding_window")
+	userID := uuid.New()
+
+	// Разные токены и адреса одного пользователя расходуют один лимит
+	for i, ip := range []string{"10.0.0.1", "10.0.0.2"} {
+		req := &authv1.AuthenticateRequest{JwtToken: f.accessToken(t, userID)}
+		if _, err := f.call(authenticateMethod, ip, req); err != nil {
+			t.Fatalf("call %d: unexpected error %v", i+1, err)
+		}
+	}
+
+	header, err := f.call(authenticateMethod, "10.0.0.3",
+		&authv1.AuthenticateRequest{JwtToken: " " + f.accessToken(t, userID) + " "})
+	assertRateLimited(t, header, err)
+
+	other := &authv1.AuthenticateRequest{JwtToken: f.accessToken(t, uuid.New())}
+	if _, err := f.call(authenticateMethod, "10.0.0.3", other); err != nil {
+		t.Fatalf("another account must not be limited, got %v", err)
+	}
+
+	// Токен, подписанный чужим ключом, не расходует лимит владельца
+	forged, err := jwt.NewService("other-secret", time.Minute, time.Hour).
+		GenerateTokenPair(ports.TokenSubject{UserID: userID, CreatedAt: time.Now()})
+	if err != nil {
+		t.Fatalf("GenerateTokenPair() error = %v", err)
+	}
+	if _, err := f.call(authenticateMethod, "10.0.0.3", &authv1.AuthenticateRequest{JwtToken: forged.AccessToken}); err != nil {
+		t.Fatalf("a forged token must not be counted against its subject, got %v", err)
+	}
+}
+
+func TestRateLimitInterceptorKeysPhoneChangeOnE164(t *testing.T) {
+	f := newInterceptorFixture(t, requestPhoneChangeMethod+" account 1/1h sliding_window")
+
+	first := &authv1.RequestPhoneChangeRequest{JwtToken: f.accessToken(t, uuid.New()), NewPhone: "+12015550123"}
+	if _, err := f.call(requestPhoneChangeMethod, "10.0.0.1", first); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// Тот же номер в национальном формате с другого аккаунта
+	second := &authv1.RequestPhoneChangeRequest{JwtToken: f.accessToken(t, uuid.New()), NewPhone: "(201) 555-0123"}
+	header, err := f.call(requestPhoneChangeMethod, "10.0.0.2", second)
+	assertRateLimited(t, header, err)
+}
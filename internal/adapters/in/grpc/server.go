@@ -11,15 +11,15 @@ import (
 )
 
 // StartServer запускает gRPC сервер
-func StartServer(port string, authHandler *AuthHandler) error {
+func StartServer(port string, authHandler *AuthHandler, interceptors ...grpc.UnaryServerInterceptor) error {
 	// Создаем listener
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		return err
 	}
 
-	// Создаем gRPC сервер
-	grpcServer := grpc.NewServer()
+	// Создаем gRPC сервер; перехватчики (например, ограничение частоты) выполняются по порядку
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
 
 	// Регистрируем сервис аутентификации
 	authv1.RegisterAuthServiceServer(grpcServer, authHandler)
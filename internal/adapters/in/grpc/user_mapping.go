@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"time"
+
+	authv1 "github.com/Vi-72/quest-auth/api/grpc/sdk/go/auth/v1"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/queries"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoUser собирает пользователя из результата команды
+func toProtoUser(info commands.UserInfo) (*authv1.User, error) {
+	metadata, err := toProtoMetadata(info.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authv1.User{
+		Id:        info.ID.String(),
+		Name:      info.Name,
+		Email:     info.Email,
+		Phone:     info.Phone,
+		PhoneType: info.PhoneType,
+		Username:  info.Username,
+		CreatedAt: timestamppb.New(info.CreatedAt),
+
+		EmailVerified: info.EmailVerified,
+		PhoneVerified: info.PhoneVerified,
+
+		Status: info.Status,
+
+		Locale:    info.Locale,
+		Timezone:  info.Timezone,
+		AvatarUrl: info.AvatarURL,
+		BirthDate: formatBirthDate(info.BirthDate),
+		Metadata:  metadata,
+	}, nil
+}
+
+// toProtoUserFromInfo собирает пользователя из данных владельца токена
+func toProtoUserFromInfo(info queries.AuthenticatedInfo) (*authv1.User, error) {
+	metadata, err := toProtoMetadata(info.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authv1.User{
+		Id:        info.ID.String(),
+		Name:      info.Name,
+		Email:     info.Email,
+		Phone:     info.Phone,
+		PhoneType: info.PhoneType,
+		Username:  info.Username,
+		CreatedAt: timestamppb.New(info.CreatedAt),
+
+		EmailVerified: info.EmailVerified,
+		PhoneVerified: info.PhoneVerified,
+
+		Status: info.Status,
+
+		Locale:    info.Locale,
+		Timezone:  info.Timezone,
+		AvatarUrl: info.AvatarURL,
+		BirthDate: formatBirthDate(info.BirthDate),
+		Metadata:  metadata,
+	}, nil
+}
+
+// toProtoUserFromAggregate собирает пользователя из агрегата
+func toProtoUserFromAggregate(user *auth.User) (*authv1.User, error) {
+	metadata, err := toProtoMetadata(user.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authv1.User{
+		Id:        user.ID().String(),
+		Name:      user.Name,
+		Email:     user.Email.String(),
+		Phone:     user.Phone.String(),
+		PhoneType: string(user.Phone.Type()),
+		Username:  user.Username.String(),
+		CreatedAt: timestamppb.New(user.CreatedAt),
+
+		EmailVerified: user.IsEmailVerified(),
+		PhoneVerified: user.IsPhoneVerified(),
+
+		Status: user.Status.String(),
+
+		Locale:    user.Profile.Locale,
+		Timezone:  user.Profile.Timezone,
+		AvatarUrl: user.Profile.AvatarURL,
+		BirthDate: user.Profile.BirthDateString(),
+		Metadata:  metadata,
+	}, nil
+}
+
+// formatBirthDate — дата рождения в формате YYYY-MM-DD (пусто — не указана)
+func formatBirthDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format(auth.BirthDateLayout)
+}
+
+// toProtoMetadata — metadata в виде google.protobuf.Struct (nil — не задана)
+func toProtoMetadata(metadata map[string]any) (*structpb.Struct, error) {
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+	return structpb.NewStruct(metadata)
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+)
+
+// RequestAccountDeletion implements DELETE /me from OpenAPI.
+func (a *APIHandler) RequestAccountDeletion(
+	ctx context.Context,
+	request v1.RequestAccountDeletionRequestObject,
+) (v1.RequestAccountDeletionResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToRequestAccountDeletionResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	cmd := commands.RequestAccountDeletionCommand{
+		UserID:          user.ID,
+		CurrentPassword: request.Body.CurrentPassword,
+	}
+
+	result, err := a.requestAccountDeletionHandler.Handle(ctx, cmd)
+	if err != nil {
+		return httperrs.ToRequestAccountDeletionResponse(err), nil
+	}
+
+	return v1.RequestAccountDeletion202JSONResponse{
+		DeletionScheduledAt: result.ScheduledAt,
+	}, nil
+}
+
+// CancelAccountDeletion implements POST /me/deletion/cancel from OpenAPI.
+func (a *APIHandler) CancelAccountDeletion(
+	ctx context.Context,
+	_ v1.CancelAccountDeletionRequestObject,
+) (v1.CancelAccountDeletionResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToCancelAccountDeletionResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	cmd := commands.CancelAccountDeletionCommand{UserID: user.ID}
+
+	if err := a.cancelAccountDeletionHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToCancelAccountDeletionResponse(err), nil
+	}
+
+	return v1.CancelAccountDeletion204Response{}, nil
+}
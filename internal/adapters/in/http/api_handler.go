@@ -1,19 +1,142 @@
 package http
 
-import "github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+import (
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/queries"
+)
 
 // APIHandler реализует StrictServerInterface для OpenAPI
 type APIHandler struct {
-	registerHandler *commands.RegisterUserHandler
-	loginHandler    *commands.LoginUserHandler
+	registerHandler       *commands.RegisterUserHandler
+	loginHandler          *commands.LoginUserHandler
+	refreshTokensHandler  *commands.RefreshTokensHandler
+	changePasswordHandler *commands.ChangePasswordHandler
+
+	requestPhoneLoginCodeHandler *commands.RequestPhoneLoginCodeHandler
+	loginWithPhoneCodeHandler    *commands.LoginWithPhoneCodeHandler
+
+	changeExpiredPasswordHandler *commands.ChangeExpiredPasswordHandler
+	requirePasswordChangeHandler *commands.RequirePasswordChangeHandler
+	unlockUserHandler            *commands.UnlockUserHandler
+	changeUserStatusHandler      *commands.ChangeUserStatusHandler
+	deleteUserHandler            *commands.DeleteUserHandler
+
+	getMeHandler         *queries.GetMeHandler
+	updateProfileHandler *commands.UpdateProfileHandler
+
+	requestAccountDeletionHandler *commands.RequestAccountDeletionHandler
+	cancelAccountDeletionHandler  *commands.CancelAccountDeletionHandler
+
+	exportUserDataHandler *commands.ExportUserDataHandler
+	getDataExportHandler  *queries.GetDataExportHandler
+
+	sendEmailVerificationHandler *commands.SendEmailVerificationHandler
+	verifyEmailHandler           *commands.VerifyEmailHandler
+
+	requestEmailChangeHandler *commands.RequestEmailChangeHandler
+	confirmEmailChangeHandler *commands.ConfirmEmailChangeHandler
+
+	sendPhoneVerificationHandler *commands.SendPhoneVerificationHandler
+	verifyPhoneHandler           *commands.VerifyPhoneHandler
+
+	requestPhoneChangeHandler *commands.RequestPhoneChangeHandler
+	confirmPhoneChangeHandler *commands.ConfirmPhoneChangeHandler
+
+	enrollTOTPHandler  *commands.EnrollTOTPHandler
+	confirmTOTPHandler *commands.ConfirmTOTPHandler
+	verifyMFAHandler   *commands.VerifyMFAHandler
+
+	beginWebAuthnRegistrationHandler  *commands.BeginWebAuthnRegistrationHandler
+	finishWebAuthnRegistrationHandler *commands.FinishWebAuthnRegistrationHandler
+	beginWebAuthnLoginHandler         *commands.BeginWebAuthnLoginHandler
+	finishWebAuthnLoginHandler        *commands.FinishWebAuthnLoginHandler
+
+	requestMagicLinkHandler *commands.RequestMagicLinkHandler
+	redeemMagicLinkHandler  *commands.RedeemMagicLinkHandler
 }
 
 func NewAPIHandler(
 	registerHandler *commands.RegisterUserHandler,
 	loginHandler *commands.LoginUserHandler,
+	changePasswordHandler *commands.ChangePasswordHandler,
+	changeExpiredPasswordHandler *commands.ChangeExpiredPasswordHandler,
+	requirePasswordChangeHandler *commands.RequirePasswordChangeHandler,
+	sendEmailVerificationHandler *commands.SendEmailVerificationHandler,
+	verifyEmailHandler *commands.VerifyEmailHandler,
+	sendPhoneVerificationHandler *commands.SendPhoneVerificationHandler,
+	verifyPhoneHandler *commands.VerifyPhoneHandler,
+	requestEmailChangeHandler *commands.RequestEmailChangeHandler,
+	confirmEmailChangeHandler *commands.ConfirmEmailChangeHandler,
+	requestPhoneChangeHandler *commands.RequestPhoneChangeHandler,
+	confirmPhoneChangeHandler *commands.ConfirmPhoneChangeHandler,
+	enrollTOTPHandler *commands.EnrollTOTPHandler,
+	confirmTOTPHandler *commands.ConfirmTOTPHandler,
+	verifyMFAHandler *commands.VerifyMFAHandler,
+	beginWebAuthnRegistrationHandler *commands.BeginWebAuthnRegistrationHandler,
+	finishWebAuthnRegistrationHandler *commands.FinishWebAuthnRegistrationHandler,
+	beginWebAuthnLoginHandler *commands.BeginWebAuthnLoginHandler,
+	finishWebAuthnLoginHandler *commands.FinishWebAuthnLoginHandler,
+	requestMagicLinkHandler *commands.RequestMagicLinkHandler,
+	redeemMagicLinkHandler *commands.RedeemMagicLinkHandler,
+	requestPhoneLoginCodeHandler *commands.RequestPhoneLoginCodeHandler,
+	loginWithPhoneCodeHandler *commands.LoginWithPhoneCodeHandler,
+	unlockUserHandler *commands.UnlockUserHandler,
+	refreshTokensHandler *commands.RefreshTokensHandler,
+	changeUserStatusHandler *commands.ChangeUserStatusHandler,
+	deleteUserHandler *commands.DeleteUserHandler,
+	requestAccountDeletionHandler *commands.RequestAccountDeletionHandler,
+	cancelAccountDeletionHandler *commands.CancelAccountDeletionHandler,
+	exportUserDataHandler *commands.ExportUserDataHandler,
+	getDataExportHandler *queries.GetDataExportHandler,
+	getMeHandler *queries.GetMeHandler,
+	updateProfileHandler *commands.UpdateProfileHandler,
 ) (*APIHandler, error) {
 	return &APIHandler{
-		registerHandler: registerHandler,
-		loginHandler:    loginHandler,
+		registerHandler:       registerHandler,
+		loginHandler:          loginHandler,
+		refreshTokensHandler:  refreshTokensHandler,
+		changePasswordHandler: changePasswordHandler,
+
+		requestPhoneLoginCodeHandler: requestPhoneLoginCodeHandler,
+		loginWithPhoneCodeHandler:    loginWithPhoneCodeHandler,
+
+		changeExpiredPasswordHandler: changeExpiredPasswordHandler,
+		requirePasswordChangeHandler: requirePasswordChangeHandler,
+		unlockUserHandler:            unlockUserHandler,
+		changeUserStatusHandler:      changeUserStatusHandler,
+		deleteUserHandler:            deleteUserHandler,
+
+		getMeHandler:         getMeHandler,
+		updateProfileHandler: updateProfileHandler,
+
+		requestAccountDeletionHandler: requestAccountDeletionHandler,
+		cancelAccountDeletionHandler:  cancelAccountDeletionHandler,
+
+		exportUserDataHandler: exportUserDataHandler,
+		getDataExportHandler:  getDataExportHandler,
+
+		sendEmailVerificationHandler: sendEmailVerificationHandler,
+		verifyEmailHandler:           verifyEmailHandler,
+
+		requestEmailChangeHandler: requestEmailChangeHandler,
+		confirmEmailChangeHandler: confirmEmailChangeHandler,
+
+		sendPhoneVerificationHandler: sendPhoneVerificationHandler,
+		verifyPhoneHandler:           verifyPhoneHandler,
+
+		requestPhoneChangeHandler: requestPhoneChangeHandler,
+		confirmPhoneChangeHandler: confirmPhoneChangeHandler,
+
+		enrollTOTPHandler:  enrollTOTPHandler,
+		confirmTOTPHandler: confirmTOTPHandler,
+		verifyMFAHandler:   verifyMFAHandler,
+
+		beginWebAuthnRegistrationHandler:  beginWebAuthnRegistrationHandler,
+		finishWebAuthnRegistrationHandler: finishWebAuthnRegistrationHandler,
+		beginWebAuthnLoginHandler:         beginWebAuthnLoginHandler,
+		finishWebAuthnLoginHandler:        finishWebAuthnLoginHandler,
+
+		requestMagicLinkHandler: requestMagicLinkHandler,
+		redeemMagicLinkHandler:  redeemMagicLinkHandler,
 	}, nil
 }
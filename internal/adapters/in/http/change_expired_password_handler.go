@@ -0,0 +1,36 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+)
+
+// ChangeExpiredPassword implements POST /auth/password/expired from OpenAPI.
+func (a *APIHandler) ChangeExpiredPassword(
+	ctx context.Context,
+	request v1.ChangeExpiredPasswordRequestObject,
+) (v1.ChangeExpiredPasswordResponseObject, error) {
+	body := request.Body
+
+	cmd := commands.ChangeExpiredPasswordCommand{
+		PasswordChangeToken: body.PasswordChangeToken,
+		NewPassword:         body.NewPassword,
+	}
+
+	result, err := a.changeExpiredPasswordHandler.Handle(ctx, cmd)
+	if err != nil {
+		return httperrs.ToChangeExpiredPasswordResponse(err), nil
+	}
+
+	return v1.ChangeExpiredPassword200JSONResponse(v1.LoginResponse{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		TokenType:    result.TokenType,
+		ExpiresIn:    int(result.ExpiresIn),
+		User:         toUser(result.User),
+	}), nil
+}
@@ -0,0 +1,37 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+)
+
+// ChangePassword implements POST /auth/password/change from OpenAPI.
+func (a *APIHandler) ChangePassword(
+	ctx context.Context,
+	request v1.ChangePasswordRequestObject,
+) (v1.ChangePasswordResponseObject, error) {
+	// BearerAuthMiddleware already authenticated the caller
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToChangePasswordResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	body := request.Body
+
+	cmd := commands.ChangePasswordCommand{
+		UserID:          user.ID,
+		CurrentPassword: body.CurrentPassword,
+		NewPassword:     body.NewPassword,
+	}
+
+	if err := a.changePasswordHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToChangePasswordResponse(err), nil
+	}
+
+	return v1.ChangePassword204Response{}, nil
+}
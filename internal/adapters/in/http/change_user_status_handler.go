@@ -0,0 +1,31 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+)
+
+// ChangeUserStatus implements POST /admin/users/{user_id}/status from OpenAPI.
+func (a *APIHandler) ChangeUserStatus(
+	ctx context.Context,
+	request v1.ChangeUserStatusRequestObject,
+) (v1.ChangeUserStatusResponseObject, error) {
+	// AdminAPIKeyMiddleware already checked the admin API key
+	cmd := commands.ChangeUserStatusCommand{
+		UserID: request.UserId,
+		Status: string(request.Body.Status),
+	}
+	if request.Body.Reason != nil {
+		cmd.Reason = *request.Body.Reason
+	}
+
+	if err := a.changeUserStatusHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToChangeUserStatusResponse(err), nil
+	}
+
+	return v1.ChangeUserStatus204Response{}, nil
+}
@@ -0,0 +1,125 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/queries"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+)
+
+// ExportMyData implements GET /me/export from OpenAPI.
+func (a *APIHandler) ExportMyData(
+	ctx context.Context,
+	_ v1.ExportMyDataRequestObject,
+) (v1.ExportMyDataResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToExportMyDataResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	result, err := a.exportUserDataHandler.Handle(ctx, commands.ExportUserDataCommand{UserID: user.ID})
+	if err != nil {
+		return httperrs.ToExportMyDataResponse(err), nil
+	}
+
+	if result.Export != nil {
+		return v1.ExportMyData202JSONResponse(dataExportBody(result.Export)), nil
+	}
+	archive, err := archiveBody(result.Archive)
+	if err != nil {
+		return httperrs.ToExportMyDataResponse(err), nil
+	}
+	return v1.ExportMyData200JSONResponse(archive), nil
+}
+
+// GetMyDataExport implements GET /me/exports/{export_id} from OpenAPI.
+func (a *APIHandler) GetMyDataExport(
+	ctx context.Context,
+	request v1.GetMyDataExportRequestObject,
+) (v1.GetMyDataExportResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToGetMyDataExportResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	export, err := a.getDataExportHandler.Handle(ctx, queries.GetDataExportQuery{UserID: user.ID, ExportID: request.ExportId})
+	if err != nil {
+		return httperrs.ToGetMyDataExportResponse(err), nil
+	}
+
+	if !export.IsReady() {
+		return v1.GetMyDataExport202JSONResponse(dataExportBody(export)), nil
+	}
+	archive, err := archiveBody(export.Archive)
+	if err != nil {
+		return httperrs.ToGetMyDataExportResponse(err), nil
+	}
+	return v1.GetMyDataExport200JSONResponse(archive), nil
+}
+
+// ExportUserData implements GET /admin/users/{user_id}/export from OpenAPI.
+func (a *APIHandler) ExportUserData(
+	ctx context.Context,
+	request v1.ExportUserDataRequestObject,
+) (v1.ExportUserDataResponseObject, error) {
+	// AdminAPIKeyMiddleware already checked the admin API key
+	result, err := a.exportUserDataHandler.Handle(ctx, commands.ExportUserDataCommand{UserID: request.UserId})
+	if err != nil {
+		return httperrs.ToExportUserDataResponse(err), nil
+	}
+
+	if result.Export != nil {
+		return v1.ExportUserData202JSONResponse(dataExportBody(result.Export)), nil
+	}
+	archive, err := archiveBody(result.Archive)
+	if err != nil {
+		return httperrs.ToExportUserDataResponse(err), nil
+	}
+	return v1.ExportUserData200JSONResponse(archive), nil
+}
+
+// GetUserDataExport implements GET /admin/users/{user_id}/exports/{export_id} from OpenAPI.
+func (a *APIHandler) GetUserDataExport(
+	ctx context.Context,
+	request v1.GetUserDataExportRequestObject,
+) (v1.GetUserDataExportResponseObject, error) {
+	// AdminAPIKeyMiddleware already checked the admin API key
+	export, err := a.getDataExportHandler.Handle(ctx, queries.GetDataExportQuery{UserID: request.UserId, ExportID: request.ExportId})
+	if err != nil {
+		return httperrs.ToGetUserDataExportResponse(err), nil
+	}
+
+	if !export.IsReady() {
+		return v1.GetUserDataExport202JSONResponse(dataExportBody(export)), nil
+	}
+	archive, err := archiveBody(export.Archive)
+	if err != nil {
+		return httperrs.ToGetUserDataExportResponse(err), nil
+	}
+	return v1.GetUserDataExport200JSONResponse(archive), nil
+}
+
+// archiveBody decodes the JSON archive built by the use case; its format matches the UserDataArchive schema
+func archiveBody(raw []byte) (v1.UserDataArchive, error) {
+	var archive v1.UserDataArchive
+	if err := json.Unmarshal(raw, &archive); err != nil {
+		return v1.UserDataArchive{}, err
+	}
+	return archive, nil
+}
+
+// dataExportBody describes an export that is still being generated
+func dataExportBody(export *auth.DataExport) v1.DataExport {
+	return v1.DataExport{
+		Id:        export.ID,
+		Status:    v1.DataExportStatus(export.Status),
+		CreatedAt: export.CreatedAt,
+		ExpiresAt: export.ExpiresAt,
+	}
+}
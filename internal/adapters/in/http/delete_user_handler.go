@@ -0,0 +1,25 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+)
+
+// DeleteUser implements DELETE /admin/users/{user_id} from OpenAPI.
+func (a *APIHandler) DeleteUser(
+	ctx context.Context,
+	request v1.DeleteUserRequestObject,
+) (v1.DeleteUserResponseObject, error) {
+	// AdminAPIKeyMiddleware already checked the admin API key
+	cmd := commands.DeleteUserCommand{UserID: request.UserId}
+
+	if err := a.deleteUserHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToDeleteUserResponse(err), nil
+	}
+
+	return v1.DeleteUser204Response{}, nil
+}
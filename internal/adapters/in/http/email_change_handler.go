@@ -0,0 +1,50 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+)
+
+// RequestEmailChange implements POST /auth/email/change from OpenAPI.
+func (a *APIHandler) RequestEmailChange(
+	ctx context.Context,
+	request v1.RequestEmailChangeRequestObject,
+) (v1.RequestEmailChangeResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToRequestEmailChangeResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	cmd := commands.RequestEmailChangeCommand{
+		UserID:          user.ID,
+		CurrentPassword: request.Body.CurrentPassword,
+		NewEmail:        string(request.Body.NewEmail),
+	}
+
+	if err := a.requestEmailChangeHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToRequestEmailChangeResponse(err), nil
+	}
+
+	return v1.RequestEmailChange202Response{}, nil
+}
+
+// ConfirmEmailChange implements POST /auth/email/change/confirm from OpenAPI.
+func (a *APIHandler) ConfirmEmailChange(
+	ctx context.Context,
+	request v1.ConfirmEmailChangeRequestObject,
+) (v1.ConfirmEmailChangeResponseObject, error) {
+	cmd := commands.ConfirmEmailChangeCommand{
+		Token: request.Body.Token,
+	}
+
+	if err := a.confirmEmailChangeHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToConfirmEmailChangeResponse(err), nil
+	}
+
+	return v1.ConfirmEmailChange204Response{}, nil
+}
@@ -0,0 +1,42 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+)
+
+// SendEmailVerification implements POST /auth/email/verification from OpenAPI.
+func (a *APIHandler) SendEmailVerification(
+	ctx context.Context,
+	request v1.SendEmailVerificationRequestObject,
+) (v1.SendEmailVerificationResponseObject, error) {
+	cmd := commands.SendEmailVerificationCommand{
+		Email: string(request.Body.Email),
+	}
+
+	if err := a.sendEmailVerificationHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToSendEmailVerificationResponse(err), nil
+	}
+
+	return v1.SendEmailVerification202Response{}, nil
+}
+
+// VerifyEmail implements POST /auth/email/verify from OpenAPI.
+func (a *APIHandler) VerifyEmail(
+	ctx context.Context,
+	request v1.VerifyEmailRequestObject,
+) (v1.VerifyEmailResponseObject, error) {
+	cmd := commands.VerifyEmailCommand{
+		Token: request.Body.Token,
+	}
+
+	if err := a.verifyEmailHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToVerifyEmailResponse(err), nil
+	}
+
+	return v1.VerifyEmail204Response{}, nil
+}
@@ -2,19 +2,25 @@ package httperrs
 
 import (
 	"errors"
+	"math"
 	stdhttp "net/http"
 
 	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
 	"github.com/Vi-72/quest-auth/internal/pkg/errs"
 )
 
 // HTTP Status Code Constants
 const (
-	StatusBadRequest          = 400
-	StatusUnauthorized        = 401
-	StatusNotFound            = 404
-	StatusConflict            = 409
-	StatusInternalServerError = 500
+	StatusBadRequest           = 400
+	StatusUnauthorized         = 401
+	StatusForbidden            = 403
+	StatusNotFound             = 404
+	StatusConflict             = 409
+	StatusLocked               = 423
+	StatusPreconditionRequired = 428
+	StatusTooManyRequests      = 429
+	StatusInternalServerError  = 500
 )
 
 // HTTPError represents a structured HTTP error response
@@ -47,7 +53,7 @@ func ToHTTP(err error) HTTPError {
 	if errors.As(err, &domainValidationErr) {
 		switch domainValidationErr.Field {
 		case "email":
-			if domainValidationErr.Message == "email already exists" {
+			if errors.Is(err, auth.ErrEmailTaken) {
 				return HTTPError{
 					Type:       "conflict",
 					Title:      "Conflict",
@@ -64,7 +70,7 @@ func ToHTTP(err error) HTTPError {
 				StatusCode: stdhttp.StatusBadRequest,
 			}
 		case "phone":
-			if domainValidationErr.Message == "phone already exists" {
+			if errors.Is(err, auth.ErrPhoneTaken) {
 				return HTTPError{
 					Type:       "conflict",
 					Title:      "Conflict",
@@ -99,6 +105,66 @@ func ToHTTP(err error) HTTPError {
 		}
 	}
 
+	// Check for JWT validation errors (invalid, expired or misused tokens)
+	var jwtErr *errs.JWTValidationError
+	if errors.As(err, &jwtErr) {
+		return HTTPError{
+			Type:       "unauthorized",
+			Title:      "Unauthorized",
+			Status:     StatusUnauthorized,
+			Detail:     "Invalid or expired token",
+			StatusCode: stdhttp.StatusUnauthorized,
+		}
+	}
+
+	// Check for forbidden errors (reason becomes problem type)
+	var forbiddenErr *errs.ForbiddenError
+	if errors.As(err, &forbiddenErr) {
+		return HTTPError{
+			Type:       forbiddenErr.Reason,
+			Title:      "Forbidden",
+			Status:     StatusForbidden,
+			Detail:     forbiddenErr.Message,
+			StatusCode: stdhttp.StatusForbidden,
+		}
+	}
+
+	// Check for throttled operations
+	var tooManyErr *errs.TooManyRequestsError
+	if errors.As(err, &tooManyErr) {
+		return HTTPError{
+			Type:       "too-many-requests",
+			Title:      "Too Many Requests",
+			Status:     StatusTooManyRequests,
+			Detail:     tooManyErr.Message,
+			StatusCode: stdhttp.StatusTooManyRequests,
+		}
+	}
+
+	// Check for temporarily locked accounts
+	var lockedErr *errs.LockedError
+	if errors.As(err, &lockedErr) {
+		return HTTPError{
+			Type:       "account-locked",
+			Title:      "Account Locked",
+			Status:     StatusLocked,
+			Detail:     lockedErr.Message,
+			StatusCode: stdhttp.StatusLocked,
+		}
+	}
+
+	// Check for operations that need a solved CAPTCHA
+	var captchaErr *errs.CaptchaRequiredError
+	if errors.As(err, &captchaErr) {
+		return HTTPError{
+			Type:       "captcha-required",
+			Title:      "CAPTCHA Required",
+			Status:     StatusPreconditionRequired,
+			Detail:     captchaErr.Message,
+			StatusCode: stdhttp.StatusPreconditionRequired,
+		}
+	}
+
 	// Check for not found errors
 	var notFoundErr *errs.NotFoundError
 	if errors.As(err, &notFoundErr) {
@@ -137,7 +203,16 @@ func ToHTTP(err error) HTTPError {
 func ToRegisterResponse(err error) v1.RegisterResponseObject {
 	httpErr := ToHTTP(err)
 
-	// OpenAPI schema only supports 400 and 500 for register endpoint
+	if httpErr.StatusCode == stdhttp.StatusPreconditionRequired {
+		return v1.Register428JSONResponse(v1.CaptchaRequired{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	}
+
+	// OpenAPI schema has no 409 for register endpoint
 	if httpErr.StatusCode == stdhttp.StatusConflict {
 		httpErr.Status = 400
 		httpErr.StatusCode = stdhttp.StatusBadRequest
@@ -163,6 +238,32 @@ func ToLoginResponse(err error) v1.LoginResponseObject {
 			Status: httpErr.Status,
 			Detail: httpErr.Detail,
 		})
+	case stdhttp.StatusForbidden:
+		return v1.Login403JSONResponse(v1.LoginForbidden{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusLocked:
+		retryAfter := retryAfterSeconds(err)
+		return v1.Login423JSONResponse{
+			Body: v1.AccountLocked{
+				Type:       httpErr.Type,
+				Title:      httpErr.Title,
+				Status:     httpErr.Status,
+				Detail:     httpErr.Detail,
+				RetryAfter: retryAfter,
+			},
+			Headers: v1.Login423ResponseHeaders{RetryAfter: retryAfter},
+		}
+	case stdhttp.StatusPreconditionRequired:
+		return v1.Login428JSONResponse(v1.CaptchaRequired{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
 	case stdhttp.StatusBadRequest:
 		return v1.Login400JSONResponse(v1.BadRequest{
 			Type:   httpErr.Type,
@@ -181,17 +282,826 @@ func ToLoginResponse(err error) v1.LoginResponseObject {
 	}
 }
 
-// Helper functions
+// ToRefreshTokensResponse converts error to RefreshTokens strict response wrapper
+func ToRefreshTokensResponse(err error) v1.RefreshTokensResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.RefreshTokens401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusForbidden:
+		return v1.RefreshTokens403JSONResponse(v1.LoginForbidden{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.RefreshTokens400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.RefreshTokens500Response{}
+	}
+}
+
+// ToChangePasswordResponse converts error to ChangePassword strict response wrapper
+func ToChangePasswordResponse(err error) v1.ChangePasswordResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		// Token belongs to a user that no longer exists — treat as unauthenticated
+		return v1.ChangePassword401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.ChangePassword400JSONResponse(v1.BadRequest{
+			Type:   "bad-request",
+			Title:  "Bad Request",
+			Status: StatusBadRequest,
+			Detail: httpErr.Detail,
+		})
+	}
+}
+
+// ToChangeExpiredPasswordResponse converts error to ChangeExpiredPassword strict response wrapper
+func ToChangeExpiredPasswordResponse(err error) v1.ChangeExpiredPasswordResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.ChangeExpiredPassword401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusForbidden:
+		return v1.ChangeExpiredPassword403JSONResponse(v1.LoginForbidden{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.ChangeExpiredPassword400JSONResponse(v1.BadRequest{
+			Type:   "bad-request",
+			Title:  "Bad Request",
+			Status: StatusBadRequest,
+			Detail: httpErr.Detail,
+		})
+	}
+}
+
+// ToRequirePasswordChangeResponse converts error to RequirePasswordChange strict response wrapper
+func ToRequirePasswordChangeResponse(err error) v1.RequirePasswordChangeResponseObject {
+	httpErr := ToHTTP(err)
+
+	if httpErr.StatusCode == stdhttp.StatusNotFound {
+		return v1.RequirePasswordChange404JSONResponse(v1.NotFound{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	}
+
+	return v1.RequirePasswordChange500Response{}
+}
+
+// ToChangeUserStatusResponse converts error to ChangeUserStatus strict response wrapper
+func ToChangeUserStatusResponse(err error) v1.ChangeUserStatusResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusNotFound:
+		return v1.ChangeUserStatus404JSONResponse(v1.NotFound{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.ChangeUserStatus400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.ChangeUserStatus500Response{}
+	}
+}
+
+// ToDeleteUserResponse converts error to DeleteUser strict response wrapper
+func ToDeleteUserResponse(err error) v1.DeleteUserResponseObject {
+	httpErr := ToHTTP(err)
+
+	if httpErr.StatusCode == stdhttp.StatusNotFound {
+		return v1.DeleteUser404JSONResponse(v1.NotFound{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	}
+
+	return v1.DeleteUser500Response{}
+}
+
+// ToRequestAccountDeletionResponse converts error to RequestAccountDeletion strict response wrapper
+func ToRequestAccountDeletionResponse(err error) v1.RequestAccountDeletionResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.RequestAccountDeletion401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.RequestAccountDeletion400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.RequestAccountDeletion500Response{}
+	}
+}
+
+// ToGetMeResponse converts error to GetMe strict response wrapper
+func ToGetMeResponse(err error) v1.GetMeResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.GetMe401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.GetMe500Response{}
+	}
+}
+
+// ToUpdateProfileResponse converts error to UpdateProfile strict response wrapper
+func ToUpdateProfileResponse(err error) v1.UpdateProfileResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.UpdateProfile401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.UpdateProfile400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.UpdateProfile500Response{}
+	}
+}
+
+// ToCancelAccountDeletionResponse converts error to CancelAccountDeletion strict response wrapper
+func ToCancelAccountDeletionResponse(err error) v1.CancelAccountDeletionResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.CancelAccountDeletion401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.CancelAccountDeletion400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.CancelAccountDeletion500Response{}
+	}
+}
+
+// ToExportMyDataResponse converts error to ExportMyData strict response wrapper
+func ToExportMyDataResponse(err error) v1.ExportMyDataResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.ExportMyData401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.ExportMyData500Response{}
+	}
+}
+
+// ToGetMyDataExportResponse converts error to GetMyDataExport strict response wrapper
+func ToGetMyDataExportResponse(err error) v1.GetMyDataExportResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized:
+		return v1.GetMyDataExport401JSONResponse(v1.Unauthorized{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusNotFound:
+		return v1.GetMyDataExport404JSONResponse(v1.NotFound{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.GetMyDataExport500Response{}
+	}
+}
+
+// ToExportUserDataResponse converts error to ExportUserData strict response wrapper
+func ToExportUserDataResponse(err error) v1.ExportUserDataResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized:
+		return v1.ExportUserData401JSONResponse(v1.Unauthorized{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusNotFound:
+		return v1.ExportUserData404JSONResponse(v1.NotFound{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.ExportUserData500Response{}
+	}
+}
+
+// ToGetUserDataExportResponse converts error to GetUserDataExport strict response wrapper
+func ToGetUserDataExportResponse(err error) v1.GetUserDataExportResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized:
+		return v1.GetUserDataExport401JSONResponse(v1.Unauthorized{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusNotFound:
+		return v1.GetUserDataExport404JSONResponse(v1.NotFound{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.GetUserDataExport500Response{}
+	}
+}
+
+// ToUnlockUserResponse converts error to UnlockUser strict response wrapper
+func ToUnlockUserResponse(err error) v1.UnlockUserResponseObject {
+	httpErr := ToHTTP(err)
+
+	if httpErr.StatusCode == stdhttp.StatusNotFound {
+		return v1.UnlockUser404JSONResponse(v1.NotFound{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	}
+
+	return v1.UnlockUser500Response{}
+}
+
+// ToSendEmailVerificationResponse converts error to SendEmailVerification strict response wrapper
+func ToSendEmailVerificationResponse(err error) v1.SendEmailVerificationResponseObject {
+	httpErr := ToHTTP(err)
+
+	if httpErr.StatusCode == stdhttp.StatusBadRequest {
+		return v1.SendEmailVerification400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	}
+
+	return v1.SendEmailVerification500Response{}
+}
+
+// ToVerifyEmailResponse converts error to VerifyEmail strict response wrapper
+func ToVerifyEmailResponse(err error) v1.VerifyEmailResponseObject {
+	httpErr := ToHTTP(err)
+
+	if httpErr.StatusCode == stdhttp.StatusBadRequest {
+		return v1.VerifyEmail400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	}
+
+	return v1.VerifyEmail500Response{}
+}
+
+// ToRequestEmailChangeResponse converts error to RequestEmailChange strict response wrapper
+func ToRequestEmailChangeResponse(err error) v1.RequestEmailChangeResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.RequestEmailChange401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusConflict:
+		return v1.RequestEmailChange409JSONResponse(v1.Conflict{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.RequestEmailChange400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.RequestEmailChange500Response{}
+	}
+}
+
+// ToConfirmEmailChangeResponse converts error to ConfirmEmailChange strict response wrapper
+func ToConfirmEmailChangeResponse(err error) v1.ConfirmEmailChangeResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusConflict:
+		return v1.ConfirmEmailChange409JSONResponse(v1.Conflict{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.ConfirmEmailChange400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.ConfirmEmailChange500Response{}
+	}
+}
+
+// ToSendPhoneVerificationResponse converts error to SendPhoneVerification strict response wrapper
+func ToSendPhoneVerificationResponse(err error) v1.SendPhoneVerificationResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.SendPhoneVerification401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.SendPhoneVerification400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusTooManyRequests:
+		return v1.SendPhoneVerification429JSONResponse(v1.TooManyRequests{
+			Type:       httpErr.Type,
+			Title:      httpErr.Title,
+			Status:     httpErr.Status,
+			Detail:     httpErr.Detail,
+			RetryAfter: retryAfterSeconds(err),
+		})
+	default:
+		return v1.SendPhoneVerification500Response{}
+	}
+}
+
+// ToVerifyPhoneResponse converts error to VerifyPhone strict response wrapper
+func ToVerifyPhoneResponse(err error) v1.VerifyPhoneResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.VerifyPhone401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.VerifyPhone400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.VerifyPhone500Response{}
+	}
+}
+
+// ToRequestPhoneChangeResponse converts error to RequestPhoneChange strict response wrapper
+func ToRequestPhoneChangeResponse(err error) v1.RequestPhoneChangeResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.RequestPhoneChange401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusConflict:
+		return v1.RequestPhoneChange409JSONResponse(v1.Conflict{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusTooManyRequests:
+		return v1.RequestPhoneChange429JSONResponse(v1.TooManyRequests{
+			Type:       httpErr.Type,
+			Title:      httpErr.Title,
+			Status:     httpErr.Status,
+			Detail:     httpErr.Detail,
+			RetryAfter: retryAfterSeconds(err),
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.RequestPhoneChange400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.RequestPhoneChange500Response{}
+	}
+}
+
+// ToConfirmPhoneChangeResponse converts error to ConfirmPhoneChange strict response wrapper
+func ToConfirmPhoneChangeResponse(err error) v1.ConfirmPhoneChangeResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.ConfirmPhoneChange401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusConflict:
+		return v1.ConfirmPhoneChange409JSONResponse(v1.Conflict{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.ConfirmPhoneChange400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.ConfirmPhoneChange500Response{}
+	}
+}
+
+// ToEnrollTOTPResponse converts error to EnrollTOTP strict response wrapper
+func ToEnrollTOTPResponse(err error) v1.EnrollTOTPResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.EnrollTOTP401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.EnrollTOTP400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.EnrollTOTP500Response{}
+	}
+}
+
+// ToConfirmTOTPResponse converts error to ConfirmTOTP strict response wrapper
+func ToConfirmTOTPResponse(err error) v1.ConfirmTOTPResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.ConfirmTOTP401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.ConfirmTOTP400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.ConfirmTOTP500Response{}
+	}
+}
+
+// ToVerifyMFAResponse converts error to VerifyMFA strict response wrapper
+func ToVerifyMFAResponse(err error) v1.VerifyMFAResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.VerifyMFA401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusForbidden:
+		return v1.VerifyMFA403JSONResponse(v1.LoginForbidden{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.VerifyMFA400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.VerifyMFA500Response{}
+	}
+}
+
+// ToBeginWebAuthnRegistrationResponse converts error to BeginWebAuthnRegistration strict response wrapper
+func ToBeginWebAuthnRegistrationResponse(err error) v1.BeginWebAuthnRegistrationResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.BeginWebAuthnRegistration401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.BeginWebAuthnRegistration500Response{}
+	}
+}
+
+// ToFinishWebAuthnRegistrationResponse converts error to FinishWebAuthnRegistration strict response wrapper
+func ToFinishWebAuthnRegistrationResponse(err error) v1.FinishWebAuthnRegistrationResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.FinishWebAuthnRegistration401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.FinishWebAuthnRegistration400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.FinishWebAuthnRegistration500Response{}
+	}
+}
+
+// ToBeginWebAuthnLoginResponse converts error to BeginWebAuthnLogin strict response wrapper
+func ToBeginWebAuthnLoginResponse(_ error) v1.BeginWebAuthnLoginResponseObject {
+	return v1.BeginWebAuthnLogin500Response{}
+}
+
+// ToFinishWebAuthnLoginResponse converts error to FinishWebAuthnLogin strict response wrapper
+func ToFinishWebAuthnLoginResponse(err error) v1.FinishWebAuthnLoginResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.FinishWebAuthnLogin401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusForbidden:
+		return v1.FinishWebAuthnLogin403JSONResponse(v1.LoginForbidden{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.FinishWebAuthnLogin400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.FinishWebAuthnLogin500Response{}
+	}
+}
+
+// ToRequestPhoneLoginCodeResponse converts error to RequestPhoneLoginCode strict response wrapper
+func ToRequestPhoneLoginCodeResponse(err error) v1.RequestPhoneLoginCodeResponseObject {
+	httpErr := ToHTTP(err)
+
+	if httpErr.StatusCode == stdhttp.StatusBadRequest {
+		return v1.RequestPhoneLoginCode400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	}
+
+	return v1.RequestPhoneLoginCode500Response{}
+}
+
+// ToLoginWithPhoneCodeResponse converts error to LoginWithPhoneCode strict response wrapper
+func ToLoginWithPhoneCodeResponse(err error) v1.LoginWithPhoneCodeResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized:
+		return v1.LoginWithPhoneCode401JSONResponse(v1.Unauthorized{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusForbidden:
+		return v1.LoginWithPhoneCode403JSONResponse(v1.LoginForbidden{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusBadRequest:
+		return v1.LoginWithPhoneCode400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.LoginWithPhoneCode500Response{}
+	}
+}
+
+// ToRequestMagicLinkResponse converts error to RequestMagicLink strict response wrapper
+func ToRequestMagicLinkResponse(err error) v1.RequestMagicLinkResponseObject {
+	httpErr := ToHTTP(err)
+
+	if httpErr.StatusCode == stdhttp.StatusBadRequest {
+		return v1.RequestMagicLink400JSONResponse(v1.BadRequest{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	}
+
+	return v1.RequestMagicLink500Response{}
+}
+
+// ToRedeemMagicLinkResponse converts error to RedeemMagicLink strict response wrapper
+func ToRedeemMagicLinkResponse(err error) v1.RedeemMagicLinkResponseObject {
+	httpErr := ToHTTP(err)
+
+	switch httpErr.StatusCode {
+	case stdhttp.StatusUnauthorized, stdhttp.StatusNotFound:
+		return v1.RedeemMagicLink401JSONResponse(v1.Unauthorized{
+			Type:   "unauthorized",
+			Title:  "Unauthorized",
+			Status: StatusUnauthorized,
+			Detail: httpErr.Detail,
+		})
+	case stdhttp.StatusForbidden:
+		return v1.RedeemMagicLink403JSONResponse(v1.LoginForbidden{
+			Type:   httpErr.Type,
+			Title:  httpErr.Title,
+			Status: httpErr.Status,
+			Detail: httpErr.Detail,
+		})
+	default:
+		return v1.RedeemMagicLink500Response{}
+	}
+}
+
+// Helper functions
+
+// retryAfterSeconds — сколько секунд ждать до повтора (округление вверх)
+func retryAfterSeconds(err error) int {
+	var tooManyErr *errs.TooManyRequestsError
+	if errors.As(err, &tooManyErr) {
+		return int(math.Ceil(tooManyErr.RetryAfter.Seconds()))
+	}
+	var lockedErr *errs.LockedError
+	if errors.As(err, &lockedErr) {
+		return int(math.Ceil(lockedErr.RetryAfter.Seconds()))
+	}
+	return 0
+}
+
 func getTypeFromStatus(status int) string {
 	switch status {
 	case stdhttp.StatusBadRequest:
 		return "bad-request"
 	case stdhttp.StatusUnauthorized:
 		return "unauthorized"
+	case stdhttp.StatusForbidden:
+		return "forbidden"
 	case stdhttp.StatusNotFound:
 		return "not-found"
 	case stdhttp.StatusConflict:
 		return "conflict"
+	case stdhttp.StatusLocked:
+		return "account-locked"
+	case stdhttp.StatusTooManyRequests:
+		return "too-many-requests"
 	case stdhttp.StatusInternalServerError:
 		return "internal-server-error"
 	default:
@@ -205,10 +1115,16 @@ func getTitleFromStatus(status int) string {
 		return "Bad Request"
 	case stdhttp.StatusUnauthorized:
 		return "Unauthorized"
+	case stdhttp.StatusForbidden:
+		return "Forbidden"
 	case stdhttp.StatusNotFound:
 		return "Not Found"
 	case stdhttp.StatusConflict:
 		return "Conflict"
+	case stdhttp.StatusLocked:
+		return "Account Locked"
+	case stdhttp.StatusTooManyRequests:
+		return "Too Many Requests"
 	case stdhttp.StatusInternalServerError:
 		return "Internal Server Error"
 	default:
@@ -1,5 +1,13 @@
 package httperrs
 
+import stdhttp "net/http"
+
+// ErrUnauthenticated is returned by handlers of secured operations when no authenticated user is present
+var ErrUnauthenticated = &ErrorWithStatus{
+	StatusCode: stdhttp.StatusUnauthorized,
+	Message:    "Authentication required",
+}
+
 // ErrorWithStatus wraps an error and provides an associated HTTP status code
 type ErrorWithStatus struct {
 	Err        error
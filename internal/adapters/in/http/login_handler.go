@@ -5,8 +5,10 @@ import (
 
 	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
 	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
 
 	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
 )
 
 // Login implements POST /auth/login from OpenAPI.
@@ -15,10 +17,20 @@ func (a *APIHandler) Login(ctx context.Context, request v1.LoginRequestObject) (
 	// Just extract the data from request.Body
 	body := request.Body
 
+	identifier, err := loginIdentifier(body)
+	if err != nil {
+		return httperrs.ToLoginResponse(err), nil
+	}
+
 	// Execute login command
 	cmd := commands.LoginUserCommand{
-		Email:    string(body.Email),
-		Password: body.Password,
+		Identifier: identifier,
+		Password:   body.Password,
+		ClientIP:   middleware.ClientIPFromContext(ctx),
+		UserAgent:  middleware.UserAgentFromContext(ctx),
+	}
+	if body.CaptchaToken != nil {
+		cmd.CaptchaToken = *body.CaptchaToken
 	}
 
 	result, err := a.loginHandler.Handle(ctx, cmd)
@@ -27,17 +39,75 @@ func (a *APIHandler) Login(ctx context.Context, request v1.LoginRequestObject) (
 		return httperrs.ToLoginResponse(err), nil
 	}
 
+	// MFA enabled: the login is completed by POST /auth/mfa/verify
+	if result.MFARequired {
+		return v1.Login202JSONResponse(toMFARequired(result)), nil
+	}
+
+	// Password expired: only a password change token is issued
+	if result.PasswordExpired {
+		expiresIn := int(result.PasswordChangeTokenExpiresIn)
+		return v1.Login403JSONResponse(v1.LoginForbidden{
+			Type:                "password-expired",
+			Title:               "Password Expired",
+			Status:              httperrs.StatusForbidden,
+			Detail:              "Password has expired and must be changed",
+			PasswordChangeToken: &result.PasswordChangeToken,
+			ExpiresIn:           &expiresIn,
+		}), nil
+	}
+
+	// Login from a new device or location: completed by the link sent to the user's email
+	if result.LoginVerificationRequired {
+		return v1.Login403JSONResponse(loginVerificationRequired()), nil
+	}
+
 	// Map result to response directly
 	return v1.Login200JSONResponse(v1.LoginResponse{
 		AccessToken:  result.AccessToken,
 		RefreshToken: result.RefreshToken,
 		TokenType:    result.TokenType,
 		ExpiresIn:    int(result.ExpiresIn),
-		User: v1.User{
-			Id:    result.User.ID,
-			Email: result.User.Email,
-			Name:  result.User.Name,
-			Phone: &result.User.Phone,
-		},
+		User:         toUser(result.User),
 	}), nil
 }
+
+// loginVerificationRequired is the answer to a login that waits for confirmation by email
+func loginVerificationRequired() v1.LoginForbidden {
+	return v1.LoginForbidden{
+		Type:   "login-verification-required",
+		Title:  "Login Verification Required",
+		Status: httperrs.StatusForbidden,
+		Detail: "Sign-in from a new device or location: confirm it with the link sent to your email",
+	}
+}
+
+// toMFARequired maps the second login step challenge
+func toMFARequired(result commands.LoginUserResult) v1.MFARequired {
+	response := v1.MFARequired{
+		MfaToken:  result.MFAToken,
+		ExpiresIn: int(result.MFATokenExpiresIn),
+	}
+	for _, method := range result.MFAMethods {
+		response.Methods = append(response.Methods, v1.MFARequiredMethods(method))
+	}
+	if result.MFAWebAuthnOptions != nil {
+		options := toWebAuthnRequestOptions(*result.MFAWebAuthnOptions)
+		response.Webauthn = &options
+	}
+	return response
+}
+
+// loginIdentifier picks the identifier from the request; email is kept for older clients
+func loginIdentifier(body *v1.LoginRequest) (string, error) {
+	switch {
+	case body.Identifier != nil && body.Email != nil:
+		return "", errs.NewDomainValidationError("identifier", "give either identifier or email, not both")
+	case body.Identifier != nil:
+		return *body.Identifier, nil
+	case body.Email != nil:
+		return string(*body.Email), nil
+	default:
+		return "", errs.NewDomainValidationError("identifier", "is required")
+	}
+}
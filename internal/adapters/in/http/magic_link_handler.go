@@ -0,0 +1,69 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+)
+
+// RequestMagicLink implements POST /auth/magic-link from OpenAPI.
+func (a *APIHandler) RequestMagicLink(
+	ctx context.Context,
+	request v1.RequestMagicLinkRequestObject,
+) (v1.RequestMagicLinkResponseObject, error) {
+	cmd := commands.RequestMagicLinkCommand{
+		Email: string(request.Body.Email),
+	}
+
+	if err := a.requestMagicLinkHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToRequestMagicLinkResponse(err), nil
+	}
+
+	return v1.RequestMagicLink202Response{}, nil
+}
+
+// RedeemMagicLink implements POST /auth/magic-link/redeem from OpenAPI.
+func (a *APIHandler) RedeemMagicLink(
+	ctx context.Context,
+	request v1.RedeemMagicLinkRequestObject,
+) (v1.RedeemMagicLinkResponseObject, error) {
+	cmd := commands.RedeemMagicLinkCommand{
+		Token:     request.Body.Token,
+		ClientIP:  middleware.ClientIPFromContext(ctx),
+		UserAgent: middleware.UserAgentFromContext(ctx),
+	}
+
+	result, err := a.redeemMagicLinkHandler.Handle(ctx, cmd)
+	if err != nil {
+		return httperrs.ToRedeemMagicLinkResponse(err), nil
+	}
+
+	// MFA enabled: the login is completed by POST /auth/mfa/verify
+	if result.MFARequired {
+		return v1.RedeemMagicLink202JSONResponse(toMFARequired(result)), nil
+	}
+
+	// Password expired: only a password change token is issued
+	if result.PasswordExpired {
+		expiresIn := int(result.PasswordChangeTokenExpiresIn)
+		return v1.RedeemMagicLink403JSONResponse(v1.LoginForbidden{
+			Type:                "password-expired",
+			Title:               "Password Expired",
+			Status:              httperrs.StatusForbidden,
+			Detail:              "Password has expired and must be changed",
+			PasswordChangeToken: &result.PasswordChangeToken,
+			ExpiresIn:           &expiresIn,
+		}), nil
+	}
+
+	return v1.RedeemMagicLink200JSONResponse(v1.LoginResponse{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		TokenType:    result.TokenType,
+		ExpiresIn:    int(result.ExpiresIn),
+		User:         toUser(result.User),
+	}), nil
+}
@@ -0,0 +1,108 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+)
+
+// EnrollTOTP implements POST /auth/mfa/totp/enroll from OpenAPI.
+func (a *APIHandler) EnrollTOTP(
+	ctx context.Context,
+	_ v1.EnrollTOTPRequestObject,
+) (v1.EnrollTOTPResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToEnrollTOTPResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	result, err := a.enrollTOTPHandler.Handle(ctx, commands.EnrollTOTPCommand{UserID: user.ID})
+	if err != nil {
+		return httperrs.ToEnrollTOTPResponse(err), nil
+	}
+
+	return v1.EnrollTOTP200JSONResponse{
+		Secret:     result.Secret,
+		OtpauthUri: result.URI,
+	}, nil
+}
+
+// ConfirmTOTP implements POST /auth/mfa/totp/confirm from OpenAPI.
+func (a *APIHandler) ConfirmTOTP(
+	ctx context.Context,
+	request v1.ConfirmTOTPRequestObject,
+) (v1.ConfirmTOTPResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToConfirmTOTPResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	result, err := a.confirmTOTPHandler.Handle(ctx, commands.ConfirmTOTPCommand{
+		UserID: user.ID,
+		Code:   request.Body.Code,
+	})
+	if err != nil {
+		return httperrs.ToConfirmTOTPResponse(err), nil
+	}
+
+	return v1.ConfirmTOTP200JSONResponse{
+		RecoveryCodes: result.RecoveryCodes,
+	}, nil
+}
+
+// VerifyMFA implements POST /auth/mfa/verify from OpenAPI.
+func (a *APIHandler) VerifyMFA(
+	ctx context.Context,
+	request v1.VerifyMFARequestObject,
+) (v1.VerifyMFAResponseObject, error) {
+	body := request.Body
+
+	cmd := commands.VerifyMFACommand{
+		MFAToken:  body.MfaToken,
+		ClientIP:  middleware.ClientIPFromContext(ctx),
+		UserAgent: middleware.UserAgentFromContext(ctx),
+	}
+	if body.Code != nil {
+		cmd.Code = *body.Code
+	}
+	if body.RecoveryCode != nil {
+		cmd.RecoveryCode = *body.RecoveryCode
+	}
+	if body.Webauthn != nil {
+		assertion, err := toWebAuthnAssertion(*body.Webauthn)
+		if err != nil {
+			return httperrs.ToVerifyMFAResponse(err), nil
+		}
+		cmd.WebAuthn = &assertion
+	}
+
+	result, err := a.verifyMFAHandler.Handle(ctx, cmd)
+	if err != nil {
+		return httperrs.ToVerifyMFAResponse(err), nil
+	}
+
+	// Password expired: only a password change token is issued
+	if result.PasswordExpired {
+		expiresIn := int(result.PasswordChangeTokenExpiresIn)
+		return v1.VerifyMFA403JSONResponse(v1.LoginForbidden{
+			Type:                "password-expired",
+			Title:               "Password Expired",
+			Status:              httperrs.StatusForbidden,
+			Detail:              "Password has expired and must be changed",
+			PasswordChangeToken: &result.PasswordChangeToken,
+			ExpiresIn:           &expiresIn,
+		}), nil
+	}
+
+	return v1.VerifyMFA200JSONResponse(v1.LoginResponse{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		TokenType:    result.TokenType,
+		ExpiresIn:    int(result.ExpiresIn),
+		User:         toUser(result.User),
+	}), nil
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/problems"
+)
+
+// AdminAPIKeyHeader carries the admin API key for operations secured with adminApiKey in OpenAPI
+const AdminAPIKeyHeader = "X-Admin-Api-Key"
+
+// AdminAPIKeyMiddleware protects administrative operations with a shared API key
+type AdminAPIKeyMiddleware struct {
+	apiKey []byte
+}
+
+// NewAdminAPIKeyMiddleware creates a new admin API key middleware.
+// An empty key disables all administrative operations.
+func NewAdminAPIKeyMiddleware(apiKey string) *AdminAPIKeyMiddleware {
+	return &AdminAPIKeyMiddleware{apiKey: []byte(apiKey)}
+}
+
+// Authenticate checks the admin API key for secured operations.
+// Operations without a security requirement are passed through untouched.
+func (mw *AdminAPIKeyMiddleware) Authenticate(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Context().Value(v1.AdminApiKeyScopes) == nil {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		if len(mw.apiKey) == 0 {
+			problems.NewUnauthorized("Administrative API is disabled").WriteResponse(w)
+			return
+		}
+
+		provided := []byte(r.Header.Get(AdminAPIKeyHeader))
+		if subtle.ConstantTimeCompare(provided, mw.apiKey) != 1 {
+			problems.NewUnauthorized("Invalid admin API key").WriteResponse(w)
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
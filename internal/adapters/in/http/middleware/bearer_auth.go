@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/problems"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/queries"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+type authenticatedUserKey struct{}
+
+// BearerAuthMiddleware authenticates requests to operations secured with bearerAuth in OpenAPI
+type BearerAuthMiddleware struct {
+	authenticateByToken *queries.AuthenticateByTokenHandler
+}
+
+// NewBearerAuthMiddleware creates a new bearer authentication middleware
+func NewBearerAuthMiddleware(authenticateByToken *queries.AuthenticateByTokenHandler) *BearerAuthMiddleware {
+	return &BearerAuthMiddleware{authenticateByToken: authenticateByToken}
+}
+
+// Authenticate validates the bearer token for secured operations and stores the user in the request context.
+// Operations without a security requirement are passed through untouched.
+func (mw *BearerAuthMiddleware) Authenticate(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Context().Value(v1.BearerAuthScopes) == nil {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		token, ok := bearerToken(r)
+		if !ok {
+			problems.NewUnauthorized("Missing bearer token").WriteResponse(w)
+			return
+		}
+
+		info, err := mw.authenticateByToken.Handle(r.Context(), queries.AuthenticateByTokenQuery{RawToken: token})
+		if err != nil {
+			// Valid token of a suspended, blocked or deactivated account
+			var forbiddenErr *errs.ForbiddenError
+			if errors.As(err, &forbiddenErr) {
+				problems.NewForbidden(forbiddenErr.Reason, forbiddenErr.Message).WriteResponse(w)
+				return
+			}
+			problems.NewUnauthorized("Invalid or expired token").WriteResponse(w)
+			return
+		}
+
+		h.ServeHTTP(w, r.WithContext(WithAuthenticatedUser(r.Context(), info)))
+	})
+}
+
+// WithAuthenticatedUser stores the authenticated user in the context
+func WithAuthenticatedUser(ctx context.Context, info queries.AuthenticatedInfo) context.Context {
+	return context.WithValue(ctx, authenticatedUserKey{}, info)
+}
+
+// AuthenticatedUserFromContext returns the user authenticated by BearerAuthMiddleware
+func AuthenticatedUserFromContext(ctx context.Context) (queries.AuthenticatedInfo, bool) {
+	info, ok := ctx.Value(authenticatedUserKey{}).(queries.AuthenticatedInfo)
+	return info, ok
+}
+
+// bearerToken extracts the token from the Authorization header
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
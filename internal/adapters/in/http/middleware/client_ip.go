@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+type (
+	clientIPKey  struct{}
+	userAgentKey struct{}
+)
+
+// ClientIPMiddleware puts the client address and User-Agent into the request context for handlers
+// that count attempts per IP (e.g. login CAPTCHA) or keep the login history
+type ClientIPMiddleware struct {
+	trustForwardedFor bool
+}
+
+// NewClientIPMiddleware creates a new client IP middleware.
+// With trustForwardedFor the address is taken from X-Forwarded-For (set it only behind a trusted proxy).
+func NewClientIPMiddleware(trustForwardedFor bool) *ClientIPMiddleware {
+	return &ClientIPMiddleware{trustForwardedFor: trustForwardedFor}
+}
+
+// Resolve stores the client address and User-Agent in the request context
+func (mw *ClientIPMiddleware) Resolve(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), clientIPKey{}, ClientIP(r, mw.trustForwardedFor))
+		ctx = context.WithValue(ctx, userAgentKey{}, r.UserAgent())
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ClientIPFromContext returns the address stored by ClientIPMiddleware, or "" if there is none
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
+
+// UserAgentFromContext returns the User-Agent stored by ClientIPMiddleware, or "" if there is none
+func UserAgentFromContext(ctx context.Context) string {
+	userAgent, _ := ctx.Value(userAgentKey{}).(string)
+	return userAgent
+}
+
+// ClientIP returns the client address: the one added by the nearest proxy to X-Forwarded-For
+// when the proxy is trusted, otherwise the address of the connection
+func ClientIP(r *http.Request, trustForwardedFor bool) string {
+	if trustForwardedFor {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			hops := strings.Split(forwarded, ",")
+			if ip := strings.TrimSpace(hops[len(hops)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
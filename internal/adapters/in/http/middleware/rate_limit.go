@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/pkg/ratelimit"
+)
+
+// maxAccountBodyBytes limits how much of the request body is read to find the account identifier
+const maxAccountBodyBytes = 64 << 10
+
+// RateLimitMiddleware throttles requests by client IP, account identifier and route
+type RateLimitMiddleware struct {
+	limiter           *ratelimit.Limiter
+	trustForwardedFor bool
+	accountKeys       commands.AccountKeys
+}
+
+// NewRateLimitMiddleware creates a new rate limit middleware.
+// With trustForwardedFor the client IP is taken from X-Forwarded-For (set it only behind a trusted proxy).
+// accountKeys normalizes the account identifier, so different spellings of one account share a counter.
+func NewRateLimitMiddleware(
+	limiter *ratelimit.Limiter,
+	trustForwardedFor bool,
+	accountKeys commands.AccountKeys,
+) *RateLimitMiddleware {
+	return &RateLimitMiddleware{
+		limiter:           limiter,
+		trustForwardedFor: trustForwardedFor,
+		accountKeys:       accountKeys,
+	}
+}
+
+// Limit rejects requests over the configured limits with 429 and Retry-After.
+// Routes are matched as "METHOD /path"; if the limiter itself fails, the request is let through.
+func (mw *RateLimitMiddleware) Limit(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + r.URL.Path
+
+		keys := map[ratelimit.KeyKind]string{
+			ratelimit.KeyIP: ClientIP(r, mw.trustForwardedFor),
+		}
+		if mw.limiter.Needs(route, ratelimit.KeyAccount) {
+			keys[ratelimit.KeyAccount] = mw.accountFromBody(r)
+		}
+
+		result, err := mw.limiter.Allow(r.Context(), route, keys)
+		if err != nil {
+			log.Printf("rate limit check failed for %s: %v", route, err)
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		if result.Limit > 0 {
+			w.Header().Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+			w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		}
+
+		if !result.Allowed {
+			writeTooManyRequests(w, result.RetryAfter)
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+// accountFromBody finds the email or phone in a JSON body, puts the body back for the handler
+// and returns the account key for it
+func (mw *RateLimitMiddleware) accountFromBody(r *http.Request) string {
+	if r.Body == nil {
+		return ""
+	}
+
+	head, err := io.ReadAll(io.LimitReader(r.Body, maxAccountBodyBytes))
+	r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(head), r.Body), Closer: r.Body}
+	if err != nil {
+		return ""
+	}
+
+	var body struct {
+		Identifier string `json:"identifier"`
+		Email      string `json:"email"`
+		Phone      string `json:"phone"`
+		NewPhone   string `json:"new_phone"`
+	}
+	if json.Unmarshal(head, &body) != nil {
+		return ""
+	}
+
+	for _, account := range []string{body.Identifier, body.Email, body.Phone, body.NewPhone} {
+		if strings.TrimSpace(account) != "" {
+			return mw.accountKeys.Key(account)
+		}
+	}
+	return ""
+}
+
+// readCloser reads the restored body and closes the original one
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// writeTooManyRequests writes the 429 problem in the same shape as the API's TooManyRequests schema
+func writeTooManyRequests(w http.ResponseWriter, retryAfter time.Duration) {
+	seconds := int(math.Max(1, math.Ceil(retryAfter.Seconds())))
+
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(v1.TooManyRequests{
+		Type:       "too-many-requests",
+		Title:      "Too Many Requests",
+		Status:     http.StatusTooManyRequests,
+		Detail:     "rate limit exceeded",
+		RetryAfter: seconds,
+	})
+}
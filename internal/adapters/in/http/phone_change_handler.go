@@ -0,0 +1,56 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+)
+
+// RequestPhoneChange implements POST /auth/phone/change from OpenAPI.
+func (a *APIHandler) RequestPhoneChange(
+	ctx context.Context,
+	request v1.RequestPhoneChangeRequestObject,
+) (v1.RequestPhoneChangeResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToRequestPhoneChangeResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	result, err := a.requestPhoneChangeHandler.Handle(ctx, commands.RequestPhoneChangeCommand{
+		UserID:   user.ID,
+		NewPhone: request.Body.NewPhone,
+	})
+	if err != nil {
+		return httperrs.ToRequestPhoneChangeResponse(err), nil
+	}
+
+	return v1.RequestPhoneChange202JSONResponse{
+		ExpiresIn:   int(result.ExpiresIn.Seconds()),
+		ResendAfter: int(result.ResendAfter.Seconds()),
+	}, nil
+}
+
+// ConfirmPhoneChange implements POST /auth/phone/change/confirm from OpenAPI.
+func (a *APIHandler) ConfirmPhoneChange(
+	ctx context.Context,
+	request v1.ConfirmPhoneChangeRequestObject,
+) (v1.ConfirmPhoneChangeResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToConfirmPhoneChangeResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	result, err := a.confirmPhoneChangeHandler.Handle(ctx, commands.ConfirmPhoneChangeCommand{
+		UserID: user.ID,
+		Code:   request.Body.Code,
+	})
+	if err != nil {
+		return httperrs.ToConfirmPhoneChangeResponse(err), nil
+	}
+
+	return v1.ConfirmPhoneChange200JSONResponse(toUser(result.User)), nil
+}
@@ -0,0 +1,76 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+)
+
+// RequestPhoneLoginCode implements POST /auth/login/phone/code from OpenAPI.
+func (a *APIHandler) RequestPhoneLoginCode(
+	ctx context.Context,
+	request v1.RequestPhoneLoginCodeRequestObject,
+) (v1.RequestPhoneLoginCodeResponseObject, error) {
+	cmd := commands.RequestPhoneLoginCodeCommand{
+		Phone: request.Body.Phone,
+	}
+
+	if err := a.requestPhoneLoginCodeHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToRequestPhoneLoginCodeResponse(err), nil
+	}
+
+	return v1.RequestPhoneLoginCode202Response{}, nil
+}
+
+// LoginWithPhoneCode implements POST /auth/login/phone from OpenAPI.
+func (a *APIHandler) LoginWithPhoneCode(
+	ctx context.Context,
+	request v1.LoginWithPhoneCodeRequestObject,
+) (v1.LoginWithPhoneCodeResponseObject, error) {
+	cmd := commands.LoginWithPhoneCodeCommand{
+		Phone: request.Body.Phone,
+		Code:  request.Body.Code,
+
+		ClientIP:  middleware.ClientIPFromContext(ctx),
+		UserAgent: middleware.UserAgentFromContext(ctx),
+	}
+
+	result, err := a.loginWithPhoneCodeHandler.Handle(ctx, cmd)
+	if err != nil {
+		return httperrs.ToLoginWithPhoneCodeResponse(err), nil
+	}
+
+	// MFA enabled: the login is completed by POST /auth/mfa/verify
+	if result.MFARequired {
+		return v1.LoginWithPhoneCode202JSONResponse(toMFARequired(result)), nil
+	}
+
+	// Password expired: only a password change token is issued
+	if result.PasswordExpired {
+		expiresIn := int(result.PasswordChangeTokenExpiresIn)
+		return v1.LoginWithPhoneCode403JSONResponse(v1.LoginForbidden{
+			Type:                "password-expired",
+			Title:               "Password Expired",
+			Status:              httperrs.StatusForbidden,
+			Detail:              "Password has expired and must be changed",
+			PasswordChangeToken: &result.PasswordChangeToken,
+			ExpiresIn:           &expiresIn,
+		}), nil
+	}
+
+	// Login from a new device or location: completed by the link sent to the user's email
+	if result.LoginVerificationRequired {
+		return v1.LoginWithPhoneCode403JSONResponse(loginVerificationRequired()), nil
+	}
+
+	return v1.LoginWithPhoneCode200JSONResponse(v1.LoginResponse{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		TokenType:    result.TokenType,
+		ExpiresIn:    int(result.ExpiresIn),
+		User:         toUser(result.User),
+	}), nil
+}
@@ -0,0 +1,56 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+)
+
+// SendPhoneVerification implements POST /auth/phone/verification from OpenAPI.
+func (a *APIHandler) SendPhoneVerification(
+	ctx context.Context,
+	_ v1.SendPhoneVerificationRequestObject,
+) (v1.SendPhoneVerificationResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToSendPhoneVerificationResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	result, err := a.sendPhoneVerificationHandler.Handle(ctx, commands.SendPhoneVerificationCommand{
+		UserID: user.ID,
+	})
+	if err != nil {
+		return httperrs.ToSendPhoneVerificationResponse(err), nil
+	}
+
+	return v1.SendPhoneVerification202JSONResponse{
+		ExpiresIn:   int(result.ExpiresIn.Seconds()),
+		ResendAfter: int(result.ResendAfter.Seconds()),
+	}, nil
+}
+
+// VerifyPhone implements POST /auth/phone/verify from OpenAPI.
+func (a *APIHandler) VerifyPhone(
+	ctx context.Context,
+	request v1.VerifyPhoneRequestObject,
+) (v1.VerifyPhoneResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToVerifyPhoneResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	cmd := commands.VerifyPhoneCommand{
+		UserID: user.ID,
+		Code:   request.Body.Code,
+	}
+
+	if err := a.verifyPhoneHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToVerifyPhoneResponse(err), nil
+	}
+
+	return v1.VerifyPhone204Response{}, nil
+}
@@ -48,3 +48,23 @@ func NewNotFound(detail string) *ProblemDetails {
 		Detail: detail,
 	}
 }
+
+// NewUnauthorized creates a 401 Unauthorized problem
+func NewUnauthorized(detail string) *ProblemDetails {
+	return &ProblemDetails{
+		Type:   "unauthorized",
+		Title:  "Unauthorized",
+		Status: http.StatusUnauthorized,
+		Detail: detail,
+	}
+}
+
+// NewForbidden creates a 403 Forbidden problem
+func NewForbidden(problemType, detail string) *ProblemDetails {
+	return &ProblemDetails{
+		Type:   problemType,
+		Title:  "Forbidden",
+		Status: http.StatusForbidden,
+		Detail: detail,
+	}
+}
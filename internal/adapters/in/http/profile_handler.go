@@ -0,0 +1,62 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/queries"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+)
+
+// GetMe implements GET /me from OpenAPI.
+func (a *APIHandler) GetMe(
+	ctx context.Context,
+	_ v1.GetMeRequestObject,
+) (v1.GetMeResponseObject, error) {
+	authenticated, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToGetMeResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	user, err := a.getMeHandler.Handle(ctx, queries.GetMeQuery{UserID: authenticated.ID})
+	if err != nil {
+		return httperrs.ToGetMeResponse(err), nil
+	}
+
+	return v1.GetMe200JSONResponse(userFromAggregate(user)), nil
+}
+
+// UpdateProfile implements PATCH /me from OpenAPI.
+func (a *APIHandler) UpdateProfile(
+	ctx context.Context,
+	request v1.UpdateProfileRequestObject,
+) (v1.UpdateProfileResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToUpdateProfileResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	cmd := commands.UpdateProfileCommand{
+		UserID:   user.ID,
+		Name:     request.Body.Name,
+		Username: request.Body.Username,
+
+		Locale:    request.Body.Locale,
+		Timezone:  request.Body.Timezone,
+		AvatarURL: request.Body.AvatarUrl,
+		BirthDate: request.Body.BirthDate,
+	}
+	if request.Body.Metadata != nil {
+		cmd.Metadata = *request.Body.Metadata
+	}
+
+	result, err := a.updateProfileHandler.Handle(ctx, cmd)
+	if err != nil {
+		return httperrs.ToUpdateProfileResponse(err), nil
+	}
+
+	return v1.UpdateProfile200JSONResponse(toUser(result.User)), nil
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+)
+
+// RefreshTokens implements POST /auth/refresh from OpenAPI.
+func (a *APIHandler) RefreshTokens(
+	ctx context.Context,
+	request v1.RefreshTokensRequestObject,
+) (v1.RefreshTokensResponseObject, error) {
+	cmd := commands.RefreshTokensCommand{RefreshToken: request.Body.RefreshToken}
+
+	result, err := a.refreshTokensHandler.Handle(ctx, cmd)
+	if err != nil {
+		return httperrs.ToRefreshTokensResponse(err), nil
+	}
+
+	// Password expired or a change was required: only a password change token is issued
+	if result.PasswordExpired {
+		expiresIn := int(result.PasswordChangeTokenExpiresIn)
+		return v1.RefreshTokens403JSONResponse(v1.LoginForbidden{
+			Type:                "password-expired",
+			Title:               "Password Expired",
+			Status:              httperrs.StatusForbidden,
+			Detail:              "Password has expired and must be changed",
+			PasswordChangeToken: &result.PasswordChangeToken,
+			ExpiresIn:           &expiresIn,
+		}), nil
+	}
+
+	return v1.RefreshTokens200JSONResponse(v1.LoginResponse{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		TokenType:    result.TokenType,
+		ExpiresIn:    int(result.ExpiresIn),
+		User:         toUser(result.User),
+	}), nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
 
 	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
 )
 
 // Register implements POST /auth/register from OpenAPI.
@@ -24,6 +25,10 @@ func (a *APIHandler) Register(
 		Phone:    body.Phone,
 		Name:     body.Name,
 		Password: body.Password,
+		ClientIP: middleware.ClientIPFromContext(ctx),
+	}
+	if body.CaptchaToken != nil {
+		cmd.CaptchaToken = *body.CaptchaToken
 	}
 
 	result, err := a.registerHandler.Handle(ctx, cmd)
@@ -32,17 +37,18 @@ func (a *APIHandler) Register(
 		return httperrs.ToRegisterResponse(err), nil
 	}
 
+	// Anti-enumeration mode answers the same for new and taken accounts;
+	// with required email verification the account has to be confirmed before it gets tokens
+	if result.Accepted || result.VerificationPending {
+		return v1.Register202Response{}, nil
+	}
+
 	// Map result to response directly
 	return v1.Register201JSONResponse(v1.RegisterResponse{
 		AccessToken:  result.AccessToken,
 		RefreshToken: result.RefreshToken,
 		TokenType:    result.TokenType,
 		ExpiresIn:    int(result.ExpiresIn),
-		User: v1.User{
-			Id:    result.User.ID,
-			Email: result.User.Email,
-			Name:  result.User.Name,
-			Phone: &result.User.Phone,
-		},
+		User:         toUser(result.User),
 	}), nil
 }
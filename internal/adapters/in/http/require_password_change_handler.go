@@ -0,0 +1,25 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+)
+
+// RequirePasswordChange implements POST /admin/users/{user_id}/password/require-change from OpenAPI.
+func (a *APIHandler) RequirePasswordChange(
+	ctx context.Context,
+	request v1.RequirePasswordChangeRequestObject,
+) (v1.RequirePasswordChangeResponseObject, error) {
+	// AdminAPIKeyMiddleware already checked the admin API key
+	cmd := commands.RequirePasswordChangeCommand{UserID: request.UserId}
+
+	if err := a.requirePasswordChangeHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToRequirePasswordChangeResponse(err), nil
+	}
+
+	return v1.RequirePasswordChange204Response{}, nil
+}
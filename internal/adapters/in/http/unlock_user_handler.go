@@ -0,0 +1,25 @@
+package http
+
+import (
+	"context"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+)
+
+// UnlockUser implements POST /admin/users/{user_id}/unlock from OpenAPI.
+func (a *APIHandler) UnlockUser(
+	ctx context.Context,
+	request v1.UnlockUserRequestObject,
+) (v1.UnlockUserResponseObject, error) {
+	// AdminAPIKeyMiddleware already checked the admin API key
+	cmd := commands.UnlockUserCommand{UserID: request.UserId}
+
+	if err := a.unlockUserHandler.Handle(ctx, cmd); err != nil {
+		return httperrs.ToUnlockUserResponse(err), nil
+	}
+
+	return v1.UnlockUser204Response{}, nil
+}
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+// toUser maps the user returned by commands to the OpenAPI representation
+func toUser(info commands.UserInfo) v1.User {
+	return v1.User{
+		Id:    info.ID,
+		Email: info.Email,
+		Name:  info.Name,
+		Phone: &info.Phone,
+
+		PhoneType: optionalPhoneType(info.PhoneType),
+		Username:  optionalString(info.Username),
+
+		Locale:    optionalString(info.Locale),
+		Timezone:  optionalString(info.Timezone),
+		AvatarUrl: optionalString(info.AvatarURL),
+		BirthDate: optionalDate(info.BirthDate),
+		Metadata:  optionalMetadata(info.Metadata),
+
+		EmailVerified: info.EmailVerified,
+		PhoneVerified: info.PhoneVerified,
+
+		Status:              v1.UserStatus(info.Status),
+		DeletionScheduledAt: info.DeletionScheduledAt,
+	}
+}
+
+// userFromAggregate maps the user aggregate to the OpenAPI representation
+func userFromAggregate(user *auth.User) v1.User {
+	phone := user.Phone.String()
+	return v1.User{
+		Id:    user.ID(),
+		Email: user.Email.String(),
+		Name:  user.Name,
+		Phone: &phone,
+
+		PhoneType: optionalPhoneType(string(user.Phone.Type())),
+		Username:  optionalString(user.Username.String()),
+
+		Locale:    optionalString(user.Profile.Locale),
+		Timezone:  optionalString(user.Profile.Timezone),
+		AvatarUrl: optionalString(user.Profile.AvatarURL),
+		BirthDate: optionalDate(user.Profile.BirthDate),
+		Metadata:  optionalMetadata(user.Metadata),
+
+		EmailVerified: user.IsEmailVerified(),
+		PhoneVerified: user.IsPhoneVerified(),
+
+		Status:              v1.UserStatus(user.Status.String()),
+		DeletionScheduledAt: user.DeletionScheduledAt,
+	}
+}
+
+// optionalPhoneType leaves the type out when the number has none
+func optionalPhoneType(value string) *v1.PhoneType {
+	if value == "" {
+		return nil
+	}
+	phoneType := v1.PhoneType(value)
+	return &phoneType
+}
+
+// optionalString leaves unset profile fields out of the response
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func optionalDate(value *time.Time) *openapi_types.Date {
+	if value == nil {
+		return nil
+	}
+	return &openapi_types.Date{Time: *value}
+}
+
+func optionalMetadata(metadata map[string]any) *v1.UserMetadata {
+	if len(metadata) == 0 {
+		return nil
+	}
+	value := v1.UserMetadata(metadata)
+	return &value
+}
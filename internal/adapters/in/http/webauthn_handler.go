@@ -0,0 +1,213 @@
+package http
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	"github.com/Vi-72/quest-auth/internal/pkg/webauthn"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/httperrs"
+	"github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+)
+
+// BeginWebAuthnRegistration implements POST /auth/webauthn/register/begin from OpenAPI.
+func (a *APIHandler) BeginWebAuthnRegistration(
+	ctx context.Context,
+	_ v1.BeginWebAuthnRegistrationRequestObject,
+) (v1.BeginWebAuthnRegistrationResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToBeginWebAuthnRegistrationResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	result, err := a.beginWebAuthnRegistrationHandler.Handle(ctx, commands.BeginWebAuthnRegistrationCommand{
+		UserID: user.ID,
+	})
+	if err != nil {
+		return httperrs.ToBeginWebAuthnRegistrationResponse(err), nil
+	}
+
+	return v1.BeginWebAuthnRegistration200JSONResponse(toWebAuthnCreationOptions(result.Options)), nil
+}
+
+// FinishWebAuthnRegistration implements POST /auth/webauthn/register/finish from OpenAPI.
+func (a *APIHandler) FinishWebAuthnRegistration(
+	ctx context.Context,
+	request v1.FinishWebAuthnRegistrationRequestObject,
+) (v1.FinishWebAuthnRegistrationResponseObject, error) {
+	user, ok := middleware.AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return httperrs.ToFinishWebAuthnRegistrationResponse(httperrs.ErrUnauthenticated), nil
+	}
+
+	body := request.Body
+	clientDataJSON, err := decodeBase64URL("credential", body.Credential.Response.ClientDataJSON)
+	if err != nil {
+		return httperrs.ToFinishWebAuthnRegistrationResponse(err), nil
+	}
+	attestationObject, err := decodeBase64URL("credential", body.Credential.Response.AttestationObject)
+	if err != nil {
+		return httperrs.ToFinishWebAuthnRegistrationResponse(err), nil
+	}
+
+	cmd := commands.FinishWebAuthnRegistrationCommand{
+		UserID:            user.ID,
+		ClientDataJSON:    clientDataJSON,
+		AttestationObject: attestationObject,
+	}
+	if body.Name != nil {
+		cmd.Name = *body.Name
+	}
+
+	result, err := a.finishWebAuthnRegistrationHandler.Handle(ctx, cmd)
+	if err != nil {
+		return httperrs.ToFinishWebAuthnRegistrationResponse(err), nil
+	}
+
+	return v1.FinishWebAuthnRegistration201JSONResponse{
+		Id:           result.ID,
+		CredentialId: base64.RawURLEncoding.EncodeToString(result.CredentialID),
+		Name:         result.Name,
+		CreatedAt:    result.CreatedAt,
+	}, nil
+}
+
+// BeginWebAuthnLogin implements POST /auth/webauthn/login/begin from OpenAPI.
+func (a *APIHandler) BeginWebAuthnLogin(
+	ctx context.Context,
+	_ v1.BeginWebAuthnLoginRequestObject,
+) (v1.BeginWebAuthnLoginResponseObject, error) {
+	result, err := a.beginWebAuthnLoginHandler.Handle(ctx, commands.BeginWebAuthnLoginCommand{})
+	if err != nil {
+		return httperrs.ToBeginWebAuthnLoginResponse(err), nil
+	}
+
+	return v1.BeginWebAuthnLogin200JSONResponse(toWebAuthnRequestOptions(result.Options)), nil
+}
+
+// FinishWebAuthnLogin implements POST /auth/webauthn/login/finish from OpenAPI.
+func (a *APIHandler) FinishWebAuthnLogin(
+	ctx context.Context,
+	request v1.FinishWebAuthnLoginRequestObject,
+) (v1.FinishWebAuthnLoginResponseObject, error) {
+	assertion, err := toWebAuthnAssertion(request.Body.Credential)
+	if err != nil {
+		return httperrs.ToFinishWebAuthnLoginResponse(err), nil
+	}
+
+	cmd := commands.FinishWebAuthnLoginCommand{
+		Assertion: assertion,
+		ClientIP:  middleware.ClientIPFromContext(ctx),
+		UserAgent: middleware.UserAgentFromContext(ctx),
+	}
+
+	result, err := a.finishWebAuthnLoginHandler.Handle(ctx, cmd)
+	if err != nil {
+		return httperrs.ToFinishWebAuthnLoginResponse(err), nil
+	}
+
+	// Password expired: only a password change token is issued
+	if result.PasswordExpired {
+		expiresIn := int(result.PasswordChangeTokenExpiresIn)
+		return v1.FinishWebAuthnLogin403JSONResponse(v1.LoginForbidden{
+			Type:                "password-expired",
+			Title:               "Password Expired",
+			Status:              httperrs.StatusForbidden,
+			Detail:              "Password has expired and must be changed",
+			PasswordChangeToken: &result.PasswordChangeToken,
+			ExpiresIn:           &expiresIn,
+		}), nil
+	}
+
+	return v1.FinishWebAuthnLogin200JSONResponse(v1.LoginResponse{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		TokenType:    result.TokenType,
+		ExpiresIn:    int(result.ExpiresIn),
+		User:         toUser(result.User),
+	}), nil
+}
+
+// toWebAuthnAssertion decodes the base64url fields of a navigator.credentials.get() result
+func toWebAuthnAssertion(credential v1.WebAuthnAssertion) (commands.WebAuthnAssertion, error) {
+	var (
+		assertion commands.WebAuthnAssertion
+		err       error
+	)
+	if assertion.CredentialID, err = decodeBase64URL("credential", credential.Id); err != nil {
+		return commands.WebAuthnAssertion{}, err
+	}
+	if assertion.ClientDataJSON, err = decodeBase64URL("credential", credential.Response.ClientDataJSON); err != nil {
+		return commands.WebAuthnAssertion{}, err
+	}
+	if assertion.AuthenticatorData, err = decodeBase64URL("credential", credential.Response.AuthenticatorData); err != nil {
+		return commands.WebAuthnAssertion{}, err
+	}
+	if assertion.Signature, err = decodeBase64URL("credential", credential.Response.Signature); err != nil {
+		return commands.WebAuthnAssertion{}, err
+	}
+	if credential.Response.UserHandle != nil {
+		if assertion.UserHandle, err = decodeBase64URL("credential", *credential.Response.UserHandle); err != nil {
+			return commands.WebAuthnAssertion{}, err
+		}
+	}
+	return assertion, nil
+}
+
+func toWebAuthnCreationOptions(options webauthn.CreationOptions) v1.WebAuthnCreationOptions {
+	var response v1.WebAuthnCreationOptions
+
+	response.Challenge = base64.RawURLEncoding.EncodeToString(options.Challenge)
+	response.Rp.Id = options.RP.ID
+	response.Rp.Name = options.RP.Name
+	response.User.Id = base64.RawURLEncoding.EncodeToString(options.UserID)
+	response.User.Name = options.UserName
+	response.User.DisplayName = options.UserDisplayName
+	for _, alg := range options.Algorithms {
+		response.PubKeyCredParams = append(response.PubKeyCredParams, struct {
+			Alg  int                                            `json:"alg"`
+			Type v1.WebAuthnCreationOptionsPubKeyCredParamsType `json:"type"`
+		}{Alg: alg, Type: v1.WebAuthnCreationOptionsPubKeyCredParamsTypePublicKey})
+	}
+	response.Timeout = int(options.Timeout.Milliseconds())
+	response.ExcludeCredentials = toWebAuthnDescriptors(options.ExcludeCredentials)
+	response.AuthenticatorSelection.ResidentKey = options.ResidentKey
+	response.AuthenticatorSelection.UserVerification = options.UserVerification
+	response.Attestation = options.Attestation
+
+	return response
+}
+
+func toWebAuthnRequestOptions(options webauthn.RequestOptions) v1.WebAuthnRequestOptions {
+	return v1.WebAuthnRequestOptions{
+		Challenge:        base64.RawURLEncoding.EncodeToString(options.Challenge),
+		RpId:             options.RPID,
+		Timeout:          int(options.Timeout.Milliseconds()),
+		AllowCredentials: toWebAuthnDescriptors(options.AllowCredentials),
+		UserVerification: options.UserVerification,
+	}
+}
+
+func toWebAuthnDescriptors(ids [][]byte) []v1.WebAuthnCredentialDescriptor {
+	descriptors := make([]v1.WebAuthnCredentialDescriptor, 0, len(ids))
+	for _, id := range ids {
+		descriptors = append(descriptors, v1.WebAuthnCredentialDescriptor{
+			Id:   base64.RawURLEncoding.EncodeToString(id),
+			Type: v1.WebAuthnCredentialDescriptorTypePublicKey,
+		})
+	}
+	return descriptors
+}
+
+// decodeBase64URL decodes a binary WebAuthn field; padding is accepted but not required
+func decodeBase64URL(field, value string) ([]byte, error) {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return nil, errs.NewDomainValidationError(field, "must be base64url encoded")
+	}
+	return data, nil
+}
@@ -0,0 +1,58 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// BatchHandler processes one batch and returns how many items it handled
+type BatchHandler interface {
+	Handle(ctx context.Context) (int, error)
+}
+
+// BatchJob periodically runs a batch handler, e.g. hard-deleting accounts whose retention period has passed
+type BatchJob struct {
+	name     string
+	handler  BatchHandler
+	interval time.Duration
+}
+
+// NewBatchJob creates a job that runs the handler every interval; name is used in logs
+func NewBatchJob(name string, handler BatchHandler, interval time.Duration) *BatchJob {
+	return &BatchJob{name: name, handler: handler, interval: interval}
+}
+
+// Run processes right away and then every interval until ctx is done.
+// A full batch is followed by the next one without waiting, so a backlog is cleared in one run.
+func (j *BatchJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.process(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// process runs batches until nothing is left or a batch fails
+func (j *BatchJob) process(ctx context.Context) {
+	for ctx.Err() == nil {
+		handled, err := j.handler.Handle(ctx)
+		if handled > 0 {
+			log.Printf("%s: handled %d", j.name, handled)
+		}
+		if err != nil {
+			log.Printf("%s failed: %v", j.name, err)
+			return
+		}
+		if handled == 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package captchaadapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// Verification endpoints of the supported providers; both accept the same siteverify request.
+const (
+	HCaptchaVerifyURL  = "https://api.hcaptcha.com/siteverify"
+	TurnstileVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+)
+
+// defaultTimeout bounds a verification request when no client is given
+const defaultTimeout = 5 * time.Second
+
+// HTTPVerifier implements ports.CaptchaVerifier for hCaptcha, Cloudflare Turnstile
+// and other providers with a siteverify endpoint: a form POST of secret, response and remoteip
+// answered with {"success": bool}.
+type HTTPVerifier struct {
+	verifyURL string
+	secret    string
+	client    *http.Client
+}
+
+// NewHTTPVerifier creates a verifier; a nil client gets a default one with a short timeout.
+func NewHTTPVerifier(verifyURL, secret string, client *http.Client) *HTTPVerifier {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	return &HTTPVerifier{
+		verifyURL: verifyURL,
+		secret:    secret,
+		client:    client,
+	}
+}
+
+type verifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+// Verify asks the provider whether the token is valid.
+// A rejected token is (false, nil); an error means the provider could not be asked.
+func (v *HTTPVerifier) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	form := url.Values{
+		"secret":   {v.secret},
+		"response": {token},
+	}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, errs.WrapInfrastructureError("building captcha verification request", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, errs.WrapInfrastructureError("verifying captcha", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, errs.WrapInfrastructureError(
+			"verifying captcha", fmt.Errorf("unexpected status %d", resp.StatusCode),
+		)
+	}
+
+	var result verifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, errs.WrapInfrastructureError("decoding captcha verification response", err)
+	}
+	return result.Success, nil
+}
@@ -0,0 +1,108 @@
+package captchaadapter
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// received is what the fake provider got
+type received struct {
+	method string
+	form   url.Values
+}
+
+// newProvider starts a fake siteverify endpoint that accepts only "good-token" for "secret"
+func newProvider(t *testing.T, status int, body string) (*httptest.Server, *received) {
+	t.Helper()
+	got := &received{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parse form: %v", err)
+		}
+		got.method, got.form = r.Method, r.PostForm
+		w.WriteHeader(status)
+		if body != "" {
+			_, _ = w.Write([]byte(body))
+			return
+		}
+		if r.PostForm.Get("secret") == "secret" && r.PostForm.Get("response") == "good-token" {
+			_, _ = w.Write([]byte(`{"success": true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, got
+}
+
+func TestHTTPVerifier_AcceptsValidToken(t *testing.T) {
+	srv, got := newProvider(t, http.StatusOK, "")
+	verifier := NewHTTPVerifier(srv.URL, "secret", srv.Client())
+
+	ok, err := verifier.Verify(context.Background(), "good-token", "203.0.113.7")
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if !ok {
+		t.Fatal("valid token rejected")
+	}
+	if got.method != http.MethodPost {
+		t.Errorf("method = %s, want POST", got.method)
+	}
+	if ip := got.form.Get("remoteip"); ip != "203.0.113.7" {
+		t.Errorf("remoteip = %q, want 203.0.113.7", ip)
+	}
+}
+
+func TestHTTPVerifier_RejectsInvalidToken(t *testing.T) {
+	srv, _ := newProvider(t, http.StatusOK, "")
+
+	ok, err := NewHTTPVerifier(srv.URL, "secret", srv.Client()).Verify(context.Background(), "bad-token", "")
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if ok {
+		t.Fatal("invalid token accepted")
+	}
+
+	// A wrong secret is rejected by the provider the same way
+	ok, err = NewHTTPVerifier(srv.URL, "other-secret", srv.Client()).Verify(context.Background(), "good-token", "")
+	if err != nil || ok {
+		t.Fatalf("Verify with wrong secret = %v, %v; want false, nil", ok, err)
+	}
+}
+
+func TestHTTPVerifier_ProviderFailureIsError(t *testing.T) {
+	for name, tc := range map[string]struct {
+		status int
+		body   string
+	}{
+		"server error": {status: http.StatusInternalServerError, body: "oops"},
+		"not json":     {status: http.StatusOK, body: "<html>"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			srv, _ := newProvider(t, tc.status, tc.body)
+
+			ok, err := NewHTTPVerifier(srv.URL, "secret", srv.Client()).Verify(context.Background(), "good-token", "")
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if ok {
+				t.Fatal("token accepted on provider failure")
+			}
+		})
+	}
+}
+
+func TestNoopVerifier(t *testing.T) {
+	verifier := NewNoopVerifier()
+	if ok, _ := verifier.Verify(context.Background(), "anything", ""); !ok {
+		t.Error("non-empty token rejected")
+	}
+	if ok, _ := verifier.Verify(context.Background(), "", ""); ok {
+		t.Error("empty token accepted")
+	}
+}
@@ -0,0 +1,24 @@
+package emailadapter
+
+import (
+	"context"
+	"log"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// LogSender implements ports.EmailSender by writing emails to the application log.
+// Intended for local development until a real mail provider is configured.
+type LogSender struct{}
+
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+// Send logs the email instead of delivering it.
+func (s *LogSender) Send(_ context.Context, msg ports.EmailMessage) error {
+	log.Printf("📧 Email to %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+var _ ports.EmailSender = (*LogSender)(nil)
@@ -0,0 +1,53 @@
+package emailadapter
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// MemorySender implements ports.EmailSender by keeping sent emails in memory.
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []ports.EmailMessage
+}
+
+func NewMemorySender() *MemorySender {
+	return &MemorySender{}
+}
+
+// Send stores the email.
+func (s *MemorySender) Send(_ context.Context, msg ports.EmailMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+// LastTo returns the most recent email sent to the address.
+func (s *MemorySender) LastTo(to string) (ports.EmailMessage, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		if s.messages[i].To == to {
+			return s.messages[i], true
+		}
+	}
+	return ports.EmailMessage{}, false
+}
+
+// CountTo returns how many emails were sent to the address.
+func (s *MemorySender) CountTo(to string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, msg := range s.messages {
+		if msg.To == to {
+			count++
+		}
+	}
+	return count
+}
+
+var _ ports.EmailSender = (*MemorySender)(nil)
@@ -0,0 +1,89 @@
+package emailadapter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// SecurityNotifier implements ports.SecurityNotifier by emailing the account owner.
+type SecurityNotifier struct {
+	sender ports.EmailSender
+}
+
+func NewSecurityNotifier(sender ports.EmailSender) *SecurityNotifier {
+	return &SecurityNotifier{sender: sender}
+}
+
+// NotifySuspiciousLogin tells the user about a completed login from a new device or location.
+func (n *SecurityNotifier) NotifySuspiciousLogin(ctx context.Context, notice ports.SuspiciousLoginNotice) error {
+	return n.sender.Send(ctx, ports.EmailMessage{
+		To:      notice.Email,
+		Subject: "New sign-in to your account",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nyour account was just signed in to %s.\n\n%s\n"+
+				"If this was you, no action is needed. "+
+				"If not, change your password right away and review your two-factor authentication settings.",
+			notice.Name,
+			reason(notice),
+			details(notice),
+		),
+	})
+}
+
+// ConfirmSuspiciousLogin asks the user to confirm a login from a new device or location with a link.
+func (n *SecurityNotifier) ConfirmSuspiciousLogin(
+	ctx context.Context,
+	notice ports.SuspiciousLoginNotice,
+	link string,
+	expiresIn time.Duration,
+) error {
+	return n.sender.Send(ctx, ports.EmailMessage{
+		To:      notice.Email,
+		Subject: "Confirm the sign-in to your account",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nsomeone entered the correct credentials for your account %s.\n\n%s\n"+
+				"If this was you, finish signing in with this link:\n\n%s\n\n"+
+				"The link works once and expires in %d minutes. "+
+				"If it wasn't you, don't open the link and change your password right away.",
+			notice.Name,
+			reason(notice),
+			details(notice),
+			link,
+			int(expiresIn.Minutes()),
+		),
+	})
+}
+
+// reason explains why the login looks unusual
+func reason(notice ports.SuspiciousLoginNotice) string {
+	switch {
+	case notice.NewDevice && notice.NewLocation:
+		return "from a new device and an unusual location"
+	case notice.NewLocation:
+		return "from an unusual location"
+	default:
+		return "from a new device"
+	}
+}
+
+// details lists what is known about the login
+func details(notice ports.SuspiciousLoginNotice) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Time: %s\n", notice.At.UTC().Format(time.RFC1123))
+	if notice.UserAgent != "" {
+		fmt.Fprintf(&b, "Device: %s\n", notice.UserAgent)
+	}
+	if notice.IP != "" {
+		fmt.Fprintf(&b, "IP address: %s\n", notice.IP)
+	}
+	if location := strings.Trim(notice.City+", "+notice.Country, ", "); location != "" {
+		fmt.Fprintf(&b, "Location: %s\n", location)
+	}
+	return b.String()
+}
+
+var _ ports.SecurityNotifier = (*SecurityNotifier)(nil)
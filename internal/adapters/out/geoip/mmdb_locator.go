@@ -0,0 +1,58 @@
+package geoipadapter
+
+import (
+	"net"
+
+	"github.com/oschwald/maxminddb-golang"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// MMDBLocator implements ports.GeoLocator with a local MaxMind database
+// (GeoLite2/GeoIP2 City or Country, or any MMDB with the same layout).
+type MMDBLocator struct {
+	reader *maxminddb.Reader
+}
+
+// NewMMDBLocator opens the database file; it is read into memory once.
+func NewMMDBLocator(path string) (*MMDBLocator, error) {
+	reader, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, errs.WrapInfrastructureError("opening geoip database", err)
+	}
+	return &MMDBLocator{reader: reader}, nil
+}
+
+// mmdbRecord is the part of a GeoIP2 record the locator needs
+type mmdbRecord struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+}
+
+// Locate returns the country and city of the address; an unknown or private address gives an empty location.
+func (l *MMDBLocator) Locate(ip string) (auth.Location, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return auth.Location{}, nil
+	}
+
+	var record mmdbRecord
+	if err := l.reader.Lookup(addr, &record); err != nil {
+		return auth.Location{}, errs.WrapInfrastructureError("looking up geoip", err)
+	}
+
+	return auth.Location{
+		Country: record.Country.ISOCode,
+		City:    record.City.Names["en"],
+	}, nil
+}
+
+// Close releases the database.
+func (l *MMDBLocator) Close() error {
+	return l.reader.Close()
+}
@@ -0,0 +1,88 @@
+package geoipadapter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+// MaxMind DB encoding: control byte is type<<5 | size
+func mmdbString(s string) []byte { return append([]byte{2<<5 | byte(len(s))}, s...) }
+func mmdbMap(n int) []byte       { return []byte{7<<5 | byte(n)} }
+func mmdbUint16(v uint16) []byte { return []byte{5<<5 | 2, byte(v >> 8), byte(v)} }
+func mmdbUint32(v uint32) []byte {
+	return []byte{6<<5 | 4, byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+}
+
+// writeTestDB writes an IPv4 database with one tree node: 0.0.0.0/1 is Berlin, DE, the other half is unknown
+func writeTestDB(t *testing.T) string {
+	t.Helper()
+	const nodeCount = 1
+
+	var db []byte
+	// Left record points to the data section offset 0 (nodeCount + 16 + offset), right record is empty
+	db = append(db, 0, 0, nodeCount+16, 0, 0, nodeCount)
+	db = append(db, make([]byte, 16)...)
+
+	db = append(db, mmdbMap(2)...)
+	db = append(db, mmdbString("country")...)
+	db = append(db, mmdbMap(1)...)
+	db = append(db, mmdbString("iso_code")...)
+	db = append(db, mmdbString("DE")...)
+	db = append(db, mmdbString("city")...)
+	db = append(db, mmdbMap(1)...)
+	db = append(db, mmdbString("names")...)
+	db = append(db, mmdbMap(1)...)
+	db = append(db, mmdbString("en")...)
+	db = append(db, mmdbString("Berlin")...)
+
+	db = append(db, "\xAB\xCD\xEFMaxMind.com"...)
+	db = append(db, mmdbMap(3)...)
+	db = append(db, mmdbString("node_count")...)
+	db = append(db, mmdbUint32(nodeCount)...)
+	db = append(db, mmdbString("record_size")...)
+	db = append(db, mmdbUint16(24)...)
+	db = append(db, mmdbString("ip_version")...)
+	db = append(db, mmdbUint16(4)...)
+
+	path := filepath.Join(t.TempDir(), "test.mmdb")
+	if err := os.WriteFile(path, db, 0o600); err != nil {
+		t.Fatalf("write database: %v", err)
+	}
+	return path
+}
+
+func TestMMDBLocator_Locate(t *testing.T) {
+	locator, err := NewMMDBLocator(writeTestDB(t))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer locator.Close()
+
+	cases := []struct {
+		ip   string
+		want auth.Location
+	}{
+		{"10.1.2.3", auth.Location{Country: "DE", City: "Berlin"}},
+		{"203.0.113.7", auth.Location{}},
+		{"not-an-ip", auth.Location{}},
+		{"", auth.Location{}},
+	}
+	for _, c := range cases {
+		got, err := locator.Locate(c.ip)
+		if err != nil {
+			t.Errorf("Locate(%q): %v", c.ip, err)
+		}
+		if got != c.want {
+			t.Errorf("Locate(%q) = %+v, want %+v", c.ip, got, c.want)
+		}
+	}
+}
+
+func TestNewMMDBLocator_MissingFile(t *testing.T) {
+	if _, err := NewMMDBLocator(filepath.Join(t.TempDir(), "missing.mmdb")); err == nil {
+		t.Fatal("expected an error for a missing database")
+	}
+}
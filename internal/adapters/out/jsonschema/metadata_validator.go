@@ -0,0 +1,70 @@
+package jsonschemaadapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// MetadataValidator implements ports.MetadataValidator with a JSON Schema.
+// Schemas are checked by kin-openapi, so the keywords of OpenAPI 3 schemas are supported:
+// type, properties, required, additionalProperties, enum, format, pattern, min/max limits,
+// items, allOf/anyOf/oneOf/not. $ref to other documents is not resolved.
+type MetadataValidator struct {
+	schema *openapi3.Schema
+}
+
+// NewMetadataValidator parses and checks a JSON Schema document.
+func NewMetadataValidator(document []byte) (*MetadataValidator, error) {
+	var schema openapi3.Schema
+	if err := json.Unmarshal(document, &schema); err != nil {
+		return nil, fmt.Errorf("parsing metadata schema: %w", err)
+	}
+
+	// Keywords of standalone JSON Schema documents that OpenAPI does not know
+	err := schema.Validate(context.Background(), openapi3.AllowExtraSiblingFields("$schema", "$id", "$comment"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid metadata schema: %w", err)
+	}
+
+	return &MetadataValidator{schema: &schema}, nil
+}
+
+// NewMetadataValidatorFromFile reads the schema from a file.
+func NewMetadataValidatorFromFile(path string) (*MetadataValidator, error) {
+	document, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errs.WrapInfrastructureError("reading metadata schema", err)
+	}
+	return NewMetadataValidator(document)
+}
+
+// Validate checks the metadata; the error names the offending field and never includes its value.
+func (v *MetadataValidator) Validate(metadata map[string]any) error {
+	// VisitJSON expects values as they come from encoding/json
+	var value any = map[string]any{}
+	if metadata != nil {
+		value = metadata
+	}
+
+	err := v.schema.VisitJSON(value)
+	if err == nil {
+		return nil
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		if path := schemaErr.JSONPointer(); len(path) > 0 {
+			return fmt.Errorf("%s: %s", strings.Join(path, "."), schemaErr.Reason)
+		}
+		return errors.New(schemaErr.Reason)
+	}
+	return err
+}
@@ -0,0 +1,104 @@
+package jsonschemaadapter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const planSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "plan": {"type": "string", "enum": ["free", "pro"]},
+    "seats": {"type": "integer", "minimum": 1}
+  },
+  "required": ["plan"],
+  "additionalProperties": false
+}`
+
+// decode turns a JSON object into the form handlers pass to the validator
+func decode(t *testing.T, raw string) map[string]any {
+	t.Helper()
+	var value map[string]any
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		t.Fatalf("decode %s: %v", raw, err)
+	}
+	return value
+}
+
+func TestMetadataValidator_Validate(t *testing.T) {
+	validator, err := NewMetadataValidator([]byte(planSchema))
+	if err != nil {
+		t.Fatalf("NewMetadataValidator: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		metadata string
+		wantErr  string
+	}{
+		{name: "valid", metadata: `{"plan": "pro", "seats": 3}`},
+		{name: "enum", metadata: `{"plan": "enterprise"}`, wantErr: "plan: "},
+		{name: "required", metadata: `{"seats": 1}`, wantErr: `"plan"`},
+		{name: "additional property", metadata: `{"plan": "free", "color": "red"}`, wantErr: "color"},
+		{name: "integer", metadata: `{"plan": "free", "seats": 1.5}`, wantErr: "seats: "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validate(decode(t, tt.metadata))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+			if strings.Contains(err.Error(), "enterprise") {
+				t.Fatalf("error leaks the value: %v", err)
+			}
+		})
+	}
+}
+
+func TestMetadataValidator_EmptyMetadata(t *testing.T) {
+	validator, err := NewMetadataValidator([]byte(`{"type": "object", "properties": {"plan": {"type": "string"}}}`))
+	if err != nil {
+		t.Fatalf("NewMetadataValidator: %v", err)
+	}
+
+	if err := validator.Validate(nil); err != nil {
+		t.Fatalf("Validate(nil) error = %v", err)
+	}
+}
+
+func TestNewMetadataValidator_InvalidSchema(t *testing.T) {
+	for _, document := range []string{`not json`, `{"type": "nonsense"}`} {
+		if _, err := NewMetadataValidator([]byte(document)); err == nil {
+			t.Errorf("NewMetadataValidator(%s) expected error", document)
+		}
+	}
+}
+
+func TestNewMetadataValidatorFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metadata.schema.json")
+	if err := os.WriteFile(path, []byte(planSchema), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	validator, err := NewMetadataValidatorFromFile(path)
+	if err != nil {
+		t.Fatalf("NewMetadataValidatorFromFile: %v", err)
+	}
+	if err := validator.Validate(decode(t, `{"plan": "free"}`)); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	if _, err := NewMetadataValidatorFromFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Fatal("expected error for a missing file")
+	}
+}
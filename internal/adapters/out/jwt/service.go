@@ -32,31 +32,33 @@ type Claims struct {
 	Name      string    `json:"name,omitempty"`
 	Phone     string    `json:"phone,omitempty"`
 	CreatedAt int64     `json:"created_at,omitempty"`
-	Type      string    `json:"type"` // "access" или "refresh"
+	Type      string    `json:"type"` // "access", "refresh" или scope ограниченного токена
+
+	EmailVerified bool `json:"email_verified"`
+	PhoneVerified bool `json:"phone_verified"`
 	jwt.RegisteredClaims
 }
 
 // GenerateTokenPair создает пару access и refresh токенов
-func (s *Service) GenerateTokenPair(
-	userID uuid.UUID,
-	email, name, phone string,
-	createdAt time.Time,
-) (*ports.TokenPair, error) {
+func (s *Service) GenerateTokenPair(subject ports.TokenSubject) (*ports.TokenPair, error) {
 	now := time.Now()
 
 	// Access token
 	accessClaims := &Claims{
-		UserID:    userID,
-		Email:     email,
-		Name:      name,
-		Phone:     phone,
-		CreatedAt: createdAt.Unix(),
+		UserID:    subject.UserID,
+		Email:     subject.Email,
+		Name:      subject.Name,
+		Phone:     subject.Phone,
+		CreatedAt: subject.CreatedAt.Unix(),
 		Type:      "access",
+
+		EmailVerified: subject.EmailVerified,
+		PhoneVerified: subject.PhoneVerified,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(s.accessTokenDuration)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
-			Subject:   userID.String(),
+			Subject:   subject.UserID.String(),
 		},
 	}
 
@@ -68,17 +70,20 @@ func (s *Service) GenerateTokenPair(
 
 	// Refresh token (PII не обязательно)
 	refreshClaims := &Claims{
-		UserID:    userID,
-		Email:     email,
-		Name:      name,
-		Phone:     phone,
-		CreatedAt: createdAt.Unix(),
+		UserID:    subject.UserID,
+		Email:     subject.Email,
+		Name:      subject.Name,
+		Phone:     subject.Phone,
+		CreatedAt: subject.CreatedAt.Unix(),
 		Type:      "refresh",
+
+		EmailVerified: subject.EmailVerified,
+		PhoneVerified: subject.PhoneVerified,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(s.refreshTokenDuration)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
-			Subject:   userID.String(),
+			Subject:   subject.UserID.String(),
 		},
 	}
 
@@ -139,6 +144,9 @@ func (s *Service) ValidateAccessToken(tokenString string) (*ports.TokenClaims, e
 		Phone:     claims.Phone,
 		CreatedAt: time.Unix(claims.CreatedAt, 0),
 		Exp:       claims.ExpiresAt.Time,
+
+		EmailVerified: claims.EmailVerified,
+		PhoneVerified: claims.PhoneVerified,
 	}, nil
 }
 
@@ -154,7 +162,69 @@ func (s *Service) RefreshTokens(refreshTokenString string) (*ports.TokenPair, er
 	}
 
 	// Генерируем новую пару токенов
-	return s.GenerateTokenPair(claims.UserID, claims.Email, claims.Name, claims.Phone, time.Unix(claims.CreatedAt, 0))
+	return s.GenerateTokenPair(ports.TokenSubject{
+		UserID:    claims.UserID,
+		Email:     claims.Email,
+		Name:      claims.Name,
+		Phone:     claims.Phone,
+		CreatedAt: time.Unix(claims.CreatedAt, 0),
+
+		EmailVerified: claims.EmailVerified,
+		PhoneVerified: claims.PhoneVerified,
+	})
+}
+
+// ValidateRefreshToken проверяет refresh токен и возвращает ID его владельца
+func (s *Service) ValidateRefreshToken(refreshTokenString string) (uuid.UUID, error) {
+	claims, err := s.parseToken(refreshTokenString)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if claims.Type != "refresh" {
+		return uuid.Nil, errs.NewJWTValidationError("token is not a refresh token")
+	}
+
+	return claims.UserID, nil
+}
+
+// GenerateScopedToken создает короткоживущий токен, пригодный только для операции scope
+func (s *Service) GenerateScopedToken(userID uuid.UUID, scope string, ttl time.Duration) (string, error) {
+	if scope == "access" || scope == "refresh" {
+		return "", errs.NewDomainValidationError("scope", "must not be a session token type")
+	}
+
+	now := time.Now()
+	claims := &Claims{
+		UserID: userID,
+		Type:   scope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Subject:   userID.String(),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secretKey)
+	if err != nil {
+		return "", errs.WrapInfrastructureError("generating scoped token", err)
+	}
+	return token, nil
+}
+
+// ValidateScopedToken проверяет токен и его scope, возвращает ID пользователя
+func (s *Service) ValidateScopedToken(tokenString, scope string) (uuid.UUID, error) {
+	claims, err := s.parseToken(tokenString)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if claims.Type != scope {
+		return uuid.Nil, errs.NewJWTValidationError(fmt.Sprintf("token is not a %s token", scope))
+	}
+
+	return claims.UserID, nil
 }
 
 // Compile-time check that Service implements JWTService
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
 )
 
 func TestRefreshTokensPreservesClaims(t *testing.T) {
@@ -16,7 +18,16 @@ func TestRefreshTokensPreservesClaims(t *testing.T) {
 	phone := "+1234567890"
 	createdAt := time.Unix(1700000000, 0).UTC()
 
-	pair, err := service.GenerateTokenPair(userID, email, name, phone, createdAt)
+	pair, err := service.GenerateTokenPair(ports.TokenSubject{
+		UserID:    userID,
+		Email:     email,
+		Name:      name,
+		Phone:     phone,
+		CreatedAt: createdAt,
+
+		EmailVerified: true,
+		PhoneVerified: true,
+	})
 	if err != nil {
 		t.Fatalf("GenerateTokenPair() error = %v", err)
 	}
@@ -39,7 +50,64 @@ func TestRefreshTokensPreservesClaims(t *testing.T) {
 		t.Fatalf("expected phone %q, got %q", phone, claims.Phone)
 	}
 
+	if !claims.EmailVerified || !claims.PhoneVerified {
+		t.Fatal("expected verification claims to be preserved")
+	}
+
 	if !claims.CreatedAt.Equal(createdAt) {
 		t.Fatalf("expected createdAt %v, got %v", createdAt, claims.CreatedAt)
 	}
 }
+
+func TestScopedTokenRoundTrip(t *testing.T) {
+	service := NewService("secret", time.Minute, time.Hour)
+	userID := uuid.New()
+
+	token, err := service.GenerateScopedToken(userID, "password_change", time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateScopedToken() error = %v", err)
+	}
+
+	got, err := service.ValidateScopedToken(token, "password_change")
+	if err != nil {
+		t.Fatalf("ValidateScopedToken() error = %v", err)
+	}
+	if got != userID {
+		t.Fatalf("expected user id %v, got %v", userID, got)
+	}
+}
+
+func TestScopedTokenIsNotAccessToken(t *testing.T) {
+	service := NewService("secret", time.Minute, time.Hour)
+
+	token, err := service.GenerateScopedToken(uuid.New(), "password_change", time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateScopedToken() error = %v", err)
+	}
+
+	if _, err := service.ValidateAccessToken(token); err == nil {
+		t.Fatal("expected scoped token to be rejected as access token")
+	}
+	if _, err := service.ValidateScopedToken(token, "other_scope"); err == nil {
+		t.Fatal("expected scoped token to be rejected for another scope")
+	}
+}
+
+func TestAccessTokenIsNotScopedToken(t *testing.T) {
+	service := NewService("secret", time.Minute, time.Hour)
+
+	pair, err := service.GenerateTokenPair(ports.TokenSubject{
+		UserID:    uuid.New(),
+		Email:     "user@example.com",
+		Name:      "John",
+		Phone:     "+1234567890",
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("GenerateTokenPair() error = %v", err)
+	}
+
+	if _, err := service.ValidateScopedToken(pair.AccessToken, "password_change"); err == nil {
+		t.Fatal("expected access token to be rejected as scoped token")
+	}
+}
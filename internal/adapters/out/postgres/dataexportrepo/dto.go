@@ -0,0 +1,23 @@
+package dataexportrepo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataExportDTO — выгрузка данных пользователя
+type DataExportDTO struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key"`
+	UserID    uuid.UUID `gorm:"type:uuid;index;not null"`
+	Status    string    `gorm:"not null;index"`
+	Archive   []byte    `gorm:"type:bytea"`
+	CreatedAt time.Time `gorm:"not null"`
+	ReadyAt   *time.Time
+	ExpiresAt time.Time `gorm:"not null;index"`
+}
+
+// TableName определяет имя таблицы для GORM
+func (DataExportDTO) TableName() string {
+	return "data_exports"
+}
@@ -0,0 +1,31 @@
+package dataexportrepo
+
+import (
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+// ToEntity преобразует DTO в доменную выгрузку
+func (dto DataExportDTO) ToEntity() auth.DataExport {
+	return auth.DataExport{
+		ID:        dto.ID,
+		UserID:    dto.UserID,
+		Status:    auth.DataExportStatus(dto.Status),
+		Archive:   dto.Archive,
+		CreatedAt: dto.CreatedAt,
+		ReadyAt:   dto.ReadyAt,
+		ExpiresAt: dto.ExpiresAt,
+	}
+}
+
+// FromEntity преобразует доменную выгрузку в DTO
+func FromEntity(export *auth.DataExport) DataExportDTO {
+	return DataExportDTO{
+		ID:        export.ID,
+		UserID:    export.UserID,
+		Status:    string(export.Status),
+		Archive:   export.Archive,
+		CreatedAt: export.CreatedAt,
+		ReadyAt:   export.ReadyAt,
+		ExpiresAt: export.ExpiresAt,
+	}
+}
@@ -0,0 +1,117 @@
+package dataexportrepo
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Create сохраняет выгрузку, поставленную в очередь
+func (r *Repository) Create(export *auth.DataExport) error {
+	dto := FromEntity(export)
+	if err := r.db.Create(&dto).Error; err != nil {
+		return errs.WrapInfrastructureError("creating data export", err)
+	}
+	return nil
+}
+
+// GetByID находит выгрузку по ID
+func (r *Repository) GetByID(id uuid.UUID) (*auth.DataExport, error) {
+	var dto DataExportDTO
+	err := r.db.Where("id = ?", id).First(&dto).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("data export", id.String())
+		}
+		return nil, errs.WrapInfrastructureError("getting data export", err)
+	}
+
+	export := dto.ToEntity()
+	return &export, nil
+}
+
+// GetLatest находит последнюю выгрузку пользователя
+func (r *Repository) GetLatest(userID uuid.UUID) (*auth.DataExport, error) {
+	var dto DataExportDTO
+	err := r.db.Where("user_id = ?", userID).Order("created_at DESC").First(&dto).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("data export", userID.String())
+		}
+		return nil, errs.WrapInfrastructureError("getting latest data export", err)
+	}
+
+	export := dto.ToEntity()
+	return &export, nil
+}
+
+// ListPending возвращает несобранные выгрузки в порядке создания, не больше limit
+func (r *Repository) ListPending(limit int) ([]auth.DataExport, error) {
+	var dtos []DataExportDTO
+	err := r.db.Where("status = ?", string(auth.DataExportPending)).
+		Order("created_at").
+		Limit(limit).
+		Find(&dtos).Error
+	if err != nil {
+		return nil, errs.WrapInfrastructureError("listing pending data exports", err)
+	}
+
+	exports := make([]auth.DataExport, 0, len(dtos))
+	for _, dto := range dtos {
+		exports = append(exports, dto.ToEntity())
+	}
+	return exports, nil
+}
+
+// Update сохраняет изменения выгрузки
+func (r *Repository) Update(export *auth.DataExport) error {
+	dto := FromEntity(export)
+	result := r.db.Model(&DataExportDTO{}).Where("id = ?", export.ID).Select("*").Updates(&dto)
+
+	if result.Error != nil {
+		return errs.WrapInfrastructureError("updating data export", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.NewNotFoundError("data export", export.ID.String())
+	}
+
+	return nil
+}
+
+// DeleteExpired удаляет выгрузки, срок хранения которых истёк к now
+func (r *Repository) DeleteExpired(now time.Time) error {
+	if err := r.db.Where("expires_at <= ?", now).Delete(&DataExportDTO{}).Error; err != nil {
+		return errs.WrapInfrastructureError("deleting expired data exports", err)
+	}
+
+	return nil
+}
+
+// DeleteByUser удаляет выгрузки пользователя
+func (r *Repository) DeleteByUser(userID uuid.UUID) error {
+	if err := r.db.Where("user_id = ?", userID).Delete(&DataExportDTO{}).Error; err != nil {
+		return errs.WrapInfrastructureError("deleting user data exports", err)
+	}
+
+	return nil
+}
+
+// Compile-time check that Repository implements DataExportRepository
+var _ ports.DataExportRepository = (*Repository)(nil)
@@ -0,0 +1,79 @@
+package eventrepo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+var _ ports.EventLog = &Repository{}
+
+// redacted replaces personal data in anonymized event payloads
+const redacted = "[redacted]"
+
+// personalDataFields are payload fields that may hold personal data:
+// email, phone and name (also as Old/New in change events), device and location of logins
+var personalDataFields = []string{
+	"Email", "Phone", "Name", "Old", "New",
+	"IP", "UserAgent", "Country", "City",
+}
+
+// AnonymizeAggregate replaces personal data in the stored events of the aggregate.
+// Event type, IDs and timestamps are kept, so the history stays countable.
+func (r *Repository) AnonymizeAggregate(ctx context.Context, aggregateID uuid.UUID) error {
+	db := r.db.WithContext(ctx)
+
+	var dtos []EventDTO
+	if err := db.Where("aggregate_id = ?", aggregateID.String()).Find(&dtos).Error; err != nil {
+		return errs.WrapInfrastructureError("loading aggregate events", err)
+	}
+
+	for _, dto := range dtos {
+		data, changed, err := anonymizeEventData(dto.Data)
+		if err != nil {
+			return errs.WrapInfrastructureError("anonymizing event "+dto.ID, err)
+		}
+		if !changed {
+			continue
+		}
+
+		if err := db.Model(&EventDTO{}).Where("id = ?", dto.ID).Update("data", data).Error; err != nil {
+			return errs.WrapInfrastructureError("saving anonymized event", err)
+		}
+	}
+
+	return nil
+}
+
+// anonymizeEventData replaces non-empty personal data fields of a JSON payload
+func anonymizeEventData(data string) (string, bool, error) {
+	if data == "" {
+		return data, false, nil
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(data), &payload); err != nil {
+		return "", false, err
+	}
+
+	changed := false
+	for _, field := range personalDataFields {
+		if value, ok := payload[field].(string); ok && value != "" && value != redacted {
+			payload[field] = redacted
+			changed = true
+		}
+	}
+	if !changed {
+		return data, false, nil
+	}
+
+	anonymized, err := MarshalEventData(payload)
+	if err != nil {
+		return "", false, err
+	}
+	return anonymized, true, nil
+}
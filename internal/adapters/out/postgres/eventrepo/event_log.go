@@ -0,0 +1,46 @@
+package eventrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// ListByAggregate returns the stored events of the aggregate, oldest first
+func (r *Repository) ListByAggregate(ctx context.Context, aggregateID uuid.UUID) ([]ports.StoredEvent, error) {
+	var dtos []EventDTO
+	err := r.db.WithContext(ctx).
+		Where("aggregate_id = ?", aggregateID.String()).
+		Order("created_at ASC").
+		Find(&dtos).Error
+	if err != nil {
+		return nil, errs.WrapInfrastructureError("listing aggregate events", err)
+	}
+
+	events := make([]ports.StoredEvent, 0, len(dtos))
+	for _, dto := range dtos {
+		events = append(events, ports.StoredEvent{
+			ID:          dto.ID,
+			Type:        dto.EventType,
+			AggregateID: dto.AggregateID,
+			Data:        dto.Data,
+			CreatedAt:   dto.CreatedAt,
+		})
+	}
+	return events, nil
+}
+
+// CountByAggregate returns the number of stored events of the aggregate
+func (r *Repository) CountByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&EventDTO{}).
+		Where("aggregate_id = ?", aggregateID.String()).
+		Count(&count).Error
+	if err != nil {
+		return 0, errs.WrapInfrastructureError("counting aggregate events", err)
+	}
+	return count, nil
+}
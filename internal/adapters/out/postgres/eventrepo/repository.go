@@ -59,6 +59,14 @@ func (r *Repository) domainEventToDTO(event ddd.DomainEvent) (EventDTO, error) {
 		auth.UserPhoneChanged,
 		auth.UserNameChanged,
 		auth.UserPasswordChanged,
+		auth.UserPasswordChangeRequired,
+		auth.UserEmailVerified,
+		auth.UserPhoneVerified,
+		auth.UserEmailChangeRequested,
+		auth.UserEmailChanged,
+		auth.UserMFAEnabled,
+		auth.UserMFAVerified,
+		auth.UserWebAuthnCredentialRegistered,
 		auth.UserLoggedIn:
 		agg, ok := e.(interface {
 			GetAggregateID() uuid.UUID
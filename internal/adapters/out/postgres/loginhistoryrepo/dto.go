@@ -0,0 +1,24 @@
+package loginhistoryrepo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginRecordDTO — успешный вход пользователя: устройство и адрес
+type LoginRecordDTO struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key"`
+	UserID     uuid.UUID `gorm:"type:uuid;index:idx_login_history_user_created;not null"`
+	DeviceHash string    `gorm:"not null"`
+	UserAgent  string    `gorm:"not null"`
+	IP         string    `gorm:"not null"`
+	Country    string    `gorm:"not null;default:''"`
+	City       string    `gorm:"not null;default:''"`
+	CreatedAt  time.Time `gorm:"index:idx_login_history_user_created;not null"`
+}
+
+// TableName определяет имя таблицы для GORM
+func (LoginRecordDTO) TableName() string {
+	return "login_history"
+}
@@ -0,0 +1,33 @@
+package loginhistoryrepo
+
+import (
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+// ToEntity преобразует DTO в доменную запись о входе
+func (dto LoginRecordDTO) ToEntity() auth.LoginRecord {
+	return auth.LoginRecord{
+		ID:         dto.ID,
+		UserID:     dto.UserID,
+		DeviceHash: dto.DeviceHash,
+		UserAgent:  dto.UserAgent,
+		IP:         dto.IP,
+		Country:    dto.Country,
+		City:       dto.City,
+		CreatedAt:  dto.CreatedAt,
+	}
+}
+
+// FromEntity преобразует доменную запись о входе в DTO
+func FromEntity(record *auth.LoginRecord) LoginRecordDTO {
+	return LoginRecordDTO{
+		ID:         record.ID,
+		UserID:     record.UserID,
+		DeviceHash: record.DeviceHash,
+		UserAgent:  record.UserAgent,
+		IP:         record.IP,
+		Country:    record.Country,
+		City:       record.City,
+		CreatedAt:  record.CreatedAt,
+	}
+}
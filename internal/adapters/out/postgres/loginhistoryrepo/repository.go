@@ -0,0 +1,69 @@
+package loginhistoryrepo
+
+import (
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Add сохраняет запись о входе
+func (r *Repository) Add(record *auth.LoginRecord) error {
+	dto := FromEntity(record)
+	if err := r.db.Create(&dto).Error; err != nil {
+		return errs.WrapInfrastructureError("adding login record", err)
+	}
+	return nil
+}
+
+// ListRecent возвращает последние limit входов пользователя, новые первыми
+func (r *Repository) ListRecent(userID uuid.UUID, limit int) ([]auth.LoginRecord, error) {
+	var dtos []LoginRecordDTO
+	err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Limit(limit).Find(&dtos).Error
+	if err != nil {
+		return nil, errs.WrapInfrastructureError("listing login history", err)
+	}
+
+	records := make([]auth.LoginRecord, 0, len(dtos))
+	for _, dto := range dtos {
+		records = append(records, dto.ToEntity())
+	}
+	return records, nil
+}
+
+// ListByUser возвращает все входы пользователя, новые первыми
+func (r *Repository) ListByUser(userID uuid.UUID) ([]auth.LoginRecord, error) {
+	var dtos []LoginRecordDTO
+	err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&dtos).Error
+	if err != nil {
+		return nil, errs.WrapInfrastructureError("listing login history", err)
+	}
+
+	records := make([]auth.LoginRecord, 0, len(dtos))
+	for _, dto := range dtos {
+		records = append(records, dto.ToEntity())
+	}
+	return records, nil
+}
+
+// DeleteByUser удаляет историю входов пользователя
+func (r *Repository) DeleteByUser(userID uuid.UUID) error {
+	if err := r.db.Where("user_id = ?", userID).Delete(&LoginRecordDTO{}).Error; err != nil {
+		return errs.WrapInfrastructureError("deleting user login history", err)
+	}
+
+	return nil
+}
+
+// Compile-time check that Repository implements LoginHistoryRepository
+var _ ports.LoginHistoryRepository = (*Repository)(nil)
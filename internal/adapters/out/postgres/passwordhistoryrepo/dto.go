@@ -0,0 +1,20 @@
+package passwordhistoryrepo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PasswordHistoryDTO — ранее использованный хеш пароля пользователя
+type PasswordHistoryDTO struct {
+	ID           uuid.UUID `gorm:"type:uuid;primary_key"`
+	UserID       uuid.UUID `gorm:"type:uuid;index;not null"`
+	PasswordHash string    `gorm:"not null"`
+	CreatedAt    time.Time `gorm:"index;not null"`
+}
+
+// TableName определяет имя таблицы для GORM
+func (PasswordHistoryDTO) TableName() string {
+	return "password_history"
+}
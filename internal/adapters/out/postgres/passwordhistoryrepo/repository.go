@@ -0,0 +1,94 @@
+package passwordhistoryrepo
+
+import (
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Add сохраняет хеш пароля в историю пользователя
+func (r *Repository) Add(userID uuid.UUID, passwordHash string, createdAt time.Time) error {
+	dto := PasswordHistoryDTO{
+		ID:           uuid.New(),
+		UserID:       userID,
+		PasswordHash: passwordHash,
+		CreatedAt:    createdAt,
+	}
+
+	if err := r.db.Create(&dto).Error; err != nil {
+		return errs.WrapInfrastructureError("adding password history entry", err)
+	}
+
+	return nil
+}
+
+// GetRecent возвращает последние limit хешей, сохранённые не раньше since
+func (r *Repository) GetRecent(userID uuid.UUID, limit int, since time.Time) ([]string, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	query := r.db.Model(&PasswordHistoryDTO{}).Where("user_id = ?", userID)
+	if !since.IsZero() {
+		query = query.Where("created_at >= ?", since)
+	}
+
+	var hashes []string
+	err := query.Order("created_at DESC").Limit(limit).Pluck("password_hash", &hashes).Error
+	if err != nil {
+		return nil, errs.WrapInfrastructureError("getting password history", err)
+	}
+
+	return hashes, nil
+}
+
+// Prune удаляет записи сверх keep последних и записи старше before
+func (r *Repository) Prune(userID uuid.UUID, keep int, before time.Time) error {
+	if !before.IsZero() {
+		err := r.db.Where("user_id = ? AND created_at < ?", userID, before).Delete(&PasswordHistoryDTO{}).Error
+		if err != nil {
+			return errs.WrapInfrastructureError("pruning expired password history", err)
+		}
+	}
+
+	if keep < 0 {
+		return nil
+	}
+
+	recent := r.db.Model(&PasswordHistoryDTO{}).
+		Select("id").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(keep)
+
+	err := r.db.Where("user_id = ? AND id NOT IN (?)", userID, recent).Delete(&PasswordHistoryDTO{}).Error
+	if err != nil {
+		return errs.WrapInfrastructureError("pruning password history", err)
+	}
+
+	return nil
+}
+
+// DeleteByUser удаляет историю паролей пользователя
+func (r *Repository) DeleteByUser(userID uuid.UUID) error {
+	if err := r.db.Where("user_id = ?", userID).Delete(&PasswordHistoryDTO{}).Error; err != nil {
+		return errs.WrapInfrastructureError("deleting user password history", err)
+	}
+
+	return nil
+}
+
+// Compile-time check that Repository implements PasswordHistoryRepository
+var _ ports.PasswordHistoryRepository = (*Repository)(nil)
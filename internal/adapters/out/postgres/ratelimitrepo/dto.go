@@ -0,0 +1,17 @@
+package ratelimitrepo
+
+import "time"
+
+// RateLimitDTO — состояние одного ключа ограничителя частоты запросов
+type RateLimitDTO struct {
+	Key       string    `gorm:"primaryKey"`
+	Count     float64   `gorm:"not null;default:0"`
+	PrevCount float64   `gorm:"not null;default:0"`
+	Start     time.Time `gorm:"not null"`
+	ExpiresAt time.Time `gorm:"index;not null"`
+}
+
+// TableName определяет имя таблицы для GORM
+func (RateLimitDTO) TableName() string {
+	return "rate_limits"
+}
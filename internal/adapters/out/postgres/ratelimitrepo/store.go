@@ -0,0 +1,87 @@
+package ratelimitrepo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	"github.com/Vi-72/quest-auth/internal/pkg/ratelimit"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// purgeInterval — как часто удалять истёкшие состояния
+const purgeInterval = time.Minute
+
+// Store хранит состояния ограничителя в Postgres, чтобы лимиты были общими для всех экземпляров сервиса.
+type Store struct {
+	db *gorm.DB
+
+	mu        sync.Mutex
+	lastPurge time.Time
+}
+
+func NewStore(db *gorm.DB) *Store {
+	return &Store{db: db}
+}
+
+// Update атомарно применяет fn к состоянию ключа: строка блокируется до конца транзакции
+func (s *Store) Update(
+	ctx context.Context,
+	key string,
+	now time.Time,
+	ttl time.Duration,
+	fn func(state ratelimit.State, found bool) ratelimit.State,
+) error {
+	s.purgeExpired(ctx, now)
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Строка создаётся заранее, чтобы одновременные запросы с новым ключом ждали друг друга на блокировке
+		created := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&RateLimitDTO{Key: key, Start: now, ExpiresAt: now})
+		if created.Error != nil {
+			return created.Error
+		}
+
+		var dto RateLimitDTO
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("key = ?", key).First(&dto).Error; err != nil {
+			return err
+		}
+
+		found := created.RowsAffected == 0 && now.Before(dto.ExpiresAt)
+		state := ratelimit.State{}
+		if found {
+			state = ratelimit.State{Count: dto.Count, PrevCount: dto.PrevCount, Start: dto.Start}
+		}
+
+		state = fn(state, found)
+
+		return tx.Save(&RateLimitDTO{
+			Key:       key,
+			Count:     state.Count,
+			PrevCount: state.PrevCount,
+			Start:     state.Start,
+			ExpiresAt: now.Add(ttl),
+		}).Error
+	})
+	if err != nil {
+		return errs.WrapInfrastructureError("updating rate limit state", err)
+	}
+
+	return nil
+}
+
+// purgeExpired удаляет истёкшие состояния не чаще purgeInterval; ошибка не мешает обработке запроса
+func (s *Store) purgeExpired(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	if now.Sub(s.lastPurge) < purgeInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastPurge = now
+	s.mu.Unlock()
+
+	s.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&RateLimitDTO{})
+}
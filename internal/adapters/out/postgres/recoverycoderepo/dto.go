@@ -0,0 +1,21 @@
+package recoverycoderepo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecoveryCodeDTO — хеш одноразового кода восстановления MFA
+type RecoveryCodeDTO struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key"`
+	UserID    uuid.UUID `gorm:"type:uuid;index;not null"`
+	CodeHash  string    `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// TableName определяет имя таблицы для GORM
+func (RecoveryCodeDTO) TableName() string {
+	return "recovery_codes"
+}
@@ -0,0 +1,27 @@
+package recoverycoderepo
+
+import (
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+// ToEntity преобразует DTO в доменный код восстановления
+func (dto RecoveryCodeDTO) ToEntity() *auth.RecoveryCode {
+	return &auth.RecoveryCode{
+		ID:        dto.ID,
+		UserID:    dto.UserID,
+		CodeHash:  dto.CodeHash,
+		UsedAt:    dto.UsedAt,
+		CreatedAt: dto.CreatedAt,
+	}
+}
+
+// FromEntity преобразует доменный код восстановления в DTO
+func FromEntity(code *auth.RecoveryCode) RecoveryCodeDTO {
+	return RecoveryCodeDTO{
+		ID:        code.ID,
+		UserID:    code.UserID,
+		CodeHash:  code.CodeHash,
+		UsedAt:    code.UsedAt,
+		CreatedAt: code.CreatedAt,
+	}
+}
@@ -0,0 +1,85 @@
+package recoverycoderepo
+
+import (
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Replace удаляет все коды пользователя и сохраняет новый набор
+func (r *Repository) Replace(userID uuid.UUID, codes []auth.RecoveryCode) error {
+	if err := r.db.Where("user_id = ?", userID).Delete(&RecoveryCodeDTO{}).Error; err != nil {
+		return errs.WrapInfrastructureError("deleting recovery codes", err)
+	}
+
+	if len(codes) == 0 {
+		return nil
+	}
+
+	dtos := make([]RecoveryCodeDTO, 0, len(codes))
+	for i := range codes {
+		dtos = append(dtos, FromEntity(&codes[i]))
+	}
+
+	if err := r.db.Create(&dtos).Error; err != nil {
+		return errs.WrapInfrastructureError("creating recovery codes", err)
+	}
+
+	return nil
+}
+
+// GetUnused находит неиспользованный код пользователя по хешу
+func (r *Repository) GetUnused(userID uuid.UUID, codeHash string) (*auth.RecoveryCode, error) {
+	var dto RecoveryCodeDTO
+	err := r.db.Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).First(&dto).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("recovery code", userID.String())
+		}
+		return nil, errs.WrapInfrastructureError("getting recovery code", err)
+	}
+
+	return dto.ToEntity(), nil
+}
+
+// Update сохраняет изменения кода
+func (r *Repository) Update(code *auth.RecoveryCode) error {
+	dto := FromEntity(code)
+
+	result := r.db.Model(&RecoveryCodeDTO{}).Where("id = ?", code.ID).Select("*").Updates(&dto)
+	if result.Error != nil {
+		return errs.WrapInfrastructureError("updating recovery code", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.NewNotFoundError("recovery code", code.ID.String())
+	}
+
+	return nil
+}
+
+// DeleteByUser удаляет коды восстановления пользователя
+func (r *Repository) DeleteByUser(userID uuid.UUID) error {
+	if err := r.db.Where("user_id = ?", userID).Delete(&RecoveryCodeDTO{}).Error; err != nil {
+		return errs.WrapInfrastructureError("deleting user recovery codes", err)
+	}
+
+	return nil
+}
+
+// Compile-time check that Repository implements RecoveryCodeRepository
+var _ ports.RecoveryCodeRepository = (*Repository)(nil)
@@ -3,8 +3,14 @@ package postgres
 import (
 	"context"
 
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/dataexportrepo"
 	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/eventrepo"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/loginhistoryrepo"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/passwordhistoryrepo"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/recoverycoderepo"
 	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/userrepo"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/verificationtokenrepo"
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/webauthncredentialrepo"
 	"github.com/Vi-72/quest-auth/internal/core/ports"
 
 	"gorm.io/gorm"
@@ -27,8 +33,15 @@ func (tm *TransactionManager) RunInTransaction(
 ) error {
 	return tm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		repos := ports.Repositories{
-			User:  userrepo.NewRepository(tx),
-			Event: eventrepo.NewRepository(tx),
+			User:               userrepo.NewRepository(tx),
+			PasswordHistory:    passwordhistoryrepo.NewRepository(tx),
+			VerificationToken:  verificationtokenrepo.NewRepository(tx),
+			RecoveryCode:       recoverycoderepo.NewRepository(tx),
+			WebAuthnCredential: webauthncredentialrepo.NewRepository(tx),
+			LoginHistory:       loginhistoryrepo.NewRepository(tx),
+			DataExport:         dataexportrepo.NewRepository(tx),
+			Event:              eventrepo.NewRepository(tx),
+			EventLog:           eventrepo.NewRepository(tx),
 		}
 		return fn(ctx, repos)
 	})
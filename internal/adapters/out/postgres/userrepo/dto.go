@@ -4,17 +4,52 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // UserDTO — структура для работы с базой данных
 type UserDTO struct {
-	ID           uuid.UUID `gorm:"type:uuid;primary_key"`
-	Email        string    `gorm:"uniqueIndex;not null"`
-	Phone        string    `gorm:"uniqueIndex;not null"`
-	Name         string    `gorm:"not null"`
-	PasswordHash string    `gorm:"not null"`
-	CreatedAt    time.Time `gorm:"not null"`
-	UpdatedAt    time.Time `gorm:"not null"`
+	ID    uuid.UUID `gorm:"type:uuid;primary_key"`
+	Email string    `gorm:"uniqueIndex;not null"`
+	// EmailCanonical — форма, по которой ищут и проверяют уникальность (kernel.Email.Canonical).
+	// Пустое значение — строка ещё не пересчитана (CanonicalizeEmails) и в уникальности не участвует
+	EmailCanonical string  `gorm:"not null;default:'';uniqueIndex:idx_users_email_canonical_unique,where:email_canonical <> ''"`
+	Phone          string  `gorm:"uniqueIndex;not null"`
+	Name           string  `gorm:"not null"`
+	PasswordHash   string  `gorm:"not null"`
+	Username       *string `gorm:"uniqueIndex"` // NULL — имя не задано; NULL не участвуют в уникальности
+
+	Locale    string     `gorm:"not null;default:''"`
+	Timezone  string     `gorm:"not null;default:''"`
+	AvatarURL string     `gorm:"not null;default:''"`
+	BirthDate *time.Time `gorm:"type:date"`
+	Metadata  string     `gorm:"type:jsonb;not null;default:'{}'"`
+
+	EmailVerifiedAt *time.Time
+	PhoneVerifiedAt *time.Time
+
+	PasswordChangedAt      *time.Time // NULL для пользователей, созданных до появления поля
+	PasswordChangeRequired bool       `gorm:"not null;default:false"`
+
+	TOTPSecret    string `gorm:"not null;default:''"`
+	TOTPEnabledAt *time.Time
+	TOTPLastStep  int64 `gorm:"not null;default:0"`
+
+	FailedLoginAttempts int `gorm:"not null;default:0"`
+	Lockouts            int `gorm:"not null;default:0"`
+	LockedUntil         *time.Time
+
+	Status          string `gorm:"not null;default:'active';index"`
+	StatusReason    string `gorm:"not null;default:''"`
+	StatusChangedAt *time.Time
+
+	DeletionScheduledAt *time.Time `gorm:"index"`
+
+	// DeletedAt — мягкое удаление: GORM исключает такие строки из всех запросов, кроме Unscoped
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
 }
 
 // TableName определяет имя таблицы для GORM
@@ -1,46 +1,170 @@
 package userrepo
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
 	"github.com/Vi-72/quest-auth/internal/pkg/ddd"
 	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+
+	"gorm.io/gorm"
 )
 
 // ToEntity преобразует DTO в доменную сущность User
 func (dto UserDTO) ToEntity() (*auth.User, error) {
-	email, err := kernel.NewEmail(dto.Email)
+	email, err := kernel.RestoreEmail(dto.Email, dto.EmailCanonical)
 	if err != nil {
 		return nil, errs.WrapInfrastructureError("mapping user email", err)
 	}
 
-	phone, err := kernel.NewPhone(dto.Phone)
+	phone, err := kernel.RestorePhone(dto.Phone)
 	if err != nil {
 		return nil, errs.WrapInfrastructureError("mapping user phone", err)
 	}
 
+	passwordChangedAt := dto.CreatedAt
+	if dto.PasswordChangedAt != nil {
+		passwordChangedAt = *dto.PasswordChangedAt
+	}
+
+	// Пустое состояние — строки, сохранённые до появления статусов: такие аккаунты активны
+	status := auth.UserStatusActive
+	if dto.Status != "" {
+		if status, err = auth.ParseUserStatus(dto.Status); err != nil {
+			return nil, errs.WrapInfrastructureError("mapping user status", err)
+		}
+	}
+
+	var username kernel.Username
+	if dto.Username != nil {
+		if username, err = kernel.NewUsername(*dto.Username); err != nil {
+			return nil, errs.WrapInfrastructureError("mapping user username", err)
+		}
+	}
+
+	// Пустая строка — строки, сохранённые до появления metadata
+	metadata := map[string]any{}
+	if dto.Metadata != "" {
+		if err := json.Unmarshal([]byte(dto.Metadata), &metadata); err != nil {
+			return nil, errs.WrapInfrastructureError("mapping user metadata", err)
+		}
+	}
+
+	var deletedAt *time.Time
+	if dto.DeletedAt.Valid {
+		deletedAt = &dto.DeletedAt.Time
+	}
+
 	user := &auth.User{
 		BaseAggregate: ddd.NewBaseAggregate(dto.ID),
 		Email:         email,
 		Phone:         phone,
 		Name:          dto.Name,
 		PasswordHash:  dto.PasswordHash,
-		CreatedAt:     dto.CreatedAt,
-		UpdatedAt:     dto.UpdatedAt,
+
+		Username: username,
+
+		Profile: auth.Profile{
+			Locale:    dto.Locale,
+			Timezone:  dto.Timezone,
+			AvatarURL: dto.AvatarURL,
+			BirthDate: dto.BirthDate,
+		},
+		Metadata: metadata,
+
+		EmailVerifiedAt: dto.EmailVerifiedAt,
+		PhoneVerifiedAt: dto.PhoneVerifiedAt,
+
+		PasswordChangedAt:      passwordChangedAt,
+		PasswordChangeRequired: dto.PasswordChangeRequired,
+
+		TOTPSecret:    dto.TOTPSecret,
+		TOTPEnabledAt: dto.TOTPEnabledAt,
+		TOTPLastStep:  dto.TOTPLastStep,
+
+		FailedLoginAttempts: dto.FailedLoginAttempts,
+		Lockouts:            dto.Lockouts,
+		LockedUntil:         dto.LockedUntil,
+
+		Status:          status,
+		StatusReason:    dto.StatusReason,
+		StatusChangedAt: dto.StatusChangedAt,
+
+		DeletionScheduledAt: dto.DeletionScheduledAt,
+
+		DeletedAt: deletedAt,
+
+		CreatedAt: dto.CreatedAt,
+		UpdatedAt: dto.UpdatedAt,
 	}
 
 	return user, nil
 }
 
 // FromEntity преобразует доменную сущность User в DTO
-func FromEntity(user *auth.User) UserDTO {
-	return UserDTO{
-		ID:           user.ID(),
-		Email:        user.Email.String(),
-		Phone:        user.Phone.String(),
-		Name:         user.Name,
-		PasswordHash: user.PasswordHash,
-		CreatedAt:    user.CreatedAt,
-		UpdatedAt:    user.UpdatedAt,
+func FromEntity(user *auth.User) (UserDTO, error) {
+	passwordChangedAt := user.PasswordChangedAt
+
+	metadata := []byte("{}")
+	if len(user.Metadata) > 0 {
+		var err error
+		if metadata, err = json.Marshal(user.Metadata); err != nil {
+			return UserDTO{}, errs.WrapInfrastructureError("mapping user metadata", err)
+		}
+	}
+
+	var username *string
+	if !user.Username.IsZero() {
+		value := user.Username.String()
+		username = &value
+	}
+
+	var deletedAt gorm.DeletedAt
+	if user.DeletedAt != nil {
+		deletedAt = gorm.DeletedAt{Time: *user.DeletedAt, Valid: true}
 	}
+
+	return UserDTO{
+		ID:             user.ID(),
+		Email:          user.Email.String(),
+		EmailCanonical: user.Email.Canonical(),
+		Phone:          user.Phone.String(),
+		Name:           user.Name,
+		PasswordHash:   user.PasswordHash,
+
+		Username: username,
+
+		Locale:    user.Profile.Locale,
+		Timezone:  user.Profile.Timezone,
+		AvatarURL: user.Profile.AvatarURL,
+		BirthDate: user.Profile.BirthDate,
+		Metadata:  string(metadata),
+
+		EmailVerifiedAt: user.EmailVerifiedAt,
+		PhoneVerifiedAt: user.PhoneVerifiedAt,
+
+		PasswordChangedAt:      &passwordChangedAt,
+		PasswordChangeRequired: user.PasswordChangeRequired,
+
+		TOTPSecret:    user.TOTPSecret,
+		TOTPEnabledAt: user.TOTPEnabledAt,
+		TOTPLastStep:  user.TOTPLastStep,
+
+		FailedLoginAttempts: user.FailedLoginAttempts,
+		Lockouts:            user.Lockouts,
+		LockedUntil:         user.LockedUntil,
+
+		Status:          user.Status.String(),
+		StatusReason:    user.StatusReason,
+		StatusChangedAt: user.StatusChangedAt,
+
+		DeletionScheduledAt: user.DeletionScheduledAt,
+
+		DeletedAt: deletedAt,
+
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}, nil
 }
@@ -2,6 +2,10 @@ package userrepo
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
@@ -9,6 +13,7 @@ import (
 	"github.com/Vi-72/quest-auth/internal/pkg/errs"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
 )
 
@@ -22,9 +27,15 @@ func NewRepository(db *gorm.DB) *Repository {
 
 // Create сохраняет нового пользователя
 func (r *Repository) Create(user *auth.User) error {
-	dto := FromEntity(user)
+	dto, err := FromEntity(user)
+	if err != nil {
+		return err
+	}
 
 	if err := r.db.Create(&dto).Error; err != nil {
+		if taken := translateUniqueViolation(err); taken != nil {
+			return taken
+		}
 		return errs.WrapInfrastructureError("creating user", err)
 	}
 
@@ -46,10 +57,11 @@ func (r *Repository) GetByID(id uuid.UUID) (*auth.User, error) {
 	return dto.ToEntity()
 }
 
-// GetByEmail находит пользователя по email
+// GetByEmail находит пользователя по канонической форме email.
+// Если под неё подходят несколько старых аккаунтов, предпочитается точное совпадение адреса, затем более ранний.
 func (r *Repository) GetByEmail(email kernel.Email) (*auth.User, error) {
 	var dto UserDTO
-	err := r.db.Where("email = ?", email.String()).First(&dto).Error
+	err := r.db.Where("email_canonical = ?", email.Canonical()).First(&dto).Error
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -76,12 +88,34 @@ func (r *Repository) GetByPhone(phone kernel.Phone) (*auth.User, error) {
 	return dto.ToEntity()
 }
 
+// GetByUsername находит пользователя по публичному имени
+func (r *Repository) GetByUsername(username kernel.Username) (*auth.User, error) {
+	var dto UserDTO
+	err := r.db.Where("username = ?", username.String()).First(&dto).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("user", username.String())
+		}
+		return nil, errs.WrapInfrastructureError("getting user by username", err)
+	}
+
+	return dto.ToEntity()
+}
+
 // Update обновляет существующего пользователя
 func (r *Repository) Update(user *auth.User) error {
-	dto := FromEntity(user)
+	dto, err := FromEntity(user)
+	if err != nil {
+		return err
+	}
 
-	result := r.db.Where("id = ?", user.ID()).Updates(&dto)
+	// Select("*") — сохраняем и нулевые значения (например, сброшенные флаги)
+	result := r.db.Model(&UserDTO{}).Where("id = ?", user.ID()).Select("*").Updates(&dto)
 	if result.Error != nil {
+		if taken := translateUniqueViolation(result.Error); taken != nil {
+			return taken
+		}
 		return errs.WrapInfrastructureError("updating user", result.Error)
 	}
 
@@ -92,7 +126,7 @@ func (r *Repository) Update(user *auth.User) error {
 	return nil
 }
 
-// Delete удаляет пользователя
+// Delete помечает пользователя удалённым (мягкое удаление): строка остаётся до Purge
 func (r *Repository) Delete(id uuid.UUID) error {
 	result := r.db.Where("id = ?", id).Delete(&UserDTO{})
 
@@ -107,10 +141,52 @@ func (r *Repository) Delete(id uuid.UUID) error {
 	return nil
 }
 
-// EmailExists проверяет существование email
+// ListDeletedBefore возвращает ID пользователей, удалённых раньше before, — не больше limit
+func (r *Repository) ListDeletedBefore(before time.Time, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.Unscoped().Model(&UserDTO{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Order("deleted_at").
+		Limit(limit).
+		Pluck("id", &ids).Error
+
+	if err != nil {
+		return nil, errs.WrapInfrastructureError("listing deleted users", err)
+	}
+
+	return ids, nil
+}
+
+// ListDeletionDue возвращает ID пользователей, срок запрошенного удаления которых наступил к now, — не больше limit
+func (r *Repository) ListDeletionDue(now time.Time, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.Model(&UserDTO{}).
+		Where("deletion_scheduled_at IS NOT NULL AND deletion_scheduled_at <= ?", now).
+		Order("deletion_scheduled_at").
+		Limit(limit).
+		Pluck("id", &ids).Error
+
+	if err != nil {
+		return nil, errs.WrapInfrastructureError("listing users due for deletion", err)
+	}
+
+	return ids, nil
+}
+
+// Purge окончательно удаляет строку пользователя, в том числе помеченную удалённой
+func (r *Repository) Purge(id uuid.UUID) error {
+	if err := r.db.Unscoped().Where("id = ?", id).Delete(&UserDTO{}).Error; err != nil {
+		return errs.WrapInfrastructureError("purging user", err)
+	}
+
+	return nil
+}
+
+// EmailExists проверяет, занят ли адрес с той же канонической формой
 func (r *Repository) EmailExists(email kernel.Email) (bool, error) {
 	var count int64
-	err := r.db.Model(&UserDTO{}).Where("email = ?", email.String()).Count(&count).Error
+	// Unscoped: email удалённого аккаунта занят до окончательного удаления (уникальный индекс)
+	err := r.db.Unscoped().Model(&UserDTO{}).Where("email_canonical = ?", email.Canonical()).Count(&count).Error
 
 	if err != nil {
 		return false, errs.WrapInfrastructureError("checking email existence", err)
@@ -122,7 +198,8 @@ func (r *Repository) EmailExists(email kernel.Email) (bool, error) {
 // PhoneExists проверяет существование телефона
 func (r *Repository) PhoneExists(phone kernel.Phone) (bool, error) {
 	var count int64
-	err := r.db.Model(&UserDTO{}).Where("phone = ?", phone.String()).Count(&count).Error
+	// Unscoped: телефон удалённого аккаунта занят до окончательного удаления (уникальный индекс)
+	err := r.db.Unscoped().Model(&UserDTO{}).Where("phone = ?", phone.String()).Count(&count).Error
 
 	if err != nil {
 		return false, errs.WrapInfrastructureError("checking phone existence", err)
@@ -131,5 +208,140 @@ func (r *Repository) PhoneExists(phone kernel.Phone) (bool, error) {
 	return count > 0, nil
 }
 
+// UsernameExists проверяет, занято ли публичное имя
+func (r *Repository) UsernameExists(username kernel.Username) (bool, error) {
+	var count int64
+	// Unscoped: имя удалённого аккаунта занято до окончательного удаления (уникальный индекс)
+	err := r.db.Unscoped().Model(&UserDTO{}).Where("username = ?", username.String()).Count(&count).Error
+
+	if err != nil {
+		return false, errs.WrapInfrastructureError("checking username existence", err)
+	}
+
+	return count > 0, nil
+}
+
+// canonicalizeBatchSize — сколько пользователей читается за один запрос
+const canonicalizeBatchSize = 500
+
+// EmailCollisionError — по новым правилам несколько аккаунтов получили одну каноническую форму email.
+// Какой из них оставить за адресом, решает оператор: пересчёт ничего не меняет, пока совпадения есть.
+type EmailCollisionError struct {
+	Collisions map[string][]uuid.UUID // каноническая форма → аккаунты с ней
+}
+
+func (e *EmailCollisionError) Error() string {
+	canonicals := make([]string, 0, len(e.Collisions))
+	for canonical := range e.Collisions {
+		canonicals = append(canonicals, canonical)
+	}
+	sort.Strings(canonicals)
+
+	parts := make([]string, 0, len(canonicals))
+	for _, canonical := range canonicals {
+		ids := make([]string, 0, len(e.Collisions[canonical]))
+		for _, id := range e.Collisions[canonical] {
+			ids = append(ids, id.String())
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", canonical, strings.Join(ids, ", ")))
+	}
+	return fmt.Sprintf("%d email addresses are shared by several accounts under the current rules (%s)",
+		len(canonicals), strings.Join(parts, "; "))
+}
+
+// CanonicalizeEmails пересчитывает канонические формы email по текущим правилам, включая удалённых пользователей.
+// Нужна при появлении столбца и после смены правил; возвращает число изменённых строк.
+// Если по новым правилам адреса разных аккаунтов совпадают, возвращает *EmailCollisionError и ничего не меняет.
+func CanonicalizeEmails(db *gorm.DB, rules kernel.EmailCanonicalization) (int, error) {
+	var (
+		batch   []UserDTO
+		owners  = make(map[string][]uuid.UUID)
+		changed = make(map[uuid.UUID]string)
+	)
+	result := db.Unscoped().Model(&UserDTO{}).Select("id", "email", "email_canonical").
+		FindInBatches(&batch, canonicalizeBatchSize, func(_ *gorm.DB, _ int) error {
+			for _, dto := range batch {
+				email, err := rules.NewEmail(dto.Email)
+				if err != nil {
+					return fmt.Errorf("user %s: %w", dto.ID, err)
+				}
+				owners[email.Canonical()] = append(owners[email.Canonical()], dto.ID)
+				if email.Canonical() != dto.EmailCanonical {
+					changed[dto.ID] = email.Canonical()
+				}
+			}
+			return nil
+		})
+	if result.Error != nil {
+		return 0, errs.WrapInfrastructureError("canonicalizing emails", result.Error)
+	}
+
+	collisions := make(map[string][]uuid.UUID)
+	for canonical, ids := range owners {
+		if len(ids) > 1 {
+			collisions[canonical] = ids
+		}
+	}
+	if len(collisions) > 0 {
+		return 0, &EmailCollisionError{Collisions: collisions}
+	}
+	if len(changed) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(changed))
+	for id := range changed {
+		ids = append(ids, id)
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Сначала сбрасываем изменяемые формы: иначе обмен значениями между двумя строками
+		// упёрся бы в уникальный индекс на промежуточном шаге
+		if err := tx.Unscoped().Model(&UserDTO{}).Where("id IN ?", ids).
+			UpdateColumn("email_canonical", "").Error; err != nil {
+			return err
+		}
+		for id, canonical := range changed {
+			if err := tx.Unscoped().Model(&UserDTO{}).Where("id = ?", id).
+				UpdateColumn("email_canonical", canonical).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errs.WrapInfrastructureError("canonicalizing emails", err)
+	}
+
+	return len(changed), nil
+}
+
+// uniqueViolationCode — SQLSTATE нарушения уникальности в PostgreSQL
+const uniqueViolationCode = "23505"
+
+// uniqueViolations — какой сигнальной ошибкой отвечать на нарушение уникального индекса таблицы users.
+// Индексы страхуют проверки EmailExists и PhoneExists от гонки двух параллельных запросов.
+var uniqueViolations = map[string]struct {
+	field string
+	err   error
+}{
+	"idx_users_email":                  {"email", auth.ErrEmailTaken},
+	"idx_users_email_canonical_unique": {"email", auth.ErrEmailTaken},
+	"idx_users_phone":                  {"phone", auth.ErrPhoneTaken},
+	"idx_users_username":               {"username", auth.ErrUsernameTaken},
+}
+
+// translateUniqueViolation превращает нарушение уникального индекса в ошибку валидации «уже занято»
+func translateUniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueViolationCode {
+		return nil
+	}
+	violation, ok := uniqueViolations[pgErr.ConstraintName]
+	if !ok {
+		return nil
+	}
+	return errs.NewDomainValidationErrorWithCause(violation.field, violation.err.Error(), violation.err)
+}
+
 // Compile-time check that Repository implements UserRepository
 var _ ports.UserRepository = (*Repository)(nil)
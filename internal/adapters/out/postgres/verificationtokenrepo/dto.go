@@ -0,0 +1,25 @@
+package verificationtokenrepo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VerificationTokenDTO — одноразовый токен подтверждения
+type VerificationTokenDTO struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key"`
+	UserID     uuid.UUID `gorm:"type:uuid;index;not null"`
+	Purpose    string    `gorm:"not null"`
+	Target     string    `gorm:"not null"`
+	SecretHash string    `gorm:"uniqueIndex;not null"`
+	Attempts   int       `gorm:"not null;default:0"`
+	ExpiresAt  time.Time `gorm:"not null"`
+	UsedAt     *time.Time
+	CreatedAt  time.Time `gorm:"not null"`
+}
+
+// TableName определяет имя таблицы для GORM
+func (VerificationTokenDTO) TableName() string {
+	return "verification_tokens"
+}
@@ -0,0 +1,35 @@
+package verificationtokenrepo
+
+import (
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+// ToEntity преобразует DTO в доменный токен подтверждения
+func (dto VerificationTokenDTO) ToEntity() *auth.VerificationToken {
+	return &auth.VerificationToken{
+		ID:         dto.ID,
+		UserID:     dto.UserID,
+		Purpose:    auth.VerificationPurpose(dto.Purpose),
+		Target:     dto.Target,
+		SecretHash: dto.SecretHash,
+		Attempts:   dto.Attempts,
+		ExpiresAt:  dto.ExpiresAt,
+		UsedAt:     dto.UsedAt,
+		CreatedAt:  dto.CreatedAt,
+	}
+}
+
+// FromEntity преобразует доменный токен подтверждения в DTO
+func FromEntity(token *auth.VerificationToken) VerificationTokenDTO {
+	return VerificationTokenDTO{
+		ID:         token.ID,
+		UserID:     token.UserID,
+		Purpose:    string(token.Purpose),
+		Target:     token.Target,
+		SecretHash: token.SecretHash,
+		Attempts:   token.Attempts,
+		ExpiresAt:  token.ExpiresAt,
+		UsedAt:     token.UsedAt,
+		CreatedAt:  token.CreatedAt,
+	}
+}
@@ -0,0 +1,100 @@
+package verificationtokenrepo
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Create сохраняет выпущенный токен
+func (r *Repository) Create(token *auth.VerificationToken) error {
+	dto := FromEntity(token)
+
+	if err := r.db.Create(&dto).Error; err != nil {
+		return errs.WrapInfrastructureError("creating verification token", err)
+	}
+
+	return nil
+}
+
+// GetBySecretHash находит токен по хешу секрета и назначению
+func (r *Repository) GetBySecretHash(purpose auth.VerificationPurpose, secretHash string) (*auth.VerificationToken, error) {
+	var dto VerificationTokenDTO
+	err := r.db.Where("purpose = ? AND secret_hash = ?", string(purpose), secretHash).First(&dto).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("verification token", string(purpose))
+		}
+		return nil, errs.WrapInfrastructureError("getting verification token", err)
+	}
+
+	return dto.ToEntity(), nil
+}
+
+// GetLatest возвращает последний выпущенный токен пользователя с данным назначением
+func (r *Repository) GetLatest(userID uuid.UUID, purpose auth.VerificationPurpose) (*auth.VerificationToken, error) {
+	var dto VerificationTokenDTO
+	err := r.db.Where("user_id = ? AND purpose = ?", userID, string(purpose)).
+		Order("created_at DESC").
+		First(&dto).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("verification token", userID.String())
+		}
+		return nil, errs.WrapInfrastructureError("getting latest verification token", err)
+	}
+
+	return dto.ToEntity(), nil
+}
+
+// Update сохраняет изменения токена
+func (r *Repository) Update(token *auth.VerificationToken) error {
+	dto := FromEntity(token)
+
+	err := r.db.Model(&VerificationTokenDTO{}).Where("id = ?", dto.ID).Select("*").Updates(&dto).Error
+	if err != nil {
+		return errs.WrapInfrastructureError("updating verification token", err)
+	}
+
+	return nil
+}
+
+// InvalidateActive гасит все действующие токены пользователя с данным назначением
+func (r *Repository) InvalidateActive(userID uuid.UUID, purpose auth.VerificationPurpose, now time.Time) error {
+	err := r.db.Model(&VerificationTokenDTO{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", userID, string(purpose), now).
+		Update("used_at", now).Error
+	if err != nil {
+		return errs.WrapInfrastructureError("invalidating verification tokens", err)
+	}
+
+	return nil
+}
+
+// DeleteByUser удаляет токены подтверждения пользователя
+func (r *Repository) DeleteByUser(userID uuid.UUID) error {
+	if err := r.db.Where("user_id = ?", userID).Delete(&VerificationTokenDTO{}).Error; err != nil {
+		return errs.WrapInfrastructureError("deleting user verification tokens", err)
+	}
+
+	return nil
+}
+
+// Compile-time check that Repository implements VerificationTokenRepository
+var _ ports.VerificationTokenRepository = (*Repository)(nil)
@@ -0,0 +1,24 @@
+package webauthncredentialrepo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebAuthnCredentialDTO — открытый ключ WebAuthn пользователя
+type WebAuthnCredentialDTO struct {
+	ID           uuid.UUID `gorm:"type:uuid;primary_key"`
+	UserID       uuid.UUID `gorm:"type:uuid;index;not null"`
+	CredentialID []byte    `gorm:"uniqueIndex;not null"`
+	PublicKey    []byte    `gorm:"not null"`
+	SignCount    int64     `gorm:"not null;default:0"`
+	Name         string    `gorm:"not null"`
+	CreatedAt    time.Time `gorm:"not null"`
+	LastUsedAt   *time.Time
+}
+
+// TableName определяет имя таблицы для GORM
+func (WebAuthnCredentialDTO) TableName() string {
+	return "webauthn_credentials"
+}
@@ -0,0 +1,33 @@
+package webauthncredentialrepo
+
+import (
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+// ToEntity преобразует DTO в доменный ключ WebAuthn
+func (dto WebAuthnCredentialDTO) ToEntity() *auth.WebAuthnCredential {
+	return &auth.WebAuthnCredential{
+		ID:           dto.ID,
+		UserID:       dto.UserID,
+		CredentialID: dto.CredentialID,
+		PublicKey:    dto.PublicKey,
+		SignCount:    uint32(dto.SignCount), //nolint:gosec // в колонке хранится только uint32
+		Name:         dto.Name,
+		CreatedAt:    dto.CreatedAt,
+		LastUsedAt:   dto.LastUsedAt,
+	}
+}
+
+// FromEntity преобразует доменный ключ WebAuthn в DTO
+func FromEntity(cred *auth.WebAuthnCredential) WebAuthnCredentialDTO {
+	return WebAuthnCredentialDTO{
+		ID:           cred.ID,
+		UserID:       cred.UserID,
+		CredentialID: cred.CredentialID,
+		PublicKey:    cred.PublicKey,
+		SignCount:    int64(cred.SignCount),
+		Name:         cred.Name,
+		CreatedAt:    cred.CreatedAt,
+		LastUsedAt:   cred.LastUsedAt,
+	}
+}
@@ -0,0 +1,90 @@
+package webauthncredentialrepo
+
+import (
+	"encoding/base64"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Create сохраняет новый ключ
+func (r *Repository) Create(cred *auth.WebAuthnCredential) error {
+	dto := FromEntity(cred)
+
+	if err := r.db.Create(&dto).Error; err != nil {
+		return errs.WrapInfrastructureError("creating webauthn credential", err)
+	}
+
+	return nil
+}
+
+// GetByCredentialID находит ключ по идентификатору аутентификатора
+func (r *Repository) GetByCredentialID(credentialID []byte) (*auth.WebAuthnCredential, error) {
+	var dto WebAuthnCredentialDTO
+	err := r.db.Where("credential_id = ?", credentialID).First(&dto).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("webauthn credential", base64.RawURLEncoding.EncodeToString(credentialID))
+		}
+		return nil, errs.WrapInfrastructureError("getting webauthn credential", err)
+	}
+
+	return dto.ToEntity(), nil
+}
+
+// ListByUser возвращает ключи пользователя в порядке регистрации
+func (r *Repository) ListByUser(userID uuid.UUID) ([]auth.WebAuthnCredential, error) {
+	var dtos []WebAuthnCredentialDTO
+	if err := r.db.Where("user_id = ?", userID).Order("created_at").Find(&dtos).Error; err != nil {
+		return nil, errs.WrapInfrastructureError("listing webauthn credentials", err)
+	}
+
+	creds := make([]auth.WebAuthnCredential, 0, len(dtos))
+	for _, dto := range dtos {
+		creds = append(creds, *dto.ToEntity())
+	}
+
+	return creds, nil
+}
+
+// Update сохраняет изменения ключа
+func (r *Repository) Update(cred *auth.WebAuthnCredential) error {
+	dto := FromEntity(cred)
+
+	result := r.db.Model(&WebAuthnCredentialDTO{}).Where("id = ?", cred.ID).Select("*").Updates(&dto)
+	if result.Error != nil {
+		return errs.WrapInfrastructureError("updating webauthn credential", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.NewNotFoundError("webauthn credential", cred.ID.String())
+	}
+
+	return nil
+}
+
+// DeleteByUser удаляет ключи WebAuthn пользователя
+func (r *Repository) DeleteByUser(userID uuid.UUID) error {
+	if err := r.db.Where("user_id = ?", userID).Delete(&WebAuthnCredentialDTO{}).Error; err != nil {
+		return errs.WrapInfrastructureError("deleting user webauthn credentials", err)
+	}
+
+	return nil
+}
+
+// Compile-time check that Repository implements WebAuthnCredentialRepository
+var _ ports.WebAuthnCredentialRepository = (*Repository)(nil)
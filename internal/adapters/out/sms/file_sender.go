@@ -0,0 +1,54 @@
+package smsadapter
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// FileSender implements ports.SMSSender by appending messages to a file, one JSON object per line.
+// Useful for staging environments and manual testing of OTP flows.
+type FileSender struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+type fileRecord struct {
+	To     string    `json:"to"`
+	Text   string    `json:"text"`
+	SentAt time.Time `json:"sent_at"`
+}
+
+// Send appends the SMS to the outbox file.
+func (s *FileSender) Send(_ context.Context, msg ports.SMSMessage) error {
+	line, err := json.Marshal(fileRecord{To: msg.To, Text: msg.Text, SentAt: time.Now().UTC()})
+	if err != nil {
+		return errs.WrapInfrastructureError("encoding sms", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errs.WrapInfrastructureError("opening sms outbox file", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return errs.WrapInfrastructureError("writing sms outbox file", err)
+	}
+
+	return nil
+}
+
+var _ ports.SMSSender = (*FileSender)(nil)
@@ -0,0 +1,46 @@
+package smsadapter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+func TestFileSenderAppendsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sms.jsonl")
+	sender := NewFileSender(path)
+
+	for _, text := range []string{"first", "second"} {
+		if err := sender.Send(context.Background(), ports.SMSMessage{To: "+1234567890", Text: text}); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open outbox: %v", err)
+	}
+	defer f.Close()
+
+	var texts []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec fileRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			t.Fatalf("decode line: %v", err)
+		}
+		if rec.To != "+1234567890" {
+			t.Fatalf("expected recipient +1234567890, got %q", rec.To)
+		}
+		texts = append(texts, rec.Text)
+	}
+
+	if len(texts) != 2 || texts[0] != "first" || texts[1] != "second" {
+		t.Fatalf("unexpected outbox content: %v", texts)
+	}
+}
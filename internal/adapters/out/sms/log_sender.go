@@ -0,0 +1,24 @@
+package smsadapter
+
+import (
+	"context"
+	"log"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// LogSender implements ports.SMSSender by writing messages to the application log.
+// Intended for local development until a real SMS provider is configured.
+type LogSender struct{}
+
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+// Send logs the SMS instead of delivering it.
+func (s *LogSender) Send(_ context.Context, msg ports.SMSMessage) error {
+	log.Printf("📱 SMS to %s: %s", msg.To, msg.Text)
+	return nil
+}
+
+var _ ports.SMSSender = (*LogSender)(nil)
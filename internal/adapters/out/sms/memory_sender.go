@@ -0,0 +1,53 @@
+package smsadapter
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// MemorySender implements ports.SMSSender by keeping sent messages in memory.
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []ports.SMSMessage
+}
+
+func NewMemorySender() *MemorySender {
+	return &MemorySender{}
+}
+
+// Send stores the SMS.
+func (s *MemorySender) Send(_ context.Context, msg ports.SMSMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+// LastTo returns the most recent SMS sent to the phone number.
+func (s *MemorySender) LastTo(to string) (ports.SMSMessage, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		if s.messages[i].To == to {
+			return s.messages[i], true
+		}
+	}
+	return ports.SMSMessage{}, false
+}
+
+// CountTo returns how many messages were sent to the phone number.
+func (s *MemorySender) CountTo(to string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, msg := range s.messages {
+		if msg.To == to {
+			count++
+		}
+	}
+	return count
+}
+
+var _ ports.SMSSender = (*MemorySender)(nil)
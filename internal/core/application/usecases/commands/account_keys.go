@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+)
+
+// AccountKeys — ключ аккаунта для счётчиков ограничения частоты запросов.
+// Разные написания одного аккаунта должны попадать в один счётчик, иначе лимит на аккаунт
+// обходится сменой регистра, +тега или точек в email либо форматом телефона.
+type AccountKeys struct {
+	EmailRules kernel.EmailCanonicalization
+	PhoneRules kernel.PhoneNumbering
+}
+
+// Key — идентификатор в той же форме, по которой ищется аккаунт при входе: каноническая форма
+// email, телефон в E.164 или нормализованное имя. Неразборчивые значения берутся как есть,
+// без пробелов по краям и в нижнем регистре
+func (k AccountKeys) Key(raw string) string {
+	if id, err := newLoginIdentifier(raw, k.EmailRules, k.PhoneRules); err == nil {
+		return id.String()
+	}
+	return strings.ToLower(strings.TrimSpace(raw))
+}
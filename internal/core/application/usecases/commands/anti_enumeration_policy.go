@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"sync"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// AntiEnumerationPolicy — режим, в котором вход и регистрация не выдают, есть ли аккаунт с таким email или телефоном.
+type AntiEnumerationPolicy struct {
+	// Enabled — для неизвестных аккаунтов пароль сверяется с фиктивным хешем, блокировка входа не раскрывается,
+	// регистрация отвечает одинаково, а владелец занятого email или телефона получает письмо
+	Enabled bool
+}
+
+// existingAccountNotice — письмо владельцу аккаунта о попытке зарегистрироваться с его email или телефоном.
+func (p AntiEnumerationPolicy) existingAccountNotice(to string) ports.EmailMessage {
+	return ports.EmailMessage{
+		To:      to,
+		Subject: "Someone tried to sign up with your account details",
+		Body: "Someone tried to create a new account with the email address or phone number of your account.\n\n" +
+			"If it was you, sign in to your existing account instead. If it wasn't, you can ignore this email: " +
+			"no new account was created.",
+	}
+}
+
+// dummyPasswordRaw — пароль фиктивного хеша; совпадение с ним ни на что не влияет
+const dummyPasswordRaw = "anti-enumeration-dummy-password"
+
+// dummyPassword — фиктивный хеш для сверки пароля, когда аккаунта нет:
+// bcrypt выполняется так же, как для существующего пользователя, и время ответа не выдаёт email
+type dummyPassword struct {
+	once sync.Once
+	hash string
+}
+
+// compare сверяет пароль с фиктивным хешем и отбрасывает результат
+func (d *dummyPassword) compare(hasher ports.PasswordHasher, raw string) {
+	d.once.Do(func() {
+		d.hash, _ = hasher.Hash(dummyPasswordRaw)
+	})
+	if d.hash != "" {
+		_ = hasher.Compare(d.hash, raw)
+	}
+}
+
+// invalidCredentialsError — общий ответ на неверный идентификатор или пароль
+func invalidCredentialsError() error {
+	return errs.NewDomainValidationError("credentials", "invalid login identifier or password")
+}
@@ -0,0 +1,11 @@
+package commands
+
+import "github.com/Vi-72/quest-auth/internal/pkg/webauthn"
+
+// BeginWebAuthnLoginCommand — команда начала входа по passkey (без email и пароля)
+type BeginWebAuthnLoginCommand struct{}
+
+// BeginWebAuthnLoginResult — параметры для navigator.credentials.get()
+type BeginWebAuthnLoginResult struct {
+	Options webauthn.RequestOptions
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/webauthn"
+
+	"github.com/google/uuid"
+)
+
+// BeginWebAuthnLoginHandler — обработчик начала входа по passkey.
+// Пользователь заранее не известен: браузер предлагает любой passkey сайта.
+type BeginWebAuthnLoginHandler struct {
+	txManager      ports.TransactionManager
+	clock          ports.Clock
+	webAuthnPolicy WebAuthnPolicy
+}
+
+func NewBeginWebAuthnLoginHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+	webAuthnPolicy WebAuthnPolicy,
+) *BeginWebAuthnLoginHandler {
+	return &BeginWebAuthnLoginHandler{
+		txManager:      txManager,
+		clock:          clock,
+		webAuthnPolicy: webAuthnPolicy,
+	}
+}
+
+// Handle выпускает challenge входа
+func (h *BeginWebAuthnLoginHandler) Handle(
+	ctx context.Context,
+	_ BeginWebAuthnLoginCommand,
+) (BeginWebAuthnLoginResult, error) {
+	var result BeginWebAuthnLoginResult
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		challenge, txErr := h.webAuthnPolicy.challenge(
+			repos.VerificationToken, uuid.Nil, auth.VerificationPurposeWebAuthnLogin, h.clock,
+		)
+		if txErr != nil {
+			return txErr
+		}
+
+		// Passkey — единственный фактор входа, поэтому проверка пользователя (PIN, биометрия) обязательна
+		result.Options = h.webAuthnPolicy.RelyingParty.RequestOptions(
+			challenge, nil, webauthn.UserVerificationRequired, h.webAuthnPolicy.ChallengeTTL,
+		)
+		return nil
+	})
+	if err != nil {
+		return BeginWebAuthnLoginResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,17 @@
+package commands
+
+import (
+	"github.com/Vi-72/quest-auth/internal/pkg/webauthn"
+
+	"github.com/google/uuid"
+)
+
+// BeginWebAuthnRegistrationCommand — команда начала регистрации ключа WebAuthn
+type BeginWebAuthnRegistrationCommand struct {
+	UserID uuid.UUID
+}
+
+// BeginWebAuthnRegistrationResult — параметры для navigator.credentials.create()
+type BeginWebAuthnRegistrationResult struct {
+	Options webauthn.CreationOptions
+}
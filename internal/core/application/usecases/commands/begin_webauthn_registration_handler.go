@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// BeginWebAuthnRegistrationHandler — обработчик начала регистрации ключа WebAuthn.
+// Ключ привязывается к пользователю только после FinishWebAuthnRegistrationHandler.
+type BeginWebAuthnRegistrationHandler struct {
+	txManager      ports.TransactionManager
+	clock          ports.Clock
+	webAuthnPolicy WebAuthnPolicy
+}
+
+func NewBeginWebAuthnRegistrationHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+	webAuthnPolicy WebAuthnPolicy,
+) *BeginWebAuthnRegistrationHandler {
+	return &BeginWebAuthnRegistrationHandler{
+		txManager:      txManager,
+		clock:          clock,
+		webAuthnPolicy: webAuthnPolicy,
+	}
+}
+
+// Handle выпускает challenge регистрации. Уже зарегистрированные ключи передаются
+// в excludeCredentials, чтобы аутентификатор не создал второй ключ для того же аккаунта.
+func (h *BeginWebAuthnRegistrationHandler) Handle(
+	ctx context.Context,
+	cmd BeginWebAuthnRegistrationCommand,
+) (BeginWebAuthnRegistrationResult, error) {
+	var result BeginWebAuthnRegistrationResult
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		creds, txErr := repos.WebAuthnCredential.ListByUser(user.ID())
+		if txErr != nil {
+			return txErr
+		}
+
+		challenge, txErr := h.webAuthnPolicy.challenge(
+			repos.VerificationToken, user.ID(), auth.VerificationPurposeWebAuthnRegistration, h.clock,
+		)
+		if txErr != nil {
+			return txErr
+		}
+
+		userID := user.ID()
+		result.Options = h.webAuthnPolicy.RelyingParty.CreationOptions(
+			challenge,
+			userID[:],
+			user.Email.String(),
+			user.Name,
+			credentialIDs(creds),
+			h.webAuthnPolicy.ChallengeTTL,
+		)
+		return nil
+	})
+	if err != nil {
+		return BeginWebAuthnRegistrationResult{}, err
+	}
+
+	return result, nil
+}
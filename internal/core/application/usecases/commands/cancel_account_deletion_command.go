@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+// CancelAccountDeletionCommand — команда отмены запрошенного удаления аккаунта
+type CancelAccountDeletionCommand struct {
+	UserID uuid.UUID
+}
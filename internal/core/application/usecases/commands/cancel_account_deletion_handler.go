@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// CancelAccountDeletionHandler — обработчик отмены запрошенного удаления аккаунта
+type CancelAccountDeletionHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+}
+
+func NewCancelAccountDeletionHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+) *CancelAccountDeletionHandler {
+	return &CancelAccountDeletionHandler{
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Handle отменяет удаление, если его срок ещё не наступил
+func (h *CancelAccountDeletionHandler) Handle(ctx context.Context, cmd CancelAccountDeletionCommand) error {
+	return h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr := user.CancelDeletion(h.clock); txErr != nil {
+			if errors.Is(txErr, auth.ErrDeletionNotScheduled) {
+				return errs.NewDomainValidationError("deletion", txErr.Error())
+			}
+			return txErr
+		}
+
+		return saveUser(ctx, repos, user)
+	})
+}
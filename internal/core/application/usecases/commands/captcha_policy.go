@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// CaptchaPolicy — когда вход и регистрация требуют решённую CAPTCHA.
+type CaptchaPolicy struct {
+	// Verifier — проверка токена CAPTCHA (nil — CAPTCHA выключена)
+	Verifier ports.CaptchaVerifier
+	// Attempts — счётчик неудачных попыток по IP и аккаунту
+	Attempts ports.AttemptCounter
+	// Threshold — после скольких неудачных попыток с IP или для аккаунта нужна CAPTCHA (0 — выключена)
+	Threshold int
+}
+
+func (p CaptchaPolicy) enabled() bool {
+	return p.Verifier != nil && p.Attempts != nil && p.Threshold > 0
+}
+
+// check требует токен CAPTCHA, если с IP или для аккаунта набралось Threshold неудачных попыток.
+// Токен проверяется до транзакции: проверка — запрос к внешнему сервису.
+func (p CaptchaPolicy) check(ctx context.Context, token, ip, account string) error {
+	if !p.enabled() {
+		return nil
+	}
+
+	required, err := p.required(ctx, ip, account)
+	if err != nil || !required {
+		return err
+	}
+
+	if token == "" {
+		return errs.NewCaptchaRequiredError("solve the CAPTCHA and repeat the request with captcha_token")
+	}
+
+	valid, err := p.Verifier.Verify(ctx, token, ip)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return errs.NewCaptchaRequiredError("captcha_token is invalid or expired, solve the CAPTCHA again")
+	}
+	return nil
+}
+
+// required — набралось ли Threshold неудачных попыток хотя бы по одному ключу
+func (p CaptchaPolicy) required(ctx context.Context, ip, account string) (bool, error) {
+	for _, key := range attemptKeys(ip, account) {
+		count, err := p.Attempts.Count(ctx, key)
+		if err != nil {
+			return false, err
+		}
+		if count >= p.Threshold {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// recordFailure учитывает неудачную попытку для IP и аккаунта
+func (p CaptchaPolicy) recordFailure(ctx context.Context, ip, account string) error {
+	if !p.enabled() {
+		return nil
+	}
+
+	for _, key := range attemptKeys(ip, account) {
+		if err := p.Attempts.Add(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordSuccess снимает требование CAPTCHA для аккаунта; счётчик IP истекает сам,
+// чтобы один удачный вход не обнулял перебор чужих аккаунтов с того же адреса
+func (p CaptchaPolicy) recordSuccess(ctx context.Context, account string) error {
+	if !p.enabled() || account == "" {
+		return nil
+	}
+	return p.Attempts.Reset(ctx, "account|"+account)
+}
+
+func attemptKeys(ip, account string) []string {
+	var keys []string
+	if ip != "" {
+		keys = append(keys, "ip|"+ip)
+	}
+	if account != "" {
+		keys = append(keys, "account|"+account)
+	}
+	return keys
+}
+
+// isFailedLogin — попытка входа не удалась из-за неверных данных или блокировки
+func isFailedLogin(err error) bool {
+	var validationErr *errs.DomainValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr.Field == "credentials"
+	}
+	var lockedErr *errs.LockedError
+	return errors.As(err, &lockedErr)
+}
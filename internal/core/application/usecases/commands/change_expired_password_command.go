@@ -0,0 +1,7 @@
+package commands
+
+// ChangeExpiredPasswordCommand — смена просроченного пароля по токену, выданному при входе
+type ChangeExpiredPasswordCommand struct {
+	PasswordChangeToken string
+	NewPassword         string
+}
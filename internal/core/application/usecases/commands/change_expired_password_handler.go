@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// ChangeExpiredPasswordHandler — обработчик смены просроченного пароля.
+// После успешной смены вход завершается и выдаётся полноценная пара токенов.
+type ChangeExpiredPasswordHandler struct {
+	txManager      ports.TransactionManager
+	jwtService     ports.JWTService
+	passwordHasher ports.PasswordHasher
+	clock          ports.Clock
+	historyPolicy  PasswordHistoryPolicy
+	expiryPolicy   PasswordExpiryPolicy
+}
+
+func NewChangeExpiredPasswordHandler(
+	txManager ports.TransactionManager,
+	jwtService ports.JWTService,
+	passwordHasher ports.PasswordHasher,
+	clock ports.Clock,
+	historyPolicy PasswordHistoryPolicy,
+	expiryPolicy PasswordExpiryPolicy,
+) *ChangeExpiredPasswordHandler {
+	return &ChangeExpiredPasswordHandler{
+		txManager:      txManager,
+		jwtService:     jwtService,
+		passwordHasher: passwordHasher,
+		clock:          clock,
+		historyPolicy:  historyPolicy,
+		expiryPolicy:   expiryPolicy,
+	}
+}
+
+// Handle проверяет токен смены пароля, устанавливает новый пароль и выполняет вход
+func (h *ChangeExpiredPasswordHandler) Handle(
+	ctx context.Context,
+	cmd ChangeExpiredPasswordCommand,
+) (LoginUserResult, error) {
+	userID, err := h.jwtService.ValidateScopedToken(cmd.PasswordChangeToken, ports.TokenScopePasswordChange)
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+
+	var changedUser *auth.User
+	err = h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(userID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if !user.IsActive() {
+			return accountInactiveError(user)
+		}
+
+		now := h.clock.Now()
+
+		// Пароль уже сменён — токен больше не действителен
+		if !user.PasswordExpired(h.expiryPolicy.MaxAge, now) {
+			return errs.NewJWTValidationError("password change token has already been used")
+		}
+
+		history, txErr := h.historyPolicy.load(repos.PasswordHistory, user.ID(), now)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr := user.SetPassword(cmd.NewPassword, history, h.passwordHasher, h.clock); txErr != nil {
+			if errors.Is(txErr, auth.ErrPasswordTooShort) || errors.Is(txErr, auth.ErrPasswordReused) {
+				return errs.NewDomainValidationError("new_password", txErr.Error())
+			}
+			return txErr
+		}
+
+		if txErr := repos.User.Update(user); txErr != nil {
+			return txErr
+		}
+
+		if txErr := h.historyPolicy.record(repos.PasswordHistory, user, now); txErr != nil {
+			return txErr
+		}
+
+		user.MarkLoggedIn(h.clock)
+
+		if repos.Event != nil {
+			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+				return txErr
+			}
+		}
+		user.ClearDomainEvents()
+
+		changedUser = user
+		return nil
+	})
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+
+	user := changedUser
+
+	tokenPair, err := h.jwtService.GenerateTokenPair(newTokenSubject(user))
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+
+	return LoginUserResult{
+		User:         newUserInfo(user),
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		TokenType:    tokenPair.TokenType,
+		ExpiresIn:    tokenPair.ExpiresIn,
+	}, nil
+}
@@ -0,0 +1,10 @@
+package commands
+
+import "github.com/google/uuid"
+
+// ChangePasswordCommand — команда смены пароля аутентифицированным пользователем
+type ChangePasswordCommand struct {
+	UserID          uuid.UUID
+	CurrentPassword string
+	NewPassword     string
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// ChangePasswordHandler — обработчик смены пароля
+type ChangePasswordHandler struct {
+	txManager      ports.TransactionManager
+	passwordHasher ports.PasswordHasher
+	clock          ports.Clock
+	historyPolicy  PasswordHistoryPolicy
+}
+
+func NewChangePasswordHandler(
+	txManager ports.TransactionManager,
+	passwordHasher ports.PasswordHasher,
+	clock ports.Clock,
+	historyPolicy PasswordHistoryPolicy,
+) *ChangePasswordHandler {
+	return &ChangePasswordHandler{
+		txManager:      txManager,
+		passwordHasher: passwordHasher,
+		clock:          clock,
+		historyPolicy:  historyPolicy,
+	}
+}
+
+// Handle проверяет текущий пароль и устанавливает новый с учётом истории паролей
+func (h *ChangePasswordHandler) Handle(ctx context.Context, cmd ChangePasswordCommand) error {
+	return h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if !user.VerifyPassword(cmd.CurrentPassword, h.passwordHasher) {
+			return errs.NewDomainValidationError("current_password", "is invalid")
+		}
+
+		now := h.clock.Now()
+		history, txErr := h.historyPolicy.load(repos.PasswordHistory, user.ID(), now)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr := user.SetPassword(cmd.NewPassword, history, h.passwordHasher, h.clock); txErr != nil {
+			if errors.Is(txErr, auth.ErrPasswordTooShort) || errors.Is(txErr, auth.ErrPasswordReused) {
+				return errs.NewDomainValidationError("new_password", txErr.Error())
+			}
+			return txErr
+		}
+
+		if txErr := repos.User.Update(user); txErr != nil {
+			return txErr
+		}
+
+		if txErr := h.historyPolicy.record(repos.PasswordHistory, user, now); txErr != nil {
+			return txErr
+		}
+
+		if repos.Event != nil {
+			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+				return txErr
+			}
+		}
+		user.ClearDomainEvents()
+
+		return nil
+	})
+}
@@ -0,0 +1,10 @@
+package commands
+
+import "github.com/google/uuid"
+
+// ChangeUserStatusCommand — административная команда: активировать, приостановить, заблокировать или закрыть аккаунт
+type ChangeUserStatusCommand struct {
+	UserID uuid.UUID
+	Status string
+	Reason string
+}
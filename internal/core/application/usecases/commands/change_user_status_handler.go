@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// ChangeUserStatusHandler — обработчик смены состояния аккаунта
+type ChangeUserStatusHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+}
+
+func NewChangeUserStatusHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+) *ChangeUserStatusHandler {
+	return &ChangeUserStatusHandler{
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Handle переводит аккаунт в новое состояние. Уже выданные токены перестают приниматься сразу:
+// Authenticate и обновление токенов проверяют состояние пользователя.
+func (h *ChangeUserStatusHandler) Handle(ctx context.Context, cmd ChangeUserStatusCommand) error {
+	status, err := auth.ParseUserStatus(cmd.Status)
+	if err != nil {
+		return errs.NewDomainValidationError("status", "must be one of: active, suspended, blocked, deactivated")
+	}
+
+	return h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr := user.ChangeStatus(status, cmd.Reason, h.clock); txErr != nil {
+			if errors.Is(txErr, auth.ErrInvalidStatusTransition) {
+				return errs.NewDomainValidationError("status", "cannot change status from "+user.Status.String()+" to "+status.String())
+			}
+			return txErr
+		}
+
+		return saveUser(ctx, repos, user)
+	})
+}
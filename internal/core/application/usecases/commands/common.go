@@ -1,6 +1,15 @@
 package commands
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+
+	"github.com/google/uuid"
+)
 
 // UserInfo — информация о пользователе
 type UserInfo struct {
@@ -8,4 +17,81 @@ type UserInfo struct {
 	Email string
 	Name  string
 	Phone string
+	// PhoneType — тип номера по плану нумерации (mobile, fixed_line и т. д.)
+	PhoneType string
+	// Username — публичное имя (пусто — не задано)
+	Username string
+
+	// Необязательные поля профиля: пустые значения — не указано
+	Locale    string
+	Timezone  string
+	AvatarURL string
+	BirthDate *time.Time
+	Metadata  map[string]any
+
+	EmailVerified bool
+	PhoneVerified bool
+
+	Status string
+	// DeletionScheduledAt — когда аккаунт будет удалён по запросу пользователя (nil — не запрошено)
+	DeletionScheduledAt *time.Time
+
+	CreatedAt time.Time
+}
+
+// newUserInfo собирает UserInfo из агрегата
+func newUserInfo(user *auth.User) UserInfo {
+	return UserInfo{
+		ID:    user.ID(),
+		Email: user.Email.String(),
+		Name:  user.Name,
+		Phone: user.Phone.String(),
+
+		PhoneType: string(user.Phone.Type()),
+		Username:  user.Username.String(),
+
+		Locale:    user.Profile.Locale,
+		Timezone:  user.Profile.Timezone,
+		AvatarURL: user.Profile.AvatarURL,
+		BirthDate: user.Profile.BirthDate,
+		Metadata:  user.Metadata,
+
+		EmailVerified: user.IsEmailVerified(),
+		PhoneVerified: user.IsPhoneVerified(),
+
+		Status:              user.Status.String(),
+		DeletionScheduledAt: user.DeletionScheduledAt,
+
+		CreatedAt: user.CreatedAt,
+	}
+}
+
+// newTokenSubject собирает данные для клеймов пары токенов
+func newTokenSubject(user *auth.User) ports.TokenSubject {
+	return ports.TokenSubject{
+		UserID:    user.ID(),
+		Email:     user.Email.String(),
+		Name:      user.Name,
+		Phone:     user.Phone.String(),
+		CreatedAt: user.CreatedAt,
+
+		EmailVerified: user.IsEmailVerified(),
+		PhoneVerified: user.IsPhoneVerified(),
+	}
+}
+
+// takenError — email, телефон или username уже принадлежат другому аккаунту.
+// Сигнальная ошибка остаётся в цепочке, чтобы её можно было узнать через errors.Is.
+func takenError(field string, sentinel error) error {
+	return errs.NewDomainValidationErrorWithCause(field, sentinel.Error(), sentinel)
+}
+
+// emailNotVerifiedError — включено обязательное подтверждение email, а адрес ещё не подтверждён
+func emailNotVerifiedError() error {
+	return errs.NewForbiddenError("email-not-verified", "email address is not verified")
+}
+
+// accountInactiveError — аккаунт приостановлен, заблокирован или закрыт: входить и обновлять токены нельзя
+func accountInactiveError(user *auth.User) error {
+	return errs.NewForbiddenError("account-"+user.Status.String(), "account is "+user.Status.String())
 }
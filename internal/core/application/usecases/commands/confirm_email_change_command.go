@@ -0,0 +1,6 @@
+package commands
+
+// ConfirmEmailChangeCommand — команда подтверждения смены email токеном из письма
+type ConfirmEmailChangeCommand struct {
+	Token string
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// ConfirmEmailChangeHandler — обработчик подтверждения смены email
+type ConfirmEmailChangeHandler struct {
+	txManager  ports.TransactionManager
+	clock      ports.Clock
+	emailRules kernel.EmailCanonicalization
+}
+
+func NewConfirmEmailChangeHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+	emailRules kernel.EmailCanonicalization,
+) *ConfirmEmailChangeHandler {
+	return &ConfirmEmailChangeHandler{
+		txManager:  txManager,
+		clock:      clock,
+		emailRules: emailRules,
+	}
+}
+
+// Handle гасит токен и меняет email пользователя на подтверждённый адрес
+func (h *ConfirmEmailChangeHandler) Handle(ctx context.Context, cmd ConfirmEmailChangeCommand) error {
+	invalidToken := errs.NewDomainValidationError("token", "is invalid or expired")
+
+	return h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		token, txErr := repos.VerificationToken.GetBySecretHash(
+			auth.VerificationPurposeEmailChange,
+			auth.HashVerificationSecret(cmd.Token),
+		)
+		if txErr != nil {
+			return invalidToken
+		}
+
+		if txErr := token.Consume(h.clock); txErr != nil {
+			return invalidToken
+		}
+
+		user, txErr := repos.User.GetByID(token.UserID)
+		if txErr != nil {
+			return invalidToken
+		}
+
+		newEmail, txErr := h.emailRules.NewEmail(token.Target)
+		if txErr != nil {
+			return invalidToken
+		}
+
+		// Адрес мог занять другой аккаунт, пока письмо шло
+		exists, txErr := repos.User.EmailExists(newEmail)
+		if txErr != nil {
+			return txErr
+		}
+		if exists {
+			return takenError("email", auth.ErrEmailTaken)
+		}
+
+		if txErr := user.ConfirmEmailChange(newEmail, h.clock); txErr != nil {
+			return invalidToken
+		}
+
+		if txErr := repos.VerificationToken.Update(token); txErr != nil {
+			return txErr
+		}
+		if txErr := repos.User.Update(user); txErr != nil {
+			return txErr
+		}
+
+		if repos.Event != nil {
+			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+				return txErr
+			}
+		}
+		user.ClearDomainEvents()
+
+		return nil
+	})
+}
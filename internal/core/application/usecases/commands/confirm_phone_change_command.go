@@ -0,0 +1,14 @@
+package commands
+
+import "github.com/google/uuid"
+
+// ConfirmPhoneChangeCommand — команда подтверждения смены телефона кодом из SMS
+type ConfirmPhoneChangeCommand struct {
+	UserID uuid.UUID
+	Code   string
+}
+
+// ConfirmPhoneChangeResult — результат смены телефона
+type ConfirmPhoneChangeResult struct {
+	User UserInfo
+}
@@ -0,0 +1,109 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// ConfirmPhoneChangeHandler — обработчик подтверждения смены телефона
+type ConfirmPhoneChangeHandler struct {
+	txManager          ports.TransactionManager
+	passwordHasher     ports.PasswordHasher
+	clock              ports.Clock
+	verificationPolicy PhoneVerificationPolicy
+}
+
+func NewConfirmPhoneChangeHandler(
+	txManager ports.TransactionManager,
+	passwordHasher ports.PasswordHasher,
+	clock ports.Clock,
+	verificationPolicy PhoneVerificationPolicy,
+) *ConfirmPhoneChangeHandler {
+	return &ConfirmPhoneChangeHandler{
+		txManager:          txManager,
+		passwordHasher:     passwordHasher,
+		clock:              clock,
+		verificationPolicy: verificationPolicy,
+	}
+}
+
+// Handle проверяет код, отправленный на новый номер, и меняет телефон пользователя.
+// Новый номер сразу считается подтверждённым. Неудачная попытка фиксируется в транзакции.
+func (h *ConfirmPhoneChangeHandler) Handle(
+	ctx context.Context,
+	cmd ConfirmPhoneChangeCommand,
+) (ConfirmPhoneChangeResult, error) {
+	var (
+		result    ConfirmPhoneChangeResult
+		verifyErr error
+	)
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		token, codeErr, txErr := h.verificationPolicy.consume(
+			repos.VerificationToken,
+			user.ID(),
+			auth.VerificationPurposePhoneChange,
+			cmd.Code,
+			h.passwordHasher,
+			h.clock,
+		)
+		if txErr != nil {
+			return txErr
+		}
+		if codeErr != nil {
+			verifyErr = codeErr
+			return nil
+		}
+
+		newPhone, txErr := kernel.NewPhone(token.Target)
+		if txErr != nil {
+			return txErr
+		}
+
+		// Номер мог занять другой аккаунт, пока шла SMS
+		exists, txErr := repos.User.PhoneExists(newPhone)
+		if txErr != nil {
+			return txErr
+		}
+		if exists {
+			return takenError("phone", auth.ErrPhoneTaken)
+		}
+
+		user.ChangePhone(newPhone, h.clock)
+		if txErr := user.VerifyPhone(h.clock); txErr != nil {
+			return txErr
+		}
+
+		if txErr := repos.VerificationToken.Update(token); txErr != nil {
+			return txErr
+		}
+		if txErr := repos.User.Update(user); txErr != nil {
+			return txErr
+		}
+
+		if repos.Event != nil {
+			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+				return txErr
+			}
+		}
+		user.ClearDomainEvents()
+
+		result = ConfirmPhoneChangeResult{User: newUserInfo(user)}
+		return nil
+	})
+	if err != nil {
+		return ConfirmPhoneChangeResult{}, err
+	}
+	if verifyErr != nil {
+		return ConfirmPhoneChangeResult{}, verifyErr
+	}
+
+	return result, nil
+}
@@ -0,0 +1,14 @@
+package commands
+
+import "github.com/google/uuid"
+
+// ConfirmTOTPCommand — команда включения MFA первым кодом из приложения-аутентификатора
+type ConfirmTOTPCommand struct {
+	UserID uuid.UUID
+	Code   string
+}
+
+// ConfirmTOTPResult — коды восстановления; показываются пользователю один раз
+type ConfirmTOTPResult struct {
+	RecoveryCodes []string
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// ConfirmTOTPHandler — обработчик включения MFA
+type ConfirmTOTPHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+}
+
+func NewConfirmTOTPHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+) *ConfirmTOTPHandler {
+	return &ConfirmTOTPHandler{
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Handle проверяет первый код, включает MFA и выпускает новый набор кодов восстановления
+func (h *ConfirmTOTPHandler) Handle(ctx context.Context, cmd ConfirmTOTPCommand) (ConfirmTOTPResult, error) {
+	var recoveryCodes []string
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr := user.ConfirmTOTPEnrollment(cmd.Code, h.clock); txErr != nil {
+			switch {
+			case errors.Is(txErr, auth.ErrTOTPCodeInvalid):
+				return errs.NewDomainValidationError("code", txErr.Error())
+			case errors.Is(txErr, auth.ErrMFAAlreadyEnabled), errors.Is(txErr, auth.ErrTOTPNotEnrolled):
+				return errs.NewDomainValidationError("mfa", txErr.Error())
+			default:
+				return txErr
+			}
+		}
+
+		codes, raw, txErr := auth.NewRecoveryCodes(user.ID(), auth.RecoveryCodeCount, h.clock)
+		if txErr != nil {
+			return txErr
+		}
+		if txErr := repos.RecoveryCode.Replace(user.ID(), codes); txErr != nil {
+			return txErr
+		}
+
+		if txErr := repos.User.Update(user); txErr != nil {
+			return txErr
+		}
+
+		if repos.Event != nil {
+			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+				return txErr
+			}
+		}
+		user.ClearDomainEvents()
+
+		recoveryCodes = raw
+		return nil
+	})
+	if err != nil {
+		return ConfirmTOTPResult{}, err
+	}
+
+	return ConfirmTOTPResult{RecoveryCodes: recoveryCodes}, nil
+}
@@ -0,0 +1,34 @@
+package commands
+
+import "time"
+
+// DataExportPolicy — выгрузка данных пользователя по запросу субъекта данных
+type DataExportPolicy struct {
+	// TTL — сколько хранится собранный в фоне архив
+	TTL time.Duration
+	// MaxSyncEvents — выгрузка с большим числом событий собирается в фоне (0 — 1000)
+	MaxSyncEvents int64
+	// BatchSize — сколько выгрузок собирается за один запуск (0 — 10)
+	BatchSize int
+}
+
+const (
+	defaultDataExportMaxSyncEvents = 1000
+	defaultDataExportBatchSize     = 10
+)
+
+// maxSyncEvents — порог фоновой выгрузки с учётом значения по умолчанию
+func (p DataExportPolicy) maxSyncEvents() int64 {
+	if p.MaxSyncEvents <= 0 {
+		return defaultDataExportMaxSyncEvents
+	}
+	return p.MaxSyncEvents
+}
+
+// batchSize — размер пачки с учётом значения по умолчанию
+func (p DataExportPolicy) batchSize() int {
+	if p.BatchSize <= 0 {
+		return defaultDataExportBatchSize
+	}
+	return p.BatchSize
+}
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// DeleteScheduledUsersHandler — удаление аккаунтов, срок запрошенного удаления которых наступил.
+// Вызывается периодически фоновой задачей.
+type DeleteScheduledUsersHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+	policy    UserPurgePolicy
+}
+
+func NewDeleteScheduledUsersHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+	policy UserPurgePolicy,
+) *DeleteScheduledUsersHandler {
+	return &DeleteScheduledUsersHandler{
+		txManager: txManager,
+		clock:     clock,
+		policy:    policy,
+	}
+}
+
+// Handle удаляет одну пачку аккаунтов и возвращает их число.
+// Пользователь уже ждал удаления DeletionGracePeriod, поэтому аккаунт стирается сразу, без срока хранения.
+func (h *DeleteScheduledUsersHandler) Handle(ctx context.Context) (int, error) {
+	var ids []uuid.UUID
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		var txErr error
+		ids, txErr = repos.User.ListDeletionDue(h.clock.Now(), h.policy.batchSize())
+		return txErr
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, id := range ids {
+		var done bool
+		if err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+			var txErr error
+			done, txErr = h.deleteUser(ctx, repos, id)
+			return txErr
+		}); err != nil {
+			return deleted, err
+		}
+		if done {
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+// deleteUser удаляет аккаунт, если удаление не отменили после выборки
+func (h *DeleteScheduledUsersHandler) deleteUser(ctx context.Context, repos ports.Repositories, userID uuid.UUID) (bool, error) {
+	user, err := repos.User.GetByID(userID)
+	if err != nil {
+		return false, err
+	}
+	if !user.IsDeletionDue(h.clock.Now()) {
+		return false, nil
+	}
+
+	if err := user.Delete(h.clock); err != nil {
+		return false, err
+	}
+	if err := saveUser(ctx, repos, user); err != nil {
+		return false, err
+	}
+
+	return true, purgeUser(ctx, repos, userID)
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+// DeleteUserCommand — административная команда: удалить аккаунт
+type DeleteUserCommand struct {
+	UserID uuid.UUID
+}
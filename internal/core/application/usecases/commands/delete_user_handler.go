@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// DeleteUserHandler — обработчик удаления аккаунта
+type DeleteUserHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+}
+
+func NewDeleteUserHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+) *DeleteUserHandler {
+	return &DeleteUserHandler{
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Handle помечает аккаунт удалённым. Войти в него и пользоваться его токенами больше нельзя,
+// данные стираются окончательно после срока хранения (PurgeDeletedUsersHandler).
+func (h *DeleteUserHandler) Handle(ctx context.Context, cmd DeleteUserCommand) error {
+	return h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr := user.Delete(h.clock); txErr != nil {
+			return txErr
+		}
+
+		return saveUser(ctx, repos, user)
+	})
+}
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// EmailChangePolicy — правила смены email.
+type EmailChangePolicy struct {
+	// TokenTTL — время жизни ссылки подтверждения нового адреса
+	TokenTTL time.Duration
+	// LinkURL — страница подтверждения, токен добавляется параметром token (пусто — в письме только токен)
+	LinkURL string
+}
+
+// confirmation — письмо на новый адрес со ссылкой (или токеном) подтверждения.
+func (p EmailChangePolicy) confirmation(to, secret string) ports.EmailMessage {
+	confirm := secret
+	if p.LinkURL != "" {
+		confirm = p.LinkURL + "?token=" + url.QueryEscape(secret)
+	}
+
+	return ports.EmailMessage{
+		To:      to,
+		Subject: "Confirm your new email address",
+		Body: fmt.Sprintf(
+			"Confirm your new email address:\n\n%s\n\nThe link expires in %d minutes. If you didn't request this change, ignore this email.",
+			confirm,
+			int(p.TokenTTL.Minutes()),
+		),
+	}
+}
+
+// notice — уведомление на текущий адрес о запрошенной смене.
+func (p EmailChangePolicy) notice(to, newEmail string) ports.EmailMessage {
+	return ports.EmailMessage{
+		To:      to,
+		Subject: "Your email address is being changed",
+		Body: fmt.Sprintf(
+			"A change of your account email to %s was requested. "+
+				"If it wasn't you, change your password right away.",
+			newEmail,
+		),
+	}
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// EmailVerificationPolicy — правила подтверждения email.
+type EmailVerificationPolicy struct {
+	// TokenTTL — время жизни токена из письма
+	TokenTTL time.Duration
+	// LinkURL — страница подтверждения, токен добавляется параметром token (пусто — в письме только токен)
+	LinkURL string
+	// RequiredForLogin — не пускать пользователей с неподтверждённым email
+	RequiredForLogin bool
+}
+
+// issue выпускает новый токен подтверждения (ранее выданные гасятся) и готовит письмо.
+// Письмо отправляется после фиксации транзакции.
+func (p EmailVerificationPolicy) issue(
+	repo ports.VerificationTokenRepository,
+	user *auth.User,
+	clock ports.Clock,
+) (ports.EmailMessage, error) {
+	if err := repo.InvalidateActive(user.ID(), auth.VerificationPurposeEmail, clock.Now()); err != nil {
+		return ports.EmailMessage{}, err
+	}
+
+	token, secret, err := auth.NewVerificationToken(
+		user.ID(),
+		auth.VerificationPurposeEmail,
+		user.Email.String(),
+		p.TokenTTL,
+		clock,
+	)
+	if err != nil {
+		return ports.EmailMessage{}, err
+	}
+
+	if err := repo.Create(&token); err != nil {
+		return ports.EmailMessage{}, err
+	}
+
+	return p.message(user.Email.String(), secret), nil
+}
+
+// message — письмо со ссылкой (или токеном) подтверждения.
+func (p EmailVerificationPolicy) message(to, secret string) ports.EmailMessage {
+	confirm := secret
+	if p.LinkURL != "" {
+		confirm = p.LinkURL + "?token=" + url.QueryEscape(secret)
+	}
+
+	return ports.EmailMessage{
+		To:      to,
+		Subject: "Confirm your email address",
+		Body: fmt.Sprintf(
+			"Confirm your email address:\n\n%s\n\nThe link expires in %d minutes. If you didn't create an account, ignore this email.",
+			confirm,
+			int(p.TokenTTL.Minutes()),
+		),
+	}
+}
@@ -0,0 +1,14 @@
+package commands
+
+import "github.com/google/uuid"
+
+// EnrollTOTPCommand — команда начала подключения приложения-аутентификатора
+type EnrollTOTPCommand struct {
+	UserID uuid.UUID
+}
+
+// EnrollTOTPResult — секрет для приложения-аутентификатора
+type EnrollTOTPResult struct {
+	Secret string
+	URI    string // otpauth:// для QR-кода
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	"github.com/Vi-72/quest-auth/internal/pkg/totp"
+)
+
+// EnrollTOTPHandler — обработчик начала подключения TOTP.
+// MFA не включается, пока пользователь не подтвердит секрет первым кодом (ConfirmTOTPHandler).
+type EnrollTOTPHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+	mfaPolicy MFAPolicy
+}
+
+func NewEnrollTOTPHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+	mfaPolicy MFAPolicy,
+) *EnrollTOTPHandler {
+	return &EnrollTOTPHandler{
+		txManager: txManager,
+		clock:     clock,
+		mfaPolicy: mfaPolicy,
+	}
+}
+
+// Handle выпускает новый секрет TOTP и возвращает его вместе со ссылкой otpauth://
+func (h *EnrollTOTPHandler) Handle(ctx context.Context, cmd EnrollTOTPCommand) (EnrollTOTPResult, error) {
+	var result EnrollTOTPResult
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		secret, txErr := user.BeginTOTPEnrollment(h.clock)
+		if txErr != nil {
+			if errors.Is(txErr, auth.ErrMFAAlreadyEnabled) {
+				return errs.NewDomainValidationError("mfa", txErr.Error())
+			}
+			return txErr
+		}
+
+		if txErr := repos.User.Update(user); txErr != nil {
+			return txErr
+		}
+
+		result = EnrollTOTPResult{
+			Secret: secret,
+			URI:    totp.URI(h.mfaPolicy.Issuer, user.Email.String(), secret),
+		}
+		return nil
+	})
+	if err != nil {
+		return EnrollTOTPResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,18 @@
+package commands
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+// ExportUserDataCommand — команда выгрузки данных пользователя (им самим или администратором)
+type ExportUserDataCommand struct {
+	UserID uuid.UUID
+}
+
+// ExportUserDataResult — готовый JSON-архив либо выгрузка, собираемая в фоне
+type ExportUserDataResult struct {
+	Archive []byte
+	Export  *auth.DataExport // nil, если архив собран сразу
+}
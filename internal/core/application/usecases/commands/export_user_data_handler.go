@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// ExportUserDataHandler — обработчик выгрузки данных пользователя
+type ExportUserDataHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+	policy    DataExportPolicy
+}
+
+func NewExportUserDataHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+	policy DataExportPolicy,
+) *ExportUserDataHandler {
+	return &ExportUserDataHandler{
+		txManager: txManager,
+		clock:     clock,
+		policy:    policy,
+	}
+}
+
+// Handle собирает архив сразу, если событий пользователя не больше MaxSyncEvents.
+// Иначе ставит выгрузку в очередь GenerateDataExportsHandler; повторный запрос возвращает ту же выгрузку,
+// пока она собирается или не истекла.
+func (h *ExportUserDataHandler) Handle(ctx context.Context, cmd ExportUserDataCommand) (ExportUserDataResult, error) {
+	var result ExportUserDataResult
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		events, txErr := repos.EventLog.CountByAggregate(ctx, user.ID())
+		if txErr != nil {
+			return txErr
+		}
+		if events <= h.policy.maxSyncEvents() {
+			result.Archive, txErr = buildUserDataArchive(ctx, repos, user, h.clock)
+			return txErr
+		}
+
+		latest, txErr := repos.DataExport.GetLatest(user.ID())
+		if txErr != nil {
+			var notFound *errs.NotFoundError
+			if !errors.As(txErr, &notFound) {
+				return txErr
+			}
+		} else if !latest.IsExpired(h.clock.Now()) {
+			result.Export = latest
+			return nil
+		}
+
+		export := auth.NewDataExport(user.ID(), h.policy.TTL, h.clock)
+		if txErr := repos.DataExport.Create(&export); txErr != nil {
+			return txErr
+		}
+		result.Export = &export
+		return nil
+	})
+	if err != nil {
+		return ExportUserDataResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,18 @@
+package commands
+
+// WebAuthnAssertion — ответ аутентификатора на navigator.credentials.get()
+type WebAuthnAssertion struct {
+	CredentialID      []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+	UserHandle        []byte // пусто, если ключ выбран из allowCredentials
+}
+
+// FinishWebAuthnLoginCommand — вход по passkey: подпись challenge из BeginWebAuthnLoginCommand
+type FinishWebAuthnLoginCommand struct {
+	Assertion WebAuthnAssertion
+
+	ClientIP  string // адрес клиента для истории входов
+	UserAgent string // User-Agent клиента — отпечаток устройства
+}
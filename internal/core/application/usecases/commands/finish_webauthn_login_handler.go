@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// FinishWebAuthnLoginHandler — обработчик входа по passkey.
+// Passkey с проверкой пользователя сам по себе двухфакторный, поэтому второй шаг MFA не запрашивается;
+// остальные проверки и результат — как у LoginUserHandler.
+type FinishWebAuthnLoginHandler struct {
+	txManager      ports.TransactionManager
+	jwtService     ports.JWTService
+	clock          ports.Clock
+	webAuthnPolicy WebAuthnPolicy
+	expiryPolicy   PasswordExpiryPolicy
+
+	verificationPolicy EmailVerificationPolicy
+	riskPolicy         LoginRiskPolicy
+}
+
+func NewFinishWebAuthnLoginHandler(
+	txManager ports.TransactionManager,
+	jwtService ports.JWTService,
+	clock ports.Clock,
+	webAuthnPolicy WebAuthnPolicy,
+	expiryPolicy PasswordExpiryPolicy,
+	verificationPolicy EmailVerificationPolicy,
+	riskPolicy LoginRiskPolicy,
+) *FinishWebAuthnLoginHandler {
+	return &FinishWebAuthnLoginHandler{
+		txManager:      txManager,
+		jwtService:     jwtService,
+		clock:          clock,
+		webAuthnPolicy: webAuthnPolicy,
+		expiryPolicy:   expiryPolicy,
+
+		verificationPolicy: verificationPolicy,
+		riskPolicy:         riskPolicy,
+	}
+}
+
+// Handle проверяет подпись challenge и завершает вход.
+// Challenge гасится при любом исходе проверки; ошибка возвращается после завершения транзакции.
+func (h *FinishWebAuthnLoginHandler) Handle(ctx context.Context, cmd FinishWebAuthnLoginCommand) (LoginUserResult, error) {
+	var (
+		loggedInUser    *auth.User
+		passwordExpired bool
+		risk            loginRisk
+		verifyErr       error
+	)
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		token, challenge, txErr := h.webAuthnPolicy.lookup(
+			repos.VerificationToken, auth.VerificationPurposeWebAuthnLogin, cmd.Assertion.ClientDataJSON, h.clock.Now(),
+		)
+		if txErr != nil {
+			return txErr
+		}
+		if token == nil {
+			verifyErr = errs.NewDomainValidationError("credentials", auth.ErrVerificationTokenInvalid.Error())
+			return nil
+		}
+
+		if txErr := token.Consume(h.clock); txErr != nil {
+			return txErr
+		}
+		if txErr := repos.VerificationToken.Update(token); txErr != nil {
+			return txErr
+		}
+
+		cred, signCount, assertErr, txErr := h.webAuthnPolicy.verifyAssertion(
+			repos.WebAuthnCredential, challenge, cmd.Assertion, true,
+		)
+		if txErr != nil {
+			return txErr
+		}
+		if assertErr != nil {
+			verifyErr = errs.NewDomainValidationError("credentials", assertErr.Error())
+			return nil
+		}
+
+		user, txErr := repos.User.GetByID(cred.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if authErr := user.AuthenticateWithWebAuthn(cred, signCount, h.clock); authErr != nil {
+			verifyErr = errs.NewDomainValidationError("credentials", authErr.Error())
+			return nil
+		}
+		if txErr := repos.WebAuthnCredential.Update(cred); txErr != nil {
+			return txErr
+		}
+
+		if !user.IsActive() {
+			verifyErr = accountInactiveError(user)
+			return nil
+		}
+
+		if h.verificationPolicy.RequiredForLogin && !user.IsEmailVerified() {
+			verifyErr = emailNotVerifiedError()
+			return nil
+		}
+
+		loggedInUser = user
+
+		// Просроченный пароль: вход не завершён, токены сессии не выдаются
+		if user.PasswordExpired(h.expiryPolicy.MaxAge, h.clock.Now()) {
+			passwordExpired = true
+			return nil
+		}
+
+		// Passkey сам подтверждает вход: о подозрительном входе только сообщается
+		client := loginClient{userAgent: cmd.UserAgent, ip: cmd.ClientIP}
+		if risk, txErr = h.riskPolicy.assess(repos, user, client, h.clock); txErr != nil {
+			return txErr
+		}
+		user.MarkLoggedIn(h.clock)
+
+		if repos.Event != nil {
+			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+				return txErr
+			}
+		}
+		user.ClearDomainEvents()
+
+		return nil
+	})
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+	if verifyErr != nil {
+		return LoginUserResult{}, verifyErr
+	}
+
+	h.riskPolicy.notify(ctx, risk)
+	if passwordExpired {
+		return passwordExpiredResult(h.jwtService, h.expiryPolicy, loggedInUser)
+	}
+
+	return loggedInResult(h.jwtService, loggedInUser)
+}
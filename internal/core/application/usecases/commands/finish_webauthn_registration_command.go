@@ -0,0 +1,23 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FinishWebAuthnRegistrationCommand — ответ аутентификатора на регистрацию ключа
+type FinishWebAuthnRegistrationCommand struct {
+	UserID            uuid.UUID
+	Name              string // необязательная подпись ключа
+	ClientDataJSON    []byte
+	AttestationObject []byte
+}
+
+// FinishWebAuthnRegistrationResult — зарегистрированный ключ
+type FinishWebAuthnRegistrationResult struct {
+	ID           uuid.UUID
+	CredentialID []byte
+	Name         string
+	CreatedAt    time.Time
+}
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// FinishWebAuthnRegistrationHandler — обработчик завершения регистрации ключа WebAuthn
+type FinishWebAuthnRegistrationHandler struct {
+	txManager      ports.TransactionManager
+	clock          ports.Clock
+	webAuthnPolicy WebAuthnPolicy
+}
+
+func NewFinishWebAuthnRegistrationHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+	webAuthnPolicy WebAuthnPolicy,
+) *FinishWebAuthnRegistrationHandler {
+	return &FinishWebAuthnRegistrationHandler{
+		txManager:      txManager,
+		clock:          clock,
+		webAuthnPolicy: webAuthnPolicy,
+	}
+}
+
+// Handle проверяет ответ аутентификатора и сохраняет ключ.
+// Challenge гасится при любом исходе проверки; ошибка проверки возвращается после завершения транзакции.
+func (h *FinishWebAuthnRegistrationHandler) Handle(
+	ctx context.Context,
+	cmd FinishWebAuthnRegistrationCommand,
+) (FinishWebAuthnRegistrationResult, error) {
+	var (
+		result    FinishWebAuthnRegistrationResult
+		verifyErr error
+	)
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		token, challenge, txErr := h.webAuthnPolicy.lookup(
+			repos.VerificationToken, auth.VerificationPurposeWebAuthnRegistration, cmd.ClientDataJSON, h.clock.Now(),
+		)
+		if txErr != nil {
+			return txErr
+		}
+		if token == nil || token.UserID != cmd.UserID {
+			verifyErr = errs.NewDomainValidationError("credential", auth.ErrVerificationTokenInvalid.Error())
+			return nil
+		}
+
+		if txErr := token.Consume(h.clock); txErr != nil {
+			return txErr
+		}
+		if txErr := repos.VerificationToken.Update(token); txErr != nil {
+			return txErr
+		}
+
+		key, checkErr := h.webAuthnPolicy.RelyingParty.VerifyRegistration(
+			challenge, cmd.ClientDataJSON, cmd.AttestationObject, false,
+		)
+		if checkErr != nil {
+			verifyErr = errs.NewDomainValidationError("credential", checkErr.Error())
+			return nil
+		}
+
+		_, txErr = repos.WebAuthnCredential.GetByCredentialID(key.ID)
+		if txErr == nil {
+			verifyErr = errs.NewDomainValidationError("credential", "passkey is already registered")
+			return nil
+		}
+		var notFound *errs.NotFoundError
+		if !errors.As(txErr, &notFound) {
+			return txErr
+		}
+
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		cred, txErr := user.RegisterWebAuthnCredential(key.ID, key.PublicKey, key.SignCount, cmd.Name, h.clock)
+		if txErr != nil {
+			if errors.Is(txErr, auth.ErrWebAuthnCredentialNameLong) {
+				verifyErr = errs.NewDomainValidationError("name", txErr.Error())
+				return nil
+			}
+			return txErr
+		}
+
+		if txErr := repos.WebAuthnCredential.Create(&cred); txErr != nil {
+			return txErr
+		}
+
+		if repos.Event != nil {
+			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+				return txErr
+			}
+		}
+		user.ClearDomainEvents()
+
+		result = FinishWebAuthnRegistrationResult{
+			ID:           cred.ID,
+			CredentialID: cred.CredentialID,
+			Name:         cred.Name,
+			CreatedAt:    cred.CreatedAt,
+		}
+		return nil
+	})
+	if err != nil {
+		return FinishWebAuthnRegistrationResult{}, err
+	}
+	if verifyErr != nil {
+		return FinishWebAuthnRegistrationResult{}, verifyErr
+	}
+
+	return result, nil
+}
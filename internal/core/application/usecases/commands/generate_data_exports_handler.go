@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// GenerateDataExportsHandler — сборка выгрузок, поставленных в очередь, и удаление истёкших.
+// Вызывается периодически фоновой задачей.
+type GenerateDataExportsHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+	policy    DataExportPolicy
+}
+
+func NewGenerateDataExportsHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+	policy DataExportPolicy,
+) *GenerateDataExportsHandler {
+	return &GenerateDataExportsHandler{
+		txManager: txManager,
+		clock:     clock,
+		policy:    policy,
+	}
+}
+
+// Handle удаляет истёкшие выгрузки, собирает одну пачку и возвращает число собранных.
+// Каждая выгрузка собирается в своей транзакции: сбой одной не откатывает остальные.
+func (h *GenerateDataExportsHandler) Handle(ctx context.Context) (int, error) {
+	var pending []auth.DataExport
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		if txErr := repos.DataExport.DeleteExpired(h.clock.Now()); txErr != nil {
+			return txErr
+		}
+
+		var txErr error
+		pending, txErr = repos.DataExport.ListPending(h.policy.batchSize())
+		return txErr
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	for i := range pending {
+		export := &pending[i]
+		if err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+			return h.generate(ctx, repos, export)
+		}); err != nil {
+			return generated, err
+		}
+		generated++
+	}
+
+	return generated, nil
+}
+
+// generate собирает архив выгрузки; выгрузка удалённого пользователя удаляется
+func (h *GenerateDataExportsHandler) generate(ctx context.Context, repos ports.Repositories, export *auth.DataExport) error {
+	user, err := repos.User.GetByID(export.UserID)
+	if err != nil {
+		var notFound *errs.NotFoundError
+		if errors.As(err, &notFound) {
+			return repos.DataExport.DeleteByUser(export.UserID)
+		}
+		return err
+	}
+
+	archive, err := buildUserDataArchive(ctx, repos, user, h.clock)
+	if err != nil {
+		return err
+	}
+
+	if err := export.Complete(archive, h.policy.TTL, h.clock); err != nil {
+		return err
+	}
+	return repos.DataExport.Update(export)
+}
@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+// LockoutPolicy — правила временной блокировки входа после неверных паролей.
+type LockoutPolicy struct {
+	// Threshold — сколько неверных паролей подряд приводит к блокировке (0 — блокировка выключена)
+	Threshold int
+	// BaseDuration — длительность первой блокировки, каждая следующая вдвое длиннее
+	BaseDuration time.Duration
+	// MaxDuration — верхняя граница длительности блокировки
+	MaxDuration time.Duration
+}
+
+// rule — правила блокировки для агрегата пользователя
+func (p LockoutPolicy) rule() auth.LockoutRule {
+	return auth.LockoutRule{
+		Threshold:    p.Threshold,
+		BaseDuration: p.BaseDuration,
+		MaxDuration:  p.MaxDuration,
+	}
+}
@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// LoginRiskPolicy — распознавание входов с незнакомых устройств и из необычных мест.
+// Каждый завершённый вход записывается в историю и сравнивается с последними HistorySize входами.
+type LoginRiskPolicy struct {
+	// Locator определяет страну и город по IP (nil — место входа не учитывается)
+	Locator ports.GeoLocator
+	// Notifier сообщает пользователю о подозрительном входе (nil — без уведомлений)
+	Notifier ports.SecurityNotifier
+	// HistorySize — сколько последних входов сравнивается с новым (0 — вход только записывается)
+	HistorySize int
+	// StepUp — подозрительный вход по паролю или коду из SMS завершается только по ссылке из письма
+	StepUp bool
+	// StepUpLink — правила ссылки подтверждения (те же, что у входа по ссылке)
+	StepUpLink MagicLinkPolicy
+}
+
+// loginClient — устройство и адрес, с которых выполняется вход
+type loginClient struct {
+	userAgent string
+	ip        string
+	// stepUp — вход можно подтвердить ссылкой из письма: только для входа по паролю или коду из SMS,
+	// ссылка, второй шаг MFA и passkey сами доказывают владение аккаунтом
+	stepUp bool
+}
+
+// loginRisk — итог проверки входа для отправки уведомления после фиксации транзакции
+type loginRisk struct {
+	notice *ports.SuspiciousLoginNotice
+	// verificationRequired — вход не завершён: ждёт перехода по ссылке confirmLink
+	verificationRequired bool
+	confirmLink          string
+}
+
+// assess сравнивает вход с историей и записывает его.
+// Подозрительный вход отмечается событием пользователя. Если вход нужно подтвердить ссылкой,
+// в историю он не попадает: иначе устройство стало бы знакомым без подтверждения.
+// Сбой определения места не мешает входу — место остаётся неизвестным.
+func (p LoginRiskPolicy) assess(
+	repos ports.Repositories,
+	user *auth.User,
+	client loginClient,
+	clock ports.Clock,
+) (loginRisk, error) {
+	var location auth.Location
+	if p.Locator != nil && client.ip != "" {
+		if found, err := p.Locator.Locate(client.ip); err == nil {
+			location = found
+		}
+	}
+	record := auth.NewLoginRecord(user.ID(), client.userAgent, client.ip, location, clock)
+
+	var history []auth.LoginRecord
+	if p.HistorySize > 0 {
+		var err error
+		if history, err = repos.LoginHistory.ListRecent(user.ID(), p.HistorySize); err != nil {
+			return loginRisk{}, err
+		}
+	}
+
+	risk := auth.AssessLogin(history, record)
+	if !risk.Suspicious() {
+		return loginRisk{}, repos.LoginHistory.Add(&record)
+	}
+
+	user.FlagSuspiciousLogin(record, risk, clock)
+	result := loginRisk{notice: newSuspiciousLoginNotice(user, record, risk)}
+
+	if !p.StepUp || !client.stepUp || p.Notifier == nil {
+		return result, repos.LoginHistory.Add(&record)
+	}
+
+	// Ссылка уже отправлялась недавно — вход всё равно ждёт подтверждения, новое письмо не нужно
+	secret, issued, err := p.StepUpLink.issueSecret(repos.VerificationToken, user, clock)
+	if err != nil {
+		return loginRisk{}, err
+	}
+	result.verificationRequired = true
+	if issued {
+		result.confirmLink = p.StepUpLink.link(secret)
+	}
+	return result, nil
+}
+
+// notify отправляет уведомление о подозрительном входе или ссылку подтверждения.
+// Сбой отправки не меняет ответ на сам вход.
+func (p LoginRiskPolicy) notify(ctx context.Context, risk loginRisk) {
+	if p.Notifier == nil || risk.notice == nil {
+		return
+	}
+
+	switch {
+	case risk.confirmLink != "":
+		_ = p.Notifier.ConfirmSuspiciousLogin(ctx, *risk.notice, risk.confirmLink, p.StepUpLink.TokenTTL)
+	case !risk.verificationRequired:
+		_ = p.Notifier.NotifySuspiciousLogin(ctx, *risk.notice)
+	}
+}
+
+// newSuspiciousLoginNotice собирает уведомление о подозрительном входе
+func newSuspiciousLoginNotice(user *auth.User, record auth.LoginRecord, risk auth.LoginRisk) *ports.SuspiciousLoginNotice {
+	return &ports.SuspiciousLoginNotice{
+		Email:       user.Email.String(),
+		Name:        user.Name,
+		IP:          record.IP,
+		UserAgent:   record.UserAgent,
+		Country:     record.Country,
+		City:        record.City,
+		NewDevice:   risk.NewDevice,
+		NewLocation: risk.NewLocation,
+		At:          record.CreatedAt,
+	}
+}
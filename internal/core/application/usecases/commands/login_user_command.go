@@ -1,9 +1,15 @@
 package commands
 
+import "github.com/Vi-72/quest-auth/internal/pkg/webauthn"
+
 // LoginUserCommand — команда для входа пользователя
 type LoginUserCommand struct {
-	Email    string
-	Password string
+	Identifier string // email, телефон или публичное имя
+	Password   string
+
+	CaptchaToken string // токен решённой CAPTCHA, если она требуется
+	ClientIP     string // адрес клиента для учёта неудачных попыток и истории входов
+	UserAgent    string // User-Agent клиента — отпечаток устройства
 }
 
 // LoginUserResult — результат входа
@@ -13,4 +19,24 @@ type LoginUserResult struct {
 	RefreshToken string
 	TokenType    string
 	ExpiresIn    int64
+
+	// PasswordExpired — пароль просрочен или его смена запрошена администратором.
+	// Пара токенов в этом случае не выдаётся, вместо неё — токен только для смены пароля.
+	PasswordExpired              bool
+	PasswordChangeToken          string
+	PasswordChangeTokenExpiresIn int64
+
+	// MFARequired — у пользователя включена MFA: вход завершается вторым шагом (VerifyMFACommand)
+	// с MFAToken, пара токенов до этого не выдаётся.
+	MFARequired       bool
+	MFAToken          string
+	MFATokenExpiresIn int64
+	// MFAMethods — доступные способы второго шага (auth.MFAMethod*)
+	MFAMethods []string
+	// MFAWebAuthnOptions — параметры navigator.credentials.get(), если у пользователя есть ключи WebAuthn
+	MFAWebAuthnOptions *webauthn.RequestOptions
+
+	// LoginVerificationRequired — вход с незнакомого устройства или из необычного места ждёт подтверждения:
+	// на email отправлена ссылка, вход завершается по ней (RedeemMagicLinkCommand), пара токенов не выдаётся.
+	LoginVerificationRequired bool
 }
@@ -2,11 +2,14 @@ package commands
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
 	"github.com/Vi-72/quest-auth/internal/core/ports"
 	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	"github.com/Vi-72/quest-auth/internal/pkg/webauthn"
 )
 
 // LoginUserHandler — обработчик входа пользователя
@@ -15,6 +18,18 @@ type LoginUserHandler struct {
 	jwtService     ports.JWTService
 	passwordHasher ports.PasswordHasher
 	clock          ports.Clock
+	expiryPolicy   PasswordExpiryPolicy
+
+	verificationPolicy EmailVerificationPolicy
+	mfaPolicy          MFAPolicy
+	webAuthnPolicy     WebAuthnPolicy
+	lockoutPolicy      LockoutPolicy
+	enumerationPolicy  AntiEnumerationPolicy
+	captchaPolicy      CaptchaPolicy
+	riskPolicy         LoginRiskPolicy
+	dummyPassword      *dummyPassword
+	emailRules         kernel.EmailCanonicalization
+	phoneRules         kernel.PhoneNumbering
 }
 
 func NewLoginUserHandler(
@@ -22,76 +37,351 @@ func NewLoginUserHandler(
 	jwtService ports.JWTService,
 	passwordHasher ports.PasswordHasher,
 	clock ports.Clock,
+	expiryPolicy PasswordExpiryPolicy,
+	verificationPolicy EmailVerificationPolicy,
+	mfaPolicy MFAPolicy,
+	webAuthnPolicy WebAuthnPolicy,
+	lockoutPolicy LockoutPolicy,
+	enumerationPolicy AntiEnumerationPolicy,
+	captchaPolicy CaptchaPolicy,
+	riskPolicy LoginRiskPolicy,
+	emailRules kernel.EmailCanonicalization,
+	phoneRules kernel.PhoneNumbering,
 ) *LoginUserHandler {
 	return &LoginUserHandler{
 		txManager:      txManager,
 		jwtService:     jwtService,
 		passwordHasher: passwordHasher,
 		clock:          clock,
+		expiryPolicy:   expiryPolicy,
+
+		verificationPolicy: verificationPolicy,
+		mfaPolicy:          mfaPolicy,
+		webAuthnPolicy:     webAuthnPolicy,
+		lockoutPolicy:      lockoutPolicy,
+		enumerationPolicy:  enumerationPolicy,
+		captchaPolicy:      captchaPolicy,
+		riskPolicy:         riskPolicy,
+		dummyPassword:      &dummyPassword{},
+		emailRules:         emailRules,
+		phoneRules:         phoneRules,
 	}
 }
 
-// Handle выполняет вход пользователя
+// Handle выполняет вход пользователя по email, телефону или публичному имени и паролю.
+// Неверный пароль фиксируется (и может заблокировать вход), ошибка возвращается после завершения транзакции.
+// После серии неудачных попыток с IP клиента или для аккаунта вход требует CAPTCHA.
+// Вход с незнакомого устройства или из необычного места может потребовать подтверждения ссылкой из письма.
 func (h *LoginUserHandler) Handle(ctx context.Context, cmd LoginUserCommand) (LoginUserResult, error) {
-	// Валидация email
-	email, err := kernel.NewEmail(cmd.Email)
+	identifier, err := newLoginIdentifier(cmd.Identifier, h.emailRules, h.phoneRules)
 	if err != nil {
-		return LoginUserResult{}, errs.NewDomainValidationError("email", err.Error())
+		return LoginUserResult{}, err
 	}
 
-	var loggedInUser *auth.User
-	err = h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
-		userRepo := repos.User
+	if err := h.captchaPolicy.check(ctx, cmd.CaptchaToken, cmd.ClientIP, identifier.String()); err != nil {
+		return LoginUserResult{}, err
+	}
 
-		user, txErr := userRepo.GetByEmail(email)
+	var (
+		outcome   loginOutcome
+		verifyErr error
+	)
+	err = h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := identifier.find(repos.User)
 		if txErr != nil {
-			return errs.NewDomainValidationError("credentials", "invalid email or password")
+			// Без сверки пароля ответ для неизвестного аккаунта заметно быстрее
+			if h.enumerationPolicy.Enabled {
+				h.dummyPassword.compare(h.passwordHasher, cmd.Password)
+			}
+			return invalidCredentialsError()
 		}
 
-		if !user.VerifyPassword(cmd.Password, h.passwordHasher) {
-			return errs.NewDomainValidationError("credentials", "invalid email or password")
+		// Во время блокировки пароль не проверяется, иначе перебор продолжался бы.
+		// В режиме защиты от перебора аккаунтов 423 выдал бы, что аккаунт есть
+		now := h.clock.Now()
+		if user.IsLockedOut(now) {
+			if h.enumerationPolicy.Enabled {
+				h.dummyPassword.compare(h.passwordHasher, cmd.Password)
+				return invalidCredentialsError()
+			}
+			return accountLockedError(user.LockedFor(now))
 		}
 
-		user.MarkLoggedIn(h.clock)
+		if !user.VerifyPassword(cmd.Password, h.passwordHasher) {
+			verifyErr = invalidCredentialsError()
+			if user.RecordFailedLogin(h.lockoutPolicy.rule(), h.clock) && !h.enumerationPolicy.Enabled {
+				verifyErr = accountLockedError(user.LockedFor(now))
+			}
+			return saveUser(ctx, repos, user)
+		}
 
-		if repos.Event != nil {
-			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+		if user.ResetFailedLogins() {
+			if txErr := repos.User.Update(user); txErr != nil {
 				return txErr
 			}
 		}
-		user.ClearDomainEvents()
 
-		loggedInUser = user
-		return nil
+		if h.verificationPolicy.RequiredForLogin && !user.IsEmailVerified() {
+			return emailNotVerifiedError()
+		}
+
+		client := loginClient{userAgent: cmd.UserAgent, ip: cmd.ClientIP, stepUp: true}
+		outcome, txErr = completeLogin(ctx, repos, user, h.clock, h.expiryPolicy, h.mfaPolicy, h.riskPolicy, client)
+		return txErr
 	})
+	if err == nil {
+		err = verifyErr
+	}
+
+	// Сбой счётчика попыток не должен менять ответ на сам вход
+	if isFailedLogin(err) {
+		_ = h.captchaPolicy.recordFailure(ctx, cmd.ClientIP, identifier.String())
+	} else if err == nil {
+		_ = h.captchaPolicy.recordSuccess(ctx, identifier.String())
+	}
 	if err != nil {
 		return LoginUserResult{}, err
 	}
 
-	user := loggedInUser
+	h.riskPolicy.notify(ctx, outcome.risk)
+	return outcome.result(h.jwtService, h.expiryPolicy, h.mfaPolicy, h.webAuthnPolicy)
+}
 
-	// Генерация токенов
-	tokenPair, err := h.jwtService.GenerateTokenPair(
-		user.ID(),
-		user.Email.String(),
-		user.Name,
-		user.Phone.String(),
-		user.CreatedAt,
-	)
+// accountLockedError — вход по паролю заблокирован после неверных попыток
+func accountLockedError(retryAfter time.Duration) error {
+	return errs.NewLockedError("account is temporarily locked after too many failed login attempts", retryAfter)
+}
+
+// saveUser сохраняет пользователя и публикует его события
+func saveUser(ctx context.Context, repos ports.Repositories, user *auth.User) error {
+	if err := repos.User.Update(user); err != nil {
+		return err
+	}
+
+	if repos.Event != nil {
+		if err := repos.Event.Publish(ctx, user.GetDomainEvents()...); err != nil {
+			return err
+		}
+	}
+	user.ClearDomainEvents()
+
+	return nil
+}
+
+// loginIdentifier — email, телефон или публичное имя, по которому пользователь входит
+type loginIdentifier struct {
+	email    kernel.Email
+	phone    kernel.Phone
+	username kernel.Username
+}
+
+// newLoginIdentifier разбирает идентификатор входа: email, если в нём есть «@»,
+// телефон, если он начинается с «+», цифры или скобки, иначе публичное имя (оно начинается с буквы)
+func newLoginIdentifier(
+	s string, emailRules kernel.EmailCanonicalization, phoneRules kernel.PhoneNumbering,
+) (loginIdentifier, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "@") {
+		email, err := emailRules.NewEmail(s)
+		if err != nil {
+			return loginIdentifier{}, errs.NewDomainValidationError("identifier", err.Error())
+		}
+		return loginIdentifier{email: email}, nil
+	}
+
+	if looksLikePhone(s) {
+		phone, err := phoneRules.NewPhone(s)
+		if err != nil {
+			return loginIdentifier{}, errs.NewDomainValidationError("identifier", "is not a valid phone number")
+		}
+		return loginIdentifier{phone: phone}, nil
+	}
+
+	username, err := kernel.NewUsername(s)
+	if err != nil {
+		return loginIdentifier{}, errs.NewDomainValidationError(
+			"identifier", "must be an email, a phone number or a username",
+		)
+	}
+	return loginIdentifier{username: username}, nil
+}
+
+// looksLikePhone — идентификатор начинается так, как не может начинаться ни email, ни публичное имя
+func looksLikePhone(s string) bool {
+	if s == "" {
+		return false
+	}
+	c := s[0]
+	return c == '+' || c == '(' || (c >= '0' && c <= '9')
+}
+
+// String — email, телефон или имя в нормализованном виде
+func (id loginIdentifier) String() string {
+	switch {
+	case !id.username.IsZero():
+		return id.username.String()
+	case id.phone.String() != "":
+		return id.phone.String()
+	default:
+		return id.email.Canonical()
+	}
+}
+
+// find ищет пользователя по идентификатору
+func (id loginIdentifier) find(repo ports.UserRepository) (*auth.User, error) {
+	switch {
+	case !id.username.IsZero():
+		return repo.GetByUsername(id.username)
+	case id.phone.String() != "":
+		return repo.GetByPhone(id.phone)
+	default:
+		return repo.GetByEmail(id.email)
+	}
+}
+
+// loginOutcome — итог входа после проверки первого фактора (пароля, ссылки или кода из SMS)
+type loginOutcome struct {
+	user            *auth.User
+	passwordExpired bool
+	mfaToken        string
+	mfaCredentials  []auth.WebAuthnCredential
+	risk            loginRisk
+}
+
+// completeLogin — общая часть входа после проверки первого фактора. Неактивный аккаунт не входит.
+// Включена MFA — выпускает токен второго шага; пароль просрочен — вход не завершается;
+// иначе вход записывается в историю и отмечается событием (подозрительный вход может ждать подтверждения).
+// События пользователя публикуются в той же транзакции.
+func completeLogin(
+	ctx context.Context,
+	repos ports.Repositories,
+	user *auth.User,
+	clock ports.Clock,
+	expiryPolicy PasswordExpiryPolicy,
+	mfaPolicy MFAPolicy,
+	riskPolicy LoginRiskPolicy,
+	client loginClient,
+) (loginOutcome, error) {
+	// Неактивный аккаунт проверяется только после первого фактора, иначе ответ выдал бы, что аккаунт есть
+	if !user.IsActive() {
+		return loginOutcome{}, accountInactiveError(user)
+	}
+
+	outcome := loginOutcome{user: user}
+
+	var err error
+	switch {
+	// Включена MFA: первый фактор верен, но вход завершится только вторым шагом
+	case user.IsMFAEnabled():
+		if outcome.mfaCredentials, err = repos.WebAuthnCredential.ListByUser(user.ID()); err != nil {
+			return loginOutcome{}, err
+		}
+		if outcome.mfaToken, err = mfaPolicy.challenge(repos.VerificationToken, user.ID(), clock); err != nil {
+			return loginOutcome{}, err
+		}
+
+	// Просроченный пароль: вход не завершён, токены сессии не выдаются
+	case user.PasswordExpired(expiryPolicy.MaxAge, clock.Now()):
+		outcome.passwordExpired = true
+
+	default:
+		if outcome.risk, err = riskPolicy.assess(repos, user, client, clock); err != nil {
+			return loginOutcome{}, err
+		}
+		if !outcome.risk.verificationRequired {
+			user.MarkLoggedIn(clock)
+		}
+	}
+
+	if repos.Event != nil {
+		if err := repos.Event.Publish(ctx, user.GetDomainEvents()...); err != nil {
+			return loginOutcome{}, err
+		}
+	}
+	user.ClearDomainEvents()
+
+	return outcome, nil
+}
+
+// result — ответ клиенту по итогу входа
+func (o loginOutcome) result(
+	jwtService ports.JWTService,
+	expiryPolicy PasswordExpiryPolicy,
+	mfaPolicy MFAPolicy,
+	webAuthnPolicy WebAuthnPolicy,
+) (LoginUserResult, error) {
+	switch {
+	case o.mfaToken != "":
+		return mfaRequiredResult(mfaPolicy, webAuthnPolicy, o.user, o.mfaToken, o.mfaCredentials), nil
+	case o.passwordExpired:
+		return passwordExpiredResult(jwtService, expiryPolicy, o.user)
+	case o.risk.verificationRequired:
+		return LoginUserResult{User: newUserInfo(o.user), LoginVerificationRequired: true}, nil
+	default:
+		return loggedInResult(jwtService, o.user)
+	}
+}
+
+// loggedInResult завершает вход: выдаёт пару токенов
+func loggedInResult(jwtService ports.JWTService, user *auth.User) (LoginUserResult, error) {
+	tokenPair, err := jwtService.GenerateTokenPair(newTokenSubject(user))
 	if err != nil {
 		return LoginUserResult{}, err
 	}
 
 	return LoginUserResult{
-		User: UserInfo{
-			ID:    user.ID(),
-			Email: user.Email.String(),
-			Name:  user.Name,
-			Phone: user.Phone.String(),
-		},
+		User:         newUserInfo(user),
 		AccessToken:  tokenPair.AccessToken,
 		RefreshToken: tokenPair.RefreshToken,
 		TokenType:    tokenPair.TokenType,
 		ExpiresIn:    tokenPair.ExpiresIn,
 	}, nil
 }
+
+// mfaRequiredResult — вход ждёт второго шага: вместо пары токенов выдаётся токен MFA
+func mfaRequiredResult(
+	mfaPolicy MFAPolicy,
+	webAuthnPolicy WebAuthnPolicy,
+	user *auth.User,
+	mfaToken string,
+	credentials []auth.WebAuthnCredential,
+) LoginUserResult {
+	result := LoginUserResult{
+		User:              newUserInfo(user),
+		MFARequired:       true,
+		MFAToken:          mfaToken,
+		MFATokenExpiresIn: int64(mfaPolicy.ChallengeTTL.Seconds()),
+		MFAMethods:        []string{auth.MFAMethodTOTP, auth.MFAMethodRecoveryCode},
+	}
+	// Ключи WebAuthn подписывают сам токен второго шага — отдельный challenge не нужен
+	if len(credentials) > 0 {
+		options := webAuthnPolicy.RelyingParty.RequestOptions(
+			[]byte(mfaToken),
+			credentialIDs(credentials),
+			webauthn.UserVerificationPreferred,
+			mfaPolicy.ChallengeTTL,
+		)
+		result.MFAMethods = append(result.MFAMethods, auth.MFAMethodWebAuthn)
+		result.MFAWebAuthnOptions = &options
+	}
+	return result
+}
+
+// passwordExpiredResult выдаёт токен, пригодный только для смены пароля
+func passwordExpiredResult(
+	jwtService ports.JWTService,
+	expiryPolicy PasswordExpiryPolicy,
+	user *auth.User,
+) (LoginUserResult, error) {
+	token, err := jwtService.GenerateScopedToken(user.ID(), ports.TokenScopePasswordChange, expiryPolicy.ChangeTokenTTL)
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+
+	return LoginUserResult{
+		User:                         newUserInfo(user),
+		PasswordExpired:              true,
+		PasswordChangeToken:          token,
+		PasswordChangeTokenExpiresIn: int64(expiryPolicy.ChangeTokenTTL.Seconds()),
+	}, nil
+}
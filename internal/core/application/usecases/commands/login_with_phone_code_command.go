@@ -0,0 +1,10 @@
+package commands
+
+// LoginWithPhoneCodeCommand — вход по номеру телефона и коду из SMS (RequestPhoneLoginCodeCommand)
+type LoginWithPhoneCodeCommand struct {
+	Phone string
+	Code  string
+
+	ClientIP  string // адрес клиента для истории входов
+	UserAgent string // User-Agent клиента — отпечаток устройства
+}
@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// LoginWithPhoneCodeHandler — обработчик входа по коду из SMS.
+// Код заменяет только пароль: при включённой MFA вход завершается вторым шагом,
+// остальные проверки и результат — как у LoginUserHandler.
+type LoginWithPhoneCodeHandler struct {
+	txManager      ports.TransactionManager
+	jwtService     ports.JWTService
+	passwordHasher ports.PasswordHasher
+	clock          ports.Clock
+	expiryPolicy   PasswordExpiryPolicy
+
+	verificationPolicy      EmailVerificationPolicy
+	phoneVerificationPolicy PhoneVerificationPolicy
+	mfaPolicy               MFAPolicy
+	webAuthnPolicy          WebAuthnPolicy
+	riskPolicy              LoginRiskPolicy
+	phoneRules              kernel.PhoneNumbering
+}
+
+func NewLoginWithPhoneCodeHandler(
+	txManager ports.TransactionManager,
+	jwtService ports.JWTService,
+	passwordHasher ports.PasswordHasher,
+	clock ports.Clock,
+	expiryPolicy PasswordExpiryPolicy,
+	verificationPolicy EmailVerificationPolicy,
+	phoneVerificationPolicy PhoneVerificationPolicy,
+	mfaPolicy MFAPolicy,
+	webAuthnPolicy WebAuthnPolicy,
+	riskPolicy LoginRiskPolicy,
+	phoneRules kernel.PhoneNumbering,
+) *LoginWithPhoneCodeHandler {
+	return &LoginWithPhoneCodeHandler{
+		txManager:      txManager,
+		jwtService:     jwtService,
+		passwordHasher: passwordHasher,
+		clock:          clock,
+		expiryPolicy:   expiryPolicy,
+
+		verificationPolicy:      verificationPolicy,
+		phoneVerificationPolicy: phoneVerificationPolicy,
+		mfaPolicy:               mfaPolicy,
+		webAuthnPolicy:          webAuthnPolicy,
+		riskPolicy:              riskPolicy,
+		phoneRules:              phoneRules,
+	}
+}
+
+// Handle проверяет код и выполняет вход.
+// Неудачная попытка фиксируется, ошибка возвращается после завершения транзакции.
+func (h *LoginWithPhoneCodeHandler) Handle(ctx context.Context, cmd LoginWithPhoneCodeCommand) (LoginUserResult, error) {
+	phone, err := h.phoneRules.NewPhone(cmd.Phone)
+	if err != nil {
+		return LoginUserResult{}, errs.NewDomainValidationError("phone", err.Error())
+	}
+
+	invalidCode := errs.NewDomainValidationError("credentials", "invalid phone or code")
+
+	var (
+		outcome   loginOutcome
+		verifyErr error
+	)
+	err = h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByPhone(phone)
+		if txErr != nil {
+			return invalidCode
+		}
+
+		token, codeErr, txErr := h.phoneVerificationPolicy.consume(
+			repos.VerificationToken,
+			user.ID(),
+			auth.VerificationPurposePhoneLogin,
+			cmd.Code,
+			h.passwordHasher,
+			h.clock,
+		)
+		if txErr != nil {
+			return txErr
+		}
+		if codeErr != nil {
+			verifyErr = invalidCode
+			var validationErr *errs.DomainValidationError
+			if errors.As(codeErr, &validationErr) {
+				verifyErr = errs.NewDomainValidationError("credentials", validationErr.Message)
+			}
+			return nil
+		}
+
+		// Код отправлен на конкретный номер: после смены телефона он недействителен
+		if token.Target != user.Phone.String() {
+			return invalidCode
+		}
+
+		if txErr := repos.VerificationToken.Update(token); txErr != nil {
+			return txErr
+		}
+
+		if h.verificationPolicy.RequiredForLogin && !user.IsEmailVerified() {
+			return emailNotVerifiedError()
+		}
+
+		client := loginClient{userAgent: cmd.UserAgent, ip: cmd.ClientIP, stepUp: true}
+		outcome, txErr = completeLogin(ctx, repos, user, h.clock, h.expiryPolicy, h.mfaPolicy, h.riskPolicy, client)
+		return txErr
+	})
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+	if verifyErr != nil {
+		return LoginUserResult{}, verifyErr
+	}
+
+	h.riskPolicy.notify(ctx, outcome.risk)
+	return outcome.result(h.jwtService, h.expiryPolicy, h.mfaPolicy, h.webAuthnPolicy)
+}
@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// MagicLinkPolicy — правила входа по одноразовой ссылке из письма.
+type MagicLinkPolicy struct {
+	// TokenTTL — время жизни ссылки
+	TokenTTL time.Duration
+	// LinkURL — страница входа, токен добавляется параметром token (пусто — в письме только токен).
+	// Страница должна отправлять токен POST-запросом по действию пользователя, а не при загрузке:
+	// почтовые сканеры и превью открывают ссылки из писем сами.
+	LinkURL string
+	// ResendInterval — минимальный интервал между письмами одному пользователю
+	ResendInterval time.Duration
+}
+
+// issue выпускает новую ссылку (ранее выданные гасятся) и готовит письмо.
+// Если ссылка уже отправлялась раньше ResendInterval, ничего не делает (send = false):
+// ошибка выдала бы, что адрес зарегистрирован.
+// Письмо отправляется после фиксации транзакции.
+func (p MagicLinkPolicy) issue(
+	repo ports.VerificationTokenRepository,
+	user *auth.User,
+	clock ports.Clock,
+) (msg ports.EmailMessage, send bool, err error) {
+	secret, send, err := p.issueSecret(repo, user, clock)
+	if err != nil || !send {
+		return ports.EmailMessage{}, false, err
+	}
+
+	return p.message(user.Email.String(), secret), true, nil
+}
+
+// issueSecret выпускает новую ссылку и возвращает её секрет; правила — как у issue.
+func (p MagicLinkPolicy) issueSecret(
+	repo ports.VerificationTokenRepository,
+	user *auth.User,
+	clock ports.Clock,
+) (secret string, issued bool, err error) {
+	now := clock.Now()
+	latest, err := repo.GetLatest(user.ID(), auth.VerificationPurposeMagicLink)
+	if err != nil {
+		var notFound *errs.NotFoundError
+		if !errors.As(err, &notFound) {
+			return "", false, err
+		}
+	} else if latest.CreatedAt.Add(p.ResendInterval).After(now) {
+		return "", false, nil
+	}
+
+	if err := repo.InvalidateActive(user.ID(), auth.VerificationPurposeMagicLink, now); err != nil {
+		return "", false, err
+	}
+
+	token, secret, err := auth.NewVerificationToken(
+		user.ID(),
+		auth.VerificationPurposeMagicLink,
+		user.Email.String(),
+		p.TokenTTL,
+		clock,
+	)
+	if err != nil {
+		return "", false, err
+	}
+
+	if err := repo.Create(&token); err != nil {
+		return "", false, err
+	}
+
+	return secret, true, nil
+}
+
+// message — письмо со ссылкой (или токеном) для входа.
+func (p MagicLinkPolicy) message(to, secret string) ports.EmailMessage {
+	return ports.EmailMessage{
+		To:      to,
+		Subject: "Your sign-in link",
+		Body: fmt.Sprintf(
+			"Sign in to your account:\n\n%s\n\nThe link works once and expires in %d minutes. "+
+				"If you didn't request it, ignore this email: nobody can sign in without opening the link.",
+			p.link(secret),
+			int(p.TokenTTL.Minutes()),
+		),
+	}
+}
+
+// link — ссылка для входа с токеном (или сам токен, если LinkURL не задан).
+func (p MagicLinkPolicy) link(secret string) string {
+	if p.LinkURL == "" {
+		return secret
+	}
+	return p.LinkURL + "?token=" + url.QueryEscape(secret)
+}
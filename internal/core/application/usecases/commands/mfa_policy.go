@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// MFAPolicy — правила двухфакторной аутентификации.
+type MFAPolicy struct {
+	// Issuer — название сервиса, под которым аккаунт показывается в приложении-аутентификаторе
+	Issuer string
+	// ChallengeTTL — время жизни токена второго шага входа
+	ChallengeTTL time.Duration
+	// MaxAttempts — сколько раз можно ошибиться с кодом на втором шаге (0 — без ограничений)
+	MaxAttempts int
+}
+
+// challenge выпускает токен второго шага входа; токен возвращается клиенту вместо пары токенов.
+func (p MFAPolicy) challenge(
+	repo ports.VerificationTokenRepository,
+	userID uuid.UUID,
+	clock ports.Clock,
+) (string, error) {
+	token, secret, err := auth.NewVerificationToken(userID, auth.VerificationPurposeMFA, "", p.ChallengeTTL, clock)
+	if err != nil {
+		return "", err
+	}
+
+	if err := repo.Create(&token); err != nil {
+		return "", err
+	}
+
+	return secret, nil
+}
+
+// lookup находит активный токен второго шага. Ошибка проверки токена (challengeErr)
+// возвращается отдельно от инфраструктурной (err).
+func (p MFAPolicy) lookup(
+	repo ports.VerificationTokenRepository,
+	secret string,
+	now time.Time,
+) (token *auth.VerificationToken, challengeErr error, err error) {
+	token, err = repo.GetBySecretHash(auth.VerificationPurposeMFA, auth.HashVerificationSecret(secret))
+	if err != nil {
+		var notFound *errs.NotFoundError
+		if errors.As(err, &notFound) {
+			return nil, errs.NewDomainValidationError("credentials", auth.ErrVerificationTokenInvalid.Error()), nil
+		}
+		return nil, nil, err
+	}
+
+	if checkErr := token.CheckAttempt(now, p.MaxAttempts); checkErr != nil {
+		return nil, errs.NewDomainValidationError("credentials", checkErr.Error()), nil
+	}
+
+	return token, nil, nil
+}
@@ -0,0 +1,11 @@
+package commands
+
+import "time"
+
+// PasswordExpiryPolicy — правила истечения срока действия пароля.
+type PasswordExpiryPolicy struct {
+	// MaxAge — срок жизни пароля (0 — пароль не истекает, смену можно только запросить принудительно)
+	MaxAge time.Duration
+	// ChangeTokenTTL — время жизни токена для смены просроченного пароля
+	ChangeTokenTTL time.Duration
+}
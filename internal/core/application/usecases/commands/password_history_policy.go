@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+// PasswordHistoryPolicy — правила хранения истории паролей.
+type PasswordHistoryPolicy struct {
+	// Depth — сколько предыдущих паролей нельзя использовать повторно, не считая текущего
+	// (0 — проверка отключена)
+	Depth int
+	// MaxAge — сколько хранить записи истории (0 — без ограничения по возрасту)
+	MaxAge time.Duration
+}
+
+// cutoff — момент, раньше которого записи истории больше не учитываются.
+func (p PasswordHistoryPolicy) cutoff(now time.Time) time.Time {
+	if p.MaxAge <= 0 {
+		return time.Time{}
+	}
+	return now.Add(-p.MaxAge)
+}
+
+// entries — сколько записей хранить и проверять: текущий пароль уже лежит в истории,
+// поэтому к Depth предыдущих добавляется ещё одна запись
+func (p PasswordHistoryPolicy) entries() int {
+	return p.Depth + 1
+}
+
+// load возвращает историю паролей пользователя в рамках политики.
+func (p PasswordHistoryPolicy) load(
+	repo ports.PasswordHistoryRepository,
+	userID uuid.UUID,
+	now time.Time,
+) (auth.PasswordHistory, error) {
+	if p.Depth <= 0 {
+		return auth.NewPasswordHistory(), nil
+	}
+	hashes, err := repo.GetRecent(userID, p.entries(), p.cutoff(now))
+	if err != nil {
+		return auth.PasswordHistory{}, err
+	}
+	return auth.NewPasswordHistory(hashes...), nil
+}
+
+// record сохраняет текущий хеш пароля и удаляет записи, вышедшие за рамки политики.
+func (p PasswordHistoryPolicy) record(repo ports.PasswordHistoryRepository, user *auth.User, now time.Time) error {
+	if err := repo.Add(user.ID(), user.PasswordHash, now); err != nil {
+		return err
+	}
+	return repo.Prune(user.ID(), p.entries(), p.cutoff(now))
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// PhoneVerificationPolicy — правила подтверждения телефона одноразовым кодом из SMS.
+type PhoneVerificationPolicy struct {
+	// CodeTTL — время жизни кода
+	CodeTTL time.Duration
+	// MaxAttempts — сколько раз можно ошибиться при вводе кода (0 — без ограничений)
+	MaxAttempts int
+	// ResendInterval — минимальный интервал между отправками кода
+	ResendInterval time.Duration
+}
+
+// issue выпускает новый код для номера target (ранее выданные гасятся) и готовит SMS.
+// Повторная отправка раньше ResendInterval отклоняется с TooManyRequestsError.
+// SMS отправляется после фиксации транзакции.
+func (p PhoneVerificationPolicy) issue(
+	repo ports.VerificationTokenRepository,
+	userID uuid.UUID,
+	purpose auth.VerificationPurpose,
+	target string,
+	hasher ports.PasswordHasher,
+	clock ports.Clock,
+) (ports.SMSMessage, error) {
+	now := clock.Now()
+	latest, err := repo.GetLatest(userID, purpose)
+	if err != nil {
+		var notFound *errs.NotFoundError
+		if !errors.As(err, &notFound) {
+			return ports.SMSMessage{}, err
+		}
+	} else if wait := latest.CreatedAt.Add(p.ResendInterval).Sub(now); wait > 0 {
+		return ports.SMSMessage{}, errs.NewTooManyRequestsError("verification code was sent recently", wait)
+	}
+
+	if err := repo.InvalidateActive(userID, purpose, now); err != nil {
+		return ports.SMSMessage{}, err
+	}
+
+	token, code, err := auth.NewVerificationCode(userID, purpose, target, p.CodeTTL, hasher, clock)
+	if err != nil {
+		return ports.SMSMessage{}, err
+	}
+
+	if err := repo.Create(&token); err != nil {
+		return ports.SMSMessage{}, err
+	}
+
+	return ports.SMSMessage{To: target, Text: p.message(code)}, nil
+}
+
+// consume проверяет последний выданный пользователю код.
+// Неудачная попытка сразу сохраняется в repo, а ошибка проверки кода (codeErr) возвращается
+// отдельно от инфраструктурной (err), чтобы вызывающий зафиксировал транзакцию.
+// Погашение успешно проверенного токена сохраняет вызывающий.
+func (p PhoneVerificationPolicy) consume(
+	repo ports.VerificationTokenRepository,
+	userID uuid.UUID,
+	purpose auth.VerificationPurpose,
+	code string,
+	hasher ports.PasswordHasher,
+	clock ports.Clock,
+) (token *auth.VerificationToken, codeErr error, err error) {
+	token, err = repo.GetLatest(userID, purpose)
+	if err != nil {
+		var notFound *errs.NotFoundError
+		if errors.As(err, &notFound) {
+			return nil, errs.NewDomainValidationError("code", auth.ErrVerificationTokenInvalid.Error()), nil
+		}
+		return nil, nil, err
+	}
+
+	if consumeErr := token.ConsumeCode(code, p.MaxAttempts, hasher, clock); consumeErr != nil {
+		return nil, errs.NewDomainValidationError("code", consumeErr.Error()), repo.Update(token)
+	}
+
+	return token, nil, nil
+}
+
+// message — текст SMS с кодом подтверждения.
+func (p PhoneVerificationPolicy) message(code string) string {
+	return fmt.Sprintf("Your verification code is %s. It expires in %d minutes.", code, int(p.CodeTTL.Minutes()))
+}
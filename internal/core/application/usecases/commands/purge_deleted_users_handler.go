@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// PurgeDeletedUsersHandler — окончательное удаление аккаунтов, срок хранения которых истёк.
+// Вызывается периодически фоновой задачей.
+type PurgeDeletedUsersHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+	policy    UserPurgePolicy
+}
+
+func NewPurgeDeletedUsersHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+	policy UserPurgePolicy,
+) *PurgeDeletedUsersHandler {
+	return &PurgeDeletedUsersHandler{
+		txManager: txManager,
+		clock:     clock,
+		policy:    policy,
+	}
+}
+
+// Handle удаляет одну пачку аккаунтов и возвращает их число.
+// Каждый аккаунт удаляется в своей транзакции: сбой одного не откатывает остальные.
+func (h *PurgeDeletedUsersHandler) Handle(ctx context.Context) (int, error) {
+	before := h.clock.Now().Add(-h.policy.Retention)
+
+	var ids []uuid.UUID
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		var txErr error
+		ids, txErr = repos.User.ListDeletedBefore(before, h.policy.batchSize())
+		return txErr
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, id := range ids {
+		if err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+			return purgeUser(ctx, repos, id)
+		}); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+// purgeUser стирает пользователя и связанные с ним записи, а из его событий — персональные данные
+func purgeUser(ctx context.Context, repos ports.Repositories, userID uuid.UUID) error {
+	for _, deleteByUser := range []func(uuid.UUID) error{
+		repos.PasswordHistory.DeleteByUser,
+		repos.VerificationToken.DeleteByUser,
+		repos.RecoveryCode.DeleteByUser,
+		repos.WebAuthnCredential.DeleteByUser,
+		repos.LoginHistory.DeleteByUser,
+		repos.DataExport.DeleteByUser,
+	} {
+		if err := deleteByUser(userID); err != nil {
+			return err
+		}
+	}
+
+	if repos.EventLog != nil {
+		if err := repos.EventLog.AnonymizeAggregate(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	return repos.User.Purge(userID)
+}
@@ -0,0 +1,9 @@
+package commands
+
+// RedeemMagicLinkCommand — вход по токену из ссылки, выданной RequestMagicLinkCommand
+type RedeemMagicLinkCommand struct {
+	Token string
+
+	ClientIP  string // адрес клиента для истории входов
+	UserAgent string // User-Agent клиента — отпечаток устройства
+}
@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// RedeemMagicLinkHandler — обработчик входа по ссылке из письма.
+// Ссылка заменяет только пароль: при включённой MFA вход завершается вторым шагом,
+// остальные проверки и результат — как у LoginUserHandler.
+type RedeemMagicLinkHandler struct {
+	txManager    ports.TransactionManager
+	jwtService   ports.JWTService
+	clock        ports.Clock
+	expiryPolicy PasswordExpiryPolicy
+
+	mfaPolicy      MFAPolicy
+	webAuthnPolicy WebAuthnPolicy
+	riskPolicy     LoginRiskPolicy
+}
+
+func NewRedeemMagicLinkHandler(
+	txManager ports.TransactionManager,
+	jwtService ports.JWTService,
+	clock ports.Clock,
+	expiryPolicy PasswordExpiryPolicy,
+	mfaPolicy MFAPolicy,
+	webAuthnPolicy WebAuthnPolicy,
+	riskPolicy LoginRiskPolicy,
+) *RedeemMagicLinkHandler {
+	return &RedeemMagicLinkHandler{
+		txManager:    txManager,
+		jwtService:   jwtService,
+		clock:        clock,
+		expiryPolicy: expiryPolicy,
+
+		mfaPolicy:      mfaPolicy,
+		webAuthnPolicy: webAuthnPolicy,
+		riskPolicy:     riskPolicy,
+	}
+}
+
+// Handle гасит токен ссылки и выполняет вход.
+// Переход по ссылке доказывает владение адресом, поэтому неподтверждённый email отмечается подтверждённым.
+func (h *RedeemMagicLinkHandler) Handle(ctx context.Context, cmd RedeemMagicLinkCommand) (LoginUserResult, error) {
+	invalidLink := errs.NewDomainValidationError("credentials", "sign-in link is invalid, expired or already used")
+
+	var outcome loginOutcome
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		token, txErr := repos.VerificationToken.GetBySecretHash(
+			auth.VerificationPurposeMagicLink,
+			auth.HashVerificationSecret(cmd.Token),
+		)
+		if txErr != nil {
+			return invalidLink
+		}
+
+		if txErr := token.Consume(h.clock); txErr != nil {
+			return invalidLink
+		}
+
+		user, txErr := repos.User.GetByID(token.UserID)
+		if txErr != nil {
+			return invalidLink
+		}
+
+		// Ссылка отправлена на конкретный адрес: после смены email она недействительна
+		if user.Email.String() != token.Target {
+			return invalidLink
+		}
+
+		if txErr := repos.VerificationToken.Update(token); txErr != nil {
+			return txErr
+		}
+
+		if !user.IsEmailVerified() {
+			if txErr := user.VerifyEmail(h.clock); txErr != nil {
+				return txErr
+			}
+			if txErr := repos.User.Update(user); txErr != nil {
+				return txErr
+			}
+		}
+
+		// Ссылка заменяет пароль, но не второй фактор. Она же подтверждает подозрительный вход,
+		// поэтому повторно подтверждать вход по ней не требуется
+		client := loginClient{userAgent: cmd.UserAgent, ip: cmd.ClientIP}
+		outcome, txErr = completeLogin(ctx, repos, user, h.clock, h.expiryPolicy, h.mfaPolicy, h.riskPolicy, client)
+		return txErr
+	})
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+
+	h.riskPolicy.notify(ctx, outcome.risk)
+	return outcome.result(h.jwtService, h.expiryPolicy, h.mfaPolicy, h.webAuthnPolicy)
+}
@@ -0,0 +1,6 @@
+package commands
+
+// RefreshTokensCommand — обмен refresh токена на новую пару токенов
+type RefreshTokensCommand struct {
+	RefreshToken string
+}
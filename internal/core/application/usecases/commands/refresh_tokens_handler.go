@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// RefreshTokensHandler — обработчик обновления пары токенов.
+// Клеймы новой пары берутся из текущих данных пользователя, а не из refresh токена.
+type RefreshTokensHandler struct {
+	txManager          ports.TransactionManager
+	jwtService         ports.JWTService
+	clock              ports.Clock
+	expiryPolicy       PasswordExpiryPolicy
+	verificationPolicy EmailVerificationPolicy
+}
+
+func NewRefreshTokensHandler(
+	txManager ports.TransactionManager,
+	jwtService ports.JWTService,
+	clock ports.Clock,
+	expiryPolicy PasswordExpiryPolicy,
+	verificationPolicy EmailVerificationPolicy,
+) *RefreshTokensHandler {
+	return &RefreshTokensHandler{
+		txManager:          txManager,
+		jwtService:         jwtService,
+		clock:              clock,
+		expiryPolicy:       expiryPolicy,
+		verificationPolicy: verificationPolicy,
+	}
+}
+
+// Handle проверяет refresh токен, состояние аккаунта, подтверждение email и срок пароля и выдаёт новую пару токенов
+func (h *RefreshTokensHandler) Handle(ctx context.Context, cmd RefreshTokensCommand) (LoginUserResult, error) {
+	userID, err := h.jwtService.ValidateRefreshToken(cmd.RefreshToken)
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+
+	var user *auth.User
+	err = h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		var txErr error
+		user, txErr = repos.User.GetByID(userID)
+		return txErr
+	})
+	if err != nil {
+		// Владелец токена удалён — токен больше не действителен
+		var notFound *errs.NotFoundError
+		if errors.As(err, &notFound) {
+			return LoginUserResult{}, errs.NewJWTValidationError("token owner does not exist")
+		}
+		return LoginUserResult{}, err
+	}
+
+	if !user.IsActive() {
+		return LoginUserResult{}, accountInactiveError(user)
+	}
+	// Без этой проверки токены, выданные до включения обязательного подтверждения, продлевались бы бессрочно
+	if h.verificationPolicy.RequiredForLogin && !user.IsEmailVerified() {
+		return LoginUserResult{}, emailNotVerifiedError()
+	}
+	// Просроченный или принудительно сбрасываемый пароль: как при входе, выдаётся только токен смены пароля,
+	// иначе открытая сессия жила бы со старым паролем сколько угодно
+	if user.PasswordExpired(h.expiryPolicy.MaxAge, h.clock.Now()) {
+		return passwordExpiredResult(h.jwtService, h.expiryPolicy, user)
+	}
+
+	tokenPair, err := h.jwtService.GenerateTokenPair(newTokenSubject(user))
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+
+	return LoginUserResult{
+		User:         newUserInfo(user),
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		TokenType:    tokenPair.TokenType,
+		ExpiresIn:    tokenPair.ExpiresIn,
+	}, nil
+}
@@ -6,10 +6,18 @@ type RegisterUserCommand struct {
 	Phone    string
 	Name     string
 	Password string
+
+	CaptchaToken string // токен решённой CAPTCHA, если она требуется
+	ClientIP     string // адрес клиента для учёта неудачных попыток
 }
 
 // RegisterUserResult — результат регистрации
 type RegisterUserResult struct {
+	// Accepted — режим защиты от перебора аккаунтов: заявка принята, токены не выдаются
+	Accepted bool
+	// VerificationPending — аккаунт создан, но вход возможен только после подтверждения email: токены не выдаются
+	VerificationPending bool
+
 	User         UserInfo
 	AccessToken  string
 	RefreshToken string
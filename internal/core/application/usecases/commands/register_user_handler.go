@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
@@ -15,6 +16,13 @@ type RegisterUserHandler struct {
 	jwtService     ports.JWTService
 	passwordHasher ports.PasswordHasher
 	clock          ports.Clock
+
+	emailSender        ports.EmailSender
+	verificationPolicy EmailVerificationPolicy
+	enumerationPolicy  AntiEnumerationPolicy
+	captchaPolicy      CaptchaPolicy
+	emailRules         kernel.EmailCanonicalization
+	phoneRules         kernel.PhoneNumbering
 }
 
 func NewRegisterUserHandler(
@@ -22,30 +30,61 @@ func NewRegisterUserHandler(
 	jwtService ports.JWTService,
 	passwordHasher ports.PasswordHasher,
 	clock ports.Clock,
+	emailSender ports.EmailSender,
+	verificationPolicy EmailVerificationPolicy,
+	enumerationPolicy AntiEnumerationPolicy,
+	captchaPolicy CaptchaPolicy,
+	emailRules kernel.EmailCanonicalization,
+	phoneRules kernel.PhoneNumbering,
 ) *RegisterUserHandler {
 	return &RegisterUserHandler{
 		txManager:      txManager,
 		jwtService:     jwtService,
 		passwordHasher: passwordHasher,
 		clock:          clock,
+
+		emailSender:        emailSender,
+		verificationPolicy: verificationPolicy,
+		enumerationPolicy:  enumerationPolicy,
+		captchaPolicy:      captchaPolicy,
+		emailRules:         emailRules,
+		phoneRules:         phoneRules,
 	}
 }
 
-// Handle выполняет регистрацию пользователя
+// Handle выполняет регистрацию пользователя.
+// В режиме защиты от перебора аккаунтов ответ одинаков для нового и занятого email или телефона:
+// токены не выдаются, владелец занятых данных получает письмо.
+// Попытки с занятыми email или телефоном считаются неудачными: после серии таких попыток с IP нужна CAPTCHA.
 func (h *RegisterUserHandler) Handle(ctx context.Context, cmd RegisterUserCommand) (RegisterUserResult, error) {
 	// Валидация email
-	email, err := kernel.NewEmail(cmd.Email)
+	email, err := h.emailRules.NewEmail(cmd.Email)
 	if err != nil {
 		return RegisterUserResult{}, errs.NewDomainValidationError("email", err.Error())
 	}
 
 	// Валидация phone
-	phone, err := kernel.NewPhone(cmd.Phone)
+	phone, err := h.phoneRules.NewPhone(cmd.Phone)
 	if err != nil {
 		return RegisterUserResult{}, errs.NewDomainValidationError("phone", err.Error())
 	}
 
-	var createdUser auth.User
+	if err := h.captchaPolicy.check(ctx, cmd.CaptchaToken, cmd.ClientIP, email.Canonical()); err != nil {
+		return RegisterUserResult{}, err
+	}
+
+	// Имя и пароль проверяются до поиска занятых email и телефона: иначе в режиме защиты от перебора
+	// слабый пароль с занятым email получал бы 202, а со свободным — 400.
+	// Пароль хешируется здесь же, поэтому время ответа для нового и занятого аккаунта одинаково.
+	user, err := auth.NewUser(email, phone, cmd.Name, cmd.Password, h.passwordHasher, h.clock)
+	if err != nil {
+		return RegisterUserResult{}, errs.NewDomainValidationError("user", err.Error())
+	}
+
+	var (
+		verificationEmail ports.EmailMessage
+		existingAccount   *auth.User
+	)
 	err = h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
 		userRepo := repos.User
 
@@ -54,7 +93,11 @@ func (h *RegisterUserHandler) Handle(ctx context.Context, cmd RegisterUserComman
 			return txErr
 		}
 		if emailExists {
-			return errs.NewDomainValidationError("email", "email already exists")
+			if h.enumerationPolicy.Enabled {
+				existingAccount, txErr = userRepo.GetByEmail(email)
+				return txErr
+			}
+			return takenError("email", auth.ErrEmailTaken)
 		}
 
 		phoneExists, txErr := userRepo.PhoneExists(phone)
@@ -62,15 +105,24 @@ func (h *RegisterUserHandler) Handle(ctx context.Context, cmd RegisterUserComman
 			return txErr
 		}
 		if phoneExists {
-			return errs.NewDomainValidationError("phone", "phone already exists")
+			if h.enumerationPolicy.Enabled {
+				existingAccount, txErr = userRepo.GetByPhone(phone)
+				return txErr
+			}
+			return takenError("phone", auth.ErrPhoneTaken)
 		}
 
-		user, txErr := auth.NewUser(email, phone, cmd.Name, cmd.Password, h.passwordHasher, h.clock)
-		if txErr != nil {
-			return errs.NewDomainValidationError("user", txErr.Error())
+		if txErr := userRepo.Create(&user); txErr != nil {
+			return txErr
 		}
 
-		if txErr := userRepo.Create(&user); txErr != nil {
+		// Первый пароль тоже попадает в историю, чтобы его нельзя было вернуть при смене
+		if txErr := repos.PasswordHistory.Add(user.ID(), user.PasswordHash, user.CreatedAt); txErr != nil {
+			return txErr
+		}
+
+		verificationEmail, txErr = h.verificationPolicy.issue(repos.VerificationToken, &user, h.clock)
+		if txErr != nil {
 			return txErr
 		}
 
@@ -80,38 +132,48 @@ func (h *RegisterUserHandler) Handle(ctx context.Context, cmd RegisterUserComman
 			}
 		}
 		user.ClearDomainEvents()
-
-		createdUser = user
 		return nil
 	})
+	if isTakenAccount(err) || existingAccount != nil {
+		_ = h.captchaPolicy.recordFailure(ctx, cmd.ClientIP, "")
+	}
 	if err != nil {
 		return RegisterUserResult{}, err
 	}
 
-	user := createdUser
+	if existingAccount != nil {
+		_ = h.emailSender.Send(ctx, h.enumerationPolicy.existingAccountNotice(existingAccount.Email.String()))
+		return RegisterUserResult{Accepted: true}, nil
+	}
+
+	// Аккаунт уже создан: при сбое доставки письмо можно запросить повторно
+	_ = h.emailSender.Send(ctx, verificationEmail)
+
+	if h.enumerationPolicy.Enabled {
+		return RegisterUserResult{Accepted: true}, nil
+	}
+
+	// Токены выдаются только тем, кто мог бы сразу войти
+	if h.verificationPolicy.RequiredForLogin && !user.IsEmailVerified() {
+		return RegisterUserResult{VerificationPending: true, User: newUserInfo(&user)}, nil
+	}
 
 	// Генерация токенов
-	tokenPair, err := h.jwtService.GenerateTokenPair(
-		user.ID(),
-		user.Email.String(),
-		user.Name,
-		user.Phone.String(),
-		user.CreatedAt,
-	)
+	tokenPair, err := h.jwtService.GenerateTokenPair(newTokenSubject(&user))
 	if err != nil {
 		return RegisterUserResult{}, err
 	}
 
 	return RegisterUserResult{
-		User: UserInfo{
-			ID:    user.ID(),
-			Email: user.Email.String(),
-			Name:  user.Name,
-			Phone: user.Phone.String(),
-		},
+		User:         newUserInfo(&user),
 		AccessToken:  tokenPair.AccessToken,
 		RefreshToken: tokenPair.RefreshToken,
 		TokenType:    tokenPair.TokenType,
 		ExpiresIn:    tokenPair.ExpiresIn,
 	}, nil
 }
+
+// isTakenAccount — регистрация отклонена, потому что email или телефон уже заняты
+func isTakenAccount(err error) bool {
+	return errors.Is(err, auth.ErrEmailTaken) || errors.Is(err, auth.ErrPhoneTaken)
+}
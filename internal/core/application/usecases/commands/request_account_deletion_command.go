@@ -0,0 +1,18 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RequestAccountDeletionCommand — команда удаления аккаунта самим пользователем
+type RequestAccountDeletionCommand struct {
+	UserID          uuid.UUID
+	CurrentPassword string
+}
+
+// RequestAccountDeletionResult — когда аккаунт будет удалён
+type RequestAccountDeletionResult struct {
+	ScheduledAt time.Time
+}
@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// RequestAccountDeletionHandler — обработчик запроса удаления аккаунта самим пользователем
+type RequestAccountDeletionHandler struct {
+	txManager      ports.TransactionManager
+	passwordHasher ports.PasswordHasher
+	clock          ports.Clock
+	policy         UserPurgePolicy
+}
+
+func NewRequestAccountDeletionHandler(
+	txManager ports.TransactionManager,
+	passwordHasher ports.PasswordHasher,
+	clock ports.Clock,
+	policy UserPurgePolicy,
+) *RequestAccountDeletionHandler {
+	return &RequestAccountDeletionHandler{
+		txManager:      txManager,
+		passwordHasher: passwordHasher,
+		clock:          clock,
+		policy:         policy,
+	}
+}
+
+// Handle проверяет пароль и планирует удаление через DeletionGracePeriod.
+// Удаляет аккаунт по наступлении срока DeleteScheduledUsersHandler.
+func (h *RequestAccountDeletionHandler) Handle(
+	ctx context.Context,
+	cmd RequestAccountDeletionCommand,
+) (RequestAccountDeletionResult, error) {
+	var result RequestAccountDeletionResult
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if !user.VerifyPassword(cmd.CurrentPassword, h.passwordHasher) {
+			return errs.NewDomainValidationError("current_password", "is invalid")
+		}
+
+		if txErr := user.RequestDeletion(h.policy.DeletionGracePeriod, h.clock); txErr != nil {
+			if errors.Is(txErr, auth.ErrDeletionAlreadyScheduled) {
+				return errs.NewDomainValidationError("deletion", txErr.Error())
+			}
+			return txErr
+		}
+
+		result.ScheduledAt = *user.DeletionScheduledAt
+		return saveUser(ctx, repos, user)
+	})
+	if err != nil {
+		return RequestAccountDeletionResult{}, err
+	}
+
+	return result, nil
+}
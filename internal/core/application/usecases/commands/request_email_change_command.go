@@ -0,0 +1,10 @@
+package commands
+
+import "github.com/google/uuid"
+
+// RequestEmailChangeCommand — команда запроса смены email
+type RequestEmailChangeCommand struct {
+	UserID          uuid.UUID
+	CurrentPassword string
+	NewEmail        string
+}
@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// RequestEmailChangeHandler — обработчик запроса смены email
+type RequestEmailChangeHandler struct {
+	txManager      ports.TransactionManager
+	emailSender    ports.EmailSender
+	passwordHasher ports.PasswordHasher
+	clock          ports.Clock
+	changePolicy   EmailChangePolicy
+	emailRules     kernel.EmailCanonicalization
+}
+
+func NewRequestEmailChangeHandler(
+	txManager ports.TransactionManager,
+	emailSender ports.EmailSender,
+	passwordHasher ports.PasswordHasher,
+	clock ports.Clock,
+	changePolicy EmailChangePolicy,
+	emailRules kernel.EmailCanonicalization,
+) *RequestEmailChangeHandler {
+	return &RequestEmailChangeHandler{
+		txManager:      txManager,
+		emailSender:    emailSender,
+		passwordHasher: passwordHasher,
+		clock:          clock,
+		changePolicy:   changePolicy,
+		emailRules:     emailRules,
+	}
+}
+
+// Handle проверяет пароль и уникальность нового адреса, выпускает токен подтверждения
+// (ранее выданные гасятся) и после фиксации транзакции отправляет письма на новый и старый адреса.
+func (h *RequestEmailChangeHandler) Handle(ctx context.Context, cmd RequestEmailChangeCommand) error {
+	newEmail, err := h.emailRules.NewEmail(cmd.NewEmail)
+	if err != nil {
+		return errs.NewDomainValidationError("new_email", err.Error())
+	}
+
+	var confirmation, notice ports.EmailMessage
+	err = h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if !user.VerifyPassword(cmd.CurrentPassword, h.passwordHasher) {
+			return errs.NewDomainValidationError("current_password", "is invalid")
+		}
+
+		if txErr := user.RequestEmailChange(newEmail, h.clock); txErr != nil {
+			if errors.Is(txErr, auth.ErrEmailUnchanged) {
+				return errs.NewDomainValidationError("new_email", txErr.Error())
+			}
+			return txErr
+		}
+
+		exists, txErr := repos.User.EmailExists(newEmail)
+		if txErr != nil {
+			return txErr
+		}
+		if exists {
+			return takenError("email", auth.ErrEmailTaken)
+		}
+
+		if txErr := repos.VerificationToken.InvalidateActive(user.ID(), auth.VerificationPurposeEmailChange, h.clock.Now()); txErr != nil {
+			return txErr
+		}
+
+		token, secret, txErr := auth.NewVerificationToken(
+			user.ID(),
+			auth.VerificationPurposeEmailChange,
+			newEmail.String(),
+			h.changePolicy.TokenTTL,
+			h.clock,
+		)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr := repos.VerificationToken.Create(&token); txErr != nil {
+			return txErr
+		}
+
+		if repos.Event != nil {
+			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+				return txErr
+			}
+		}
+		user.ClearDomainEvents()
+
+		confirmation = h.changePolicy.confirmation(newEmail.String(), secret)
+		notice = h.changePolicy.notice(user.Email.String(), newEmail.String())
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := h.emailSender.Send(ctx, confirmation); err != nil {
+		return err
+	}
+	return h.emailSender.Send(ctx, notice)
+}
@@ -0,0 +1,6 @@
+package commands
+
+// RequestMagicLinkCommand — команда отправки одноразовой ссылки для входа без пароля
+type RequestMagicLinkCommand struct {
+	Email string
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// RequestMagicLinkHandler — обработчик отправки ссылки для входа
+type RequestMagicLinkHandler struct {
+	txManager       ports.TransactionManager
+	emailSender     ports.EmailSender
+	clock           ports.Clock
+	magicLinkPolicy MagicLinkPolicy
+	emailRules      kernel.EmailCanonicalization
+}
+
+func NewRequestMagicLinkHandler(
+	txManager ports.TransactionManager,
+	emailSender ports.EmailSender,
+	clock ports.Clock,
+	magicLinkPolicy MagicLinkPolicy,
+	emailRules kernel.EmailCanonicalization,
+) *RequestMagicLinkHandler {
+	return &RequestMagicLinkHandler{
+		txManager:       txManager,
+		emailSender:     emailSender,
+		clock:           clock,
+		magicLinkPolicy: magicLinkPolicy,
+		emailRules:      emailRules,
+	}
+}
+
+// Handle выпускает ссылку и отправляет письмо.
+// Для неизвестного email молча ничего не делает,
+// чтобы по ответу нельзя было узнать, зарегистрирован ли адрес.
+func (h *RequestMagicLinkHandler) Handle(ctx context.Context, cmd RequestMagicLinkCommand) error {
+	email, err := h.emailRules.NewEmail(cmd.Email)
+	if err != nil {
+		return errs.NewDomainValidationError("email", err.Error())
+	}
+
+	var (
+		msg  ports.EmailMessage
+		send bool
+	)
+	err = h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByEmail(email)
+		if txErr != nil {
+			var notFound *errs.NotFoundError
+			if errors.As(txErr, &notFound) {
+				return nil
+			}
+			return txErr
+		}
+
+		msg, send, txErr = h.magicLinkPolicy.issue(repos.VerificationToken, user, h.clock)
+		return txErr
+	})
+	if err != nil || !send {
+		return err
+	}
+
+	return h.emailSender.Send(ctx, msg)
+}
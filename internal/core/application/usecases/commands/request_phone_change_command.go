@@ -0,0 +1,19 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RequestPhoneChangeCommand — команда запроса смены телефона
+type RequestPhoneChangeCommand struct {
+	UserID   uuid.UUID
+	NewPhone string
+}
+
+// RequestPhoneChangeResult — результат отправки кода на новый номер
+type RequestPhoneChangeResult struct {
+	ExpiresIn   time.Duration
+	ResendAfter time.Duration
+}
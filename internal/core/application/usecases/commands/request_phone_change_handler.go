@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// RequestPhoneChangeHandler — обработчик запроса смены телефона
+type RequestPhoneChangeHandler struct {
+	txManager          ports.TransactionManager
+	smsSender          ports.SMSSender
+	passwordHasher     ports.PasswordHasher
+	clock              ports.Clock
+	verificationPolicy PhoneVerificationPolicy
+	phoneRules         kernel.PhoneNumbering
+}
+
+func NewRequestPhoneChangeHandler(
+	txManager ports.TransactionManager,
+	smsSender ports.SMSSender,
+	passwordHasher ports.PasswordHasher,
+	clock ports.Clock,
+	verificationPolicy PhoneVerificationPolicy,
+	phoneRules kernel.PhoneNumbering,
+) *RequestPhoneChangeHandler {
+	return &RequestPhoneChangeHandler{
+		txManager:          txManager,
+		smsSender:          smsSender,
+		passwordHasher:     passwordHasher,
+		clock:              clock,
+		verificationPolicy: verificationPolicy,
+		phoneRules:         phoneRules,
+	}
+}
+
+// Handle проверяет уникальность нового номера и отправляет на него код подтверждения.
+// Телефон меняется только после подтверждения кода (ConfirmPhoneChangeHandler).
+func (h *RequestPhoneChangeHandler) Handle(
+	ctx context.Context,
+	cmd RequestPhoneChangeCommand,
+) (RequestPhoneChangeResult, error) {
+	newPhone, err := h.phoneRules.NewPhone(cmd.NewPhone)
+	if err != nil {
+		return RequestPhoneChangeResult{}, errs.NewDomainValidationError("new_phone", err.Error())
+	}
+
+	var msg ports.SMSMessage
+	err = h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if newPhone.Equals(user.Phone) {
+			return errs.NewDomainValidationError("new_phone", auth.ErrPhoneUnchanged.Error())
+		}
+
+		exists, txErr := repos.User.PhoneExists(newPhone)
+		if txErr != nil {
+			return txErr
+		}
+		if exists {
+			return takenError("phone", auth.ErrPhoneTaken)
+		}
+
+		msg, txErr = h.verificationPolicy.issue(
+			repos.VerificationToken,
+			user.ID(),
+			auth.VerificationPurposePhoneChange,
+			newPhone.String(),
+			h.passwordHasher,
+			h.clock,
+		)
+		return txErr
+	})
+	if err != nil {
+		return RequestPhoneChangeResult{}, err
+	}
+
+	if err := h.smsSender.Send(ctx, msg); err != nil {
+		return RequestPhoneChangeResult{}, err
+	}
+
+	return RequestPhoneChangeResult{
+		ExpiresIn:   h.verificationPolicy.CodeTTL,
+		ResendAfter: h.verificationPolicy.ResendInterval,
+	}, nil
+}
@@ -0,0 +1,6 @@
+package commands
+
+// RequestPhoneLoginCodeCommand — команда отправки кода для входа по номеру телефона
+type RequestPhoneLoginCodeCommand struct {
+	Phone string
+}
@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// RequestPhoneLoginCodeHandler — обработчик отправки кода для входа по SMS
+type RequestPhoneLoginCodeHandler struct {
+	txManager          ports.TransactionManager
+	smsSender          ports.SMSSender
+	passwordHasher     ports.PasswordHasher
+	clock              ports.Clock
+	verificationPolicy PhoneVerificationPolicy
+	phoneRules         kernel.PhoneNumbering
+}
+
+func NewRequestPhoneLoginCodeHandler(
+	txManager ports.TransactionManager,
+	smsSender ports.SMSSender,
+	passwordHasher ports.PasswordHasher,
+	clock ports.Clock,
+	verificationPolicy PhoneVerificationPolicy,
+	phoneRules kernel.PhoneNumbering,
+) *RequestPhoneLoginCodeHandler {
+	return &RequestPhoneLoginCodeHandler{
+		txManager:          txManager,
+		smsSender:          smsSender,
+		passwordHasher:     passwordHasher,
+		clock:              clock,
+		verificationPolicy: verificationPolicy,
+		phoneRules:         phoneRules,
+	}
+}
+
+// Handle выпускает код и отправляет его по SMS.
+// Код получает только подтверждённый номер: иначе владелец ошибочно указанного номера вошёл бы в чужой аккаунт.
+// Для неизвестного или неподтверждённого номера, а также при повторе раньше ResendInterval молча ничего не делает,
+// чтобы по ответу нельзя было узнать, зарегистрирован ли номер.
+func (h *RequestPhoneLoginCodeHandler) Handle(ctx context.Context, cmd RequestPhoneLoginCodeCommand) error {
+	phone, err := h.phoneRules.NewPhone(cmd.Phone)
+	if err != nil {
+		return errs.NewDomainValidationError("phone", err.Error())
+	}
+
+	var (
+		msg  ports.SMSMessage
+		send bool
+	)
+	err = h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByPhone(phone)
+		if txErr != nil {
+			var notFound *errs.NotFoundError
+			if errors.As(txErr, &notFound) {
+				return nil
+			}
+			return txErr
+		}
+
+		if !user.IsPhoneVerified() {
+			return nil
+		}
+
+		msg, txErr = h.verificationPolicy.issue(
+			repos.VerificationToken,
+			user.ID(),
+			auth.VerificationPurposePhoneLogin,
+			phone.String(),
+			h.passwordHasher,
+			h.clock,
+		)
+		if txErr != nil {
+			var tooMany *errs.TooManyRequestsError
+			if errors.As(txErr, &tooMany) {
+				return nil
+			}
+			return txErr
+		}
+		send = true
+
+		return nil
+	})
+	if err != nil || !send {
+		return err
+	}
+
+	return h.smsSender.Send(ctx, msg)
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+// RequirePasswordChangeCommand — административная команда: сменить пароль при следующем входе
+type RequirePasswordChangeCommand struct {
+	UserID uuid.UUID
+}
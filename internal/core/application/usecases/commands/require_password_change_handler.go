@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// RequirePasswordChangeHandler — обработчик принудительной смены пароля
+type RequirePasswordChangeHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+}
+
+func NewRequirePasswordChangeHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+) *RequirePasswordChangeHandler {
+	return &RequirePasswordChangeHandler{
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Handle помечает пароль пользователя как требующий смены при следующем входе
+func (h *RequirePasswordChangeHandler) Handle(ctx context.Context, cmd RequirePasswordChangeCommand) error {
+	return h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		user.RequirePasswordChange(h.clock)
+
+		if txErr := repos.User.Update(user); txErr != nil {
+			return txErr
+		}
+
+		if repos.Event != nil {
+			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+				return txErr
+			}
+		}
+		user.ClearDomainEvents()
+
+		return nil
+	})
+}
@@ -0,0 +1,6 @@
+package commands
+
+// SendEmailVerificationCommand — команда (повторной) отправки письма подтверждения email
+type SendEmailVerificationCommand struct {
+	Email string
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// SendEmailVerificationHandler — обработчик отправки письма подтверждения email
+type SendEmailVerificationHandler struct {
+	txManager          ports.TransactionManager
+	emailSender        ports.EmailSender
+	clock              ports.Clock
+	verificationPolicy EmailVerificationPolicy
+	emailRules         kernel.EmailCanonicalization
+}
+
+func NewSendEmailVerificationHandler(
+	txManager ports.TransactionManager,
+	emailSender ports.EmailSender,
+	clock ports.Clock,
+	verificationPolicy EmailVerificationPolicy,
+	emailRules kernel.EmailCanonicalization,
+) *SendEmailVerificationHandler {
+	return &SendEmailVerificationHandler{
+		txManager:          txManager,
+		emailSender:        emailSender,
+		clock:              clock,
+		verificationPolicy: verificationPolicy,
+		emailRules:         emailRules,
+	}
+}
+
+// Handle выпускает новый токен и отправляет письмо.
+// Для неизвестного или уже подтверждённого email молча ничего не делает,
+// чтобы по ответу нельзя было узнать, зарегистрирован ли адрес.
+func (h *SendEmailVerificationHandler) Handle(ctx context.Context, cmd SendEmailVerificationCommand) error {
+	email, err := h.emailRules.NewEmail(cmd.Email)
+	if err != nil {
+		return errs.NewDomainValidationError("email", err.Error())
+	}
+
+	var (
+		msg  ports.EmailMessage
+		send bool
+	)
+	err = h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByEmail(email)
+		if txErr != nil {
+			var notFound *errs.NotFoundError
+			if errors.As(txErr, &notFound) {
+				return nil
+			}
+			return txErr
+		}
+
+		if user.IsEmailVerified() {
+			return nil
+		}
+
+		msg, txErr = h.verificationPolicy.issue(repos.VerificationToken, user, h.clock)
+		if txErr != nil {
+			return txErr
+		}
+		send = true
+
+		return nil
+	})
+	if err != nil || !send {
+		return err
+	}
+
+	return h.emailSender.Send(ctx, msg)
+}
@@ -0,0 +1,18 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SendPhoneVerificationCommand — команда (повторной) отправки кода подтверждения телефона
+type SendPhoneVerificationCommand struct {
+	UserID uuid.UUID
+}
+
+// SendPhoneVerificationResult — результат отправки кода
+type SendPhoneVerificationResult struct {
+	ExpiresIn   time.Duration
+	ResendAfter time.Duration
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// SendPhoneVerificationHandler — обработчик отправки кода подтверждения телефона
+type SendPhoneVerificationHandler struct {
+	txManager          ports.TransactionManager
+	smsSender          ports.SMSSender
+	passwordHasher     ports.PasswordHasher
+	clock              ports.Clock
+	verificationPolicy PhoneVerificationPolicy
+}
+
+func NewSendPhoneVerificationHandler(
+	txManager ports.TransactionManager,
+	smsSender ports.SMSSender,
+	passwordHasher ports.PasswordHasher,
+	clock ports.Clock,
+	verificationPolicy PhoneVerificationPolicy,
+) *SendPhoneVerificationHandler {
+	return &SendPhoneVerificationHandler{
+		txManager:          txManager,
+		smsSender:          smsSender,
+		passwordHasher:     passwordHasher,
+		clock:              clock,
+		verificationPolicy: verificationPolicy,
+	}
+}
+
+// Handle выпускает новый код и отправляет его по SMS на текущий номер пользователя.
+func (h *SendPhoneVerificationHandler) Handle(
+	ctx context.Context,
+	cmd SendPhoneVerificationCommand,
+) (SendPhoneVerificationResult, error) {
+	var msg ports.SMSMessage
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if user.IsPhoneVerified() {
+			return errs.NewDomainValidationError("phone", auth.ErrPhoneAlreadyVerified.Error())
+		}
+
+		msg, txErr = h.verificationPolicy.issue(
+			repos.VerificationToken,
+			user.ID(),
+			auth.VerificationPurposePhone,
+			user.Phone.String(),
+			h.passwordHasher,
+			h.clock,
+		)
+		return txErr
+	})
+	if err != nil {
+		return SendPhoneVerificationResult{}, err
+	}
+
+	if err := h.smsSender.Send(ctx, msg); err != nil {
+		return SendPhoneVerificationResult{}, err
+	}
+
+	return SendPhoneVerificationResult{
+		ExpiresIn:   h.verificationPolicy.CodeTTL,
+		ResendAfter: h.verificationPolicy.ResendInterval,
+	}, nil
+}
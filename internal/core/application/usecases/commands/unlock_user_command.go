@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/google/uuid"
+
+// UnlockUserCommand — административная команда: снять блокировку входа после неверных паролей
+type UnlockUserCommand struct {
+	UserID uuid.UUID
+}
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// UnlockUserHandler — обработчик снятия блокировки входа
+type UnlockUserHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+}
+
+func NewUnlockUserHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+) *UnlockUserHandler {
+	return &UnlockUserHandler{
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Handle снимает блокировку и сбрасывает счётчики неверных паролей
+func (h *UnlockUserHandler) Handle(ctx context.Context, cmd UnlockUserCommand) error {
+	return h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		user.Unlock(h.clock)
+
+		return saveUser(ctx, repos, user)
+	})
+}
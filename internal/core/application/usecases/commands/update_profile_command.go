@@ -0,0 +1,26 @@
+package commands
+
+import "github.com/google/uuid"
+
+// UpdateProfileCommand — команда изменения профиля пользователем.
+// Поля со значением nil не меняются, пустая строка стирает необязательное поле.
+type UpdateProfileCommand struct {
+	UserID uuid.UUID
+	Name   *string
+	// Username — публичное имя; должно быть свободно, пустая строка его снимает
+	Username *string
+
+	Locale    *string
+	Timezone  *string
+	AvatarURL *string
+	// BirthDate — дата в формате YYYY-MM-DD
+	BirthDate *string
+
+	// Metadata целиком заменяет сохранённую; пустой объект её стирает
+	Metadata map[string]any
+}
+
+// UpdateProfileResult — результат изменения профиля
+type UpdateProfileResult struct {
+	User UserInfo
+}
@@ -0,0 +1,151 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// UpdateProfileHandler — обработчик изменения профиля
+type UpdateProfileHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+	// metadataValidator — схема metadata из конфигурации (nil — принимается любой JSON-объект)
+	metadataValidator ports.MetadataValidator
+}
+
+func NewUpdateProfileHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+	metadataValidator ports.MetadataValidator,
+) *UpdateProfileHandler {
+	return &UpdateProfileHandler{
+		txManager:         txManager,
+		clock:             clock,
+		metadataValidator: metadataValidator,
+	}
+}
+
+// Handle применяет переданные поля профиля. Email и телефон меняются отдельными сценариями
+// с подтверждением, поэтому здесь их нет.
+func (h *UpdateProfileHandler) Handle(ctx context.Context, cmd UpdateProfileCommand) (UpdateProfileResult, error) {
+	if cmd.Metadata != nil && h.metadataValidator != nil {
+		if err := h.metadataValidator.Validate(cmd.Metadata); err != nil {
+			return UpdateProfileResult{}, errs.NewDomainValidationError("metadata", err.Error())
+		}
+	}
+
+	var result UpdateProfileResult
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if cmd.Name != nil {
+			if txErr := user.ChangeName(*cmd.Name, h.clock); txErr != nil {
+				return errs.NewDomainValidationError("name", txErr.Error())
+			}
+		}
+
+		if cmd.Username != nil {
+			if txErr := changeUsername(repos, user, *cmd.Username, h.clock); txErr != nil {
+				return txErr
+			}
+		}
+
+		profile, txErr := h.mergeProfile(user.Profile, cmd)
+		if txErr != nil {
+			return txErr
+		}
+		user.ChangeProfile(profile, h.clock)
+
+		if cmd.Metadata != nil {
+			if txErr := user.ChangeMetadata(cmd.Metadata, h.clock); txErr != nil {
+				return errs.NewDomainValidationError("metadata", txErr.Error())
+			}
+		}
+
+		if txErr := saveUser(ctx, repos, user); txErr != nil {
+			return txErr
+		}
+
+		result = UpdateProfileResult{User: newUserInfo(user)}
+		return nil
+	})
+	if err != nil {
+		return UpdateProfileResult{}, err
+	}
+
+	return result, nil
+}
+
+// changeUsername проверяет и назначает публичное имя; занятое другим аккаунтом имя не принимается
+func changeUsername(repos ports.Repositories, user *auth.User, raw string, clock ports.Clock) error {
+	var username kernel.Username
+	if strings.TrimSpace(raw) != "" {
+		var err error
+		if username, err = kernel.NewUsername(raw); err != nil {
+			return errs.NewDomainValidationError("username", err.Error())
+		}
+	}
+	if username.IsZero() || username.Equals(user.Username) {
+		user.ChangeUsername(username, clock)
+		return nil
+	}
+
+	exists, err := repos.User.UsernameExists(username)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return takenError("username", auth.ErrUsernameTaken)
+	}
+
+	user.ChangeUsername(username, clock)
+	return nil
+}
+
+// mergeProfile накладывает переданные поля на текущий профиль и проверяет результат
+func (h *UpdateProfileHandler) mergeProfile(current auth.Profile, cmd UpdateProfileCommand) (auth.Profile, error) {
+	locale, timezone, avatarURL, birthDate := current.Locale, current.Timezone, current.AvatarURL, current.BirthDateString()
+	if cmd.Locale != nil {
+		locale = *cmd.Locale
+	}
+	if cmd.Timezone != nil {
+		timezone = *cmd.Timezone
+	}
+	if cmd.AvatarURL != nil {
+		avatarURL = *cmd.AvatarURL
+	}
+	if cmd.BirthDate != nil {
+		birthDate = *cmd.BirthDate
+	}
+
+	profile, err := auth.NewProfile(locale, timezone, avatarURL, birthDate, h.clock.Now())
+	if err != nil {
+		return auth.Profile{}, errs.NewDomainValidationError(profileErrorField(err), err.Error())
+	}
+	return profile, nil
+}
+
+// profileErrorField — поле запроса, к которому относится ошибка профиля
+func profileErrorField(err error) string {
+	switch {
+	case errors.Is(err, auth.ErrInvalidLocale):
+		return "locale"
+	case errors.Is(err, auth.ErrInvalidTimezone):
+		return "timezone"
+	case errors.Is(err, auth.ErrInvalidAvatarURL):
+		return "avatar_url"
+	case errors.Is(err, auth.ErrInvalidBirthDate):
+		return "birth_date"
+	default:
+		return "profile"
+	}
+}
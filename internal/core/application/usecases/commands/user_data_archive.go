@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+// UserDataArchive — всё, что сервис хранит о пользователе. Сериализуется в JSON как есть,
+// поэтому теги задают формат архива (схема UserDataArchive в OpenAPI).
+type UserDataArchive struct {
+	GeneratedAt time.Time         `json:"generated_at"`
+	Profile     ArchivedProfile   `json:"profile"`
+	Logins      []ArchivedLogin   `json:"logins"`
+	Passkeys    []ArchivedPasskey `json:"passkeys"`
+	Events      []ArchivedEvent   `json:"events"`
+}
+
+// ArchivedProfile — профиль пользователя (без хеша пароля и секретов)
+type ArchivedProfile struct {
+	ID                  uuid.UUID      `json:"id"`
+	Email               string         `json:"email"`
+	Phone               string         `json:"phone"`
+	Name                string         `json:"name"`
+	Username            string         `json:"username,omitempty"`
+	Locale              string         `json:"locale,omitempty"`
+	Timezone            string         `json:"timezone,omitempty"`
+	AvatarURL           string         `json:"avatar_url,omitempty"`
+	BirthDate           string         `json:"birth_date,omitempty"`
+	Metadata            map[string]any `json:"metadata,omitempty"`
+	EmailVerifiedAt     *time.Time     `json:"email_verified_at,omitempty"`
+	PhoneVerifiedAt     *time.Time     `json:"phone_verified_at,omitempty"`
+	PasswordChangedAt   time.Time      `json:"password_changed_at"`
+	MFAEnabled          bool           `json:"mfa_enabled"`
+	Status              string         `json:"status"`
+	DeletionScheduledAt *time.Time     `json:"deletion_scheduled_at,omitempty"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+}
+
+// ArchivedLogin — вход в аккаунт: с него начинается каждая сессия
+type ArchivedLogin struct {
+	At        time.Time `json:"at"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	Country   string    `json:"country,omitempty"`
+	City      string    `json:"city,omitempty"`
+}
+
+// ArchivedPasskey — привязанный ключ WebAuthn (без открытого ключа)
+type ArchivedPasskey struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// ArchivedEvent — строка events, агрегат которой — пользователь
+type ArchivedEvent struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// buildUserDataArchive собирает архив пользователя и сериализует его в JSON
+func buildUserDataArchive(ctx context.Context, repos ports.Repositories, user *auth.User, clock ports.Clock) ([]byte, error) {
+	logins, err := repos.LoginHistory.ListByUser(user.ID())
+	if err != nil {
+		return nil, err
+	}
+	passkeys, err := repos.WebAuthnCredential.ListByUser(user.ID())
+	if err != nil {
+		return nil, err
+	}
+	events, err := repos.EventLog.ListByAggregate(ctx, user.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	archive := UserDataArchive{
+		GeneratedAt: clock.Now(),
+		Profile: ArchivedProfile{
+			ID:                  user.ID(),
+			Email:               user.Email.String(),
+			Phone:               user.Phone.String(),
+			Name:                user.Name,
+			Username:            user.Username.String(),
+			Locale:              user.Profile.Locale,
+			Timezone:            user.Profile.Timezone,
+			AvatarURL:           user.Profile.AvatarURL,
+			BirthDate:           user.Profile.BirthDateString(),
+			Metadata:            user.Metadata,
+			EmailVerifiedAt:     user.EmailVerifiedAt,
+			PhoneVerifiedAt:     user.PhoneVerifiedAt,
+			PasswordChangedAt:   user.PasswordChangedAt,
+			MFAEnabled:          user.IsMFAEnabled(),
+			Status:              user.Status.String(),
+			DeletionScheduledAt: user.DeletionScheduledAt,
+			CreatedAt:           user.CreatedAt,
+			UpdatedAt:           user.UpdatedAt,
+		},
+		Logins:   make([]ArchivedLogin, 0, len(logins)),
+		Passkeys: make([]ArchivedPasskey, 0, len(passkeys)),
+		Events:   make([]ArchivedEvent, 0, len(events)),
+	}
+	for _, login := range logins {
+		archive.Logins = append(archive.Logins, ArchivedLogin{
+			At:        login.CreatedAt,
+			IP:        login.IP,
+			UserAgent: login.UserAgent,
+			Country:   login.Country,
+			City:      login.City,
+		})
+	}
+	for _, passkey := range passkeys {
+		archive.Passkeys = append(archive.Passkeys, ArchivedPasskey{
+			ID:         passkey.ID,
+			Name:       passkey.Name,
+			CreatedAt:  passkey.CreatedAt,
+			LastUsedAt: passkey.LastUsedAt,
+		})
+	}
+	for _, event := range events {
+		data := json.RawMessage(event.Data)
+		if len(data) == 0 {
+			data = json.RawMessage("null")
+		}
+		archive.Events = append(archive.Events, ArchivedEvent{
+			ID:        event.ID,
+			Type:      event.Type,
+			CreatedAt: event.CreatedAt,
+			Data:      data,
+		})
+	}
+
+	return json.Marshal(archive)
+}
@@ -0,0 +1,23 @@
+package commands
+
+import "time"
+
+// UserPurgePolicy — сроки удаления аккаунтов
+type UserPurgePolicy struct {
+	// Retention — сколько хранятся данные удалённого аккаунта до окончательного удаления
+	Retention time.Duration
+	// DeletionGracePeriod — через сколько удаляется аккаунт, удаление которого запросил сам пользователь
+	DeletionGracePeriod time.Duration
+	// BatchSize — сколько аккаунтов удаляется за один запуск (0 — 100)
+	BatchSize int
+}
+
+const defaultUserPurgeBatchSize = 100
+
+// batchSize — размер пачки с учётом значения по умолчанию
+func (p UserPurgePolicy) batchSize() int {
+	if p.BatchSize <= 0 {
+		return defaultUserPurgeBatchSize
+	}
+	return p.BatchSize
+}
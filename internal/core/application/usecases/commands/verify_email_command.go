@@ -0,0 +1,6 @@
+package commands
+
+// VerifyEmailCommand — команда подтверждения email токеном из письма
+type VerifyEmailCommand struct {
+	Token string
+}
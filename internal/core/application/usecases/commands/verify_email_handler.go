@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// VerifyEmailHandler — обработчик подтверждения email
+type VerifyEmailHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+}
+
+func NewVerifyEmailHandler(
+	txManager ports.TransactionManager,
+	clock ports.Clock,
+) *VerifyEmailHandler {
+	return &VerifyEmailHandler{
+		txManager: txManager,
+		clock:     clock,
+	}
+}
+
+// Handle гасит токен и отмечает email пользователя подтверждённым
+func (h *VerifyEmailHandler) Handle(ctx context.Context, cmd VerifyEmailCommand) error {
+	invalidToken := errs.NewDomainValidationError("token", "is invalid or expired")
+
+	return h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		token, txErr := repos.VerificationToken.GetBySecretHash(
+			auth.VerificationPurposeEmail,
+			auth.HashVerificationSecret(cmd.Token),
+		)
+		if txErr != nil {
+			return invalidToken
+		}
+
+		if txErr := token.Consume(h.clock); txErr != nil {
+			return invalidToken
+		}
+
+		user, txErr := repos.User.GetByID(token.UserID)
+		if txErr != nil {
+			return invalidToken
+		}
+
+		// Токен подтверждает конкретный адрес: после смены email он недействителен
+		if user.Email.String() != token.Target {
+			return invalidToken
+		}
+
+		if txErr := user.VerifyEmail(h.clock); txErr != nil {
+			return invalidToken
+		}
+
+		if txErr := repos.VerificationToken.Update(token); txErr != nil {
+			return txErr
+		}
+		if txErr := repos.User.Update(user); txErr != nil {
+			return txErr
+		}
+
+		if repos.Event != nil {
+			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+				return txErr
+			}
+		}
+		user.ClearDomainEvents()
+
+		return nil
+	})
+}
@@ -0,0 +1,13 @@
+package commands
+
+// VerifyMFACommand — второй шаг входа: токен из ответа на логин и код из приложения,
+// код восстановления либо подпись ключом WebAuthn (ровно одно из трёх)
+type VerifyMFACommand struct {
+	MFAToken     string
+	Code         string
+	RecoveryCode string
+	WebAuthn     *WebAuthnAssertion // challenge — сам MFAToken
+
+	ClientIP  string // адрес клиента для истории входов
+	UserAgent string // User-Agent клиента — отпечаток устройства
+}
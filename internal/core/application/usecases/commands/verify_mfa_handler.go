@@ -0,0 +1,200 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// VerifyMFAHandler — обработчик второго шага входа.
+// После проверки кода вход завершается так же, как LoginUserHandler без MFA.
+type VerifyMFAHandler struct {
+	txManager    ports.TransactionManager
+	jwtService   ports.JWTService
+	clock        ports.Clock
+	mfaPolicy    MFAPolicy
+	expiryPolicy PasswordExpiryPolicy
+
+	webAuthnPolicy WebAuthnPolicy
+	riskPolicy     LoginRiskPolicy
+}
+
+func NewVerifyMFAHandler(
+	txManager ports.TransactionManager,
+	jwtService ports.JWTService,
+	clock ports.Clock,
+	mfaPolicy MFAPolicy,
+	expiryPolicy PasswordExpiryPolicy,
+	webAuthnPolicy WebAuthnPolicy,
+	riskPolicy LoginRiskPolicy,
+) *VerifyMFAHandler {
+	return &VerifyMFAHandler{
+		txManager:    txManager,
+		jwtService:   jwtService,
+		clock:        clock,
+		mfaPolicy:    mfaPolicy,
+		expiryPolicy: expiryPolicy,
+
+		webAuthnPolicy: webAuthnPolicy,
+		riskPolicy:     riskPolicy,
+	}
+}
+
+// Handle проверяет токен второго шага и ответ на него (TOTP, код восстановления или ключ WebAuthn).
+// Неудачная попытка фиксируется в транзакции, ошибка возвращается после её завершения.
+func (h *VerifyMFAHandler) Handle(ctx context.Context, cmd VerifyMFACommand) (LoginUserResult, error) {
+	provided := 0
+	for _, set := range []bool{cmd.Code != "", cmd.RecoveryCode != "", cmd.WebAuthn != nil} {
+		if set {
+			provided++
+		}
+	}
+	if provided != 1 {
+		return LoginUserResult{}, errs.NewDomainValidationError(
+			"code", "exactly one of code, recovery_code or webauthn is required",
+		)
+	}
+
+	var (
+		verifiedUser    *auth.User
+		passwordExpired bool
+		risk            loginRisk
+		verifyErr       error
+	)
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		token, challengeErr, txErr := h.mfaPolicy.lookup(repos.VerificationToken, cmd.MFAToken, h.clock.Now())
+		if txErr != nil {
+			return txErr
+		}
+		if challengeErr != nil {
+			verifyErr = challengeErr
+			return nil
+		}
+
+		user, txErr := repos.User.GetByID(token.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		// Аккаунт мог стать неактивным после первого шага
+		if !user.IsActive() {
+			return accountInactiveError(user)
+		}
+
+		codeErr, txErr := h.verifyCode(repos, user, cmd)
+		if txErr != nil {
+			return txErr
+		}
+		if codeErr != nil {
+			token.RecordFailedAttempt()
+			verifyErr = codeErr
+			return repos.VerificationToken.Update(token)
+		}
+
+		if txErr := token.Consume(h.clock); txErr != nil {
+			return txErr
+		}
+		if txErr := repos.VerificationToken.Update(token); txErr != nil {
+			return txErr
+		}
+
+		passwordExpired = user.PasswordExpired(h.expiryPolicy.MaxAge, h.clock.Now())
+		if !passwordExpired {
+			// Второй фактор сам подтверждает вход: о подозрительном входе только сообщается
+			client := loginClient{userAgent: cmd.UserAgent, ip: cmd.ClientIP}
+			if risk, txErr = h.riskPolicy.assess(repos, user, client, h.clock); txErr != nil {
+				return txErr
+			}
+			user.MarkLoggedIn(h.clock)
+		}
+
+		if txErr := repos.User.Update(user); txErr != nil {
+			return txErr
+		}
+
+		if repos.Event != nil {
+			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+				return txErr
+			}
+		}
+		user.ClearDomainEvents()
+
+		verifiedUser = user
+		return nil
+	})
+	if err != nil {
+		return LoginUserResult{}, err
+	}
+	if verifyErr != nil {
+		return LoginUserResult{}, verifyErr
+	}
+
+	h.riskPolicy.notify(ctx, risk)
+	if passwordExpired {
+		return passwordExpiredResult(h.jwtService, h.expiryPolicy, verifiedUser)
+	}
+
+	return loggedInResult(h.jwtService, verifiedUser)
+}
+
+// verifyCode проверяет код из приложения, код восстановления или подпись ключом WebAuthn.
+// Неверный код (codeErr) возвращается отдельно от инфраструктурной ошибки (err).
+func (h *VerifyMFAHandler) verifyCode(
+	repos ports.Repositories,
+	user *auth.User,
+	cmd VerifyMFACommand,
+) (codeErr error, err error) {
+	if cmd.WebAuthn != nil {
+		return h.verifyWebAuthn(repos.WebAuthnCredential, user, cmd)
+	}
+
+	if cmd.Code != "" {
+		if verifyErr := user.VerifyTOTP(cmd.Code, h.clock); verifyErr != nil {
+			if errors.Is(verifyErr, auth.ErrTOTPCodeInvalid) || errors.Is(verifyErr, auth.ErrMFANotEnabled) {
+				return errs.NewDomainValidationError("code", verifyErr.Error()), nil
+			}
+			return nil, verifyErr
+		}
+		return nil, nil
+	}
+
+	repo := repos.RecoveryCode
+	code, err := repo.GetUnused(user.ID(), auth.HashRecoveryCode(cmd.RecoveryCode))
+	if err != nil {
+		var notFound *errs.NotFoundError
+		if errors.As(err, &notFound) {
+			return errs.NewDomainValidationError("recovery_code", auth.ErrRecoveryCodeInvalid.Error()), nil
+		}
+		return nil, err
+	}
+
+	if useErr := user.UseRecoveryCode(code, h.clock); useErr != nil {
+		return errs.NewDomainValidationError("recovery_code", useErr.Error()), nil
+	}
+
+	return nil, repo.Update(code)
+}
+
+// verifyWebAuthn проверяет подпись токена второго шага ключом пользователя
+func (h *VerifyMFAHandler) verifyWebAuthn(
+	repo ports.WebAuthnCredentialRepository,
+	user *auth.User,
+	cmd VerifyMFACommand,
+) (codeErr error, err error) {
+	cred, signCount, assertErr, err := h.webAuthnPolicy.verifyAssertion(repo, []byte(cmd.MFAToken), *cmd.WebAuthn, false)
+	if err != nil {
+		return nil, err
+	}
+	if assertErr != nil {
+		return errs.NewDomainValidationError("webauthn", assertErr.Error()), nil
+	}
+
+	if verifyErr := user.VerifyWebAuthn(cred, signCount, h.clock); verifyErr != nil {
+		return errs.NewDomainValidationError("webauthn", verifyErr.Error()), nil
+	}
+
+	return nil, repo.Update(cred)
+}
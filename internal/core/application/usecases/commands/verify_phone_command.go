@@ -0,0 +1,9 @@
+package commands
+
+import "github.com/google/uuid"
+
+// VerifyPhoneCommand — команда подтверждения телефона кодом из SMS
+type VerifyPhoneCommand struct {
+	UserID uuid.UUID
+	Code   string
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+)
+
+// VerifyPhoneHandler — обработчик подтверждения телефона кодом из SMS
+type VerifyPhoneHandler struct {
+	txManager          ports.TransactionManager
+	passwordHasher     ports.PasswordHasher
+	clock              ports.Clock
+	verificationPolicy PhoneVerificationPolicy
+}
+
+func NewVerifyPhoneHandler(
+	txManager ports.TransactionManager,
+	passwordHasher ports.PasswordHasher,
+	clock ports.Clock,
+	verificationPolicy PhoneVerificationPolicy,
+) *VerifyPhoneHandler {
+	return &VerifyPhoneHandler{
+		txManager:          txManager,
+		passwordHasher:     passwordHasher,
+		clock:              clock,
+		verificationPolicy: verificationPolicy,
+	}
+}
+
+// Handle проверяет последний выданный код и отмечает телефон пользователя подтверждённым.
+// Неудачная попытка фиксируется в транзакции, ошибка возвращается после её завершения.
+func (h *VerifyPhoneHandler) Handle(ctx context.Context, cmd VerifyPhoneCommand) error {
+	var verifyErr error
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		user, txErr := repos.User.GetByID(cmd.UserID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if user.IsPhoneVerified() {
+			verifyErr = errs.NewDomainValidationError("phone", auth.ErrPhoneAlreadyVerified.Error())
+			return nil
+		}
+
+		token, codeErr, txErr := h.verificationPolicy.consume(
+			repos.VerificationToken,
+			user.ID(),
+			auth.VerificationPurposePhone,
+			cmd.Code,
+			h.passwordHasher,
+			h.clock,
+		)
+		if txErr != nil {
+			return txErr
+		}
+		if codeErr != nil {
+			verifyErr = codeErr
+			return nil
+		}
+
+		// Код подтверждает конкретный номер: после смены телефона он недействителен
+		if token.Target != user.Phone.String() {
+			verifyErr = errs.NewDomainValidationError("code", auth.ErrVerificationTokenInvalid.Error())
+			return nil
+		}
+
+		if txErr := user.VerifyPhone(h.clock); txErr != nil {
+			return errs.NewDomainValidationError("phone", txErr.Error())
+		}
+
+		if txErr := repos.VerificationToken.Update(token); txErr != nil {
+			return txErr
+		}
+		if txErr := repos.User.Update(user); txErr != nil {
+			return txErr
+		}
+
+		if repos.Event != nil {
+			if txErr := repos.Event.Publish(ctx, user.GetDomainEvents()...); txErr != nil {
+				return txErr
+			}
+		}
+		user.ClearDomainEvents()
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return verifyErr
+}
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	"github.com/Vi-72/quest-auth/internal/pkg/webauthn"
+
+	"github.com/google/uuid"
+)
+
+// WebAuthnPolicy — правила входа по ключам WebAuthn (passkey).
+type WebAuthnPolicy struct {
+	// RelyingParty — домен и источники, к которым привязываются ключи
+	RelyingParty webauthn.RelyingParty
+	// ChallengeTTL — время жизни challenge регистрации и входа
+	ChallengeTTL time.Duration
+}
+
+// challenge выпускает challenge церемонии. Состояние хранится как одноразовый токен:
+// клиент возвращает challenge в clientDataJSON, по нему токен и находится.
+func (p WebAuthnPolicy) challenge(
+	repo ports.VerificationTokenRepository,
+	userID uuid.UUID,
+	purpose auth.VerificationPurpose,
+	clock ports.Clock,
+) ([]byte, error) {
+	token, secret, err := auth.NewVerificationToken(userID, purpose, "", p.ChallengeTTL, clock)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.Create(&token); err != nil {
+		return nil, err
+	}
+
+	return []byte(secret), nil
+}
+
+// lookup находит активный токен по challenge из клиентских данных.
+// nil без ошибки — challenge неизвестен, просрочен или уже использован.
+func (p WebAuthnPolicy) lookup(
+	repo ports.VerificationTokenRepository,
+	purpose auth.VerificationPurpose,
+	clientDataJSON []byte,
+	now time.Time,
+) (*auth.VerificationToken, []byte, error) {
+	challenge, err := webauthn.Challenge(clientDataJSON)
+	if err != nil {
+		return nil, nil, nil
+	}
+
+	token, err := repo.GetBySecretHash(purpose, auth.HashVerificationSecret(string(challenge)))
+	if err != nil {
+		var notFound *errs.NotFoundError
+		if errors.As(err, &notFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	if !token.Active(now) {
+		return nil, nil, nil
+	}
+
+	return token, challenge, nil
+}
+
+// verifyAssertion находит ключ и проверяет им подпись. Ошибка проверки (assertErr)
+// возвращается отдельно от инфраструктурной (err).
+func (p WebAuthnPolicy) verifyAssertion(
+	repo ports.WebAuthnCredentialRepository,
+	challenge []byte,
+	assertion WebAuthnAssertion,
+	requireUserVerification bool,
+) (cred *auth.WebAuthnCredential, signCount uint32, assertErr error, err error) {
+	cred, err = repo.GetByCredentialID(assertion.CredentialID)
+	if err != nil {
+		var notFound *errs.NotFoundError
+		if errors.As(err, &notFound) {
+			return nil, 0, auth.ErrWebAuthnCredentialInvalid, nil
+		}
+		return nil, 0, nil, err
+	}
+
+	// userHandle — идентификатор пользователя, записанный в passkey при регистрации
+	if len(assertion.UserHandle) > 0 && !bytes.Equal(assertion.UserHandle, cred.UserID[:]) {
+		return nil, 0, auth.ErrWebAuthnCredentialInvalid, nil
+	}
+
+	result, verifyErr := p.RelyingParty.VerifyAssertion(
+		challenge,
+		cred.PublicKey,
+		assertion.ClientDataJSON,
+		assertion.AuthenticatorData,
+		assertion.Signature,
+		requireUserVerification,
+	)
+	if verifyErr != nil {
+		return nil, 0, verifyErr, nil
+	}
+
+	return cred, result.SignCount, nil, nil
+}
+
+// credentialIDs — идентификаторы ключей для allowCredentials / excludeCredentials
+func credentialIDs(creds []auth.WebAuthnCredential) [][]byte {
+	ids := make([][]byte, 0, len(creds))
+	for _, cred := range creds {
+		ids = append(ids, cred.CredentialID)
+	}
+	return ids
+}
@@ -2,8 +2,10 @@ package queries
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
 	"github.com/Vi-72/quest-auth/internal/core/ports"
 	"github.com/Vi-72/quest-auth/internal/pkg/errs"
@@ -16,7 +18,20 @@ type AuthenticatedInfo struct {
 	Name      string
 	Email     string
 	Phone     string
+	PhoneType string
 	CreatedAt time.Time
+	Username  string
+
+	Locale    string
+	Timezone  string
+	AvatarURL string
+	BirthDate *time.Time
+	Metadata  map[string]any
+
+	EmailVerified bool
+	PhoneVerified bool
+
+	Status string
 }
 
 type AuthenticateByTokenQuery struct {
@@ -24,11 +39,12 @@ type AuthenticateByTokenQuery struct {
 }
 
 type AuthenticateByTokenHandler struct {
-	jwt ports.JWTService
+	jwt       ports.JWTService
+	txManager ports.TransactionManager
 }
 
-func NewAuthenticateByTokenHandler(jwt ports.JWTService) *AuthenticateByTokenHandler {
-	return &AuthenticateByTokenHandler{jwt: jwt}
+func NewAuthenticateByTokenHandler(jwt ports.JWTService, txManager ports.TransactionManager) *AuthenticateByTokenHandler {
+	return &AuthenticateByTokenHandler{jwt: jwt, txManager: txManager}
 }
 
 func (h *AuthenticateByTokenHandler) Handle(
@@ -46,12 +62,44 @@ func (h *AuthenticateByTokenHandler) Handle(
 		return AuthenticatedInfo{}, err
 	}
 
-	// Собираем ответ из доступных клеймов (без похода в БД)
+	// Состояние аккаунта меняется раньше, чем истекает токен, поэтому пользователь читается из БД:
+	// приостановленный или заблокированный аккаунт теряет доступ сразу
+	var user *auth.User
+	err = h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		var txErr error
+		user, txErr = repos.User.GetByID(claims.UserID)
+		return txErr
+	})
+	if err != nil {
+		var notFound *errs.NotFoundError
+		if errors.As(err, &notFound) {
+			return AuthenticatedInfo{}, errs.NewJWTValidationError("token owner does not exist")
+		}
+		return AuthenticatedInfo{}, err
+	}
+
+	if !user.IsActive() {
+		return AuthenticatedInfo{}, errs.NewForbiddenError("account-"+user.Status.String(), "account is "+user.Status.String())
+	}
+
 	return AuthenticatedInfo{
-		ID:        claims.UserID,
-		Name:      claims.Name,
-		Email:     claims.Email,
-		Phone:     claims.Phone,
-		CreatedAt: claims.CreatedAt,
+		ID:        user.ID(),
+		Name:      user.Name,
+		Email:     user.Email.String(),
+		Phone:     user.Phone.String(),
+		PhoneType: string(user.Phone.Type()),
+		CreatedAt: user.CreatedAt,
+		Username:  user.Username.String(),
+
+		Locale:    user.Profile.Locale,
+		Timezone:  user.Profile.Timezone,
+		AvatarURL: user.Profile.AvatarURL,
+		BirthDate: user.Profile.BirthDate,
+		Metadata:  user.Metadata,
+
+		EmailVerified: user.IsEmailVerified(),
+		PhoneVerified: user.IsPhoneVerified(),
+
+		Status: user.Status.String(),
 	}, nil
 }
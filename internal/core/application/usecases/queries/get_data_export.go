@@ -0,0 +1,44 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+type GetDataExportQuery struct {
+	UserID   uuid.UUID
+	ExportID uuid.UUID
+}
+
+type GetDataExportHandler struct {
+	txManager ports.TransactionManager
+	clock     ports.Clock
+}
+
+func NewGetDataExportHandler(txManager ports.TransactionManager, clock ports.Clock) *GetDataExportHandler {
+	return &GetDataExportHandler{txManager: txManager, clock: clock}
+}
+
+// Handle возвращает выгрузку пользователя. Чужая и истёкшая выгрузки не находятся.
+func (h *GetDataExportHandler) Handle(ctx context.Context, q GetDataExportQuery) (*auth.DataExport, error) {
+	var export *auth.DataExport
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		var txErr error
+		export, txErr = repos.DataExport.GetByID(q.ExportID)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if export.UserID != q.UserID || export.IsExpired(h.clock.Now()) {
+		return nil, errs.NewNotFoundError("data export", q.ExportID.String())
+	}
+
+	return export, nil
+}
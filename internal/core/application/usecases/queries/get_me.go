@@ -0,0 +1,38 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+type GetMeQuery struct {
+	UserID uuid.UUID
+}
+
+type GetMeHandler struct {
+	txManager ports.TransactionManager
+}
+
+func NewGetMeHandler(txManager ports.TransactionManager) *GetMeHandler {
+	return &GetMeHandler{txManager: txManager}
+}
+
+// Handle возвращает профиль владельца токена из БД, а не из клеймов: они устаревают
+// до обновления токенов
+func (h *GetMeHandler) Handle(ctx context.Context, q GetMeQuery) (*auth.User, error) {
+	var user *auth.User
+	err := h.txManager.RunInTransaction(ctx, func(ctx context.Context, repos ports.Repositories) error {
+		var txErr error
+		user, txErr = repos.User.GetByID(q.UserID)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
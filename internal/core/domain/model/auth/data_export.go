@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataExportStatus — готов ли архив с данными пользователя
+type DataExportStatus string
+
+const (
+	DataExportPending DataExportStatus = "pending" // архив ещё собирается в фоне
+	DataExportReady   DataExportStatus = "ready"
+)
+
+var ErrDataExportAlreadyReady = errors.New("data export is already ready")
+
+// DataExport — выгрузка данных пользователя (запрос субъекта данных по GDPR), собираемая в фоне.
+// Готовый архив хранится до ExpiresAt, затем удаляется.
+type DataExport struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Status    DataExportStatus
+	Archive   []byte // JSON-архив, пуст до готовности
+	CreatedAt time.Time
+	ReadyAt   *time.Time
+	ExpiresAt time.Time
+}
+
+// NewDataExport ставит выгрузку в очередь. Несобранная за ttl выгрузка тоже удаляется.
+func NewDataExport(userID uuid.UUID, ttl time.Duration, clock Clock) DataExport {
+	now := clock.Now()
+	return DataExport{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Status:    DataExportPending,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+}
+
+// Complete сохраняет собранный архив; он доступен для скачивания ttl.
+func (e *DataExport) Complete(archive []byte, ttl time.Duration, clock Clock) error {
+	if e.IsReady() {
+		return ErrDataExportAlreadyReady
+	}
+
+	now := clock.Now()
+	e.Status = DataExportReady
+	e.Archive = archive
+	e.ReadyAt = &now
+	e.ExpiresAt = now.Add(ttl)
+	return nil
+}
+
+// IsReady — собран ли архив
+func (e *DataExport) IsReady() bool {
+	return e.Status == DataExportReady
+}
+
+// IsExpired — истёк ли срок хранения выгрузки
+func (e *DataExport) IsExpired(now time.Time) bool {
+	return !now.Before(e.ExpiresAt)
+}
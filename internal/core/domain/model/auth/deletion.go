@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrUserAlreadyDeleted       = errors.New("user is already deleted")
+	ErrDeletionAlreadyScheduled = errors.New("account deletion is already scheduled")
+	ErrDeletionNotScheduled     = errors.New("account deletion is not scheduled")
+)
+
+// IsDeleted — удалён ли аккаунт
+func (u *User) IsDeleted() bool {
+	return u.DeletedAt != nil
+}
+
+// Delete помечает аккаунт удалённым. Данные остаются до окончательного удаления после срока хранения.
+func (u *User) Delete(clock Clock) error {
+	if u.IsDeleted() {
+		return ErrUserAlreadyDeleted
+	}
+
+	now := clock.Now()
+	u.DeletedAt = &now
+	u.DeletionScheduledAt = nil
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserDeleted(u.ID(), now))
+	return nil
+}
+
+// IsDeletionScheduled — запросил ли пользователь удаление аккаунта
+func (u *User) IsDeletionScheduled() bool {
+	return u.DeletionScheduledAt != nil
+}
+
+// IsDeletionDue — наступил ли срок запрошенного удаления
+func (u *User) IsDeletionDue(now time.Time) bool {
+	return u.IsDeletionScheduled() && !now.Before(*u.DeletionScheduledAt)
+}
+
+// RequestDeletion планирует удаление аккаунта через gracePeriod.
+// Аккаунт продолжает работать: до срока пользователь может войти и отменить удаление.
+func (u *User) RequestDeletion(gracePeriod time.Duration, clock Clock) error {
+	if u.IsDeleted() {
+		return ErrUserAlreadyDeleted
+	}
+	if u.IsDeletionScheduled() {
+		return ErrDeletionAlreadyScheduled
+	}
+
+	now := clock.Now()
+	scheduledAt := now.Add(gracePeriod)
+	u.DeletionScheduledAt = &scheduledAt
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserDeletionRequested(u.ID(), scheduledAt, now))
+	return nil
+}
+
+// CancelDeletion отменяет запрошенное удаление
+func (u *User) CancelDeletion(clock Clock) error {
+	if !u.IsDeletionScheduled() {
+		return ErrDeletionNotScheduled
+	}
+
+	now := clock.Now()
+	u.DeletionScheduledAt = nil
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserDeletionCancelled(u.ID(), now))
+	return nil
+}
@@ -75,6 +75,28 @@ func (e UserNameChanged) GetID() uuid.UUID          { return e.ID }
 func (e UserNameChanged) GetName() string           { return "UserNameChanged" }
 func (e UserNameChanged) GetAggregateID() uuid.UUID { return e.UserID }
 
+type UserUsernameChanged struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Old    string
+	New    string
+	At     time.Time
+}
+
+func NewUserUsernameChanged(userID uuid.UUID, old, new string, at time.Time) UserUsernameChanged {
+	return UserUsernameChanged{
+		ID:     uuid.New(),
+		UserID: userID,
+		Old:    old,
+		New:    new,
+		At:     at,
+	}
+}
+
+func (e UserUsernameChanged) GetID() uuid.UUID          { return e.ID }
+func (e UserUsernameChanged) GetName() string           { return "UserUsernameChanged" }
+func (e UserUsernameChanged) GetAggregateID() uuid.UUID { return e.UserID }
+
 type UserPasswordChanged struct {
 	ID     uuid.UUID
 	UserID uuid.UUID
@@ -93,6 +115,24 @@ func (e UserPasswordChanged) GetID() uuid.UUID          { return e.ID }
 func (e UserPasswordChanged) GetName() string           { return "UserPasswordChanged" }
 func (e UserPasswordChanged) GetAggregateID() uuid.UUID { return e.UserID }
 
+type UserPasswordChangeRequired struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	At     time.Time
+}
+
+func NewUserPasswordChangeRequired(userID uuid.UUID, at time.Time) UserPasswordChangeRequired {
+	return UserPasswordChangeRequired{
+		ID:     uuid.New(),
+		UserID: userID,
+		At:     at,
+	}
+}
+
+func (e UserPasswordChangeRequired) GetID() uuid.UUID          { return e.ID }
+func (e UserPasswordChangeRequired) GetName() string           { return "UserPasswordChangeRequired" }
+func (e UserPasswordChangeRequired) GetAggregateID() uuid.UUID { return e.UserID }
+
 type UserLoggedIn struct {
 	ID     uuid.UUID
 	UserID uuid.UUID
@@ -110,3 +150,363 @@ func NewUserLoggedIn(userID uuid.UUID, at time.Time) UserLoggedIn {
 func (e UserLoggedIn) GetID() uuid.UUID          { return e.ID }
 func (e UserLoggedIn) GetName() string           { return "user.login" }
 func (e UserLoggedIn) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserEmailVerified struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Email  string
+	At     time.Time
+}
+
+func NewUserEmailVerified(userID uuid.UUID, email string, at time.Time) UserEmailVerified {
+	return UserEmailVerified{
+		ID:     uuid.New(),
+		UserID: userID,
+		Email:  email,
+		At:     at,
+	}
+}
+
+func (e UserEmailVerified) GetID() uuid.UUID          { return e.ID }
+func (e UserEmailVerified) GetName() string           { return "UserEmailVerified" }
+func (e UserEmailVerified) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserPhoneVerified struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Phone  string
+	At     time.Time
+}
+
+func NewUserPhoneVerified(userID uuid.UUID, phone string, at time.Time) UserPhoneVerified {
+	return UserPhoneVerified{
+		ID:     uuid.New(),
+		UserID: userID,
+		Phone:  phone,
+		At:     at,
+	}
+}
+
+func (e UserPhoneVerified) GetID() uuid.UUID          { return e.ID }
+func (e UserPhoneVerified) GetName() string           { return "UserPhoneVerified" }
+func (e UserPhoneVerified) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserEmailChangeRequested struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Old    string
+	New    string
+	At     time.Time
+}
+
+func NewUserEmailChangeRequested(userID uuid.UUID, old, new string, at time.Time) UserEmailChangeRequested {
+	return UserEmailChangeRequested{
+		ID:     uuid.New(),
+		UserID: userID,
+		Old:    old,
+		New:    new,
+		At:     at,
+	}
+}
+
+func (e UserEmailChangeRequested) GetID() uuid.UUID          { return e.ID }
+func (e UserEmailChangeRequested) GetName() string           { return "UserEmailChangeRequested" }
+func (e UserEmailChangeRequested) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserEmailChanged struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Old    string
+	New    string
+	At     time.Time
+}
+
+func NewUserEmailChanged(userID uuid.UUID, old, new string, at time.Time) UserEmailChanged {
+	return UserEmailChanged{
+		ID:     uuid.New(),
+		UserID: userID,
+		Old:    old,
+		New:    new,
+		At:     at,
+	}
+}
+
+func (e UserEmailChanged) GetID() uuid.UUID          { return e.ID }
+func (e UserEmailChanged) GetName() string           { return "UserEmailChanged" }
+func (e UserEmailChanged) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserMFAEnabled struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Method string
+	At     time.Time
+}
+
+func NewUserMFAEnabled(userID uuid.UUID, method string, at time.Time) UserMFAEnabled {
+	return UserMFAEnabled{
+		ID:     uuid.New(),
+		UserID: userID,
+		Method: method,
+		At:     at,
+	}
+}
+
+func (e UserMFAEnabled) GetID() uuid.UUID          { return e.ID }
+func (e UserMFAEnabled) GetName() string           { return "UserMFAEnabled" }
+func (e UserMFAEnabled) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserMFAVerified struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Method string
+	At     time.Time
+}
+
+func NewUserMFAVerified(userID uuid.UUID, method string, at time.Time) UserMFAVerified {
+	return UserMFAVerified{
+		ID:     uuid.New(),
+		UserID: userID,
+		Method: method,
+		At:     at,
+	}
+}
+
+func (e UserMFAVerified) GetID() uuid.UUID          { return e.ID }
+func (e UserMFAVerified) GetName() string           { return "UserMFAVerified" }
+func (e UserMFAVerified) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserWebAuthnCredentialRegistered struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	CredentialID string // base64url
+	Name         string
+	At           time.Time
+}
+
+func NewUserWebAuthnCredentialRegistered(
+	userID uuid.UUID,
+	credentialID, name string,
+	at time.Time,
+) UserWebAuthnCredentialRegistered {
+	return UserWebAuthnCredentialRegistered{
+		ID:           uuid.New(),
+		UserID:       userID,
+		CredentialID: credentialID,
+		Name:         name,
+		At:           at,
+	}
+}
+
+func (e UserWebAuthnCredentialRegistered) GetID() uuid.UUID { return e.ID }
+func (e UserWebAuthnCredentialRegistered) GetName() string {
+	return "UserWebAuthnCredentialRegistered"
+}
+func (e UserWebAuthnCredentialRegistered) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserLoginFailed struct {
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	Attempts int // неверные пароли подряд, включая этот
+	At       time.Time
+}
+
+func NewUserLoginFailed(userID uuid.UUID, attempts int, at time.Time) UserLoginFailed {
+	return UserLoginFailed{
+		ID:       uuid.New(),
+		UserID:   userID,
+		Attempts: attempts,
+		At:       at,
+	}
+}
+
+func (e UserLoginFailed) GetID() uuid.UUID          { return e.ID }
+func (e UserLoginFailed) GetName() string           { return "UserLoginFailed" }
+func (e UserLoginFailed) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserLockedOut struct {
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	Until    time.Time
+	Lockouts int // номер блокировки подряд
+	At       time.Time
+}
+
+func NewUserLockedOut(userID uuid.UUID, until time.Time, lockouts int, at time.Time) UserLockedOut {
+	return UserLockedOut{
+		ID:       uuid.New(),
+		UserID:   userID,
+		Until:    until,
+		Lockouts: lockouts,
+		At:       at,
+	}
+}
+
+func (e UserLockedOut) GetID() uuid.UUID          { return e.ID }
+func (e UserLockedOut) GetName() string           { return "UserLockedOut" }
+func (e UserLockedOut) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserUnlocked struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	At     time.Time
+}
+
+func NewUserUnlocked(userID uuid.UUID, at time.Time) UserUnlocked {
+	return UserUnlocked{
+		ID:     uuid.New(),
+		UserID: userID,
+		At:     at,
+	}
+}
+
+func (e UserUnlocked) GetID() uuid.UUID          { return e.ID }
+func (e UserUnlocked) GetName() string           { return "UserUnlocked" }
+func (e UserUnlocked) GetAggregateID() uuid.UUID { return e.UserID }
+
+type SuspiciousLoginDetected struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	IP          string
+	UserAgent   string
+	Country     string
+	City        string
+	NewDevice   bool
+	NewLocation bool
+	At          time.Time
+}
+
+func NewSuspiciousLoginDetected(userID uuid.UUID, record LoginRecord, risk LoginRisk, at time.Time) SuspiciousLoginDetected {
+	return SuspiciousLoginDetected{
+		ID:          uuid.New(),
+		UserID:      userID,
+		IP:          record.IP,
+		UserAgent:   record.UserAgent,
+		Country:     record.Country,
+		City:        record.City,
+		NewDevice:   risk.NewDevice,
+		NewLocation: risk.NewLocation,
+		At:          at,
+	}
+}
+
+func (e SuspiciousLoginDetected) GetID() uuid.UUID          { return e.ID }
+func (e SuspiciousLoginDetected) GetName() string           { return "SuspiciousLoginDetected" }
+func (e SuspiciousLoginDetected) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserStatusChanged struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	From   string
+	To     string
+	Reason string
+	At     time.Time
+}
+
+func NewUserStatusChanged(userID uuid.UUID, from, to UserStatus, reason string, at time.Time) UserStatusChanged {
+	return UserStatusChanged{
+		ID:     uuid.New(),
+		UserID: userID,
+		From:   from.String(),
+		To:     to.String(),
+		Reason: reason,
+		At:     at,
+	}
+}
+
+func (e UserStatusChanged) GetID() uuid.UUID          { return e.ID }
+func (e UserStatusChanged) GetName() string           { return "UserStatusChanged" }
+func (e UserStatusChanged) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserDeleted struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	At     time.Time
+}
+
+func NewUserDeleted(userID uuid.UUID, at time.Time) UserDeleted {
+	return UserDeleted{
+		ID:     uuid.New(),
+		UserID: userID,
+		At:     at,
+	}
+}
+
+func (e UserDeleted) GetID() uuid.UUID          { return e.ID }
+func (e UserDeleted) GetName() string           { return "UserDeleted" }
+func (e UserDeleted) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserDeletionRequested struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	ScheduledAt time.Time
+	At          time.Time
+}
+
+func NewUserDeletionRequested(userID uuid.UUID, scheduledAt, at time.Time) UserDeletionRequested {
+	return UserDeletionRequested{
+		ID:          uuid.New(),
+		UserID:      userID,
+		ScheduledAt: scheduledAt,
+		At:          at,
+	}
+}
+
+func (e UserDeletionRequested) GetID() uuid.UUID          { return e.ID }
+func (e UserDeletionRequested) GetName() string           { return "UserDeletionRequested" }
+func (e UserDeletionRequested) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserDeletionCancelled struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	At     time.Time
+}
+
+func NewUserDeletionCancelled(userID uuid.UUID, at time.Time) UserDeletionCancelled {
+	return UserDeletionCancelled{
+		ID:     uuid.New(),
+		UserID: userID,
+		At:     at,
+	}
+}
+
+func (e UserDeletionCancelled) GetID() uuid.UUID          { return e.ID }
+func (e UserDeletionCancelled) GetName() string           { return "UserDeletionCancelled" }
+func (e UserDeletionCancelled) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserProfileChanged struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Fields []string
+	At     time.Time
+}
+
+func NewUserProfileChanged(userID uuid.UUID, fields []string, at time.Time) UserProfileChanged {
+	return UserProfileChanged{
+		ID:     uuid.New(),
+		UserID: userID,
+		Fields: fields,
+		At:     at,
+	}
+}
+
+func (e UserProfileChanged) GetID() uuid.UUID          { return e.ID }
+func (e UserProfileChanged) GetName() string           { return "UserProfileChanged" }
+func (e UserProfileChanged) GetAggregateID() uuid.UUID { return e.UserID }
+
+type UserMetadataChanged struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	At     time.Time
+}
+
+func NewUserMetadataChanged(userID uuid.UUID, at time.Time) UserMetadataChanged {
+	return UserMetadataChanged{
+		ID:     uuid.New(),
+		UserID: userID,
+		At:     at,
+	}
+}
+
+func (e UserMetadataChanged) GetID() uuid.UUID          { return e.ID }
+func (e UserMetadataChanged) GetName() string           { return "UserMetadataChanged" }
+func (e UserMetadataChanged) GetAggregateID() uuid.UUID { return e.UserID }
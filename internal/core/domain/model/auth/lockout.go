@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"math"
+	"time"
+)
+
+// LockoutRule — правила временной блокировки входа по паролю после неудачных попыток.
+type LockoutRule struct {
+	// Threshold — сколько неверных паролей подряд приводит к блокировке (0 — блокировки нет)
+	Threshold int
+	// BaseDuration — длительность первой блокировки, каждая следующая вдвое длиннее
+	BaseDuration time.Duration
+	// MaxDuration — верхняя граница длительности блокировки (0 — без ограничения)
+	MaxDuration time.Duration
+}
+
+// duration — длительность блокировки с номером lockout (с 1): BaseDuration * 2^(lockout-1), не больше MaxDuration.
+// Без MaxDuration удвоение останавливается на максимуме time.Duration (~292 года): переполнение
+// дало бы отрицательную длительность, и блокировка заканчивалась бы раньше, чем началась.
+func (r LockoutRule) duration(lockout int) time.Duration {
+	d := r.BaseDuration
+	for i := 1; i < lockout; i++ {
+		if r.MaxDuration > 0 && d >= r.MaxDuration {
+			break
+		}
+		if d > math.MaxInt64/2 {
+			d = math.MaxInt64
+			break
+		}
+		d *= 2
+	}
+	if r.MaxDuration > 0 && d > r.MaxDuration {
+		return r.MaxDuration
+	}
+	return d
+}
+
+// IsLockedOut — заблокирован ли вход по паролю в момент now.
+func (u *User) IsLockedOut(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
+}
+
+// LockedFor — сколько ещё действует блокировка (0 — вход не заблокирован).
+func (u *User) LockedFor(now time.Time) time.Duration {
+	if !u.IsLockedOut(now) {
+		return 0
+	}
+	return u.LockedUntil.Sub(now)
+}
+
+// RecordFailedLogin — учёт неверного пароля. После rule.Threshold неудач подряд вход блокируется,
+// каждая следующая блокировка длиннее предыдущей; счётчик попыток начинается заново.
+// Возвращает true, если попытка привела к блокировке.
+func (u *User) RecordFailedLogin(rule LockoutRule, clock Clock) bool {
+	now := clock.Now()
+	u.FailedLoginAttempts++
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserLoginFailed(u.ID(), u.FailedLoginAttempts, now))
+
+	if rule.Threshold <= 0 || u.FailedLoginAttempts < rule.Threshold {
+		return false
+	}
+
+	u.Lockouts++
+	until := now.Add(rule.duration(u.Lockouts))
+	u.LockedUntil = &until
+	u.FailedLoginAttempts = 0
+	u.RaiseDomainEvent(NewUserLockedOut(u.ID(), until, u.Lockouts, now))
+	return true
+}
+
+// ResetFailedLogins — сброс счётчиков после верного пароля: следующая блокировка снова будет короткой.
+// Возвращает true, если что-то изменилось и пользователя нужно сохранить.
+func (u *User) ResetFailedLogins() bool {
+	if u.FailedLoginAttempts == 0 && u.Lockouts == 0 && u.LockedUntil == nil {
+		return false
+	}
+	u.FailedLoginAttempts = 0
+	u.Lockouts = 0
+	u.LockedUntil = nil
+	return true
+}
+
+// Unlock — снятие блокировки администратором вместе со счётчиками неудачных попыток.
+func (u *User) Unlock(clock Clock) {
+	if !u.ResetFailedLogins() {
+		return
+	}
+	now := clock.Now()
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserUnlocked(u.ID(), now))
+}
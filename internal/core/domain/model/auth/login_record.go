@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Location — местоположение по IP: код страны ISO 3166-1 и город (пусто, если неизвестно).
+type Location struct {
+	Country string
+	City    string
+}
+
+// LoginRecord — успешный вход: устройство (User-Agent) и адрес, с которых он выполнен.
+type LoginRecord struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	DeviceHash string // отпечаток устройства: SHA-256 от User-Agent
+	UserAgent  string
+	IP         string
+	Country    string
+	City       string
+	CreatedAt  time.Time
+}
+
+// NewLoginRecord фиксирует вход пользователя с устройства userAgent и адреса ip.
+func NewLoginRecord(userID uuid.UUID, userAgent, ip string, location Location, clock Clock) LoginRecord {
+	return LoginRecord{
+		ID:         uuid.New(),
+		UserID:     userID,
+		DeviceHash: HashDevice(userAgent),
+		UserAgent:  userAgent,
+		IP:         ip,
+		Country:    location.Country,
+		City:       location.City,
+		CreatedAt:  clock.Now(),
+	}
+}
+
+// HashDevice — отпечаток устройства по User-Agent (без учёта регистра и пробелов по краям).
+func HashDevice(userAgent string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(userAgent))))
+	return hex.EncodeToString(sum[:])
+}
+
+// LoginRisk — чем вход отличается от прошлых входов пользователя.
+type LoginRisk struct {
+	NewDevice   bool
+	NewLocation bool
+}
+
+// Suspicious — вход с незнакомого устройства или из необычного места.
+func (r LoginRisk) Suspicious() bool {
+	return r.NewDevice || r.NewLocation
+}
+
+// AssessLogin сравнивает вход с историей. Первый вход сравнивать не с чем — он не подозрительный.
+// Место сравнивается по стране и только если она известна и для входа, и хотя бы для одного прошлого входа.
+func AssessLogin(history []LoginRecord, record LoginRecord) LoginRisk {
+	if len(history) == 0 {
+		return LoginRisk{}
+	}
+
+	knownDevice, knownCountry, countriesKnown := false, false, false
+	for _, past := range history {
+		knownDevice = knownDevice || past.DeviceHash == record.DeviceHash
+		if past.Country != "" {
+			countriesKnown = true
+			knownCountry = knownCountry || past.Country == record.Country
+		}
+	}
+
+	return LoginRisk{
+		NewDevice:   !knownDevice,
+		NewLocation: record.Country != "" && countriesKnown && !knownCountry,
+	}
+}
+
+// FlagSuspiciousLogin отмечает подозрительный вход событием.
+func (u *User) FlagSuspiciousLogin(record LoginRecord, risk LoginRisk, clock Clock) {
+	u.RaiseDomainEvent(NewSuspiciousLoginDetected(u.ID(), record, risk, clock.Now()))
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/pkg/totp"
+)
+
+// Способы прохождения второго фактора
+const (
+	MFAMethodTOTP         = "totp"
+	MFAMethodRecoveryCode = "recovery_code"
+	MFAMethodWebAuthn     = "webauthn"
+)
+
+// totpSkew — сколько соседних шагов TOTP принимается из-за расхождения часов
+const totpSkew = 1
+
+var (
+	ErrMFAAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrMFANotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrTOTPNotEnrolled   = errors.New("TOTP enrollment has not been started")
+	ErrTOTPCodeInvalid   = errors.New("authentication code is invalid")
+)
+
+// IsMFAEnabled — включена ли двухфакторная аутентификация.
+func (u *User) IsMFAEnabled() bool {
+	return u.TOTPEnabledAt != nil
+}
+
+// BeginTOTPEnrollment — выпуск нового секрета TOTP. MFA включается только после
+// подтверждения первым кодом из приложения (ConfirmTOTPEnrollment); повторный вызов до этого заменяет секрет.
+func (u *User) BeginTOTPEnrollment(clock Clock) (string, error) {
+	if u.IsMFAEnabled() {
+		return "", ErrMFAAlreadyEnabled
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", err
+	}
+	u.TOTPSecret = secret
+	u.TOTPLastStep = 0
+	u.UpdatedAt = clock.Now()
+	return secret, nil
+}
+
+// ConfirmTOTPEnrollment — включение MFA после проверки первого кода.
+func (u *User) ConfirmTOTPEnrollment(code string, clock Clock) error {
+	if u.IsMFAEnabled() {
+		return ErrMFAAlreadyEnabled
+	}
+	if u.TOTPSecret == "" {
+		return ErrTOTPNotEnrolled
+	}
+	now := clock.Now()
+	step, ok := totp.Validate(u.TOTPSecret, code, now, totpSkew, u.TOTPLastStep)
+	if !ok {
+		return ErrTOTPCodeInvalid
+	}
+	u.TOTPLastStep = step
+	u.TOTPEnabledAt = &now
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserMFAEnabled(u.ID(), MFAMethodTOTP, now))
+	return nil
+}
+
+// VerifyTOTP — проверка кода на втором шаге входа. Код нельзя использовать повторно.
+func (u *User) VerifyTOTP(code string, clock Clock) error {
+	if !u.IsMFAEnabled() {
+		return ErrMFANotEnabled
+	}
+	now := clock.Now()
+	step, ok := totp.Validate(u.TOTPSecret, code, now, totpSkew, u.TOTPLastStep)
+	if !ok {
+		return ErrTOTPCodeInvalid
+	}
+	u.TOTPLastStep = step
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserMFAVerified(u.ID(), MFAMethodTOTP, now))
+	return nil
+}
+
+// UseRecoveryCode — прохождение второго шага входа кодом восстановления.
+func (u *User) UseRecoveryCode(code *RecoveryCode, clock Clock) error {
+	if !u.IsMFAEnabled() {
+		return ErrMFANotEnabled
+	}
+	if code.UserID != u.ID() {
+		return ErrRecoveryCodeInvalid
+	}
+	if err := code.Use(clock); err != nil {
+		return err
+	}
+	u.RaiseDomainEvent(NewUserMFAVerified(u.ID(), MFAMethodRecoveryCode, *code.UsedAt))
+	return nil
+}
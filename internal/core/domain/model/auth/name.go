@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// MaxNameLength — максимальная длина имени в символах (рунах) после нормализации
+	MaxNameLength = 100
+)
+
+var (
+	ErrNameTooLong            = errors.New("name must be at most 100 characters")
+	ErrNameInvalidEncoding    = errors.New("name must be valid UTF-8")
+	ErrNameControlCharacter   = errors.New("name must not contain control characters")
+	ErrNameBidiCharacter      = errors.New("name must not contain bidirectional formatting characters")
+	ErrNameInvisibleCharacter = errors.New("name must not contain invisible formatting characters")
+)
+
+// normalizeName приводит имя к NFC, схлопывает пробельные символы в один пробел, убирает
+// невидимые символы без смысла в имени и проверяет длину в рунах.
+// Управляющие и bidi-символы не вырезаются, а отклоняются: они почти всегда признак подделки
+// отображения (U+202E переворачивает текст), и молча «исправлять» такое имя нельзя.
+func normalizeName(s string) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", ErrNameInvalidEncoding
+	}
+	s = norm.NFC.String(s)
+
+	var b strings.Builder
+	b.Grow(len(s))
+	runes := []rune(s)
+	pendingSpace := false
+	for i, r := range runes {
+		switch {
+		case unicode.IsSpace(r):
+			// табуляции, переводы строк, неразрывные и «широкие» пробелы — всё это один пробел
+			pendingSpace = b.Len() > 0
+			continue
+		case isBidiControl(r):
+			return "", ErrNameBidiCharacter
+		case unicode.Is(unicode.Cc, r):
+			return "", ErrNameControlCharacter
+		case isStrippedInvisible(r):
+			continue
+		case r == '\u200c' || r == '\u200d':
+			// ZWNJ/ZWJ нужны внутри слов (персидский, деванагари, эмодзи-последовательности),
+			// но на границе слова ничего не значат
+			if !joinsCharacters(runes, i) {
+				continue
+			}
+		case unicode.Is(unicode.Cf, r) && !isEmojiTag(r):
+			return "", ErrNameInvisibleCharacter
+		}
+		if pendingSpace {
+			b.WriteByte(' ')
+			pendingSpace = false
+		}
+		b.WriteRune(r)
+	}
+
+	name := b.String()
+	if name == "" {
+		return "", ErrNameEmpty
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return "", ErrNameTooLong
+	}
+	return name, nil
+}
+
+// isBidiControl — явные метки и изоляторы направления текста
+func isBidiControl(r rune) bool {
+	switch {
+	case r == '\u061c', r == '\u200e', r == '\u200f':
+		return true
+	case r >= '\u202a' && r <= '\u202e':
+		return true
+	case r >= '\u2066' && r <= '\u2069':
+		return true
+	}
+	return false
+}
+
+// isStrippedInvisible — невидимые символы, которые попадают в имя при копировании и ничего не меняют:
+// пробел нулевой ширины, соединитель слов, BOM и мягкий перенос
+func isStrippedInvisible(r rune) bool {
+	return r == '\u200b' || r == '\u2060' || r == '\ufeff' || r == '\u00ad'
+}
+
+// isEmojiTag — теговые символы флагов-подразделений (🏴 + теги + U+E007F)
+func isEmojiTag(r rune) bool {
+	return r >= '\U000e0020' && r <= '\U000e007f'
+}
+
+// joinsCharacters — стоит ли соединитель между двумя видимыми символами
+func joinsCharacters(runes []rune, i int) bool {
+	if i == 0 || i == len(runes)-1 {
+		return false
+	}
+	prev, next := runes[i-1], runes[i+1]
+	return !unicode.IsSpace(prev) && !unicode.IsSpace(next)
+}
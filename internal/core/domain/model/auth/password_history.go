@@ -0,0 +1,29 @@
+package auth
+
+import "errors"
+
+var ErrPasswordReused = errors.New("password must not match recently used passwords")
+
+// PasswordHistory — хеши паролей, которые пользователь использовал недавно.
+// Какие записи считаются «недавними» (глубина и возраст), решает вызывающая сторона.
+type PasswordHistory struct {
+	hashes []string
+}
+
+// NewPasswordHistory собирает историю из сохранённых хешей.
+func NewPasswordHistory(hashes ...string) PasswordHistory {
+	return PasswordHistory{hashes: hashes}
+}
+
+// Contains — совпадает ли пароль хотя бы с одним хешем из истории.
+func (h PasswordHistory) Contains(raw string, hasher PasswordHasher) bool {
+	for _, hash := range h.hashes {
+		if hash != "" && hasher.Compare(hash, raw) {
+			return true
+		}
+	}
+	return false
+}
+
+// Len — количество хешей в истории.
+func (h PasswordHistory) Len() int { return len(h.hashes) }
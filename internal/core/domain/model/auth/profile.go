@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"reflect"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	// BirthDateLayout — формат даты рождения (ISO 8601, без времени)
+	BirthDateLayout = "2006-01-02"
+	// MaxAvatarURLLength — максимальная длина ссылки на аватар
+	MaxAvatarURLLength = 2048
+	// MaxMetadataSize — максимальный размер metadata в JSON, байт
+	MaxMetadataSize = 16 * 1024
+)
+
+var (
+	ErrInvalidLocale    = errors.New("locale must be a BCP 47 language tag")
+	ErrInvalidTimezone  = errors.New("timezone must be an IANA time zone name")
+	ErrInvalidAvatarURL = errors.New("avatar url must be an absolute http or https URL")
+	ErrInvalidBirthDate = errors.New("birth date must be a past date in YYYY-MM-DD format")
+	ErrMetadataTooLarge = errors.New("metadata must not exceed 16 KiB")
+)
+
+// minBirthDate — более ранние даты рождения считаются ошибкой ввода
+var minBirthDate = time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Profile — необязательные сведения о пользователе. Пустое значение поля — «не указано».
+type Profile struct {
+	// Locale — язык и регион в виде BCP 47 тега (en, pt-BR)
+	Locale string
+	// Timezone — часовой пояс из базы IANA (Europe/Berlin)
+	Timezone string
+	// AvatarURL — ссылка на изображение профиля
+	AvatarURL string
+	// BirthDate — дата рождения, полночь UTC (nil — не указана)
+	BirthDate *time.Time
+}
+
+// NewProfile проверяет и нормализует поля профиля. birthDate передаётся в формате BirthDateLayout.
+func NewProfile(locale, timezone, avatarURL, birthDate string, now time.Time) (Profile, error) {
+	var p Profile
+
+	if locale != "" {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			return Profile{}, ErrInvalidLocale
+		}
+		p.Locale = tag.String()
+	}
+
+	if timezone != "" {
+		// "Local" зависит от машины, на которой работает сервис, и для профиля бессмысленна
+		if timezone == "Local" {
+			return Profile{}, ErrInvalidTimezone
+		}
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return Profile{}, ErrInvalidTimezone
+		}
+		p.Timezone = timezone
+	}
+
+	if avatarURL != "" {
+		if len(avatarURL) > MaxAvatarURLLength {
+			return Profile{}, ErrInvalidAvatarURL
+		}
+		u, err := url.Parse(avatarURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return Profile{}, ErrInvalidAvatarURL
+		}
+		p.AvatarURL = avatarURL
+	}
+
+	if birthDate != "" {
+		date, err := time.Parse(BirthDateLayout, birthDate)
+		if err != nil || date.Before(minBirthDate) || !date.Before(now) {
+			return Profile{}, ErrInvalidBirthDate
+		}
+		p.BirthDate = &date
+	}
+
+	return p, nil
+}
+
+// BirthDateString — дата рождения в формате BirthDateLayout (пусто — не указана)
+func (p Profile) BirthDateString() string {
+	if p.BirthDate == nil {
+		return ""
+	}
+	return p.BirthDate.Format(BirthDateLayout)
+}
+
+// Equals — совпадают ли все поля профилей
+func (p Profile) Equals(other Profile) bool {
+	return p.Locale == other.Locale &&
+		p.Timezone == other.Timezone &&
+		p.AvatarURL == other.AvatarURL &&
+		p.BirthDateString() == other.BirthDateString()
+}
+
+// changedFields — имена полей, которыми профили отличаются
+func (p Profile) changedFields(other Profile) []string {
+	var fields []string
+	if p.Locale != other.Locale {
+		fields = append(fields, "locale")
+	}
+	if p.Timezone != other.Timezone {
+		fields = append(fields, "timezone")
+	}
+	if p.AvatarURL != other.AvatarURL {
+		fields = append(fields, "avatar_url")
+	}
+	if p.BirthDateString() != other.BirthDateString() {
+		fields = append(fields, "birth_date")
+	}
+	return fields
+}
+
+// ChangeProfile — замена необязательных полей профиля.
+// В событие попадают только имена изменённых полей, без значений.
+func (u *User) ChangeProfile(profile Profile, clock Clock) {
+	if u.Profile.Equals(profile) {
+		return
+	}
+	fields := u.Profile.changedFields(profile)
+	u.Profile = profile
+	now := clock.Now()
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserProfileChanged(u.ID(), fields, now))
+}
+
+// ChangeMetadata — замена произвольных данных приложения о пользователе.
+// Соответствие схеме проверяет слой приложения: схема задаётся конфигурацией.
+func (u *User) ChangeMetadata(metadata map[string]any, clock Clock) error {
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	if len(raw) > MaxMetadataSize {
+		return ErrMetadataTooLarge
+	}
+	if reflect.DeepEqual(u.Metadata, metadata) || (len(u.Metadata) == 0 && len(metadata) == 0) {
+		return nil
+	}
+
+	u.Metadata = metadata
+	now := clock.Now()
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserMetadataChanged(u.ID(), now))
+	return nil
+}
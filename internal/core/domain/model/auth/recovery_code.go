@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	RecoveryCodeCount = 10 // сколько кодов восстановления выдаётся при включении MFA
+
+	recoveryCodeBytes     = 10 // 80 бит: код хранится как SHA-256 без соли
+	recoveryCodeGroupSize = 4
+)
+
+var (
+	ErrRecoveryCodeInvalid = errors.New("recovery code is invalid or already used")
+
+	recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// RecoveryCode — одноразовый код восстановления для входа без приложения-аутентификатора.
+// Сам код показывается пользователю один раз, хранится только его хеш.
+type RecoveryCode struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	CodeHash  string
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// NewRecoveryCodes выпускает набор из n кодов и возвращает их вместе с кодами для показа пользователю.
+func NewRecoveryCodes(userID uuid.UUID, n int, clock Clock) ([]RecoveryCode, []string, error) {
+	now := clock.Now()
+	codes := make([]RecoveryCode, 0, n)
+	raw := make([]string, 0, n)
+
+	for i := 0; i < n; i++ {
+		buf := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		code := formatRecoveryCode(strings.ToLower(recoveryCodeEncoding.EncodeToString(buf)))
+
+		codes = append(codes, RecoveryCode{
+			ID:        uuid.New(),
+			UserID:    userID,
+			CodeHash:  HashRecoveryCode(code),
+			CreatedAt: now,
+		})
+		raw = append(raw, code)
+	}
+
+	return codes, raw, nil
+}
+
+// HashRecoveryCode — хеш кода, по которому он ищется в хранилище.
+// Регистр, пробелы и дефисы при вводе не важны.
+func HashRecoveryCode(code string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(code))
+	return HashVerificationSecret(normalized)
+}
+
+// Use — погашение кода; повторно использованный код отклоняется.
+func (c *RecoveryCode) Use(clock Clock) error {
+	if c.UsedAt != nil {
+		return ErrRecoveryCodeInvalid
+	}
+	now := clock.Now()
+	c.UsedAt = &now
+	return nil
+}
+
+// formatRecoveryCode разбивает код на группы для удобства переписывания: xxxx-xxxx-xxxx-xxxx.
+func formatRecoveryCode(s string) string {
+	groups := make([]string, 0, len(s)/recoveryCodeGroupSize+1)
+	for len(s) > recoveryCodeGroupSize {
+		groups = append(groups, s[:recoveryCodeGroupSize])
+		s = s[recoveryCodeGroupSize:]
+	}
+	groups = append(groups, s)
+	return strings.Join(groups, "-")
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+)
+
+// UserStatus — состояние аккаунта. Входить и пользоваться токенами может только активный аккаунт.
+type UserStatus string
+
+const (
+	// UserStatusActive — обычное состояние аккаунта
+	UserStatusActive UserStatus = "active"
+	// UserStatusSuspended — вход временно приостановлен администратором (например, на время проверки)
+	UserStatusSuspended UserStatus = "suspended"
+	// UserStatusBlocked — аккаунт заблокирован за нарушения, снять блокировку может только администратор
+	UserStatusBlocked UserStatus = "blocked"
+	// UserStatusDeactivated — аккаунт закрыт, но данные сохранены и его можно восстановить
+	UserStatusDeactivated UserStatus = "deactivated"
+)
+
+var (
+	ErrUnknownUserStatus       = errors.New("unknown user status")
+	ErrInvalidStatusTransition = errors.New("invalid status transition")
+)
+
+// userStatusTransitions — допустимые переходы между состояниями.
+// Заблокированный аккаунт нельзя закрыть или приостановить: блокировка снимается только активацией.
+var userStatusTransitions = map[UserStatus][]UserStatus{
+	UserStatusActive:      {UserStatusSuspended, UserStatusBlocked, UserStatusDeactivated},
+	UserStatusSuspended:   {UserStatusActive, UserStatusBlocked, UserStatusDeactivated},
+	UserStatusBlocked:     {UserStatusActive},
+	UserStatusDeactivated: {UserStatusActive, UserStatusBlocked},
+}
+
+// ParseUserStatus разбирает состояние аккаунта из строки.
+func ParseUserStatus(s string) (UserStatus, error) {
+	status := UserStatus(s)
+	if _, ok := userStatusTransitions[status]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownUserStatus, s)
+	}
+	return status, nil
+}
+
+// String — значение состояния для хранения и API.
+func (s UserStatus) String() string {
+	return string(s)
+}
+
+// canTransitionTo — допустим ли переход в состояние to.
+func (s UserStatus) canTransitionTo(to UserStatus) bool {
+	for _, allowed := range userStatusTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActive — может ли пользователь входить и пользоваться выданными токенами.
+func (u *User) IsActive() bool {
+	return u.Status == UserStatusActive
+}
+
+// Activate возвращает аккаунт в активное состояние (снятие приостановки, блокировки или восстановление).
+func (u *User) Activate(reason string, clock Clock) error {
+	return u.changeStatus(UserStatusActive, reason, clock)
+}
+
+// Suspend временно приостанавливает вход.
+func (u *User) Suspend(reason string, clock Clock) error {
+	return u.changeStatus(UserStatusSuspended, reason, clock)
+}
+
+// Block блокирует аккаунт.
+func (u *User) Block(reason string, clock Clock) error {
+	return u.changeStatus(UserStatusBlocked, reason, clock)
+}
+
+// Deactivate закрывает аккаунт с возможностью восстановления.
+func (u *User) Deactivate(reason string, clock Clock) error {
+	return u.changeStatus(UserStatusDeactivated, reason, clock)
+}
+
+// ChangeStatus переводит аккаунт в состояние to методом соответствующего перехода.
+func (u *User) ChangeStatus(to UserStatus, reason string, clock Clock) error {
+	switch to {
+	case UserStatusActive:
+		return u.Activate(reason, clock)
+	case UserStatusSuspended:
+		return u.Suspend(reason, clock)
+	case UserStatusBlocked:
+		return u.Block(reason, clock)
+	case UserStatusDeactivated:
+		return u.Deactivate(reason, clock)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownUserStatus, to)
+	}
+}
+
+// changeStatus выполняет переход и отмечает его событием.
+func (u *User) changeStatus(to UserStatus, reason string, clock Clock) error {
+	from := u.Status
+	if !from.canTransitionTo(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, from, to)
+	}
+
+	now := clock.Now()
+	u.Status = to
+	u.StatusReason = reason
+	u.StatusChangedAt = &now
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserStatusChanged(u.ID(), from, to, reason, now))
+	return nil
+}
@@ -17,6 +17,17 @@ const (
 var (
 	ErrNameEmpty        = errors.New("name must not be empty")
 	ErrPasswordTooShort = errors.New("password must be at least 8 characters")
+
+	ErrEmailAlreadyVerified = errors.New("email is already verified")
+	ErrPhoneAlreadyVerified = errors.New("phone is already verified")
+
+	ErrEmailUnchanged = errors.New("new email must differ from the current one")
+	ErrPhoneUnchanged = errors.New("new phone must differ from the current one")
+
+	// Уникальность проверяет слой приложения; эти ошибки он оборачивает в ошибку валидации
+	ErrEmailTaken    = errors.New("email already exists")
+	ErrPhoneTaken    = errors.New("phone already exists")
+	ErrUsernameTaken = errors.New("username already exists")
 )
 
 // PasswordHasher provides methods to hash and compare passwords.
@@ -39,6 +50,53 @@ type User struct {
 	Name         string
 	PasswordHash string
 
+	// Username — публичное имя для входа и упоминаний (нулевое значение — не задано)
+	Username kernel.Username
+
+	// Profile — необязательные сведения: язык, часовой пояс, аватар, дата рождения
+	Profile Profile
+	// Metadata — произвольные данные приложения о пользователе (JSON-объект, проверяется по схеме из конфигурации)
+	Metadata map[string]any
+
+	// EmailVerifiedAt — когда email был подтверждён (nil — не подтверждён)
+	EmailVerifiedAt *time.Time
+	// PhoneVerifiedAt — когда телефон был подтверждён кодом из SMS (nil — не подтверждён)
+	PhoneVerifiedAt *time.Time
+
+	// PasswordChangedAt — когда пароль был установлен в последний раз
+	PasswordChangedAt time.Time
+	// PasswordChangeRequired — пароль нужно сменить при следующем входе (принудительно администратором)
+	PasswordChangeRequired bool
+
+	// TOTPSecret — секрет приложения-аутентификатора в base32 (пусто — подключение не начиналось)
+	TOTPSecret string
+	// TOTPEnabledAt — когда MFA включена подтверждением первого кода (nil — выключена)
+	TOTPEnabledAt *time.Time
+	// TOTPLastStep — шаг последнего принятого кода, более ранние коды не принимаются
+	TOTPLastStep int64
+
+	// FailedLoginAttempts — неверные пароли подряд с последнего входа или блокировки
+	FailedLoginAttempts int
+	// Lockouts — число блокировок подряд, от него растёт длительность следующей
+	Lockouts int
+	// LockedUntil — до какого момента вход по паролю заблокирован (nil — не блокировался)
+	LockedUntil *time.Time
+
+	// Status — состояние аккаунта (см. UserStatus)
+	Status UserStatus
+	// StatusReason — причина последней смены состояния
+	StatusReason string
+	// StatusChangedAt — когда состояние менялось в последний раз (nil — с регистрации не менялось)
+	StatusChangedAt *time.Time
+
+	// DeletionScheduledAt — когда аккаунт будет удалён по запросу пользователя (nil — удаление не запрошено).
+	// До этого момента пользователь может войти и отменить удаление
+	DeletionScheduledAt *time.Time
+
+	// DeletedAt — когда аккаунт удалён (nil — не удалён). Удалённый аккаунт не находится поиском
+	// и стирается окончательно после срока хранения
+	DeletedAt *time.Time
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -53,8 +111,9 @@ func NewUser(
 	hasher PasswordHasher,
 	clock Clock,
 ) (User, error) {
-	if name = normalizeName(name); name == "" {
-		return User{}, ErrNameEmpty
+	name, err := normalizeName(name)
+	if err != nil {
+		return User{}, err
 	}
 	if len(rawPassword) < MinPasswordLength {
 		return User{}, ErrPasswordTooShort
@@ -74,18 +133,84 @@ func NewUser(
 		Phone:         phone,
 		Name:          name,
 		PasswordHash:  hash,
-		CreatedAt:     now,
-		UpdatedAt:     now,
+
+		PasswordChangedAt: now,
+
+		Status: UserStatusActive,
+
+		CreatedAt: now,
+		UpdatedAt: now,
 	}
 
 	u.RaiseDomainEvent(NewUserRegistered(id, email.String(), phone.String(), now))
 	return u, nil
 }
 
+// IsEmailVerified — подтверждён ли текущий email.
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// VerifyEmail — подтверждение email (по токену из письма).
+func (u *User) VerifyEmail(clock Clock) error {
+	if u.IsEmailVerified() {
+		return ErrEmailAlreadyVerified
+	}
+	now := clock.Now()
+	u.EmailVerifiedAt = &now
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserEmailVerified(u.ID(), u.Email.String(), now))
+	return nil
+}
+
+// RequestEmailChange — запрос смены email. Адрес меняется только после
+// подтверждения ссылкой, отправленной на новый адрес (ConfirmEmailChange).
+func (u *User) RequestEmailChange(newEmail kernel.Email, clock Clock) error {
+	if newEmail.Equals(u.Email) {
+		return ErrEmailUnchanged
+	}
+	u.RaiseDomainEvent(NewUserEmailChangeRequested(u.ID(), u.Email.String(), newEmail.String(), clock.Now()))
+	return nil
+}
+
+// ConfirmEmailChange — смена email после подтверждения.
+// Владение новым адресом доказано ссылкой из письма, поэтому он сразу считается подтверждённым.
+func (u *User) ConfirmEmailChange(newEmail kernel.Email, clock Clock) error {
+	if newEmail.Equals(u.Email) {
+		return ErrEmailUnchanged
+	}
+	old := u.Email
+	now := clock.Now()
+	u.Email = newEmail
+	u.EmailVerifiedAt = &now
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserEmailChanged(u.ID(), old.String(), newEmail.String(), now))
+	return nil
+}
+
+// IsPhoneVerified — подтверждён ли текущий телефон.
+func (u *User) IsPhoneVerified() bool {
+	return u.PhoneVerifiedAt != nil
+}
+
+// VerifyPhone — подтверждение телефона (по коду из SMS).
+func (u *User) VerifyPhone(clock Clock) error {
+	if u.IsPhoneVerified() {
+		return ErrPhoneAlreadyVerified
+	}
+	now := clock.Now()
+	u.PhoneVerifiedAt = &now
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserPhoneVerified(u.ID(), u.Phone.String(), now))
+	return nil
+}
+
 // ChangePhone — смена телефона (например, после подтверждения OTP).
+// Новый номер считается неподтверждённым.
 func (u *User) ChangePhone(newPhone kernel.Phone, clock Clock) {
 	old := u.Phone
 	u.Phone = newPhone
+	u.PhoneVerifiedAt = nil
 	now := clock.Now()
 	u.UpdatedAt = now
 	u.RaiseDomainEvent(NewUserPhoneChanged(u.ID(), old.String(), newPhone.String(), now))
@@ -93,9 +218,9 @@ func (u *User) ChangePhone(newPhone kernel.Phone, clock Clock) {
 
 // ChangeName — обновление отображаемого имени.
 func (u *User) ChangeName(newName string, clock Clock) error {
-	newName = normalizeName(newName)
-	if newName == "" {
-		return ErrNameEmpty
+	newName, err := normalizeName(newName)
+	if err != nil {
+		return err
 	}
 	if newName == u.Name {
 		return nil
@@ -108,22 +233,64 @@ func (u *User) ChangeName(newName string, clock Clock) error {
 	return nil
 }
 
+// ChangeUsername — установка, смена или удаление (нулевое значение) публичного имени.
+// Уникальность проверяет слой приложения.
+func (u *User) ChangeUsername(username kernel.Username, clock Clock) {
+	if username.Equals(u.Username) {
+		return
+	}
+	old := u.Username
+	u.Username = username
+	now := clock.Now()
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserUsernameChanged(u.ID(), old.String(), username.String(), now))
+}
+
 // SetPassword — смена пароля (с валидацией и перезаписью хеша).
-func (u *User) SetPassword(rawPassword string, hasher PasswordHasher, clock Clock) error {
+// Новый пароль не должен совпадать ни с текущим, ни с паролями из history.
+func (u *User) SetPassword(rawPassword string, history PasswordHistory, hasher PasswordHasher, clock Clock) error {
 	if len(rawPassword) < MinPasswordLength {
 		return ErrPasswordTooShort
 	}
+	if u.VerifyPassword(rawPassword, hasher) || history.Contains(rawPassword, hasher) {
+		return ErrPasswordReused
+	}
 	hash, err := hasher.Hash(rawPassword)
 	if err != nil {
 		return err
 	}
 	u.PasswordHash = hash
 	now := clock.Now()
+	u.PasswordChangedAt = now
+	u.PasswordChangeRequired = false
 	u.UpdatedAt = now
 	u.RaiseDomainEvent(NewUserPasswordChanged(u.ID(), now))
 	return nil
 }
 
+// PasswordExpired — пароль нужно сменить перед выдачей токенов: либо смена
+// запрошена принудительно, либо с последней смены прошло не меньше maxAge (0 — срок не ограничен).
+func (u *User) PasswordExpired(maxAge time.Duration, now time.Time) bool {
+	if u.PasswordChangeRequired {
+		return true
+	}
+	if maxAge <= 0 {
+		return false
+	}
+	return !now.Before(u.PasswordChangedAt.Add(maxAge))
+}
+
+// RequirePasswordChange — принудительная смена пароля при следующем входе.
+func (u *User) RequirePasswordChange(clock Clock) {
+	if u.PasswordChangeRequired {
+		return
+	}
+	u.PasswordChangeRequired = true
+	now := clock.Now()
+	u.UpdatedAt = now
+	u.RaiseDomainEvent(NewUserPasswordChangeRequired(u.ID(), now))
+}
+
 // VerifyPassword — проверка пароля при логине.
 func (u *User) VerifyPassword(raw string, hasher PasswordHasher) bool {
 	if u.PasswordHash == "" {
@@ -136,19 +303,3 @@ func (u *User) VerifyPassword(raw string, hasher PasswordHasher) bool {
 func (u *User) MarkLoggedIn(clock Clock) {
 	u.RaiseDomainEvent(NewUserLoggedIn(u.ID(), clock.Now()))
 }
-
-// Вспомогательные функции
-func normalizeName(s string) string {
-	// лёгкая нормализация; можно добавить unicode.TrimSpace/Title
-	if len(s) == 0 {
-		return s
-	}
-	// обрезка пробелов
-	for len(s) > 0 && (s[0] == ' ' || s[0] == '\t' || s[0] == '\n') {
-		s = s[1:]
-	}
-	for len(s) > 0 && (s[len(s)-1] == ' ' || s[len(s)-1] == '\t' || s[len(s)-1] == '\n') {
-		s = s[:len(s)-1]
-	}
-	return s
-}
@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VerificationPurpose — для какой операции выпущен одноразовый токен
+type VerificationPurpose string
+
+const (
+	VerificationPurposeEmail       VerificationPurpose = "email_verification"
+	VerificationPurposePhone       VerificationPurpose = "phone_verification"
+	VerificationPurposeEmailChange VerificationPurpose = "email_change"  // Target — новый адрес
+	VerificationPurposePhoneChange VerificationPurpose = "phone_change"  // Target — новый номер
+	VerificationPurposeMFA         VerificationPurpose = "mfa_challenge" // второй шаг входа, Target не используется
+	VerificationPurposeMagicLink   VerificationPurpose = "magic_link"    // вход по ссылке из письма, Target — адрес получателя
+	VerificationPurposePhoneLogin  VerificationPurpose = "phone_login"   // вход по коду из SMS, Target — номер получателя
+	// challenge церемоний WebAuthn — сам секрет токена; Target не используется
+	VerificationPurposeWebAuthnRegistration VerificationPurpose = "webauthn_registration"
+	VerificationPurposeWebAuthnLogin        VerificationPurpose = "webauthn_login" // UserID — uuid.Nil: пользователь определяется по ключу
+)
+
+const (
+	verificationSecretBytes = 32
+	VerificationCodeDigits  = 6 // длина одноразового кода (OTP)
+)
+
+var (
+	ErrVerificationTokenInvalid     = errors.New("verification token is invalid or expired")
+	ErrVerificationCodeMismatch     = errors.New("verification code is invalid")
+	ErrVerificationAttemptsExceeded = errors.New("too many invalid attempts, request a new code")
+)
+
+// VerificationToken — одноразовый токен подтверждения (ссылка из письма, код из SMS).
+// Секрет отдаётся пользователю один раз, хранится только его хеш:
+// SHA-256 для длинных токенов-ссылок, PasswordHasher для коротких кодов.
+type VerificationToken struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Purpose    VerificationPurpose
+	Target     string // что подтверждается (например, email или телефон на момент выпуска)
+	SecretHash string
+	Attempts   int // неудачные попытки ввода кода
+	ExpiresAt  time.Time
+	UsedAt     *time.Time
+	CreatedAt  time.Time
+}
+
+// NewVerificationToken выпускает токен и возвращает его вместе с секретом для отправки пользователю.
+func NewVerificationToken(
+	userID uuid.UUID,
+	purpose VerificationPurpose,
+	target string,
+	ttl time.Duration,
+	clock Clock,
+) (VerificationToken, string, error) {
+	buf := make([]byte, verificationSecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return VerificationToken{}, "", err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(buf)
+
+	now := clock.Now()
+	return VerificationToken{
+		ID:         uuid.New(),
+		UserID:     userID,
+		Purpose:    purpose,
+		Target:     target,
+		SecretHash: HashVerificationSecret(secret),
+		ExpiresAt:  now.Add(ttl),
+		CreatedAt:  now,
+	}, secret, nil
+}
+
+// NewVerificationCode выпускает короткий цифровой код (OTP) и возвращает его для отправки пользователю.
+func NewVerificationCode(
+	userID uuid.UUID,
+	purpose VerificationPurpose,
+	target string,
+	ttl time.Duration,
+	hasher PasswordHasher,
+	clock Clock,
+) (VerificationToken, string, error) {
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(VerificationCodeDigits), nil)
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return VerificationToken{}, "", err
+	}
+	code := fmt.Sprintf("%0*d", VerificationCodeDigits, n.Int64())
+
+	hash, err := hasher.Hash(code)
+	if err != nil {
+		return VerificationToken{}, "", err
+	}
+
+	now := clock.Now()
+	return VerificationToken{
+		ID:         uuid.New(),
+		UserID:     userID,
+		Purpose:    purpose,
+		Target:     target,
+		SecretHash: hash,
+		ExpiresAt:  now.Add(ttl),
+		CreatedAt:  now,
+	}, code, nil
+}
+
+// HashVerificationSecret — хеш секрета, по которому токен ищется в хранилище
+func HashVerificationSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Consume — погашение токена; повторно использованный или просроченный токен отклоняется.
+func (t *VerificationToken) Consume(clock Clock) error {
+	now := clock.Now()
+	if !t.Active(now) {
+		return ErrVerificationTokenInvalid
+	}
+	t.UsedAt = &now
+	return nil
+}
+
+// Active — токен ещё можно погасить.
+func (t *VerificationToken) Active(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
+
+// ConsumeCode — погашение кода с учётом лимита попыток.
+// При неверном коде увеличивает Attempts: изменение нужно сохранить, даже если операция завершилась ошибкой.
+func (t *VerificationToken) ConsumeCode(code string, maxAttempts int, hasher PasswordHasher, clock Clock) error {
+	now := clock.Now()
+	if err := t.CheckAttempt(now, maxAttempts); err != nil {
+		return err
+	}
+	if !hasher.Compare(t.SecretHash, code) {
+		t.RecordFailedAttempt()
+		return ErrVerificationCodeMismatch
+	}
+	t.UsedAt = &now
+	return nil
+}
+
+// CheckAttempt — можно ли ещё предъявить ответ по токену: он активен и лимит попыток не исчерпан.
+// Для токенов, ответ на которые проверяется вне токена (например, код TOTP на втором шаге входа).
+func (t *VerificationToken) CheckAttempt(now time.Time, maxAttempts int) error {
+	if !t.Active(now) {
+		return ErrVerificationTokenInvalid
+	}
+	if maxAttempts > 0 && t.Attempts >= maxAttempts {
+		return ErrVerificationAttemptsExceeded
+	}
+	return nil
+}
+
+// RecordFailedAttempt — учёт неверного ответа; изменение нужно сохранить.
+func (t *VerificationToken) RecordFailedAttempt() {
+	t.Attempts++
+}
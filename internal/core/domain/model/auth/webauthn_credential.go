@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultWebAuthnCredentialName = "Passkey"
+	MaxWebAuthnCredentialName     = 64 // символов
+)
+
+var (
+	ErrWebAuthnCredentialInvalid  = errors.New("passkey is not registered for this user")
+	ErrWebAuthnCredentialCloned   = errors.New("passkey signature counter did not increase, the authenticator may be cloned")
+	ErrWebAuthnCredentialNameLong = errors.New("passkey name must be at most 64 characters")
+)
+
+// WebAuthnCredential — ключ WebAuthn (passkey или аппаратный ключ), привязанный к пользователю.
+// Хранится только открытый ключ, закрытый не покидает аутентификатор.
+type WebAuthnCredential struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	CredentialID []byte // идентификатор, выданный аутентификатором
+	PublicKey    []byte // COSE_Key
+	SignCount    uint32 // последнее значение счётчика подписей
+	Name         string // подпись для пользователя ("MacBook", "YubiKey")
+	CreatedAt    time.Time
+	LastUsedAt   *time.Time
+}
+
+// RegisterWebAuthnCredential — привязка нового ключа после успешной церемонии регистрации.
+func (u *User) RegisterWebAuthnCredential(
+	credentialID, publicKey []byte,
+	signCount uint32,
+	name string,
+	clock Clock,
+) (WebAuthnCredential, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = DefaultWebAuthnCredentialName
+	}
+	if utf8.RuneCountInString(name) > MaxWebAuthnCredentialName {
+		return WebAuthnCredential{}, ErrWebAuthnCredentialNameLong
+	}
+
+	now := clock.Now()
+	cred := WebAuthnCredential{
+		ID:           uuid.New(),
+		UserID:       u.ID(),
+		CredentialID: credentialID,
+		PublicKey:    publicKey,
+		SignCount:    signCount,
+		Name:         name,
+		CreatedAt:    now,
+	}
+
+	u.RaiseDomainEvent(NewUserWebAuthnCredentialRegistered(
+		u.ID(), base64.RawURLEncoding.EncodeToString(credentialID), name, now,
+	))
+	return cred, nil
+}
+
+// AuthenticateWithWebAuthn — вход по passkey без пароля (подпись уже проверена).
+// Событие входа поднимает MarkLoggedIn.
+func (u *User) AuthenticateWithWebAuthn(cred *WebAuthnCredential, signCount uint32, clock Clock) error {
+	if cred.UserID != u.ID() {
+		return ErrWebAuthnCredentialInvalid
+	}
+	return cred.recordUse(signCount, clock)
+}
+
+// VerifyWebAuthn — прохождение второго шага входа ключом WebAuthn (подпись уже проверена).
+func (u *User) VerifyWebAuthn(cred *WebAuthnCredential, signCount uint32, clock Clock) error {
+	if !u.IsMFAEnabled() {
+		return ErrMFANotEnabled
+	}
+	if err := u.AuthenticateWithWebAuthn(cred, signCount, clock); err != nil {
+		return err
+	}
+	u.RaiseDomainEvent(NewUserMFAVerified(u.ID(), MFAMethodWebAuthn, *cred.LastUsedAt))
+	return nil
+}
+
+// recordUse — учёт использования ключа. Счётчик подписей должен расти;
+// если аутентификатор его не ведёт (оба значения 0), проверка пропускается.
+func (c *WebAuthnCredential) recordUse(signCount uint32, clock Clock) error {
+	if (signCount != 0 || c.SignCount != 0) && signCount <= c.SignCount {
+		return ErrWebAuthnCredentialCloned
+	}
+	now := clock.Now()
+	c.SignCount = signCount
+	c.LastUsedAt = &now
+	return nil
+}
@@ -3,8 +3,13 @@ package kernel
 import (
 	"errors"
 	"fmt"
-	"regexp"
+	"slices"
 	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/text/unicode/norm"
 )
 
 var (
@@ -12,23 +17,225 @@ var (
 	ErrEmailInvalid = errors.New("email is invalid")
 )
 
-var emailRe = regexp.MustCompile(`^[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}$`)
+const (
+	// maxEmailLocalLength — предел локальной части в октетах (RFC 5321)
+	maxEmailLocalLength = 64
+	// maxEmailLength — предел всего адреса с доменом в punycode
+	maxEmailLength = 254
+)
+
+// emailASCIISpecials — ASCII-символы локальной части помимо букв и цифр (atext из RFC 5322)
+const emailASCIISpecials = "!#$%&'*+-/=?^_`{|}~"
 
+// emailDomainProfile — проверка домена по IDNA 2008 и перевод в punycode
+var emailDomainProfile = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.VerifyDNSLength(true),
+	idna.Transitional(false),
+)
+
+// Email — адрес электронной почты (RFC 6531: локальная часть в UTF-8, домен IDN или punycode).
+// Хранит две формы: отображаемую — как ввёл пользователь, с доменом в Unicode и нижнем регистре, —
+// и каноническую, по которой адреса сравниваются и проверяются на уникальность.
 type Email struct {
-	value string
+	value     string
+	canonical string
 }
 
+// NewEmail разбирает адрес. Каноническая форма — локальная часть в нижнем регистре и домен в punycode;
+// правила почтовых сервисов (метки после «+», точки) накладывает EmailCanonicalization.
+// Локальные части в кавычках не поддерживаются.
 func NewEmail(s string) (Email, error) {
-	s = strings.TrimSpace(strings.ToLower(s))
+	s = strings.TrimSpace(s)
 	if s == "" {
 		return Email{}, ErrEmailEmpty
 	}
-	if !emailRe.MatchString(s) {
+
+	at := strings.LastIndexByte(s, '@')
+	if at <= 0 || at == len(s)-1 {
+		return Email{}, fmt.Errorf("%w: %q", ErrEmailInvalid, s)
+	}
+
+	local := norm.NFC.String(s[:at])
+	if !validEmailLocalPart(local) {
+		return Email{}, fmt.Errorf("%w: %q", ErrEmailInvalid, s)
+	}
+
+	asciiDomain, err := emailDomainProfile.ToASCII(s[at+1:])
+	if err != nil || !validEmailDomain(asciiDomain) || len(local)+1+len(asciiDomain) > maxEmailLength {
+		return Email{}, fmt.Errorf("%w: %q", ErrEmailInvalid, s)
+	}
+	unicodeDomain, err := emailDomainProfile.ToUnicode(asciiDomain)
+	if err != nil {
 		return Email{}, fmt.Errorf("%w: %q", ErrEmailInvalid, s)
 	}
-	return Email{value: s}, nil
+
+	return Email{
+		value:     local + "@" + unicodeDomain,
+		canonical: strings.ToLower(local) + "@" + asciiDomain,
+	}, nil
+}
+
+// RestoreEmail восстанавливает адрес из хранилища вместе с канонической формой, вычисленной при сохранении
+// (пусто — базовая форма NewEmail)
+func RestoreEmail(value, canonical string) (Email, error) {
+	e, err := NewEmail(value)
+	if err != nil {
+		return Email{}, err
+	}
+	if canonical != "" {
+		e.canonical = canonical
+	}
+	return e, nil
+}
+
+// validEmailLocalPart — dot-atom из ASCII atext и любых печатных символов за пределами ASCII
+func validEmailLocalPart(local string) bool {
+	if local == "" || len(local) > maxEmailLocalLength || !utf8.ValidString(local) {
+		return false
+	}
+	if local[0] == '.' || local[len(local)-1] == '.' || strings.Contains(local, "..") {
+		return false
+	}
+	for _, r := range local {
+		if r >= utf8.RuneSelf {
+			if !unicode.IsGraphic(r) || unicode.IsSpace(r) {
+				return false
+			}
+			continue
+		}
+		if !isASCIILetterOrDigit(r) && r != '.' && !strings.ContainsRune(emailASCIISpecials, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// validEmailDomain — домен в punycode: не меньше двух меток, домен верхнего уровня из букв или IDN
+func validEmailDomain(domain string) bool {
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" {
+			return false
+		}
+	}
+
+	tld := labels[len(labels)-1]
+	if strings.HasPrefix(tld, "xn--") {
+		return true
+	}
+	if len(tld) < 2 {
+		return false
+	}
+	for _, r := range tld {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
 }
 
+func isASCIILetterOrDigit(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+// String — отображаемая форма адреса
 func (e Email) String() string { return e.value }
 
-func (e Email) Equals(other Email) bool { return e.value == other.value }
+// Canonical — каноническая форма: по ней адреса сравниваются и ищутся
+func (e Email) Canonical() string { return e.canonical }
+
+// Equals — один и тот же ли это ящик (по канонической форме)
+func (e Email) Equals(other Email) bool { return e.canonical == other.canonical }
+
+// EmailCanonicalization — правила, по которым разные написания считаются одним ящиком.
+// Применяются поверх базовой канонической формы NewEmail. Домены указываются в нижнем регистре, в punycode.
+type EmailCanonicalization struct {
+	// StripPlusTags — метка после «+» в локальной части не различает ящики (john+news@ → john@)
+	StripPlusTags bool
+	// DotInsensitiveDomains — домены, где точки в локальной части не значимы (gmail.com)
+	DotInsensitiveDomains []string
+	// DomainAliases — домен → основной домен того же сервиса (googlemail.com → gmail.com)
+	DomainAliases map[string]string
+}
+
+// NewEmailCanonicalization собирает правила, приводя домены к нижнему регистру и punycode
+func NewEmailCanonicalization(
+	stripPlusTags bool,
+	dotInsensitiveDomains []string,
+	domainAliases map[string]string,
+) (EmailCanonicalization, error) {
+	c := EmailCanonicalization{StripPlusTags: stripPlusTags}
+	for _, domain := range dotInsensitiveDomains {
+		ascii, err := canonicalEmailDomain(domain)
+		if err != nil {
+			return EmailCanonicalization{}, err
+		}
+		c.DotInsensitiveDomains = append(c.DotInsensitiveDomains, ascii)
+	}
+	for alias, domain := range domainAliases {
+		asciiAlias, err := canonicalEmailDomain(alias)
+		if err != nil {
+			return EmailCanonicalization{}, err
+		}
+		asciiDomain, err := canonicalEmailDomain(domain)
+		if err != nil {
+			return EmailCanonicalization{}, err
+		}
+		if c.DomainAliases == nil {
+			c.DomainAliases = make(map[string]string, len(domainAliases))
+		}
+		c.DomainAliases[asciiAlias] = asciiDomain
+	}
+	return c, nil
+}
+
+// canonicalEmailDomain — домен в той же форме, что в канонической форме адреса
+func canonicalEmailDomain(domain string) (string, error) {
+	ascii, err := emailDomainProfile.ToASCII(strings.TrimSpace(domain))
+	if err != nil || !validEmailDomain(ascii) {
+		return "", fmt.Errorf("%w: domain %q", ErrEmailInvalid, domain)
+	}
+	return ascii, nil
+}
+
+// NewEmail разбирает адрес и вычисляет его каноническую форму по правилам
+func (c EmailCanonicalization) NewEmail(s string) (Email, error) {
+	e, err := NewEmail(s)
+	if err != nil {
+		return Email{}, err
+	}
+	return c.Apply(e), nil
+}
+
+// Apply пересчитывает каноническую форму адреса по правилам; отображаемая форма не меняется
+func (c EmailCanonicalization) Apply(e Email) Email {
+	base, err := NewEmail(e.value)
+	if err != nil {
+		return e
+	}
+
+	at := strings.LastIndexByte(base.canonical, '@')
+	local, domain := base.canonical[:at], base.canonical[at+1:]
+
+	if alias, ok := c.DomainAliases[domain]; ok {
+		domain = alias
+	}
+	if c.StripPlusTags {
+		if i := strings.IndexByte(local, '+'); i > 0 {
+			local = local[:i]
+		}
+	}
+	if slices.Contains(c.DotInsensitiveDomains, domain) {
+		if stripped := strings.ReplaceAll(local, ".", ""); stripped != "" {
+			local = stripped
+		}
+	}
+
+	e.canonical = local + "@" + domain
+	return e
+}
@@ -3,33 +3,140 @@ package kernel
 import (
 	"errors"
 	"fmt"
-	"regexp"
 	"strings"
+	"unicode"
+
+	"github.com/nyaruka/phonenumbers"
 )
 
 var (
-	ErrPhoneEmpty   = errors.New("phone number is empty")
-	ErrPhoneInvalid = errors.New("phone number is invalid")
+	ErrPhoneEmpty         = errors.New("phone number is empty")
+	ErrPhoneInvalid       = errors.New("phone number is invalid")
+	ErrPhoneRegionUnknown = errors.New("phone region is unknown")
+)
+
+// PhoneType — тип номера по плану нумерации страны
+type PhoneType string
+
+const (
+	PhoneTypeFixedLine         PhoneType = "fixed_line"
+	PhoneTypeMobile            PhoneType = "mobile"
+	PhoneTypeFixedLineOrMobile PhoneType = "fixed_line_or_mobile" // план не различает (США, Канада)
+	PhoneTypeTollFree          PhoneType = "toll_free"
+	PhoneTypePremiumRate       PhoneType = "premium_rate"
+	PhoneTypeSharedCost        PhoneType = "shared_cost"
+	PhoneTypeVoIP              PhoneType = "voip"
+	PhoneTypePersonalNumber    PhoneType = "personal_number"
+	PhoneTypePager             PhoneType = "pager"
+	PhoneTypeUAN               PhoneType = "uan"
+	PhoneTypeVoicemail         PhoneType = "voicemail"
+	PhoneTypeUnknown           PhoneType = "unknown"
 )
 
-// Простое регулярное выражение для международного формата (минимум 7 цифр после +)
-var phoneRe = regexp.MustCompile(`^\+[1-9]\d{6,14}$`)
+var phoneTypes = map[phonenumbers.PhoneNumberType]PhoneType{
+	phonenumbers.FIXED_LINE:           PhoneTypeFixedLine,
+	phonenumbers.MOBILE:               PhoneTypeMobile,
+	phonenumbers.FIXED_LINE_OR_MOBILE: PhoneTypeFixedLineOrMobile,
+	phonenumbers.TOLL_FREE:            PhoneTypeTollFree,
+	phonenumbers.PREMIUM_RATE:         PhoneTypePremiumRate,
+	phonenumbers.SHARED_COST:          PhoneTypeSharedCost,
+	phonenumbers.VOIP:                 PhoneTypeVoIP,
+	phonenumbers.PERSONAL_NUMBER:      PhoneTypePersonalNumber,
+	phonenumbers.PAGER:                PhoneTypePager,
+	phonenumbers.UAN:                  PhoneTypeUAN,
+	phonenumbers.VOICEMAIL:            PhoneTypeVoicemail,
+}
+
+// phoneSeparators — символы, которыми люди разбивают номер; кроме них допускаются только цифры и «+» в начале
+const phoneSeparators = " -(). /"
 
+// Phone — номер телефона в E.164 (+12015550123). Хранится и сравнивается только в этой форме.
 type Phone struct {
 	value string
+	kind  PhoneType
 }
 
+// NewPhone разбирает номер в международном формате: «+», код страны и номер, можно с пробелами,
+// дефисами и скобками. Номер проверяется по плану нумерации страны.
 func NewPhone(s string) (Phone, error) {
+	return parsePhone(s, "")
+}
+
+// RestorePhone восстанавливает номер, сохранённый в E.164, без проверки по плану нумерации:
+// планы меняются, а однажды принятый номер должен читаться всегда
+func RestorePhone(value string) (Phone, error) {
+	if value == "" {
+		return Phone{}, ErrPhoneEmpty
+	}
+	if !strings.HasPrefix(value, "+") {
+		return Phone{}, fmt.Errorf("%w: %q is not in E.164 format", ErrPhoneInvalid, value)
+	}
+
+	p := Phone{value: value, kind: PhoneTypeUnknown}
+	if num, err := phonenumbers.Parse(value, ""); err == nil {
+		p.kind = phoneTypeOf(num)
+	}
+	return p, nil
+}
+
+// parsePhone разбирает номер; без «+» в начале он считается национальным номером региона region
+func parsePhone(s, region string) (Phone, error) {
 	s = strings.TrimSpace(s)
 	if s == "" {
 		return Phone{}, ErrPhoneEmpty
 	}
-	if !phoneRe.MatchString(s) {
-		return Phone{}, fmt.Errorf("%w: %q (expected format: +1234567890)", ErrPhoneInvalid, s)
+	for i, r := range s {
+		if unicode.IsDigit(r) || strings.ContainsRune(phoneSeparators, r) || (r == '+' && i == 0) {
+			continue
+		}
+		return Phone{}, fmt.Errorf("%w: %q contains %q", ErrPhoneInvalid, s, r)
 	}
-	return Phone{value: s}, nil
+	if region == "" && !strings.HasPrefix(s, "+") {
+		return Phone{}, fmt.Errorf("%w: %q (expected international format: +12015550123)", ErrPhoneInvalid, s)
+	}
+
+	num, err := phonenumbers.Parse(s, region)
+	if err != nil || !phonenumbers.IsValidNumber(num) {
+		return Phone{}, fmt.Errorf("%w: %q is not a valid number", ErrPhoneInvalid, s)
+	}
+
+	return Phone{
+		value: phonenumbers.Format(num, phonenumbers.E164),
+		kind:  phoneTypeOf(num),
+	}, nil
+}
+
+func phoneTypeOf(num *phonenumbers.PhoneNumber) PhoneType {
+	if kind, ok := phoneTypes[phonenumbers.GetNumberType(num)]; ok {
+		return kind
+	}
+	return PhoneTypeUnknown
 }
 
 func (p Phone) String() string { return p.value }
 
+// Type — тип номера: мобильный, городской, бесплатный и т. д.
+func (p Phone) Type() PhoneType { return p.kind }
+
 func (p Phone) Equals(other Phone) bool { return p.value == other.value }
+
+// PhoneNumbering — как понимать номера, введённые без кода страны
+type PhoneNumbering struct {
+	// DefaultRegion — регион ISO 3166-1 (US, GB, RU) для номеров в национальном формате;
+	// пусто — принимается только международный формат
+	DefaultRegion string
+}
+
+// NewPhoneNumbering проверяет, что регион по умолчанию известен плану нумерации
+func NewPhoneNumbering(defaultRegion string) (PhoneNumbering, error) {
+	region := strings.ToUpper(strings.TrimSpace(defaultRegion))
+	if region != "" && phonenumbers.GetCountryCodeForRegion(region) == 0 {
+		return PhoneNumbering{}, fmt.Errorf("%w: %q", ErrPhoneRegionUnknown, defaultRegion)
+	}
+	return PhoneNumbering{DefaultRegion: region}, nil
+}
+
+// NewPhone разбирает номер в международном или национальном формате региона по умолчанию
+func (n PhoneNumbering) NewPhone(s string) (Phone, error) {
+	return parsePhone(s, n.DefaultRegion)
+}
@@ -0,0 +1,168 @@
+package kernel
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	MinUsernameLength = 3
+	MaxUsernameLength = 30
+)
+
+var (
+	ErrUsernameEmpty      = errors.New("username is empty")
+	ErrUsernameInvalid    = errors.New("username is invalid")
+	ErrUsernameReserved   = errors.New("username is reserved")
+	ErrUsernameConfusable = errors.New("username mixes look-alike characters")
+)
+
+// reservedUsernames — имена, которые выглядят как служебные. Сравниваются без разделителей («ad.min» тоже занято).
+var reservedUsernames = map[string]struct{}{
+	"abuse": {}, "admin": {}, "administrator": {}, "anonymous": {}, "api": {}, "auth": {},
+	"everyone": {}, "help": {}, "hostmaster": {}, "info": {}, "login": {}, "logout": {},
+	"me": {}, "mod": {}, "moderator": {}, "noreply": {}, "null": {}, "official": {},
+	"owner": {}, "postmaster": {}, "register": {}, "root": {}, "security": {}, "settings": {},
+	"signup": {}, "staff": {}, "support": {}, "system": {}, "undefined": {}, "webmaster": {},
+	"www": {},
+}
+
+// latinLookalikes — строчные буквы кириллицы и греческого, неотличимые от латинских.
+// Имя, целиком набранное такими буквами («рос», «аре»), читается как латинское.
+var latinLookalikes = map[rune]struct{}{
+	// кириллица
+	'а': {}, 'е': {}, 'о': {}, 'р': {}, 'с': {}, 'у': {}, 'х': {}, 'і': {}, 'ј': {}, 'ѕ': {},
+	'һ': {}, 'ӏ': {}, 'ԁ': {}, 'ԛ': {}, 'ԝ': {},
+	// греческий
+	'α': {}, 'ι': {}, 'κ': {}, 'ν': {}, 'ο': {}, 'ρ': {}, 'υ': {}, 'χ': {},
+}
+
+// maxCombiningMarks — сколько диакритических знаков подряд допускается после буквы
+const maxCombiningMarks = 2
+
+var usernameFolder = cases.Fold()
+
+// Username — публичное имя пользователя (handle). Хранится в нормализованном виде:
+// NFKC и свёртка регистра, поэтому «Alice», «ALICE» и «Ａｌｉｃｅ» — одно и то же имя.
+// Нулевое значение — имя не задано.
+type Username struct {
+	value string
+}
+
+// NewUsername нормализует и проверяет имя: 3–30 символов, буквы одной письменности,
+// цифры 0–9, «_» и «.»; начинается с буквы, разделители не стоят подряд и в конце.
+func NewUsername(s string) (Username, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Username{}, ErrUsernameEmpty
+	}
+	s = norm.NFKC.String(usernameFolder.String(norm.NFKC.String(s)))
+
+	if n := utf8.RuneCountInString(s); n < MinUsernameLength || n > MaxUsernameLength {
+		return Username{}, fmt.Errorf("%w: must be %d to %d characters long", ErrUsernameInvalid, MinUsernameLength, MaxUsernameLength)
+	}
+	if err := checkUsernameCharacters(s); err != nil {
+		return Username{}, err
+	}
+	if err := checkUsernameScript(s); err != nil {
+		return Username{}, err
+	}
+
+	skeleton := strings.NewReplacer(".", "", "_", "").Replace(s)
+	if _, ok := reservedUsernames[skeleton]; ok {
+		return Username{}, fmt.Errorf("%w: %q", ErrUsernameReserved, s)
+	}
+
+	return Username{value: s}, nil
+}
+
+// checkUsernameCharacters — допустимые символы и их порядок
+func checkUsernameCharacters(s string) error {
+	var prev rune
+	marks := 0
+	for i, r := range s {
+		switch {
+		case unicode.IsLetter(r):
+			marks = 0
+		case unicode.In(r, unicode.Mn, unicode.Mc):
+			// Диакритика только после буквы и без нагромождений
+			marks++
+			if i == 0 || marks > maxCombiningMarks || !(unicode.IsLetter(prev) || unicode.In(prev, unicode.Mn, unicode.Mc)) {
+				return fmt.Errorf("%w: misplaced combining mark", ErrUsernameInvalid)
+			}
+		case r >= '0' && r <= '9':
+			// Только ASCII-цифры: цифры других систем легко спутать
+			if i == 0 {
+				return fmt.Errorf("%w: must start with a letter", ErrUsernameInvalid)
+			}
+			marks = 0
+		case r == '_' || r == '.':
+			if i == 0 {
+				return fmt.Errorf("%w: must start with a letter", ErrUsernameInvalid)
+			}
+			if prev == '_' || prev == '.' {
+				return fmt.Errorf("%w: separators must not follow each other", ErrUsernameInvalid)
+			}
+			marks = 0
+		default:
+			return fmt.Errorf("%w: only letters, digits 0-9, \"_\" and \".\" are allowed", ErrUsernameInvalid)
+		}
+		prev = r
+	}
+	if prev == '_' || prev == '.' {
+		return fmt.Errorf("%w: must not end with a separator", ErrUsernameInvalid)
+	}
+	return nil
+}
+
+// checkUsernameScript — все буквы из одной письменности (японские хирагана, катакана и иероглифы — одна),
+// и имя не состоит целиком из кириллических или греческих двойников латинских букв
+func checkUsernameScript(s string) error {
+	script := ""
+	lookalikesOnly := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		rs := scriptOf(r)
+		if script == "" {
+			script = rs
+		} else if rs != script {
+			return fmt.Errorf("%w: letters from %s and %s", ErrUsernameConfusable, script, rs)
+		}
+		if _, ok := latinLookalikes[r]; !ok {
+			lookalikesOnly = false
+		}
+	}
+	if lookalikesOnly && (script == "Cyrillic" || script == "Greek") {
+		return fmt.Errorf("%w: %s letters that read as Latin", ErrUsernameConfusable, script)
+	}
+	return nil
+}
+
+// scriptOf — письменность буквы по таблицам Unicode
+func scriptOf(r rune) string {
+	for name, table := range unicode.Scripts {
+		if name == "Common" || name == "Inherited" || !unicode.Is(table, r) {
+			continue
+		}
+		if name == "Hiragana" || name == "Katakana" {
+			return "Han"
+		}
+		return name
+	}
+	return "Common"
+}
+
+func (u Username) String() string { return u.value }
+
+// IsZero — имя не задано
+func (u Username) IsZero() bool { return u.value == "" }
+
+func (u Username) Equals(other Username) bool { return u.value == other.value }
@@ -0,0 +1,16 @@
+package ports
+
+import "context"
+
+// CaptchaVerifier проверяет токен, который клиент получил, пройдя CAPTCHA
+type CaptchaVerifier interface {
+	// Verify возвращает false, если токен недействителен; ошибка — только при сбое проверки
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
+
+// AttemptCounter считает неудачные попытки по ключу (IP, аккаунт) за ограниченный период
+type AttemptCounter interface {
+	Count(ctx context.Context, key string) (int, error)
+	Add(ctx context.Context, key string) error
+	Reset(ctx context.Context, key string) error
+}
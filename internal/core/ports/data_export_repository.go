@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+
+	"github.com/google/uuid"
+)
+
+type DataExportRepository interface {
+	// Create — сохранение выгрузки, поставленной в очередь
+	Create(export *auth.DataExport) error
+
+	// GetByID — поиск выгрузки по ID
+	GetByID(id uuid.UUID) (*auth.DataExport, error)
+
+	// GetLatest — последняя выгрузка пользователя (в любом состоянии)
+	GetLatest(userID uuid.UUID) (*auth.DataExport, error)
+
+	// ListPending — несобранные выгрузки в порядке создания (не больше limit)
+	ListPending(limit int) ([]auth.DataExport, error)
+
+	// Update — сохранение изменений выгрузки (собранный архив)
+	Update(export *auth.DataExport) error
+
+	// DeleteExpired — удаление выгрузок, срок хранения которых истёк к now
+	DeleteExpired(now time.Time) error
+
+	// DeleteByUser — удаление всех записей пользователя (при окончательном удалении аккаунта)
+	DeleteByUser(userID uuid.UUID) error
+}
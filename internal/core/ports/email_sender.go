@@ -0,0 +1,15 @@
+package ports
+
+import "context"
+
+// EmailMessage — письмо пользователю
+type EmailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// EmailSender доставляет письма пользователям
+type EmailSender interface {
+	Send(ctx context.Context, msg EmailMessage) error
+}
@@ -3,8 +3,10 @@ package ports
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/Vi-72/quest-auth/internal/pkg/ddd"
+	"github.com/google/uuid"
 )
 
 // EventPublisher defines methods for publishing domain events
@@ -12,6 +14,27 @@ type EventPublisher interface {
 	Publish(ctx context.Context, events ...ddd.DomainEvent) error
 }
 
+// StoredEvent is an event as kept in the event log; Data is its JSON payload
+type StoredEvent struct {
+	ID          string
+	Type        string
+	AggregateID string
+	Data        string
+	CreatedAt   time.Time
+}
+
+// EventLog gives access to stored events
+type EventLog interface {
+	// ListByAggregate returns the stored events of the aggregate, oldest first
+	ListByAggregate(ctx context.Context, aggregateID uuid.UUID) ([]StoredEvent, error)
+
+	// CountByAggregate returns the number of stored events of the aggregate
+	CountByAggregate(ctx context.Context, aggregateID uuid.UUID) (int64, error)
+
+	// AnonymizeAggregate removes personal data from the stored events of the aggregate
+	AnonymizeAggregate(ctx context.Context, aggregateID uuid.UUID) error
+}
+
 // NullEventPublisher is a no-op implementation for development
 type NullEventPublisher struct{}
 
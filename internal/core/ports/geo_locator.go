@@ -0,0 +1,9 @@
+package ports
+
+import "github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+
+// GeoLocator определяет местоположение по IP-адресу
+type GeoLocator interface {
+	// Locate возвращает пустое местоположение, если адрес не найден
+	Locate(ip string) (auth.Location, error)
+}
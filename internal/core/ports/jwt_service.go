@@ -14,16 +14,43 @@ type TokenPair struct {
 	ExpiresIn    int64 // в секундах
 }
 
+// TokenSubject — данные пользователя, которые попадают в клеймы пары токенов
+type TokenSubject struct {
+	UserID    uuid.UUID
+	Email     string
+	Name      string
+	Phone     string
+	CreatedAt time.Time
+
+	EmailVerified bool
+	PhoneVerified bool
+}
+
+// Области действия (scope) ограниченных токенов
+const (
+	// TokenScopePasswordChange — токен пригоден только для смены просроченного пароля
+	TokenScopePasswordChange = "password_change"
+)
+
 // JWTService интерфейс для работы с JWT токенами
 type JWTService interface {
 	// GenerateTokenPair создает пару access и refresh токенов
-	GenerateTokenPair(userID uuid.UUID, email, name, phone string, createdAt time.Time) (*TokenPair, error)
+	GenerateTokenPair(subject TokenSubject) (*TokenPair, error)
 
 	// ValidateAccessToken проверяет валидность access токена
 	ValidateAccessToken(token string) (*TokenClaims, error)
 
 	// RefreshTokens обновляет токены по refresh токену
 	RefreshTokens(refreshToken string) (*TokenPair, error)
+
+	// ValidateRefreshToken проверяет refresh токен и возвращает ID его владельца
+	ValidateRefreshToken(refreshToken string) (uuid.UUID, error)
+
+	// GenerateScopedToken создает короткоживущий токен, пригодный только для операции scope
+	GenerateScopedToken(userID uuid.UUID, scope string, ttl time.Duration) (string, error)
+
+	// ValidateScopedToken проверяет токен и его scope, возвращает ID пользователя
+	ValidateScopedToken(token, scope string) (uuid.UUID, error)
 }
 
 // TokenClaims содержит данные из токена
@@ -34,4 +61,7 @@ type TokenClaims struct {
 	Phone     string
 	Exp       time.Time
 	CreatedAt time.Time
+
+	EmailVerified bool
+	PhoneVerified bool
 }
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+
+	"github.com/google/uuid"
+)
+
+type LoginHistoryRepository interface {
+	// Add — запись успешного входа
+	Add(record *auth.LoginRecord) error
+
+	// ListRecent — последние limit входов пользователя, новые первыми
+	ListRecent(userID uuid.UUID, limit int) ([]auth.LoginRecord, error)
+
+	// ListByUser — все входы пользователя, новые первыми
+	ListByUser(userID uuid.UUID) ([]auth.LoginRecord, error)
+
+	// DeleteByUser — удаление всех записей пользователя (при окончательном удалении аккаунта)
+	DeleteByUser(userID uuid.UUID) error
+}
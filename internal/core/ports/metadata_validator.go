@@ -0,0 +1,7 @@
+package ports
+
+// MetadataValidator проверяет metadata пользователя по схеме, заданной конфигурацией
+type MetadataValidator interface {
+	// Validate возвращает ошибку с описанием первого нарушения схемы
+	Validate(metadata map[string]any) error
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PasswordHistoryRepository interface {
+	// Add — сохранение хеша пароля в историю пользователя
+	Add(userID uuid.UUID, passwordHash string, createdAt time.Time) error
+
+	// GetRecent — последние limit хешей, сохранённые не раньше since (нулевое since — без ограничения)
+	GetRecent(userID uuid.UUID, limit int, since time.Time) ([]string, error)
+
+	// Prune — удаление записей сверх keep последних и записей старше before (нулевое before — без ограничения)
+	Prune(userID uuid.UUID, keep int, before time.Time) error
+
+	// DeleteByUser — удаление всех записей пользователя (при окончательном удалении аккаунта)
+	DeleteByUser(userID uuid.UUID) error
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+
+	"github.com/google/uuid"
+)
+
+type RecoveryCodeRepository interface {
+	// Replace — замена всех кодов восстановления пользователя новым набором
+	Replace(userID uuid.UUID, codes []auth.RecoveryCode) error
+
+	// GetUnused — неиспользованный код пользователя по хешу
+	GetUnused(userID uuid.UUID, codeHash string) (*auth.RecoveryCode, error)
+
+	// Update — сохранение изменений кода (погашение)
+	Update(code *auth.RecoveryCode) error
+
+	// DeleteByUser — удаление всех записей пользователя (при окончательном удалении аккаунта)
+	DeleteByUser(userID uuid.UUID) error
+}
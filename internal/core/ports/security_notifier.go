@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// SuspiciousLoginNotice — уведомление о входе с незнакомого устройства или из необычного места
+type SuspiciousLoginNotice struct {
+	Email       string
+	Name        string
+	IP          string
+	UserAgent   string
+	Country     string
+	City        string
+	NewDevice   bool
+	NewLocation bool
+	At          time.Time
+}
+
+// SecurityNotifier сообщает пользователю о событиях безопасности его аккаунта
+type SecurityNotifier interface {
+	NotifySuspiciousLogin(ctx context.Context, notice SuspiciousLoginNotice) error
+
+	// ConfirmSuspiciousLogin просит подтвердить вход по ссылке, действующей expiresIn
+	ConfirmSuspiciousLogin(ctx context.Context, notice SuspiciousLoginNotice, link string, expiresIn time.Duration) error
+}
@@ -0,0 +1,14 @@
+package ports
+
+import "context"
+
+// SMSMessage — SMS пользователю
+type SMSMessage struct {
+	To   string
+	Text string
+}
+
+// SMSSender доставляет SMS пользователям
+type SMSSender interface {
+	Send(ctx context.Context, msg SMSMessage) error
+}
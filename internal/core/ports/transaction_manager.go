@@ -4,8 +4,15 @@ import "context"
 
 // Repositories groups repositories available within a transactional boundary.
 type Repositories struct {
-	User  UserRepository
-	Event EventPublisher
+	User               UserRepository
+	PasswordHistory    PasswordHistoryRepository
+	VerificationToken  VerificationTokenRepository
+	RecoveryCode       RecoveryCodeRepository
+	WebAuthnCredential WebAuthnCredentialRepository
+	LoginHistory       LoginHistoryRepository
+	DataExport         DataExportRepository
+	Event              EventPublisher
+	EventLog           EventLog
 }
 
 // TransactionManager defines transactional coordination for use cases.
@@ -1,6 +1,8 @@
 package ports
 
 import (
+	"time"
+
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
 
@@ -20,15 +22,30 @@ type UserRepository interface {
 	// GetByPhone — поиск пользователя по телефону
 	GetByPhone(phone kernel.Phone) (*auth.User, error)
 
+	// GetByUsername — поиск пользователя по публичному имени
+	GetByUsername(username kernel.Username) (*auth.User, error)
+
 	// Update — обновление существующего пользователя
 	Update(user *auth.User) error
 
-	// Delete — удаление пользователя (мягкое или жёсткое)
+	// Delete — мягкое удаление: пользователь больше не находится, но строка хранится до Purge
 	Delete(id uuid.UUID) error
 
+	// ListDeletedBefore — ID пользователей, удалённых раньше before (не больше limit)
+	ListDeletedBefore(before time.Time, limit int) ([]uuid.UUID, error)
+
+	// ListDeletionDue — ID пользователей, срок запрошенного удаления которых наступил к now (не больше limit)
+	ListDeletionDue(now time.Time, limit int) ([]uuid.UUID, error)
+
+	// Purge — окончательное удаление пользователя, в том числе помеченного удалённым
+	Purge(id uuid.UUID) error
+
 	// EmailExists — проверка существования email
 	EmailExists(email kernel.Email) (bool, error)
 
 	// PhoneExists — проверка существования телефона
 	PhoneExists(phone kernel.Phone) (bool, error)
+
+	// UsernameExists — проверка, занято ли публичное имя
+	UsernameExists(username kernel.Username) (bool, error)
 }
@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+
+	"github.com/google/uuid"
+)
+
+type VerificationTokenRepository interface {
+	// Create — сохранение выпущенного токена
+	Create(token *auth.VerificationToken) error
+
+	// GetBySecretHash — поиск токена по хешу секрета и назначению
+	GetBySecretHash(purpose auth.VerificationPurpose, secretHash string) (*auth.VerificationToken, error)
+
+	// GetLatest — последний выпущенный токен пользователя с данным назначением (в любом состоянии)
+	GetLatest(userID uuid.UUID, purpose auth.VerificationPurpose) (*auth.VerificationToken, error)
+
+	// Update — сохранение изменений токена (погашение)
+	Update(token *auth.VerificationToken) error
+
+	// InvalidateActive — погашение всех непросроченных неиспользованных токенов пользователя с данным назначением
+	InvalidateActive(userID uuid.UUID, purpose auth.VerificationPurpose, now time.Time) error
+
+	// DeleteByUser — удаление всех записей пользователя (при окончательном удалении аккаунта)
+	DeleteByUser(userID uuid.UUID) error
+}
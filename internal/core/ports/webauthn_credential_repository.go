@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+
+	"github.com/google/uuid"
+)
+
+type WebAuthnCredentialRepository interface {
+	// Create — сохранение зарегистрированного ключа
+	Create(cred *auth.WebAuthnCredential) error
+
+	// GetByCredentialID — поиск ключа по идентификатору, выданному аутентификатором
+	GetByCredentialID(credentialID []byte) (*auth.WebAuthnCredential, error)
+
+	// ListByUser — все ключи пользователя в порядке регистрации
+	ListByUser(userID uuid.UUID) ([]auth.WebAuthnCredential, error)
+
+	// Update — сохранение изменений ключа (счётчик подписей, время использования)
+	Update(cred *auth.WebAuthnCredential) error
+
+	// DeleteByUser — удаление всех записей пользователя (при окончательном удалении аккаунта)
+	DeleteByUser(userID uuid.UUID) error
+}
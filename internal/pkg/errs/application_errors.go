@@ -1,6 +1,9 @@
 package errs
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // DomainValidationError represents validation error at domain/application level
 type DomainValidationError struct {
@@ -10,12 +13,14 @@ type DomainValidationError struct {
 }
 
 func (e *DomainValidationError) Error() string {
-	if e.Cause != nil {
+	if e.Cause != nil && e.Cause.Error() != e.Message {
 		return fmt.Sprintf("domain validation error: field '%s' %s (cause: %v)", e.Field, e.Message, e.Cause)
 	}
 	return fmt.Sprintf("domain validation error: field '%s' %s", e.Field, e.Message)
 }
 
+func (e *DomainValidationError) Unwrap() error { return e.Cause }
+
 func NewDomainValidationError(field, message string) *DomainValidationError {
 	return &DomainValidationError{
 		Field:   field,
@@ -59,3 +64,67 @@ func NewNotFoundErrorWithCause(resource, id string, cause error) *NotFoundError
 		Cause:    cause,
 	}
 }
+
+// ForbiddenError represents an operation that is not allowed for the subject in its current state
+type ForbiddenError struct {
+	Reason  string
+	Message string
+}
+
+func (e *ForbiddenError) Error() string {
+	return fmt.Sprintf("forbidden (%s): %s", e.Reason, e.Message)
+}
+
+func NewForbiddenError(reason, message string) *ForbiddenError {
+	return &ForbiddenError{
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+// TooManyRequestsError represents an operation that is temporarily throttled
+type TooManyRequestsError struct {
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *TooManyRequestsError) Error() string {
+	return fmt.Sprintf("too many requests: %s (retry after %s)", e.Message, e.RetryAfter)
+}
+
+func NewTooManyRequestsError(message string, retryAfter time.Duration) *TooManyRequestsError {
+	return &TooManyRequestsError{
+		Message:    message,
+		RetryAfter: retryAfter,
+	}
+}
+
+// LockedError represents a resource that is temporarily locked, e.g. an account after failed logins
+type LockedError struct {
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("locked: %s (retry after %s)", e.Message, e.RetryAfter)
+}
+
+func NewLockedError(message string, retryAfter time.Duration) *LockedError {
+	return &LockedError{
+		Message:    message,
+		RetryAfter: retryAfter,
+	}
+}
+
+// CaptchaRequiredError represents an operation that needs a solved CAPTCHA after suspicious activity
+type CaptchaRequiredError struct {
+	Message string
+}
+
+func (e *CaptchaRequiredError) Error() string {
+	return fmt.Sprintf("captcha required: %s", e.Message)
+}
+
+func NewCaptchaRequiredError(message string) *CaptchaRequiredError {
+	return &CaptchaRequiredError{Message: message}
+}
@@ -15,3 +15,11 @@ func (e *NotFoundError) GRPCCode() codes.Code { return codes.NotFound }
 func (e *InfrastructureError) GRPCCode() codes.Code { return codes.Internal }
 
 func (e *JWTValidationError) GRPCCode() codes.Code { return codes.Unauthenticated }
+
+func (e *ForbiddenError) GRPCCode() codes.Code { return codes.PermissionDenied }
+
+func (e *TooManyRequestsError) GRPCCode() codes.Code { return codes.ResourceExhausted }
+
+func (e *LockedError) GRPCCode() codes.Code { return codes.ResourceExhausted }
+
+func (e *CaptchaRequiredError) GRPCCode() codes.Code { return codes.FailedPrecondition }
@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Counter считает события по ключу (например, неудачные попытки входа с IP) в окне Window,
+// которое начинается с первого события. Состояние хранится в том же Store, что и лимиты.
+type Counter struct {
+	store  Store
+	clock  Clock
+	prefix string
+	window time.Duration
+}
+
+// NewCounter создаёт счётчик; prefix отделяет его ключи от ключей правил и других счётчиков.
+func NewCounter(store Store, clock Clock, prefix string, window time.Duration) *Counter {
+	return &Counter{
+		store:  store,
+		clock:  clock,
+		prefix: prefix,
+		window: window,
+	}
+}
+
+// Count возвращает число событий в текущем окне
+func (c *Counter) Count(ctx context.Context, key string) (int, error) {
+	now := c.clock.Now()
+	var count float64
+	err := c.store.Update(ctx, c.key(key), now, c.window, func(state State, found bool) State {
+		if !found || c.expired(state, now) {
+			return State{}
+		}
+		count = state.Count
+		return state
+	})
+	return int(count), err
+}
+
+// Add учитывает событие; окно начинается заново, если предыдущее истекло
+func (c *Counter) Add(ctx context.Context, key string) error {
+	now := c.clock.Now()
+	return c.store.Update(ctx, c.key(key), now, c.window, func(state State, found bool) State {
+		if !found || c.expired(state, now) {
+			state = State{Start: now}
+		}
+		state.Count++
+		return state
+	})
+}
+
+// Reset обнуляет счётчик ключа
+func (c *Counter) Reset(ctx context.Context, key string) error {
+	return c.store.Update(ctx, c.key(key), c.clock.Now(), c.window, func(State, bool) State {
+		return State{}
+	})
+}
+
+func (c *Counter) key(key string) string {
+	return c.prefix + "|" + key
+}
+
+// expired — окно началось раньше, чем Window назад (пустое состояние тоже считается истёкшим)
+func (c *Counter) expired(state State, now time.Time) bool {
+	return state.Start.IsZero() || !now.Before(state.Start.Add(c.window))
+}
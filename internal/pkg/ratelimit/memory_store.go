@@ -0,0 +1,58 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// purgeInterval — как часто хранилища удаляют истёкшие состояния
+const purgeInterval = time.Minute
+
+// MemoryStore хранит состояния в памяти процесса: лимиты не делятся между экземплярами сервиса.
+type MemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]memoryEntry
+	lastPurge time.Time
+}
+
+type memoryEntry struct {
+	state     State
+	expiresAt time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]memoryEntry)}
+}
+
+// Update атомарно применяет fn к состоянию ключа
+func (s *MemoryStore) Update(
+	_ context.Context,
+	key string,
+	now time.Time,
+	ttl time.Duration,
+	fn func(state State, found bool) State,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastPurge) >= purgeInterval {
+		for k, entry := range s.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(s.entries, k)
+			}
+		}
+		s.lastPurge = now
+	}
+
+	entry, found := s.entries[key]
+	if found && !now.Before(entry.expiresAt) {
+		entry, found = memoryEntry{}, false
+	}
+
+	s.entries[key] = memoryEntry{
+		state:     fn(entry.state, found),
+		expiresAt: now.Add(ttl),
+	}
+	return nil
+}
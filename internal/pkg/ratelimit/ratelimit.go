@@ -0,0 +1,114 @@
+// Package ratelimit ограничивает частоту запросов: token bucket и скользящее окно
+// с ключами по IP, идентификатору аккаунта и маршруту. Состояние хранится в Store —
+// в памяти процесса или в общей базе, если экземпляров сервиса несколько.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Clock provides current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// State — состояние одного ключа. Поля трактуются алгоритмом правила:
+// для token bucket Count — оставшиеся токены, Start — время последнего пополнения;
+// для скользящего окна Count и PrevCount — запросы в текущем и предыдущем окне, Start — начало текущего окна.
+type State struct {
+	Count     float64
+	PrevCount float64
+	Start     time.Time
+}
+
+// Store — хранилище состояний.
+type Store interface {
+	// Update атомарно применяет fn к состоянию ключа и сохраняет результат на ttl.
+	// found = false, если состояния нет или оно истекло.
+	Update(ctx context.Context, key string, now time.Time, ttl time.Duration, fn func(state State, found bool) State) error
+}
+
+// Result — решение по запросу.
+type Result struct {
+	Allowed bool
+	// Limit и Remaining — лимит самого строгого из сработавших правил и остаток по нему
+	Limit     int
+	Remaining int
+	// RetryAfter — когда можно повторить (только для отклонённого запроса)
+	RetryAfter time.Duration
+}
+
+// Limiter проверяет запросы по набору правил.
+type Limiter struct {
+	store Store
+	clock Clock
+	rules []Rule
+}
+
+func NewLimiter(store Store, clock Clock, rules []Rule) *Limiter {
+	return &Limiter{
+		store: store,
+		clock: clock,
+		rules: rules,
+	}
+}
+
+// Needs — есть ли для маршрута правила с ключом kind (например, чтобы не читать тело запроса без нужды).
+func (l *Limiter) Needs(route string, kind KeyKind) bool {
+	for _, rule := range l.rules {
+		if rule.Key == kind && rule.Matches(route) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allow учитывает запрос по всем правилам маршрута. keys — значения ключей запроса (IP, аккаунт);
+// правила, для ключа которых значения нет, пропускаются. Запрос отклоняется, если его отклонило хотя бы одно правило.
+func (l *Limiter) Allow(ctx context.Context, route string, keys map[KeyKind]string) (Result, error) {
+	now := l.clock.Now()
+	result := Result{Allowed: true, Remaining: -1}
+
+	for _, rule := range l.rules {
+		if !rule.Matches(route) {
+			continue
+		}
+
+		// Ключ маршрута один на правило: правило с префиксом считает все свои маршруты вместе
+		value := "*"
+		if rule.Key != KeyRoute {
+			if value = keys[rule.Key]; value == "" {
+				continue
+			}
+		}
+
+		var decision Result
+		err := l.store.Update(ctx, rule.storeKey(value), now, rule.ttl(), func(state State, found bool) State {
+			state, decision = rule.apply(state, found, now)
+			return state
+		})
+		if err != nil {
+			return Result{}, err
+		}
+
+		result = merge(result, decision)
+	}
+
+	if result.Remaining < 0 {
+		result.Remaining = 0
+	}
+	return result, nil
+}
+
+// merge объединяет решения правил: отказ важнее разрешения, из отказов — самый долгий
+func merge(acc, decision Result) Result {
+	switch {
+	case !decision.Allowed && (acc.Allowed || decision.RetryAfter > acc.RetryAfter):
+		return decision
+	case acc.Allowed && (acc.Remaining < 0 || decision.Remaining < acc.Remaining):
+		return decision
+	default:
+		return acc
+	}
+}
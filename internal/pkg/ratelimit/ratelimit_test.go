@@ -0,0 +1,204 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.t }
+
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+// start — начало окна, чтобы границы окон скользящего окна были предсказуемы
+var start = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestLimiter(t *testing.T, spec string) (*Limiter, *fakeClock) {
+	t.Helper()
+	rules, err := ParseRules(spec)
+	if err != nil {
+		t.Fatalf("ParseRules() error = %v", err)
+	}
+	clock := &fakeClock{t: start}
+	return NewLimiter(NewMemoryStore(), clock, rules), clock
+}
+
+// allowN делает n запросов и возвращает число пропущенных
+func allowN(t *testing.T, l *Limiter, route string, keys map[KeyKind]string, n int) int {
+	t.Helper()
+	allowed := 0
+	for i := 0; i < n; i++ {
+		result, err := l.Allow(context.Background(), route, keys)
+		if err != nil {
+			t.Fatalf("Allow() error = %v", err)
+		}
+		if result.Allowed {
+			allowed++
+		}
+	}
+	return allowed
+}
+
+func TestTokenBucketAllowsBurstThenRefills(t *testing.T) {
+	l, clock := newTestLimiter(t, "POST /login ip 5/1m")
+	keys := map[KeyKind]string{KeyIP: "10.0.0.1"}
+
+	if got := allowN(t, l, "POST /login", keys, 7); got != 5 {
+		t.Fatalf("allowed %d of 7, want 5", got)
+	}
+
+	result, _ := l.Allow(context.Background(), "POST /login", keys)
+	if result.Allowed || result.RetryAfter <= 0 || result.RetryAfter > 12*time.Second {
+		t.Fatalf("result = %+v, want denied with retry after up to 12s", result)
+	}
+
+	// Один токен пополняется за 12 секунд
+	clock.advance(12 * time.Second)
+	if got := allowN(t, l, "POST /login", keys, 2); got != 1 {
+		t.Fatalf("allowed %d after refill, want 1", got)
+	}
+}
+
+func TestSlidingWindowCountsPreviousWindow(t *testing.T) {
+	l, clock := newTestLimiter(t, "POST /register ip 10/1m sliding_window")
+	keys := map[KeyKind]string{KeyIP: "10.0.0.1"}
+
+	if got := allowN(t, l, "POST /register", keys, 12); got != 10 {
+		t.Fatalf("allowed %d of 12, want 10", got)
+	}
+
+	// Через 30 секунд после начала нового окна половина прошлых запросов ещё учитывается
+	clock.advance(90 * time.Second)
+	if got := allowN(t, l, "POST /register", keys, 10); got != 5 {
+		t.Fatalf("allowed %d in the next window, want 5", got)
+	}
+
+	result, _ := l.Allow(context.Background(), "POST /register", keys)
+	if result.Allowed || result.RetryAfter <= 0 {
+		t.Fatalf("result = %+v, want denied with retry after", result)
+	}
+
+	// Через два окна прошлое забыто
+	clock.advance(2 * time.Minute)
+	if got := allowN(t, l, "POST /register", keys, 10); got != 10 {
+		t.Fatalf("allowed %d after two windows, want 10", got)
+	}
+}
+
+func TestKeysAreCountedSeparately(t *testing.T) {
+	l, _ := newTestLimiter(t, "POST /login ip 2/1m; POST /login account 3/1m")
+
+	a := map[KeyKind]string{KeyIP: "10.0.0.1", KeyAccount: "a@example.com"}
+	b := map[KeyKind]string{KeyIP: "10.0.0.2", KeyAccount: "a@example.com"}
+
+	if got := allowN(t, l, "POST /login", a, 3); got != 2 {
+		t.Fatalf("allowed %d for first IP, want 2", got)
+	}
+	// Другой IP, но тот же аккаунт: в лимите аккаунта остался один запрос
+	if got := allowN(t, l, "POST /login", b, 3); got != 0 {
+		t.Fatalf("allowed %d for second IP, want 0", got)
+	}
+}
+
+func TestRuleWithoutKeyValueIsSkipped(t *testing.T) {
+	l, _ := newTestLimiter(t, "POST /login account 1/1m")
+
+	if got := allowN(t, l, "POST /login", map[KeyKind]string{KeyIP: "10.0.0.1"}, 5); got != 5 {
+		t.Fatalf("allowed %d without account, want 5", got)
+	}
+}
+
+func TestRoutePrefixAndRouteKey(t *testing.T) {
+	l, _ := newTestLimiter(t, "POST /auth/* route 3/1m")
+
+	if got := allowN(t, l, "POST /auth/login", nil, 2) + allowN(t, l, "POST /auth/register", nil, 2); got != 3 {
+		t.Fatalf("allowed %d across prefix, want 3", got)
+	}
+	if got := allowN(t, l, "GET /health", nil, 5); got != 5 {
+		t.Fatalf("allowed %d for unmatched route, want 5", got)
+	}
+	if !l.Needs("POST /auth/login", KeyRoute) || l.Needs("POST /auth/login", KeyAccount) {
+		t.Fatal("Needs() does not reflect the rules")
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules(`
+		POST /api/v1/auth/login account 5/15m sliding_window;
+		/auth.v1.AuthService/Authenticate ip 100/1s`)
+	if err != nil {
+		t.Fatalf("ParseRules() error = %v", err)
+	}
+
+	want := []Rule{
+		{Route: "POST /api/v1/auth/login", Key: KeyAccount, Limit: 5, Period: 15 * time.Minute, Algorithm: SlidingWindow},
+		{Route: "/auth.v1.AuthService/Authenticate", Key: KeyIP, Limit: 100, Period: time.Second, Algorithm: TokenBucket},
+	}
+	if len(rules) != len(want) {
+		t.Fatalf("got %d rules, want %d", len(rules), len(want))
+	}
+	for i := range want {
+		if rules[i] != want[i] {
+			t.Errorf("rule %d = %+v, want %+v", i, rules[i], want[i])
+		}
+	}
+
+	if rules, err := ParseRules("off"); err != nil || rules != nil {
+		t.Errorf(`ParseRules("off") = %v, %v; want no rules`, rules, err)
+	}
+
+	for _, bad := range []string{"POST /login ip", "POST /login user 5/1m", "POST /login ip 0/1m", "POST /login ip 5/forever"} {
+		if _, err := ParseRules(bad); err == nil {
+			t.Errorf("ParseRules(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+func TestMemoryStoreExpiresState(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	_ = store.Update(ctx, "k", start, time.Minute, func(State, bool) State { return State{Count: 1} })
+
+	var found bool
+	_ = store.Update(ctx, "k", start.Add(time.Minute), time.Minute, func(state State, ok bool) State {
+		found = ok
+		return state
+	})
+	if found {
+		t.Fatal("state found after ttl, want expired")
+	}
+}
+
+func TestCounterCountsWithinWindow(t *testing.T) {
+	clock := &fakeClock{t: start}
+	counter := NewCounter(NewMemoryStore(), clock, "failures", time.Hour)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if err := counter.Add(ctx, "ip|203.0.113.7"); err != nil {
+			t.Fatal(err)
+		}
+		clock.advance(10 * time.Minute)
+	}
+	if n, _ := counter.Count(ctx, "ip|203.0.113.7"); n != 3 {
+		t.Fatalf("count = %d, want 3", n)
+	}
+	if n, _ := counter.Count(ctx, "ip|203.0.113.8"); n != 0 {
+		t.Fatalf("count of another key = %d, want 0", n)
+	}
+
+	// Окно отсчитывается от первого события
+	clock.advance(30 * time.Minute)
+	if n, _ := counter.Count(ctx, "ip|203.0.113.7"); n != 0 {
+		t.Fatalf("count after window = %d, want 0", n)
+	}
+
+	_ = counter.Add(ctx, "ip|203.0.113.7")
+	_ = counter.Reset(ctx, "ip|203.0.113.7")
+	if n, _ := counter.Count(ctx, "ip|203.0.113.7"); n != 0 {
+		t.Fatalf("count after reset = %d, want 0", n)
+	}
+}
@@ -0,0 +1,195 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Algorithm — алгоритм ограничения.
+type Algorithm string
+
+const (
+	// TokenBucket — корзина на Limit токенов, пополняется равномерно за Period: допускает всплески до Limit
+	TokenBucket Algorithm = "token_bucket"
+	// SlidingWindow — не больше Limit запросов за любые Period (счётчики текущего и предыдущего окна)
+	SlidingWindow Algorithm = "sliding_window"
+)
+
+// KeyKind — по чему считаются запросы.
+type KeyKind string
+
+const (
+	KeyIP      KeyKind = "ip"      // адрес клиента
+	KeyAccount KeyKind = "account" // email или телефон из запроса
+	KeyRoute   KeyKind = "route"   // все запросы к маршруту вместе
+)
+
+// Rule — ограничение для маршрута.
+type Rule struct {
+	// Route — «METHOD /path» для HTTP или полное имя метода gRPC; «*» в конце задаёт префикс
+	Route     string
+	Key       KeyKind
+	Limit     int
+	Period    time.Duration
+	Algorithm Algorithm
+}
+
+// Matches — относится ли правило к маршруту.
+func (r Rule) Matches(route string) bool {
+	if prefix, ok := strings.CutSuffix(r.Route, "*"); ok {
+		return strings.HasPrefix(route, prefix)
+	}
+	return route == r.Route
+}
+
+// storeKey — ключ состояния; маршрут в нём — шаблон правила, поэтому правило с префиксом
+// делит один лимит на все свои маршруты
+func (r Rule) storeKey(value string) string {
+	return strings.Join([]string{string(r.Algorithm), r.Route, string(r.Key), value}, "|")
+}
+
+// ttl — через сколько состояние без запросов не отличается от нового
+func (r Rule) ttl() time.Duration {
+	if r.Algorithm == SlidingWindow {
+		return 2 * r.Period
+	}
+	return r.Period
+}
+
+// apply учитывает запрос в состоянии ключа
+func (r Rule) apply(state State, found bool, now time.Time) (State, Result) {
+	if r.Algorithm == SlidingWindow {
+		return r.slidingWindow(state, found, now)
+	}
+	return r.tokenBucket(state, found, now)
+}
+
+// tokenBucket — токены пополняются со скоростью Limit за Period, каждый запрос забирает один
+func (r Rule) tokenBucket(state State, found bool, now time.Time) (State, Result) {
+	limit := float64(r.Limit)
+	rate := limit / r.Period.Seconds()
+
+	tokens := limit
+	if found {
+		tokens = math.Min(limit, state.Count+now.Sub(state.Start).Seconds()*rate)
+	}
+
+	if tokens < 1 {
+		retryAfter := time.Duration((1 - tokens) / rate * float64(time.Second))
+		return State{Count: tokens, Start: now}, Result{Limit: r.Limit, RetryAfter: retryAfter}
+	}
+
+	tokens--
+	return State{Count: tokens, Start: now}, Result{Allowed: true, Limit: r.Limit, Remaining: int(tokens)}
+}
+
+// slidingWindow — число запросов за последние Period оценивается по текущему окну
+// и доле предыдущего, ещё попадающей в интервал
+func (r Rule) slidingWindow(state State, found bool, now time.Time) (State, Result) {
+	windowStart := now.Truncate(r.Period)
+	switch {
+	case !found || state.Start.Before(windowStart.Add(-r.Period)):
+		state = State{Start: windowStart}
+	case state.Start.Before(windowStart):
+		state = State{PrevCount: state.Count, Start: windowStart}
+	}
+
+	elapsed := now.Sub(windowStart).Seconds() / r.Period.Seconds()
+	limit := float64(r.Limit)
+	estimate := state.PrevCount*(1-elapsed) + state.Count
+
+	if estimate+1 > limit {
+		return state, Result{Limit: r.Limit, RetryAfter: r.slidingRetryAfter(state, windowStart, now)}
+	}
+
+	state.Count++
+	remaining := int(limit - math.Ceil(estimate+1))
+	return state, Result{Allowed: true, Limit: r.Limit, Remaining: remaining}
+}
+
+// slidingRetryAfter — когда оценка опустится настолько, что запрос поместится в лимит
+func (r Rule) slidingRetryAfter(state State, windowStart, now time.Time) time.Duration {
+	limit := float64(r.Limit)
+	period := r.Period.Seconds()
+
+	// Освободится ещё в текущем окне, когда доля предыдущего окна уменьшится
+	if state.Count+1 <= limit && state.PrevCount > 0 {
+		elapsed := 1 - (limit-1-state.Count)/state.PrevCount
+		return windowStart.Add(time.Duration(elapsed * period * float64(time.Second))).Sub(now)
+	}
+
+	// Иначе — в следующем окне, где текущее станет предыдущим
+	nextStart := windowStart.Add(r.Period)
+	elapsed := math.Max(0, 1-(limit-1)/state.Count)
+	return nextStart.Add(time.Duration(elapsed * period * float64(time.Second))).Sub(now)
+}
+
+// ParseRules разбирает правила из конфигурации. Правила разделяются «;» или переводом строки:
+//
+//	<маршрут> <ключ> <лимит>/<период> [алгоритм]
+//
+// например «POST /api/v1/auth/login account 5/15m sliding_window» или
+// «/auth.v1.AuthService/Authenticate ip 100/1s». Алгоритм по умолчанию — token_bucket.
+// Пустая строка или «off» — ограничений нет.
+func ParseRules(spec string) ([]Rule, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" || spec == "off" {
+		return nil, nil
+	}
+
+	var rules []Rule
+	for _, line := range strings.FieldsFunc(spec, func(r rune) bool { return r == ';' || r == '\n' }) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		rule, err := parseRule(line)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func parseRule(line string) (Rule, error) {
+	fields := strings.Fields(line)
+	rule := Rule{Algorithm: TokenBucket}
+
+	if last := len(fields) - 1; last >= 0 {
+		switch algorithm := Algorithm(fields[last]); algorithm {
+		case TokenBucket, SlidingWindow:
+			rule.Algorithm = algorithm
+			fields = fields[:last]
+		}
+	}
+	if len(fields) < 3 {
+		return Rule{}, fmt.Errorf("rate limit rule %q: want <route> <key> <limit>/<period> [algorithm]", line)
+	}
+
+	n := len(fields)
+	rule.Route = strings.Join(fields[:n-2], " ")
+
+	switch key := KeyKind(fields[n-2]); key {
+	case KeyIP, KeyAccount, KeyRoute:
+		rule.Key = key
+	default:
+		return Rule{}, fmt.Errorf("rate limit rule %q: unknown key %q", line, key)
+	}
+
+	limit, period, ok := strings.Cut(fields[n-1], "/")
+	if !ok {
+		return Rule{}, fmt.Errorf("rate limit rule %q: limit must look like 10/1m", line)
+	}
+	var err error
+	if rule.Limit, err = strconv.Atoi(limit); err != nil || rule.Limit < 1 {
+		return Rule{}, fmt.Errorf("rate limit rule %q: invalid limit %q", line, limit)
+	}
+	if rule.Period, err = time.ParseDuration(period); err != nil || rule.Period <= 0 {
+		return Rule{}, fmt.Errorf("rate limit rule %q: invalid period %q", line, period)
+	}
+
+	return rule, nil
+}
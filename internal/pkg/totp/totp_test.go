@@ -0,0 +1,85 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+// Секрет из приложения B RFC 6238 ("12345678901234567890") в base32
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCodeMatchesRFC6238Vectors(t *testing.T) {
+	// Эталонные 8-значные коды SHA-1 из RFC, усечённые до 6 младших цифр
+	vectors := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, v := range vectors {
+		got, err := Code(rfcSecret, Step(time.Unix(v.unix, 0)))
+		if err != nil {
+			t.Fatalf("Code(%d) error = %v", v.unix, err)
+		}
+		if got != v.code {
+			t.Errorf("Code(%d) = %s, want %s", v.unix, got, v.code)
+		}
+	}
+}
+
+func TestValidateAcceptsAdjacentStepsAndRejectsReplay(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := Step(now)
+
+	prev, _ := Code(rfcSecret, step-1)
+	if got, ok := Validate(rfcSecret, prev, now, 1, 0); !ok || got != step-1 {
+		t.Fatalf("Validate(previous step) = %d, %v; want %d, true", got, ok, step-1)
+	}
+
+	if _, ok := Validate(rfcSecret, prev, now, 1, step-1); ok {
+		t.Fatal("Validate() accepted a code for an already used step")
+	}
+
+	old, _ := Code(rfcSecret, step-2)
+	if _, ok := Validate(rfcSecret, old, now, 1, 0); ok {
+		t.Fatal("Validate() accepted a code outside the skew window")
+	}
+}
+
+func TestGenerateSecretIsUsable(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	if len(secret) != 32 {
+		t.Fatalf("secret length = %d, want 32", len(secret))
+	}
+	if _, err := Code(secret, 1); err != nil {
+		t.Fatalf("Code() with generated secret error = %v", err)
+	}
+}
+
+func TestURI(t *testing.T) {
+	raw := URI("Quest Auth", "user@example.com", rfcSecret)
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatalf("parse uri: %v", err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" {
+		t.Fatalf("unexpected uri %q", raw)
+	}
+	if u.Path != "/Quest Auth:user@example.com" {
+		t.Errorf("label = %q", u.Path)
+	}
+	q := u.Query()
+	if q.Get("secret") != rfcSecret || q.Get("issuer") != "Quest Auth" || q.Get("digits") != "6" {
+		t.Errorf("unexpected query %q", u.RawQuery)
+	}
+}
@@ -0,0 +1,330 @@
+// Package webauthn — проверка церемоний WebAuthn (регистрация и вход по passkey) на стороне сервера.
+//
+// Реализована проверка клиентских данных, данных аутентификатора и подписи (WebAuthn Level 2, §7.1, §7.2).
+// Заявления об аттестации не проверяются: сервис запрашивает attestation "none"
+// и доверяет ключу, полученному при регистрации от уже аутентифицированного пользователя.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+)
+
+// Варианты userVerification / residentKey из спецификации
+const (
+	UserVerificationRequired  = "required"
+	UserVerificationPreferred = "preferred"
+
+	ResidentKeyRequired = "required"
+
+	AttestationNone = "none"
+)
+
+const (
+	clientDataTypeCreate = "webauthn.create"
+	clientDataTypeGet    = "webauthn.get"
+
+	flagUserPresent    = 0x01
+	flagUserVerified   = 0x04
+	flagBackupEligible = 0x08
+	flagAttestedData   = 0x40
+
+	authDataMinLength   = 37 // rpIdHash (32) + flags (1) + signCount (4)
+	aaguidLength        = 16
+	maxCredentialIDSize = 1023
+)
+
+var (
+	ErrInvalidClientData        = errors.New("client data is malformed")
+	ErrChallengeMismatch        = errors.New("challenge does not match")
+	ErrOriginMismatch           = errors.New("origin is not allowed")
+	ErrInvalidAuthenticatorData = errors.New("authenticator data is malformed")
+	ErrRPIDMismatch             = errors.New("credential is scoped to another relying party")
+	ErrUserNotPresent           = errors.New("user presence was not confirmed")
+	ErrUserNotVerified          = errors.New("user verification is required")
+	ErrInvalidAttestation       = errors.New("attestation object is malformed")
+	ErrInvalidSignature         = errors.New("signature is invalid")
+)
+
+// RelyingParty — проверяющая сторона: сайт, к которому привязаны ключи.
+type RelyingParty struct {
+	// ID — домен (rpId), например "example.com"
+	ID string
+	// Name — название сервиса, которое показывает браузер
+	Name string
+	// Origins — допустимые источники клиентских данных, например "https://example.com"
+	Origins []string
+}
+
+// Credential — ключ, полученный при регистрации.
+type Credential struct {
+	ID        []byte
+	PublicKey []byte // COSE_Key
+	SignCount uint32
+
+	UserVerified   bool
+	BackupEligible bool // ключ синхронизируется между устройствами (passkey)
+}
+
+// Assertion — результат проверки подписи при входе.
+type Assertion struct {
+	SignCount    uint32
+	UserVerified bool
+}
+
+// CreationOptions — параметры navigator.credentials.create().
+type CreationOptions struct {
+	Challenge          []byte
+	RP                 RelyingParty
+	UserID             []byte
+	UserName           string
+	UserDisplayName    string
+	Algorithms         []int
+	Timeout            time.Duration
+	ExcludeCredentials [][]byte
+	ResidentKey        string
+	UserVerification   string
+	Attestation        string
+}
+
+// RequestOptions — параметры navigator.credentials.get().
+type RequestOptions struct {
+	Challenge        []byte
+	RPID             string
+	Timeout          time.Duration
+	AllowCredentials [][]byte // пусто — браузер предложит любой passkey сайта
+	UserVerification string
+}
+
+// CreationOptions собирает параметры регистрации. Ключ создаётся как passkey (resident key),
+// чтобы по нему можно было войти без email.
+func (rp RelyingParty) CreationOptions(
+	challenge, userID []byte,
+	userName, userDisplayName string,
+	exclude [][]byte,
+	timeout time.Duration,
+) CreationOptions {
+	return CreationOptions{
+		Challenge:          challenge,
+		RP:                 rp,
+		UserID:             userID,
+		UserName:           userName,
+		UserDisplayName:    userDisplayName,
+		Algorithms:         SupportedAlgorithms,
+		Timeout:            timeout,
+		ExcludeCredentials: exclude,
+		ResidentKey:        ResidentKeyRequired,
+		UserVerification:   UserVerificationPreferred,
+		Attestation:        AttestationNone,
+	}
+}
+
+// RequestOptions собирает параметры входа.
+func (rp RelyingParty) RequestOptions(
+	challenge []byte,
+	allow [][]byte,
+	userVerification string,
+	timeout time.Duration,
+) RequestOptions {
+	return RequestOptions{
+		Challenge:        challenge,
+		RPID:             rp.ID,
+		Timeout:          timeout,
+		AllowCredentials: allow,
+		UserVerification: userVerification,
+	}
+}
+
+// clientData — CollectedClientData из clientDataJSON
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+func parseClientData(clientDataJSON []byte) (clientData, []byte, error) {
+	var cd clientData
+	if err := json.Unmarshal(clientDataJSON, &cd); err != nil {
+		return clientData{}, nil, ErrInvalidClientData
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(cd.Challenge)
+	if err != nil || len(challenge) == 0 {
+		return clientData{}, nil, ErrInvalidClientData
+	}
+	return cd, challenge, nil
+}
+
+// Challenge извлекает challenge из клиентских данных — по нему находится сохранённое состояние церемонии.
+// Подлинность данных при этом не проверяется.
+func Challenge(clientDataJSON []byte) ([]byte, error) {
+	_, challenge, err := parseClientData(clientDataJSON)
+	return challenge, err
+}
+
+func (rp RelyingParty) verifyClientData(clientDataJSON []byte, typ string, expectedChallenge []byte) error {
+	cd, challenge, err := parseClientData(clientDataJSON)
+	if err != nil {
+		return err
+	}
+	if cd.Type != typ || cd.CrossOrigin {
+		return ErrInvalidClientData
+	}
+	if subtle.ConstantTimeCompare(challenge, expectedChallenge) != 1 {
+		return ErrChallengeMismatch
+	}
+	if !slices.Contains(rp.Origins, cd.Origin) {
+		return ErrOriginMismatch
+	}
+	return nil
+}
+
+// authenticatorData — разобранные данные аутентификатора
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+
+	credentialID []byte
+	publicKey    []byte
+}
+
+func parseAuthenticatorData(data []byte) (authenticatorData, error) {
+	if len(data) < authDataMinLength {
+		return authenticatorData{}, ErrInvalidAuthenticatorData
+	}
+	ad := authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	if ad.flags&flagAttestedData == 0 {
+		return ad, nil
+	}
+
+	rest := data[authDataMinLength:]
+	if len(rest) < aaguidLength+2 {
+		return authenticatorData{}, ErrInvalidAuthenticatorData
+	}
+	rest = rest[aaguidLength:]
+	idLen := int(binary.BigEndian.Uint16(rest))
+	rest = rest[2:]
+	if idLen == 0 || idLen > maxCredentialIDSize || idLen > len(rest) {
+		return authenticatorData{}, ErrInvalidAuthenticatorData
+	}
+	ad.credentialID = rest[:idLen]
+	rest = rest[idLen:]
+
+	// За ключом могут следовать расширения — ключ занимает ровно одно значение CBOR
+	_, after, err := decodeCBOR(rest)
+	if err != nil {
+		return authenticatorData{}, ErrInvalidAuthenticatorData
+	}
+	ad.publicKey = rest[:len(rest)-len(after)]
+	return ad, nil
+}
+
+func (rp RelyingParty) verifyFlags(ad authenticatorData, requireUserVerification bool) error {
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(ad.rpIDHash, rpIDHash[:]) {
+		return ErrRPIDMismatch
+	}
+	if ad.flags&flagUserPresent == 0 {
+		return ErrUserNotPresent
+	}
+	if requireUserVerification && ad.flags&flagUserVerified == 0 {
+		return ErrUserNotVerified
+	}
+	return nil
+}
+
+// VerifyRegistration проверяет ответ navigator.credentials.create() и возвращает новый ключ.
+func (rp RelyingParty) VerifyRegistration(
+	challenge, clientDataJSON, attestationObject []byte,
+	requireUserVerification bool,
+) (Credential, error) {
+	if err := rp.verifyClientData(clientDataJSON, clientDataTypeCreate, challenge); err != nil {
+		return Credential{}, err
+	}
+
+	value, rest, err := decodeCBOR(attestationObject)
+	if err != nil || len(rest) != 0 {
+		return Credential{}, ErrInvalidAttestation
+	}
+	obj, ok := value.(map[any]any)
+	if !ok {
+		return Credential{}, ErrInvalidAttestation
+	}
+	if _, ok := obj["fmt"].(string); !ok {
+		return Credential{}, ErrInvalidAttestation
+	}
+	rawAuthData, ok := obj["authData"].([]byte)
+	if !ok {
+		return Credential{}, ErrInvalidAttestation
+	}
+
+	ad, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return Credential{}, err
+	}
+	if err := rp.verifyFlags(ad, requireUserVerification); err != nil {
+		return Credential{}, err
+	}
+	if ad.credentialID == nil {
+		return Credential{}, ErrInvalidAttestation
+	}
+	if _, err := parsePublicKey(ad.publicKey); err != nil {
+		return Credential{}, err
+	}
+
+	return Credential{
+		ID:             bytes.Clone(ad.credentialID),
+		PublicKey:      bytes.Clone(ad.publicKey),
+		SignCount:      ad.signCount,
+		UserVerified:   ad.flags&flagUserVerified != 0,
+		BackupEligible: ad.flags&flagBackupEligible != 0,
+	}, nil
+}
+
+// VerifyAssertion проверяет ответ navigator.credentials.get() ключом, сохранённым при регистрации.
+// Счётчик подписей сравнивает вызывающая сторона.
+func (rp RelyingParty) VerifyAssertion(
+	challenge, publicKeyCOSE, clientDataJSON, authenticatorDataRaw, signature []byte,
+	requireUserVerification bool,
+) (Assertion, error) {
+	if err := rp.verifyClientData(clientDataJSON, clientDataTypeGet, challenge); err != nil {
+		return Assertion{}, err
+	}
+
+	ad, err := parseAuthenticatorData(authenticatorDataRaw)
+	if err != nil {
+		return Assertion{}, err
+	}
+	if err := rp.verifyFlags(ad, requireUserVerification); err != nil {
+		return Assertion{}, err
+	}
+
+	key, err := parsePublicKey(publicKeyCOSE)
+	if err != nil {
+		return Assertion{}, err
+	}
+
+	// Подписываются authenticatorData || SHA-256(clientDataJSON)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(bytes.Clone(authenticatorDataRaw), clientDataHash[:]...)
+	if !key.verify(signed, signature) {
+		return Assertion{}, ErrInvalidSignature
+	}
+
+	return Assertion{
+		SignCount:    ad.signCount,
+		UserVerified: ad.flags&flagUserVerified != 0,
+	}, nil
+}
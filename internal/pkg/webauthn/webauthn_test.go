@@ -0,0 +1,148 @@
+package webauthn
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Vi-72/quest-auth/internal/pkg/webauthn/webauthntest"
+)
+
+var testRP = RelyingParty{
+	ID:      "example.com",
+	Name:    "Example",
+	Origins: []string{"https://example.com"},
+}
+
+func register(t *testing.T, a *webauthntest.Authenticator, challenge []byte) Credential {
+	t.Helper()
+	reg, err := a.Register(challenge, []byte("user-handle"))
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	cred, err := testRP.VerifyRegistration(challenge, reg.ClientDataJSON, reg.AttestationObject, true)
+	if err != nil {
+		t.Fatalf("VerifyRegistration() error = %v", err)
+	}
+	return cred
+}
+
+func TestRegistrationAndAssertion(t *testing.T) {
+	a := webauthntest.New(testRP.ID, testRP.Origins[0])
+	cred := register(t, a, []byte("registration-challenge"))
+
+	if len(cred.ID) == 0 || !cred.UserVerified {
+		t.Fatalf("unexpected credential %+v", cred)
+	}
+
+	challenge := []byte("login-challenge")
+	assertion, err := a.Login(challenge, nil)
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	got, err := testRP.VerifyAssertion(challenge, cred.PublicKey,
+		assertion.ClientDataJSON, assertion.AuthenticatorData, assertion.Signature, true)
+	if err != nil {
+		t.Fatalf("VerifyAssertion() error = %v", err)
+	}
+	if got.SignCount != 1 {
+		t.Errorf("SignCount = %d, want 1", got.SignCount)
+	}
+
+	fromClientData, err := Challenge(assertion.ClientDataJSON)
+	if err != nil || string(fromClientData) != string(challenge) {
+		t.Errorf("Challenge() = %q, %v", fromClientData, err)
+	}
+}
+
+func TestVerifyRegistrationRejections(t *testing.T) {
+	challenge := []byte("registration-challenge")
+
+	tests := []struct {
+		name    string
+		prepare func(a *webauthntest.Authenticator)
+		rp      RelyingParty
+		want    error
+	}{
+		{"foreign origin", func(a *webauthntest.Authenticator) { a.Origin = "https://evil.example" }, testRP, ErrOriginMismatch},
+		{"foreign rp id", func(a *webauthntest.Authenticator) { a.RPID = "evil.example" }, testRP, ErrRPIDMismatch},
+		{"no user verification", func(a *webauthntest.Authenticator) { a.SkipUserVerification = true }, testRP, ErrUserNotVerified},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := webauthntest.New(testRP.ID, testRP.Origins[0])
+			tt.prepare(a)
+			reg, err := a.Register(challenge, []byte("user-handle"))
+			if err != nil {
+				t.Fatalf("Register() error = %v", err)
+			}
+
+			_, err = tt.rp.VerifyRegistration(challenge, reg.ClientDataJSON, reg.AttestationObject, true)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("VerifyRegistration() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	a := webauthntest.New(testRP.ID, testRP.Origins[0])
+	reg, _ := a.Register(challenge, []byte("user-handle"))
+	if _, err := testRP.VerifyRegistration([]byte("other"), reg.ClientDataJSON, reg.AttestationObject, true); !errors.Is(err, ErrChallengeMismatch) {
+		t.Errorf("VerifyRegistration(other challenge) error = %v, want %v", err, ErrChallengeMismatch)
+	}
+	if _, err := testRP.VerifyRegistration(challenge, reg.ClientDataJSON, reg.AttestationObject[:10], true); err == nil {
+		t.Error("VerifyRegistration() accepted a truncated attestation object")
+	}
+}
+
+func TestVerifyAssertionRejections(t *testing.T) {
+	a := webauthntest.New(testRP.ID, testRP.Origins[0])
+	cred := register(t, a, []byte("registration-challenge"))
+	challenge := []byte("login-challenge")
+
+	assertion, err := a.Login(challenge, [][]byte{cred.ID})
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	tampered := append([]byte{}, assertion.AuthenticatorData...)
+	tampered[len(tampered)-1]++
+	if _, err := testRP.VerifyAssertion(challenge, cred.PublicKey,
+		assertion.ClientDataJSON, tampered, assertion.Signature, false); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("tampered authenticator data: error = %v, want %v", err, ErrInvalidSignature)
+	}
+
+	if _, err := testRP.VerifyAssertion(challenge, cred.PublicKey,
+		assertion.ClientDataJSON, assertion.AuthenticatorData, assertion.Signature, false); err != nil {
+		t.Fatalf("VerifyAssertion() error = %v", err)
+	}
+
+	// Ответ на регистрацию не принимается как ответ на вход
+	reg, _ := a.Register(challenge, []byte("user-handle"))
+	if _, err := testRP.VerifyAssertion(challenge, cred.PublicKey,
+		reg.ClientDataJSON, assertion.AuthenticatorData, assertion.Signature, false); !errors.Is(err, ErrInvalidClientData) {
+		t.Errorf("create client data: error = %v, want %v", err, ErrInvalidClientData)
+	}
+
+	a.SkipUserVerification = true
+	noUV, _ := a.Login(challenge, [][]byte{cred.ID})
+	if _, err := testRP.VerifyAssertion(challenge, cred.PublicKey,
+		noUV.ClientDataJSON, noUV.AuthenticatorData, noUV.Signature, true); !errors.Is(err, ErrUserNotVerified) {
+		t.Errorf("no user verification: error = %v, want %v", err, ErrUserNotVerified)
+	}
+}
+
+func TestDecodeCBORRejectsMalformedInput(t *testing.T) {
+	inputs := [][]byte{
+		{},
+		{0x5a, 0xff, 0xff, 0xff, 0xff}, // байтовая строка длиннее данных
+		{0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, // огромный массив
+		{0xa1, 0x40, 0x01}, // ключ словаря — байтовая строка
+		{0x5f},             // неопределённая длина
+	}
+	for _, in := range inputs {
+		if _, _, err := decodeCBOR(in); err == nil {
+			t.Errorf("decodeCBOR(%x) accepted malformed input", in)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+// Package webauthntest — программный аутентификатор для тестов церемоний WebAuthn.
+package webauthntest
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+const (
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttestedData = 0x40
+
+	credentialIDBytes = 16
+)
+
+var ErrNoCredential = errors.New("authenticator has no matching credential")
+
+// Authenticator хранит ключи ES256 в памяти и отвечает на церемонии как браузер с passkey.
+type Authenticator struct {
+	RPID   string
+	Origin string
+
+	// SkipUserVerification — не выставлять флаг UV (ключ без PIN и биометрии)
+	SkipUserVerification bool
+
+	credentials []*credential
+}
+
+type credential struct {
+	id         []byte
+	key        *ecdsa.PrivateKey
+	userHandle []byte
+	signCount  uint32
+}
+
+// Registration — ответ navigator.credentials.create()
+type Registration struct {
+	CredentialID      []byte
+	ClientDataJSON    []byte
+	AttestationObject []byte
+}
+
+// Assertion — ответ navigator.credentials.get()
+type Assertion struct {
+	CredentialID      []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+	UserHandle        []byte
+}
+
+func New(rpID, origin string) *Authenticator {
+	return &Authenticator{RPID: rpID, Origin: origin}
+}
+
+// Register создаёт новый ключ для userHandle и возвращает ответ с attestation "none".
+func (a *Authenticator) Register(challenge, userHandle []byte) (Registration, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return Registration{}, err
+	}
+	id := make([]byte, credentialIDBytes)
+	if _, err := rand.Read(id); err != nil {
+		return Registration{}, err
+	}
+	cred := &credential{id: id, key: key, userHandle: bytes.Clone(userHandle)}
+	a.credentials = append(a.credentials, cred)
+
+	clientDataJSON, err := a.clientData("webauthn.create", challenge)
+	if err != nil {
+		return Registration{}, err
+	}
+
+	authData := a.authenticatorData(flagAttestedData, 0)
+	authData = append(authData, make([]byte, 16)...) // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(id)))
+	authData = append(authData, id...)
+	authData = append(authData, coseKey(&key.PublicKey)...)
+
+	attestationObject := encodeMap(map[any]any{
+		"fmt":      "none",
+		"attStmt":  map[any]any{},
+		"authData": authData,
+	})
+
+	return Registration{
+		CredentialID:      bytes.Clone(id),
+		ClientDataJSON:    clientDataJSON,
+		AttestationObject: attestationObject,
+	}, nil
+}
+
+// Login подписывает challenge первым ключом из allow (или любым ключом, если список пуст).
+func (a *Authenticator) Login(challenge []byte, allow [][]byte) (Assertion, error) {
+	cred := a.find(allow)
+	if cred == nil {
+		return Assertion{}, ErrNoCredential
+	}
+	cred.signCount++
+
+	clientDataJSON, err := a.clientData("webauthn.get", challenge)
+	if err != nil {
+		return Assertion{}, err
+	}
+	authData := a.authenticatorData(0, cred.signCount)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(bytes.Clone(authData), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, cred.key, digest[:])
+	if err != nil {
+		return Assertion{}, err
+	}
+
+	return Assertion{
+		CredentialID:      bytes.Clone(cred.id),
+		ClientDataJSON:    clientDataJSON,
+		AuthenticatorData: authData,
+		Signature:         signature,
+		UserHandle:        bytes.Clone(cred.userHandle),
+	}, nil
+}
+
+func (a *Authenticator) find(allow [][]byte) *credential {
+	for _, cred := range a.credentials {
+		if len(allow) == 0 {
+			return cred
+		}
+		for _, id := range allow {
+			if bytes.Equal(id, cred.id) {
+				return cred
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Authenticator) clientData(typ string, challenge []byte) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":        typ,
+		"challenge":   base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+}
+
+func (a *Authenticator) authenticatorData(flags byte, signCount uint32) []byte {
+	flags |= flagUserPresent
+	if !a.SkipUserVerification {
+		flags |= flagUserVerified
+	}
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+	data := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(data, signCount)
+}
+
+// coseKey кодирует открытый ключ P-256 как COSE_Key (kty EC2, alg ES256)
+func coseKey(pub *ecdsa.PublicKey) []byte {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	pub.X.FillBytes(x)
+	pub.Y.FillBytes(y)
+	return encodeMap(map[any]any{
+		int64(1):  int64(2),
+		int64(3):  int64(-7),
+		int64(-1): int64(1),
+		int64(-2): x,
+		int64(-3): y,
+	})
+}
+
+// Минимальный кодировщик CBOR для ответов аутентификатора
+
+func encodeMap(m map[any]any) []byte {
+	keys := make([]any, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	// детерминированный порядок: сначала целые ключи, затем строковые
+	sort.Slice(keys, func(i, j int) bool {
+		ki, iInt := keys[i].(int64)
+		kj, jInt := keys[j].(int64)
+		if iInt != jInt {
+			return iInt
+		}
+		if iInt {
+			return ki < kj
+		}
+		return keys[i].(string) < keys[j].(string)
+	})
+
+	out := encodeHead(5, uint64(len(m)))
+	for _, k := range keys {
+		out = append(out, encodeValue(k)...)
+		out = append(out, encodeValue(m[k])...)
+	}
+	return out
+}
+
+func encodeValue(v any) []byte {
+	switch v := v.(type) {
+	case int64:
+		if v >= 0 {
+			return encodeHead(0, uint64(v))
+		}
+		return encodeHead(1, uint64(-1-v))
+	case []byte:
+		return append(encodeHead(2, uint64(len(v))), v...)
+	case string:
+		return append(encodeHead(3, uint64(len(v))), v...)
+	case map[any]any:
+		return encodeMap(v)
+	}
+	panic("webauthntest: unsupported CBOR value")
+}
+
+func encodeHead(major byte, arg uint64) []byte {
+	head := major << 5
+	switch {
+	case arg < 24:
+		return []byte{head | byte(arg)}
+	case arg <= 0xff:
+		return []byte{head | 24, byte(arg)}
+	case arg <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{head | 25}, uint16(arg))
+	case arg <= 0xffffffff:
+		return binary.BigEndian.AppendUint32([]byte{head | 26}, uint32(arg))
+	}
+	return binary.BigEndian.AppendUint64([]byte{head | 27}, arg)
+}
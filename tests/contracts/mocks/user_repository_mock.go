@@ -2,7 +2,9 @@ package mocks
 
 import (
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
@@ -74,6 +76,17 @@ func (m *MockUserRepository) GetByPhone(phone kernel.Phone) (*auth.User, error)
 	return u, nil
 }
 
+func (m *MockUserRepository) GetByUsername(username kernel.Username) (*auth.User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, u := range m.byID {
+		if !u.Username.IsZero() && u.Username.Equals(username) {
+			return u, nil
+		}
+	}
+	return nil, fmt.Errorf("not found")
+}
+
 func (m *MockUserRepository) Update(user *auth.User) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -114,6 +127,64 @@ func (m *MockUserRepository) PhoneExists(phone kernel.Phone) (bool, error) {
 	return ok, nil
 }
 
+func (m *MockUserRepository) UsernameExists(username kernel.Username) (bool, error) {
+	_, err := m.GetByUsername(username)
+	return err == nil, nil
+}
+
+func (m *MockUserRepository) ListDeletedBefore(before time.Time, limit int) ([]uuid.UUID, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var deleted []*auth.User
+	for _, u := range m.byID {
+		if u.DeletedAt != nil && u.DeletedAt.Before(before) {
+			deleted = append(deleted, u)
+		}
+	}
+	sort.Slice(deleted, func(i, j int) bool { return deleted[i].DeletedAt.Before(*deleted[j].DeletedAt) })
+	if limit > 0 && len(deleted) > limit {
+		deleted = deleted[:limit]
+	}
+	ids := make([]uuid.UUID, 0, len(deleted))
+	for _, u := range deleted {
+		ids = append(ids, u.ID())
+	}
+	return ids, nil
+}
+
+func (m *MockUserRepository) ListDeletionDue(now time.Time, limit int) ([]uuid.UUID, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var due []*auth.User
+	for _, u := range m.byID {
+		if !u.IsDeleted() && u.IsDeletionDue(now) {
+			due = append(due, u)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].DeletionScheduledAt.Before(*due[j].DeletionScheduledAt) })
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+	ids := make([]uuid.UUID, 0, len(due))
+	for _, u := range due {
+		ids = append(ids, u.ID())
+	}
+	return ids, nil
+}
+
+func (m *MockUserRepository) Purge(id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.byID[id]
+	if !ok {
+		return nil
+	}
+	delete(m.byID, id)
+	delete(m.byEmail, u.Email.String())
+	delete(m.byPhone, u.Phone.String())
+	return nil
+}
+
 // Helpers
 func (m *MockUserRepository) Clear() {
 	m.mu.Lock()
@@ -32,7 +32,7 @@ func TestUserRepositoryContract(t *testing.T) { suite.Run(t, new(UserRepositoryC
 
 func (s *UserRepositoryContractSuite) TestCreateAndGetByID() {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 	u, _ := auth.NewUser(email, phone, "John Doe", "password123", testHasher{}, testClock{})
 
 	err := s.repo.Create(&u)
@@ -45,7 +45,7 @@ func (s *UserRepositoryContractSuite) TestCreateAndGetByID() {
 
 func (s *UserRepositoryContractSuite) TestGetByEmailAndPhone() {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 	u, _ := auth.NewUser(email, phone, "John Doe", "password123", testHasher{}, testClock{})
 	_ = s.repo.Create(&u)
 
@@ -58,9 +58,31 @@ func (s *UserRepositoryContractSuite) TestGetByEmailAndPhone() {
 	s.Equal(u.ID(), gp.ID())
 }
 
+func (s *UserRepositoryContractSuite) TestGetByUsername() {
+	email, _ := kernel.NewEmail("user@example.com")
+	phone, _ := kernel.NewPhone("+12015550100")
+	u, _ := auth.NewUser(email, phone, "John Doe", "password123", testHasher{}, testClock{})
+	username, _ := kernel.NewUsername("John.Doe")
+	u.ChangeUsername(username, testClock{})
+	_ = s.repo.Create(&u)
+
+	got, err := s.repo.GetByUsername(username)
+	s.Require().NoError(err)
+	s.Equal(u.ID(), got.ID())
+
+	exists, err := s.repo.UsernameExists(username)
+	s.Require().NoError(err)
+	s.True(exists)
+
+	other, _ := kernel.NewUsername("jane.doe")
+	exists, err = s.repo.UsernameExists(other)
+	s.Require().NoError(err)
+	s.False(exists)
+}
+
 func (s *UserRepositoryContractSuite) TestUpdateAndDelete() {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 	u, _ := auth.NewUser(email, phone, "John Doe", "password123", testHasher{}, testClock{})
 	_ = s.repo.Create(&u)
 
@@ -0,0 +1,50 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for user data exports generated in the background
+
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+func TestNewDataExport_IsPending(t *testing.T) {
+	now := time.Now()
+	userID := uuid.New()
+
+	export := auth.NewDataExport(userID, 72*time.Hour, FakeClockAt(now))
+
+	assert.NotEqual(t, uuid.Nil, export.ID)
+	assert.Equal(t, userID, export.UserID)
+	assert.Equal(t, auth.DataExportPending, export.Status)
+	assert.False(t, export.IsReady())
+	assert.Empty(t, export.Archive)
+	assert.Nil(t, export.ReadyAt)
+	assert.Equal(t, now.Add(72*time.Hour), export.ExpiresAt)
+}
+
+func TestDataExport_Complete_KeepsArchiveForTTL(t *testing.T) {
+	created := time.Now()
+	export := auth.NewDataExport(uuid.New(), time.Hour, FakeClockAt(created))
+	ready := created.Add(30 * time.Minute)
+
+	require.NoError(t, export.Complete([]byte(`{"profile":{}}`), time.Hour, FakeClockAt(ready)))
+
+	assert.True(t, export.IsReady())
+	assert.Equal(t, auth.DataExportReady, export.Status)
+	assert.JSONEq(t, `{"profile":{}}`, string(export.Archive))
+	require.NotNil(t, export.ReadyAt)
+	assert.Equal(t, ready, *export.ReadyAt)
+	assert.False(t, export.IsExpired(created.Add(time.Hour)))
+	assert.True(t, export.IsExpired(ready.Add(time.Hour)))
+
+	err := export.Complete([]byte(`{}`), time.Hour, FakeClockAt(ready))
+	assert.True(t, errors.Is(err, auth.ErrDataExportAlreadyReady))
+}
@@ -0,0 +1,59 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for email change with confirmation of the new address
+
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+)
+
+func TestUser_RequestEmailChange_KeepsEmailUntilConfirmed(t *testing.T) {
+	u := newTestUser(t)
+	newEmail, err := kernel.NewEmail("new@example.com")
+	require.NoError(t, err)
+
+	err = u.RequestEmailChange(newEmail, FakeClock{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "user@example.com", u.Email.String())
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "UserEmailChangeRequested", events[0].GetName())
+}
+
+func TestUser_RequestEmailChange_SameEmail(t *testing.T) {
+	u := newTestUser(t)
+
+	err := u.RequestEmailChange(u.Email, FakeClock{})
+
+	require.ErrorIs(t, err, auth.ErrEmailUnchanged)
+	assert.Empty(t, u.GetDomainEvents())
+}
+
+func TestUser_ConfirmEmailChange(t *testing.T) {
+	now := time.Now().Add(time.Hour)
+	u := newTestUser(t)
+	newEmail, err := kernel.NewEmail("new@example.com")
+	require.NoError(t, err)
+
+	err = u.ConfirmEmailChange(newEmail, FakeClock{t: now})
+	require.NoError(t, err)
+
+	assert.Equal(t, "new@example.com", u.Email.String())
+	assert.True(t, u.IsEmailVerified())
+	assert.Equal(t, now, u.UpdatedAt)
+
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	changed, ok := events[0].(auth.UserEmailChanged)
+	require.True(t, ok)
+	assert.Equal(t, "user@example.com", changed.Old)
+	assert.Equal(t, "new@example.com", changed.New)
+}
@@ -53,5 +53,115 @@ func TestEmail_EqualsAndString(t *testing.T) {
 
 	assert.True(t, e1.Equals(e2))
 	assert.False(t, e1.Equals(e3))
-	assert.Equal(t, "user@example.com", e1.String())
+	assert.Equal(t, "User@example.com", e1.String())
+	assert.Equal(t, "user@example.com", e1.Canonical())
+}
+
+func TestNewEmail_Internationalized(t *testing.T) {
+	tests := []struct {
+		in        string
+		display   string
+		canonical string
+	}{
+		{"José@Example.com", "José@example.com", "josé@example.com"},
+		{"用户@例子.广告", "用户@例子.广告", "用户@xn--fsqu00a.xn--4rr70v"},
+		{"user@xn--bcher-kva.example", "user@bücher.example", "user@xn--bcher-kva.example"},
+		{"user@Bücher.Example", "user@bücher.example", "user@xn--bcher-kva.example"},
+		{"o'brien+news@example.com", "o'brien+news@example.com", "o'brien+news@example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			e, err := kernel.NewEmail(tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.display, e.String())
+			assert.Equal(t, tt.canonical, e.Canonical())
+		})
+	}
+}
+
+func TestNewEmail_InvalidInternationalized(t *testing.T) {
+	invalid := []string{
+		"us er@example.com",
+		"user\u00a0@example.com",
+		".user@example.com",
+		"us..er@example.com",
+		"user@exa_mple.com",
+		"user@example.c",
+		"user@-example.com",
+		"user@xn--zz.com",
+		"user\u200b@example.com",
+	}
+	for _, s := range invalid {
+		t.Run(s, func(t *testing.T) {
+			_, err := kernel.NewEmail(s)
+			assert.ErrorIs(t, err, kernel.ErrEmailInvalid)
+		})
+	}
+}
+
+func TestEmailCanonicalization(t *testing.T) {
+	rules := kernel.EmailCanonicalization{
+		StripPlusTags:         true,
+		DotInsensitiveDomains: []string{"gmail.com"},
+		DomainAliases:         map[string]string{"googlemail.com": "gmail.com"},
+	}
+
+	tests := []struct {
+		in        string
+		canonical string
+	}{
+		{"John.Doe+x@gmail.com", "johndoe@gmail.com"},
+		{"johndoe@googlemail.com", "johndoe@gmail.com"},
+		{"j.o.h.n.d.o.e@GMAIL.com", "johndoe@gmail.com"},
+		{"john.doe+x@example.com", "john.doe@example.com"},
+		{"+tag@example.com", "+tag@example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			e, err := rules.NewEmail(tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.canonical, e.Canonical())
+			// The display form is kept
+			plain, _ := kernel.NewEmail(tt.in)
+			assert.Equal(t, plain.String(), e.String())
+		})
+	}
+
+	a, _ := rules.NewEmail("John.Doe+x@gmail.com")
+	b, _ := rules.NewEmail("johndoe@gmail.com")
+	assert.True(t, a.Equals(b))
+
+	// Without rules plus tags and dots are significant
+	c, _ := kernel.NewEmail("John.Doe+x@gmail.com")
+	d, _ := kernel.NewEmail("johndoe@gmail.com")
+	assert.False(t, c.Equals(d))
+}
+
+func TestRestoreEmail_KeepsStoredCanonicalForm(t *testing.T) {
+	e, err := kernel.RestoreEmail("John.Doe+x@gmail.com", "johndoe@gmail.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "John.Doe+x@gmail.com", e.String())
+	assert.Equal(t, "johndoe@gmail.com", e.Canonical())
+
+	legacy, err := kernel.RestoreEmail("user@example.com", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", legacy.Canonical())
+}
+
+func TestNewEmailCanonicalization_NormalizesDomains(t *testing.T) {
+	rules, err := kernel.NewEmailCanonicalization(
+		true,
+		[]string{" GMail.com ", "bücher.example"},
+		map[string]string{"GoogleMail.com": "gmail.com"},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"gmail.com", "xn--bcher-kva.example"}, rules.DotInsensitiveDomains)
+	assert.Equal(t, map[string]string{"googlemail.com": "gmail.com"}, rules.DomainAliases)
+
+	e, err := rules.NewEmail("j.doe@bücher.example")
+	assert.NoError(t, err)
+	assert.Equal(t, "jdoe@xn--bcher-kva.example", e.Canonical())
+
+	_, err = kernel.NewEmailCanonicalization(false, []string{"localhost"}, nil)
+	assert.ErrorIs(t, err, kernel.ErrEmailInvalid)
 }
@@ -0,0 +1,90 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for email verification state and one-time verification tokens
+
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+func TestUser_NewUser_EmailNotVerified(t *testing.T) {
+	u := newTestUser(t)
+
+	assert.False(t, u.IsEmailVerified())
+	assert.Nil(t, u.EmailVerifiedAt)
+}
+
+func TestUser_VerifyEmail(t *testing.T) {
+	now := time.Now().Add(time.Hour)
+	u := newTestUser(t)
+
+	err := u.VerifyEmail(FakeClock{t: now})
+	require.NoError(t, err)
+
+	assert.True(t, u.IsEmailVerified())
+	require.NotNil(t, u.EmailVerifiedAt)
+	assert.Equal(t, now, *u.EmailVerifiedAt)
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "UserEmailVerified", events[0].GetName())
+}
+
+func TestUser_VerifyEmail_AlreadyVerified(t *testing.T) {
+	u := newTestUser(t)
+	require.NoError(t, u.VerifyEmail(FakeClock{}))
+	u.ClearDomainEvents()
+
+	err := u.VerifyEmail(FakeClock{})
+
+	require.ErrorIs(t, err, auth.ErrEmailAlreadyVerified)
+	assert.Empty(t, u.GetDomainEvents())
+}
+
+func TestVerificationToken_NewHidesSecret(t *testing.T) {
+	token, secret, err := auth.NewVerificationToken(uuid.New(), auth.VerificationPurposeEmail, "user@example.com", time.Hour, FakeClock{})
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, secret)
+	assert.NotEqual(t, secret, token.SecretHash)
+	assert.Equal(t, auth.HashVerificationSecret(secret), token.SecretHash)
+	assert.Equal(t, "user@example.com", token.Target)
+}
+
+func TestVerificationToken_SecretsAreUnique(t *testing.T) {
+	_, first, err := auth.NewVerificationToken(uuid.New(), auth.VerificationPurposeEmail, "user@example.com", time.Hour, FakeClock{})
+	require.NoError(t, err)
+	_, second, err := auth.NewVerificationToken(uuid.New(), auth.VerificationPurposeEmail, "user@example.com", time.Hour, FakeClock{})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+}
+
+func TestVerificationToken_ConsumeOnce(t *testing.T) {
+	now := time.Now()
+	token, _, err := auth.NewVerificationToken(uuid.New(), auth.VerificationPurposeEmail, "user@example.com", time.Hour, FakeClock{t: now})
+	require.NoError(t, err)
+
+	require.NoError(t, token.Consume(FakeClock{t: now.Add(time.Minute)}))
+	require.NotNil(t, token.UsedAt)
+
+	err = token.Consume(FakeClock{t: now.Add(2 * time.Minute)})
+	require.ErrorIs(t, err, auth.ErrVerificationTokenInvalid)
+}
+
+func TestVerificationToken_ConsumeExpired(t *testing.T) {
+	now := time.Now()
+	token, _, err := auth.NewVerificationToken(uuid.New(), auth.VerificationPurposeEmail, "user@example.com", time.Hour, FakeClock{t: now})
+	require.NoError(t, err)
+
+	err = token.Consume(FakeClock{t: now.Add(time.Hour)})
+
+	require.ErrorIs(t, err, auth.ErrVerificationTokenInvalid)
+	assert.Nil(t, token.UsedAt)
+}
@@ -0,0 +1,130 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for login lockout after failed password attempts
+
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+var testLockoutRule = auth.LockoutRule{
+	Threshold:    3,
+	BaseDuration: time.Minute,
+	MaxDuration:  10 * time.Minute,
+}
+
+// failLogins records n failed logins at now and returns whether the last one locked the user
+func failLogins(u *auth.User, n int, now time.Time) bool {
+	locked := false
+	for i := 0; i < n; i++ {
+		locked = u.RecordFailedLogin(testLockoutRule, FakeClockAt(now))
+	}
+	return locked
+}
+
+func TestUser_RecordFailedLogin_LocksAfterThreshold(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+
+	assert.False(t, failLogins(&u, 2, now))
+	assert.False(t, u.IsLockedOut(now))
+
+	assert.True(t, failLogins(&u, 1, now))
+	assert.True(t, u.IsLockedOut(now))
+	assert.Equal(t, time.Minute, u.LockedFor(now))
+	assert.False(t, u.IsLockedOut(now.Add(time.Minute)))
+	assert.Equal(t, 0, u.FailedLoginAttempts)
+
+	events := u.GetDomainEvents()
+	require.Len(t, events, 4)
+	assert.Equal(t, "UserLoginFailed", events[0].GetName())
+	assert.Equal(t, "UserLockedOut", events[3].GetName())
+}
+
+func TestUser_RecordFailedLogin_BackoffDoublesUpToMax(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+
+	var durations []time.Duration
+	for i := 0; i < 6; i++ {
+		require.True(t, failLogins(&u, 3, now))
+		durations = append(durations, u.LockedFor(now))
+	}
+
+	assert.Equal(t, []time.Duration{
+		time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 10 * time.Minute, 10 * time.Minute,
+	}, durations)
+}
+
+func TestUser_RecordFailedLogin_UncappedBackoffSaturates(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+	rule := auth.LockoutRule{Threshold: 1, BaseDuration: time.Minute}
+
+	// Pre-condition: a persistent attacker has already caused many lockouts
+	u.Lockouts = 1000
+
+	// Act
+	require.True(t, u.RecordFailedLogin(rule, FakeClockAt(now)))
+
+	// Assert: the doubling saturates instead of overflowing into the past
+	assert.True(t, u.IsLockedOut(now))
+	assert.Equal(t, time.Duration(math.MaxInt64), u.LockedFor(now))
+
+	prev := time.Duration(0)
+	u = newTestUser(t)
+	for i := 0; i < 70; i++ {
+		require.True(t, u.RecordFailedLogin(rule, FakeClockAt(now)))
+		got := u.LockedFor(now)
+		assert.GreaterOrEqual(t, got, prev, "lockout %d", i+1)
+		prev = got
+	}
+}
+
+func TestUser_RecordFailedLogin_ZeroThresholdNeverLocks(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+
+	for i := 0; i < 100; i++ {
+		assert.False(t, u.RecordFailedLogin(auth.LockoutRule{}, FakeClockAt(now)))
+	}
+	assert.False(t, u.IsLockedOut(now))
+}
+
+func TestUser_ResetFailedLogins_RestartsBackoff(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+	failLogins(&u, 6, now)
+	assert.Equal(t, 2*time.Minute, u.LockedFor(now))
+
+	assert.True(t, u.ResetFailedLogins())
+	assert.False(t, u.ResetFailedLogins())
+
+	failLogins(&u, 3, now)
+	assert.Equal(t, time.Minute, u.LockedFor(now))
+}
+
+func TestUser_Unlock(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+	failLogins(&u, 3, now)
+	u.ClearDomainEvents()
+
+	u.Unlock(FakeClockAt(now))
+
+	assert.False(t, u.IsLockedOut(now))
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "UserUnlocked", events[0].GetName())
+
+	// Пользователь без блокировки — событий нет
+	u.Unlock(FakeClockAt(now))
+	assert.Len(t, u.GetDomainEvents(), 1)
+}
@@ -0,0 +1,100 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for new-device and unusual-location login detection
+
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+const (
+	testDesktopUA = "Mozilla/5.0 (X11; Linux x86_64) Firefox/130.0"
+	testPhoneUA   = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) Safari/604.1"
+)
+
+func newTestLoginRecord(t *testing.T, userAgent, country string) auth.LoginRecord {
+	t.Helper()
+	return auth.NewLoginRecord(
+		newTestUser(t).ID(), userAgent, "203.0.113.7", auth.Location{Country: country}, FakeClockAt(time.Now()),
+	)
+}
+
+func TestHashDevice_IgnoresCaseAndSurroundingSpaces(t *testing.T) {
+	assert.Equal(t, auth.HashDevice(testDesktopUA), auth.HashDevice("  "+testDesktopUA+"\n"))
+	assert.Equal(t, auth.HashDevice(testDesktopUA), auth.HashDevice(strings.ToUpper(testDesktopUA)))
+	assert.NotEqual(t, auth.HashDevice(testDesktopUA), auth.HashDevice(testPhoneUA))
+	assert.Len(t, auth.HashDevice(testDesktopUA), 64)
+}
+
+func TestAssessLogin_FirstLoginIsNotSuspicious(t *testing.T) {
+	risk := auth.AssessLogin(nil, newTestLoginRecord(t, testDesktopUA, "DE"))
+
+	assert.False(t, risk.Suspicious())
+}
+
+func TestAssessLogin_KnownDeviceAndCountry(t *testing.T) {
+	history := []auth.LoginRecord{newTestLoginRecord(t, testDesktopUA, "DE")}
+
+	risk := auth.AssessLogin(history, newTestLoginRecord(t, testDesktopUA, "DE"))
+
+	assert.Equal(t, auth.LoginRisk{}, risk)
+}
+
+func TestAssessLogin_NewDevice(t *testing.T) {
+	history := []auth.LoginRecord{newTestLoginRecord(t, testDesktopUA, "DE")}
+
+	risk := auth.AssessLogin(history, newTestLoginRecord(t, testPhoneUA, "DE"))
+
+	assert.True(t, risk.Suspicious())
+	assert.True(t, risk.NewDevice)
+	assert.False(t, risk.NewLocation)
+}
+
+func TestAssessLogin_NewCountry(t *testing.T) {
+	history := []auth.LoginRecord{
+		newTestLoginRecord(t, testPhoneUA, "FR"),
+		newTestLoginRecord(t, testDesktopUA, "DE"),
+	}
+
+	risk := auth.AssessLogin(history, newTestLoginRecord(t, testDesktopUA, "BR"))
+
+	assert.False(t, risk.NewDevice)
+	assert.True(t, risk.NewLocation)
+}
+
+func TestAssessLogin_UnknownCountryIsNotCompared(t *testing.T) {
+	// Без базы GeoIP страна прошлых входов неизвестна
+	history := []auth.LoginRecord{newTestLoginRecord(t, testDesktopUA, "")}
+	assert.False(t, auth.AssessLogin(history, newTestLoginRecord(t, testDesktopUA, "DE")).NewLocation)
+
+	// Адрес нового входа не найден в базе
+	history = []auth.LoginRecord{newTestLoginRecord(t, testDesktopUA, "DE")}
+	assert.False(t, auth.AssessLogin(history, newTestLoginRecord(t, testDesktopUA, "")).NewLocation)
+}
+
+func TestUser_FlagSuspiciousLogin_RaisesEvent(t *testing.T) {
+	u := newTestUser(t)
+	u.ClearDomainEvents()
+	record := newTestLoginRecord(t, testPhoneUA, "BR")
+
+	u.FlagSuspiciousLogin(record, auth.LoginRisk{NewDevice: true, NewLocation: true}, FakeClockAt(time.Now()))
+
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "SuspiciousLoginDetected", events[0].GetName())
+
+	event, ok := events[0].(auth.SuspiciousLoginDetected)
+	require.True(t, ok)
+	assert.Equal(t, u.ID(), event.UserID)
+	assert.Equal(t, testPhoneUA, event.UserAgent)
+	assert.Equal(t, "BR", event.Country)
+	assert.True(t, event.NewDevice)
+	assert.True(t, event.NewLocation)
+}
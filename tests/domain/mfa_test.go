@@ -0,0 +1,182 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for TOTP enrollment, second-factor verification and recovery codes
+
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/pkg/totp"
+)
+
+func totpCode(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	code, err := totp.Code(secret, totp.Step(at))
+	require.NoError(t, err)
+	return code
+}
+
+func newMFAUser(t *testing.T, now time.Time) auth.User {
+	t.Helper()
+	u := newTestUser(t)
+	secret, err := u.BeginTOTPEnrollment(FakeClockAt(now))
+	require.NoError(t, err)
+	require.NoError(t, u.ConfirmTOTPEnrollment(totpCode(t, secret, now), FakeClockAt(now)))
+	u.ClearDomainEvents()
+	return u
+}
+
+func TestUser_TOTPEnrollment(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+
+	secret, err := u.BeginTOTPEnrollment(FakeClockAt(now))
+	require.NoError(t, err)
+	assert.NotEmpty(t, secret)
+	assert.False(t, u.IsMFAEnabled(), "MFA must stay disabled until the first code is confirmed")
+	assert.Empty(t, u.GetDomainEvents())
+
+	err = u.ConfirmTOTPEnrollment(totpCode(t, secret, now), FakeClockAt(now))
+	require.NoError(t, err)
+
+	assert.True(t, u.IsMFAEnabled())
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "UserMFAEnabled", events[0].GetName())
+	assert.Equal(t, auth.MFAMethodTOTP, events[0].(auth.UserMFAEnabled).Method)
+}
+
+func TestUser_ConfirmTOTPEnrollment_WrongCode(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+	_, err := u.BeginTOTPEnrollment(FakeClockAt(now))
+	require.NoError(t, err)
+
+	err = u.ConfirmTOTPEnrollment("000000", FakeClockAt(now.Add(time.Hour)))
+
+	require.ErrorIs(t, err, auth.ErrTOTPCodeInvalid)
+	assert.False(t, u.IsMFAEnabled())
+}
+
+func TestUser_ConfirmTOTPEnrollment_NotStarted(t *testing.T) {
+	u := newTestUser(t)
+
+	err := u.ConfirmTOTPEnrollment("123456", FakeClock{})
+
+	require.ErrorIs(t, err, auth.ErrTOTPNotEnrolled)
+}
+
+func TestUser_BeginTOTPEnrollment_AlreadyEnabled(t *testing.T) {
+	u := newMFAUser(t, time.Now())
+	secret := u.TOTPSecret
+
+	_, err := u.BeginTOTPEnrollment(FakeClock{})
+
+	require.ErrorIs(t, err, auth.ErrMFAAlreadyEnabled)
+	assert.Equal(t, secret, u.TOTPSecret, "secret of enabled MFA must not be replaced")
+}
+
+func TestUser_VerifyTOTP(t *testing.T) {
+	enrolledAt := time.Now()
+	u := newMFAUser(t, enrolledAt)
+	loginAt := enrolledAt.Add(2 * totp.Period)
+
+	err := u.VerifyTOTP(totpCode(t, u.TOTPSecret, loginAt), FakeClockAt(loginAt))
+
+	require.NoError(t, err)
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "UserMFAVerified", events[0].GetName())
+	assert.Equal(t, auth.MFAMethodTOTP, events[0].(auth.UserMFAVerified).Method)
+}
+
+func TestUser_VerifyTOTP_RejectsReplay(t *testing.T) {
+	enrolledAt := time.Now()
+	u := newMFAUser(t, enrolledAt)
+	loginAt := enrolledAt.Add(2 * totp.Period)
+	code := totpCode(t, u.TOTPSecret, loginAt)
+	require.NoError(t, u.VerifyTOTP(code, FakeClockAt(loginAt)))
+
+	err := u.VerifyTOTP(code, FakeClockAt(loginAt))
+
+	require.ErrorIs(t, err, auth.ErrTOTPCodeInvalid)
+}
+
+func TestUser_VerifyTOTP_NotEnabled(t *testing.T) {
+	u := newTestUser(t)
+
+	err := u.VerifyTOTP("123456", FakeClock{})
+
+	require.ErrorIs(t, err, auth.ErrMFANotEnabled)
+}
+
+func TestNewRecoveryCodes(t *testing.T) {
+	userID := uuid.New()
+
+	codes, raw, err := auth.NewRecoveryCodes(userID, auth.RecoveryCodeCount, FakeClock{})
+
+	require.NoError(t, err)
+	require.Len(t, codes, auth.RecoveryCodeCount)
+	require.Len(t, raw, auth.RecoveryCodeCount)
+	seen := map[string]bool{}
+	for i, code := range codes {
+		assert.Equal(t, userID, code.UserID)
+		assert.Regexp(t, `^[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}$`, raw[i])
+		assert.Equal(t, auth.HashRecoveryCode(raw[i]), code.CodeHash)
+		assert.NotContains(t, code.CodeHash, raw[i], "only the hash must be stored")
+		assert.False(t, seen[raw[i]], "codes must be unique")
+		seen[raw[i]] = true
+	}
+}
+
+func TestHashRecoveryCode_IgnoresFormatting(t *testing.T) {
+	assert.Equal(t, auth.HashRecoveryCode("abcd-efgh-ijkl-mnop"), auth.HashRecoveryCode(" ABCD EFGH-IJKL-MNOP"))
+}
+
+func TestUser_UseRecoveryCode(t *testing.T) {
+	u := newMFAUser(t, time.Now())
+	codes, _, err := auth.NewRecoveryCodes(u.ID(), 1, FakeClock{})
+	require.NoError(t, err)
+	code := &codes[0]
+
+	require.NoError(t, u.UseRecoveryCode(code, FakeClock{}))
+
+	assert.NotNil(t, code.UsedAt)
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, auth.MFAMethodRecoveryCode, events[0].(auth.UserMFAVerified).Method)
+
+	err = u.UseRecoveryCode(code, FakeClock{})
+	require.ErrorIs(t, err, auth.ErrRecoveryCodeInvalid)
+}
+
+func TestUser_UseRecoveryCode_OtherUser(t *testing.T) {
+	u := newMFAUser(t, time.Now())
+	codes, _, err := auth.NewRecoveryCodes(uuid.New(), 1, FakeClock{})
+	require.NoError(t, err)
+
+	err = u.UseRecoveryCode(&codes[0], FakeClock{})
+
+	require.ErrorIs(t, err, auth.ErrRecoveryCodeInvalid)
+	assert.Nil(t, codes[0].UsedAt)
+}
+
+func TestVerificationToken_CheckAttempt(t *testing.T) {
+	now := time.Now()
+	token, _, err := auth.NewVerificationToken(uuid.New(), auth.VerificationPurposeMFA, "", time.Minute, FakeClockAt(now))
+	require.NoError(t, err)
+
+	require.NoError(t, token.CheckAttempt(now, 2))
+	token.RecordFailedAttempt()
+	require.NoError(t, token.CheckAttempt(now, 2))
+	token.RecordFailedAttempt()
+
+	require.ErrorIs(t, token.CheckAttempt(now, 2), auth.ErrVerificationAttemptsExceeded)
+	require.ErrorIs(t, token.CheckAttempt(now.Add(time.Hour), 0), auth.ErrVerificationTokenInvalid)
+}
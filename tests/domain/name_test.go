@@ -0,0 +1,106 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for domain model business rules and validation logic
+
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+)
+
+func newUserWithName(name string) (auth.User, error) {
+	email, _ := kernel.NewEmail("user@example.com")
+	phone, _ := kernel.NewPhone("+12015550100")
+	return auth.NewUser(email, phone, name, "password123", FakeHasher{}, FakeClock{})
+}
+
+func TestUser_Name_Normalized(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"trims whitespace", "  John Doe\t\n", "John Doe"},
+		{"collapses internal whitespace", "John \t\n  Doe", "John Doe"},
+		{"unicode spaces", "John\u00a0\u3000Doe ", "John Doe"},
+		{"NFC composition", "Jose\u0301", "Jos\u00e9"},
+		{"strips zero-width space", "Jo\u200bhn\ufeff Doe\u00ad", "John Doe"},
+		{"strips joiner at word boundary", "\u200dJohn \u200cDoe", "John Doe"},
+		{"keeps joiner inside a word", "می\u200cخواهم", "می\u200cخواهم"},
+		{"keeps emoji sequence", "👩\u200d💻 Ada", "👩\u200d💻 Ada"},
+		{"keeps subdivision flag", "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f Ada", "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f Ada"},
+		{"non-latin scripts", "Иван Петров", "Иван Петров"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := newUserWithName(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, u.Name)
+		})
+	}
+}
+
+func TestUser_Name_Rejected(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want error
+	}{
+		{"empty", "", auth.ErrNameEmpty},
+		{"only whitespace", " \t\u00a0\n", auth.ErrNameEmpty},
+		{"only invisible characters", "\u200b\u200d", auth.ErrNameEmpty},
+		{"invalid UTF-8", "John\xffDoe", auth.ErrNameInvalidEncoding},
+		{"NUL", "John\x00Doe", auth.ErrNameControlCharacter},
+		{"escape", "John\x1b[31mDoe", auth.ErrNameControlCharacter},
+		{"C1 control", "John\u009b31mDoe", auth.ErrNameControlCharacter},
+		{"right-to-left override", "John\u202eeoD", auth.ErrNameBidiCharacter},
+		{"isolate", "\u2067John\u2069", auth.ErrNameBidiCharacter},
+		{"right-to-left mark", "John\u200f", auth.ErrNameBidiCharacter},
+		{"invisible operator", "John\u2062Doe", auth.ErrNameInvisibleCharacter},
+		{"too long", strings.Repeat("a", auth.MaxNameLength+1), auth.ErrNameTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newUserWithName(tt.in)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestUser_Name_LengthCountsCharacters(t *testing.T) {
+	// 100 two-byte characters fit, although they take 200 bytes
+	u, err := newUserWithName(strings.Repeat("ж", auth.MaxNameLength))
+	require.NoError(t, err)
+	assert.Len(t, []rune(u.Name), auth.MaxNameLength)
+
+	// Length is checked after normalization: decomposed "é" counts once
+	_, err = newUserWithName(strings.Repeat("e\u0301", auth.MaxNameLength))
+	require.NoError(t, err)
+
+	// Collapsed whitespace does not count
+	_, err = newUserWithName(strings.Repeat("a", 50) + strings.Repeat(" ", 20) + strings.Repeat("b", 49))
+	require.NoError(t, err)
+}
+
+func TestUser_ChangeName_Normalized(t *testing.T) {
+	u, err := newUserWithName("John Doe")
+	require.NoError(t, err)
+	u.ClearDomainEvents()
+
+	// Only whitespace differs: nothing changes
+	require.NoError(t, u.ChangeName("  John   Doe ", FakeClock{}))
+	assert.Empty(t, u.GetDomainEvents())
+
+	require.NoError(t, u.ChangeName("Jose\u0301  Doe", FakeClock{}))
+	assert.Equal(t, "José Doe", u.Name)
+
+	err = u.ChangeName("Jose\u202e", FakeClock{})
+	assert.ErrorIs(t, err, auth.ErrNameBidiCharacter)
+	assert.Equal(t, "José Doe", u.Name)
+}
@@ -0,0 +1,58 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for password expiration and forced password change
+
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+func TestUser_PasswordExpired_ByMaxAge(t *testing.T) {
+	u := newTestUser(t)
+	changedAt := u.PasswordChangedAt
+	maxAge := 90 * 24 * time.Hour
+
+	assert.False(t, u.PasswordExpired(maxAge, changedAt.Add(maxAge-time.Second)))
+	assert.True(t, u.PasswordExpired(maxAge, changedAt.Add(maxAge)))
+}
+
+func TestUser_PasswordExpired_ZeroMaxAgeNeverExpires(t *testing.T) {
+	u := newTestUser(t)
+
+	assert.False(t, u.PasswordExpired(0, u.PasswordChangedAt.Add(10*365*24*time.Hour)))
+}
+
+func TestUser_RequirePasswordChange(t *testing.T) {
+	u := newTestUser(t)
+
+	u.RequirePasswordChange(FakeClock{})
+
+	assert.True(t, u.PasswordChangeRequired)
+	assert.True(t, u.PasswordExpired(0, time.Now()))
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "UserPasswordChangeRequired", events[0].GetName())
+
+	// Повторный вызов не порождает новых событий
+	u.RequirePasswordChange(FakeClock{})
+	assert.Len(t, u.GetDomainEvents(), 1)
+}
+
+func TestUser_SetPassword_ResetsExpiry(t *testing.T) {
+	now := time.Now().Add(time.Hour)
+	u := newTestUser(t)
+	u.RequirePasswordChange(FakeClock{})
+
+	err := u.SetPassword("brandnewpassword1", auth.NewPasswordHistory(), FakeHasher{}, FakeClock{t: now})
+	require.NoError(t, err)
+
+	assert.False(t, u.PasswordChangeRequired)
+	assert.Equal(t, now, u.PasswordChangedAt)
+	assert.False(t, u.PasswordExpired(24*time.Hour, now))
+}
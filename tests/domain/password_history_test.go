@@ -0,0 +1,76 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for password history and password reuse rules
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+)
+
+func newTestUser(t *testing.T) auth.User {
+	t.Helper()
+	email, _ := kernel.NewEmail("user@example.com")
+	phone, _ := kernel.NewPhone("+12015550100")
+	u, err := auth.NewUser(email, phone, "John Doe", "password123", FakeHasher{}, FakeClock{})
+	require.NoError(t, err)
+	u.ClearDomainEvents()
+	return u
+}
+
+func TestPasswordHistory_Contains(t *testing.T) {
+	history := auth.NewPasswordHistory("hash:first-password", "hash:second-password")
+
+	assert.True(t, history.Contains("first-password", FakeHasher{}))
+	assert.True(t, history.Contains("second-password", FakeHasher{}))
+	assert.False(t, history.Contains("third-password", FakeHasher{}))
+	assert.Equal(t, 2, history.Len())
+}
+
+func TestPasswordHistory_Empty(t *testing.T) {
+	history := auth.NewPasswordHistory()
+
+	assert.False(t, history.Contains("anything", FakeHasher{}))
+	assert.Equal(t, 0, history.Len())
+}
+
+func TestUser_SetPassword_RejectsCurrentPassword(t *testing.T) {
+	u := newTestUser(t)
+	oldHash := u.PasswordHash
+
+	err := u.SetPassword("password123", auth.NewPasswordHistory(), FakeHasher{}, FakeClock{})
+	require.ErrorIs(t, err, auth.ErrPasswordReused)
+	assert.Equal(t, oldHash, u.PasswordHash)
+	assert.Empty(t, u.GetDomainEvents())
+}
+
+func TestUser_SetPassword_RejectsPasswordFromHistory(t *testing.T) {
+	u := newTestUser(t)
+	history := auth.NewPasswordHistory("hash:oldpassword1", "hash:oldpassword2")
+
+	err := u.SetPassword("oldpassword2", history, FakeHasher{}, FakeClock{})
+	require.ErrorIs(t, err, auth.ErrPasswordReused)
+	assert.True(t, u.VerifyPassword("password123", FakeHasher{}))
+}
+
+func TestUser_SetPassword_AcceptsPasswordOutsideHistory(t *testing.T) {
+	u := newTestUser(t)
+	history := auth.NewPasswordHistory("hash:oldpassword1", "hash:oldpassword2")
+
+	err := u.SetPassword("brandnewpassword", history, FakeHasher{}, FakeClock{})
+	require.NoError(t, err)
+	assert.True(t, u.VerifyPassword("brandnewpassword", FakeHasher{}))
+}
+
+func TestUser_SetPassword_TooShortCheckedFirst(t *testing.T) {
+	u := newTestUser(t)
+	history := auth.NewPasswordHistory("hash:short")
+
+	err := u.SetPassword("short", history, FakeHasher{}, FakeClock{})
+	require.ErrorIs(t, err, auth.ErrPasswordTooShort)
+}
@@ -7,44 +7,105 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
 )
 
 func TestNewPhone_ValidInput(t *testing.T) {
-	valid := []string{
-		"+1234567",
-		"+123456789012345",
-		"+79991234567",
+	tests := []struct {
+		in   string
+		want string
+		kind kernel.PhoneType
+	}{
+		{"+12015550123", "+12015550123", kernel.PhoneTypeFixedLineOrMobile},
+		{"+1 (201) 555-0123", "+12015550123", kernel.PhoneTypeFixedLineOrMobile},
+		{" +7 999 123-45-67 ", "+79991234567", kernel.PhoneTypeMobile},
+		{"+44 20 7183 8750", "+442071838750", kernel.PhoneTypeFixedLine},
+		{"+1.800.555.0199", "+18005550199", kernel.PhoneTypeTollFree},
+		{"+49 30 901820", "+4930901820", kernel.PhoneTypeFixedLine},
 	}
-	for _, s := range valid {
-		t.Run(s, func(t *testing.T) {
-			p, err := kernel.NewPhone(s)
-			assert.NoError(t, err)
-			assert.Equal(t, s, p.String())
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			p, err := kernel.NewPhone(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, p.String())
+			assert.Equal(t, tt.kind, p.Type())
 		})
 	}
 }
 
 func TestNewPhone_InvalidInput(t *testing.T) {
-	invalid := []string{
-		"",
-		"+12345",
-		"1234567890",
-		"+0123456789",
+	tests := []struct {
+		in   string
+		want error
+	}{
+		{"", kernel.ErrPhoneEmpty},
+		{"+12345", kernel.ErrPhoneInvalid},
+		{"2015550123", kernel.ErrPhoneInvalid},  // no country code and no default region
+		{"+0123456789", kernel.ErrPhoneInvalid}, // no such country code
+		{"+1234567890", kernel.ErrPhoneInvalid}, // +1 234 is not an area code
+		{"+123456789012345", kernel.ErrPhoneInvalid},
+		{"+1 201 555 0123 ext. 7", kernel.ErrPhoneInvalid},
+		{"+1-800-FLOWERS", kernel.ErrPhoneInvalid},
+		{"++12015550123", kernel.ErrPhoneInvalid},
 	}
-	for _, s := range invalid {
-		t.Run(s, func(t *testing.T) {
-			_, err := kernel.NewPhone(s)
-			assert.Error(t, err)
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			_, err := kernel.NewPhone(tt.in)
+			assert.ErrorIs(t, err, tt.want)
 		})
 	}
 }
 
+func TestPhoneNumbering_DefaultRegion(t *testing.T) {
+	us, err := kernel.NewPhoneNumbering("us")
+	require.NoError(t, err)
+	assert.Equal(t, "US", us.DefaultRegion)
+
+	p, err := us.NewPhone("(201) 555-0123")
+	require.NoError(t, err)
+	assert.Equal(t, "+12015550123", p.String())
+
+	// International numbers ignore the default region
+	p, err = us.NewPhone("+44 20 7183 8750")
+	require.NoError(t, err)
+	assert.Equal(t, "+442071838750", p.String())
+
+	ru, err := kernel.NewPhoneNumbering("RU")
+	require.NoError(t, err)
+	p, err = ru.NewPhone("8 (999) 123-45-67")
+	require.NoError(t, err)
+	assert.Equal(t, "+79991234567", p.String())
+	assert.Equal(t, kernel.PhoneTypeMobile, p.Type())
+
+	// A national number that is not valid in the region
+	_, err = us.NewPhone("555-0123")
+	assert.ErrorIs(t, err, kernel.ErrPhoneInvalid)
+
+	_, err = kernel.NewPhoneNumbering("XX")
+	assert.ErrorIs(t, err, kernel.ErrPhoneRegionUnknown)
+}
+
+func TestRestorePhone_SkipsNumberingPlan(t *testing.T) {
+	// A number accepted before the plan changed still loads
+	p, err := kernel.RestorePhone("+1234567890")
+	require.NoError(t, err)
+	assert.Equal(t, "+1234567890", p.String())
+	assert.Equal(t, kernel.PhoneTypeUnknown, p.Type())
+
+	p, err = kernel.RestorePhone("+79991234567")
+	require.NoError(t, err)
+	assert.Equal(t, kernel.PhoneTypeMobile, p.Type())
+
+	_, err = kernel.RestorePhone("89991234567")
+	assert.ErrorIs(t, err, kernel.ErrPhoneInvalid)
+}
+
 func TestPhone_Equals(t *testing.T) {
-	p1, _ := kernel.NewPhone("+1234567890")
-	p2, _ := kernel.NewPhone("+1234567890")
-	p3, _ := kernel.NewPhone("+1234567899")
+	p1, _ := kernel.NewPhone("+12015550100")
+	p2, _ := kernel.NewPhone("+1 201-555-0100")
+	p3, _ := kernel.NewPhone("+12015550109")
 	assert.True(t, p1.Equals(p2))
 	assert.False(t, p1.Equals(p3))
 }
@@ -0,0 +1,114 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for phone verification state and one-time SMS codes
+
+package domain
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+)
+
+func newTestCode(t *testing.T, now time.Time) (auth.VerificationToken, string) {
+	t.Helper()
+	token, code, err := auth.NewVerificationCode(
+		uuid.New(), auth.VerificationPurposePhone, "+12015550100", 5*time.Minute, FakeHasher{}, FakeClock{t: now},
+	)
+	require.NoError(t, err)
+	return token, code
+}
+
+func TestUser_VerifyPhone(t *testing.T) {
+	now := time.Now().Add(time.Hour)
+	u := newTestUser(t)
+	require.False(t, u.IsPhoneVerified())
+
+	err := u.VerifyPhone(FakeClock{t: now})
+	require.NoError(t, err)
+
+	assert.True(t, u.IsPhoneVerified())
+	require.NotNil(t, u.PhoneVerifiedAt)
+	assert.Equal(t, now, *u.PhoneVerifiedAt)
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "UserPhoneVerified", events[0].GetName())
+}
+
+func TestUser_VerifyPhone_AlreadyVerified(t *testing.T) {
+	u := newTestUser(t)
+	require.NoError(t, u.VerifyPhone(FakeClock{}))
+	u.ClearDomainEvents()
+
+	err := u.VerifyPhone(FakeClock{})
+
+	require.ErrorIs(t, err, auth.ErrPhoneAlreadyVerified)
+	assert.Empty(t, u.GetDomainEvents())
+}
+
+func TestUser_ChangePhone_ResetsVerification(t *testing.T) {
+	u := newTestUser(t)
+	require.NoError(t, u.VerifyPhone(FakeClock{}))
+
+	phone, err := kernel.NewPhone("+12025550143")
+	require.NoError(t, err)
+	u.ChangePhone(phone, FakeClock{})
+
+	assert.False(t, u.IsPhoneVerified())
+}
+
+func TestVerificationCode_New(t *testing.T) {
+	now := time.Now()
+	token, code := newTestCode(t, now)
+
+	assert.Regexp(t, regexp.MustCompile(`^\d{6}$`), code)
+	assert.Equal(t, "hash:"+code, token.SecretHash)
+	assert.Equal(t, auth.VerificationPurposePhone, token.Purpose)
+	assert.Equal(t, now.Add(5*time.Minute), token.ExpiresAt)
+	assert.Zero(t, token.Attempts)
+}
+
+func TestVerificationCode_Consume(t *testing.T) {
+	now := time.Now()
+	token, code := newTestCode(t, now)
+
+	require.NoError(t, token.ConsumeCode(code, 3, FakeHasher{}, FakeClock{t: now}))
+	require.NotNil(t, token.UsedAt)
+
+	// Код одноразовый
+	err := token.ConsumeCode(code, 3, FakeHasher{}, FakeClock{t: now})
+	require.ErrorIs(t, err, auth.ErrVerificationTokenInvalid)
+}
+
+func TestVerificationCode_Expired(t *testing.T) {
+	now := time.Now()
+	token, code := newTestCode(t, now)
+
+	err := token.ConsumeCode(code, 3, FakeHasher{}, FakeClock{t: now.Add(5 * time.Minute)})
+
+	require.ErrorIs(t, err, auth.ErrVerificationTokenInvalid)
+	assert.Nil(t, token.UsedAt)
+}
+
+func TestVerificationCode_MismatchCountsAttempts(t *testing.T) {
+	now := time.Now()
+	token, code := newTestCode(t, now)
+	clock := FakeClock{t: now}
+
+	for i := 1; i <= 3; i++ {
+		err := token.ConsumeCode("wrong", 3, FakeHasher{}, clock)
+		require.ErrorIs(t, err, auth.ErrVerificationCodeMismatch)
+		assert.Equal(t, i, token.Attempts)
+	}
+
+	// Лимит исчерпан: даже верный код больше не принимается
+	err := token.ConsumeCode(code, 3, FakeHasher{}, clock)
+	require.ErrorIs(t, err, auth.ErrVerificationAttemptsExceeded)
+	assert.Nil(t, token.UsedAt)
+}
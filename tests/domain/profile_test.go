@@ -0,0 +1,125 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for optional profile attributes and user metadata
+
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+func TestNewProfile_NormalizesFields(t *testing.T) {
+	now := time.Date(2026, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	p, err := auth.NewProfile("pt-br", "Europe/Berlin", "https://cdn.example.com/a.png", "1990-04-21", now)
+
+	require.NoError(t, err)
+	assert.Equal(t, "pt-BR", p.Locale)
+	assert.Equal(t, "Europe/Berlin", p.Timezone)
+	assert.Equal(t, "https://cdn.example.com/a.png", p.AvatarURL)
+	assert.Equal(t, "1990-04-21", p.BirthDateString())
+}
+
+func TestNewProfile_EmptyFieldsAreUnset(t *testing.T) {
+	p, err := auth.NewProfile("", "", "", "", time.Now())
+
+	require.NoError(t, err)
+	assert.Equal(t, auth.Profile{}, p)
+	assert.Empty(t, p.BirthDateString())
+}
+
+func TestNewProfile_RejectsInvalidValues(t *testing.T) {
+	now := time.Date(2026, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		locale    string
+		timezone  string
+		avatarURL string
+		birthDate string
+		want      error
+	}{
+		{"malformed locale", "not a locale", "", "", "", auth.ErrInvalidLocale},
+		{"unknown timezone", "", "Mars/Olympus", "", "", auth.ErrInvalidTimezone},
+		{"machine local timezone", "", "Local", "", "", auth.ErrInvalidTimezone},
+		{"relative avatar url", "", "", "/avatars/a.png", "", auth.ErrInvalidAvatarURL},
+		{"non-http avatar url", "", "", "javascript:alert(1)", "", auth.ErrInvalidAvatarURL},
+		{"overlong avatar url", "", "", "https://example.com/" + strings.Repeat("a", auth.MaxAvatarURLLength), "", auth.ErrInvalidAvatarURL},
+		{"birth date with time", "", "", "", "1990-04-21T00:00:00Z", auth.ErrInvalidBirthDate},
+		{"birth date in the future", "", "", "", "2026-05-02", auth.ErrInvalidBirthDate},
+		{"birth date too early", "", "", "", "1899-12-31", auth.ErrInvalidBirthDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := auth.NewProfile(tt.locale, tt.timezone, tt.avatarURL, tt.birthDate, now)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestUser_ChangeProfile_RaisesEventWithFieldNames(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+	u.ClearDomainEvents()
+
+	profile, err := auth.NewProfile("en", "UTC", "", "1990-04-21", now)
+	require.NoError(t, err)
+	u.ChangeProfile(profile, FakeClockAt(now))
+
+	assert.Equal(t, profile, u.Profile)
+	assert.Equal(t, now, u.UpdatedAt)
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	changed, ok := events[0].(auth.UserProfileChanged)
+	require.True(t, ok)
+	assert.Equal(t, "UserProfileChanged", changed.GetName())
+	assert.Equal(t, u.ID(), changed.UserID)
+	assert.Equal(t, []string{"locale", "timezone", "birth_date"}, changed.Fields)
+
+	// Same profile again is a no-op
+	u.ClearDomainEvents()
+	u.ChangeProfile(profile, FakeClockAt(now))
+	assert.Empty(t, u.GetDomainEvents())
+}
+
+func TestUser_ChangeMetadata(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+	u.ClearDomainEvents()
+
+	require.NoError(t, u.ChangeMetadata(map[string]any{"plan": "pro"}, FakeClockAt(now)))
+
+	assert.Equal(t, map[string]any{"plan": "pro"}, u.Metadata)
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	_, ok := events[0].(auth.UserMetadataChanged)
+	assert.True(t, ok)
+
+	// Unchanged metadata raises nothing
+	u.ClearDomainEvents()
+	require.NoError(t, u.ChangeMetadata(map[string]any{"plan": "pro"}, FakeClockAt(now)))
+	assert.Empty(t, u.GetDomainEvents())
+
+	// nil clears the bag
+	require.NoError(t, u.ChangeMetadata(nil, FakeClockAt(now)))
+	assert.Empty(t, u.Metadata)
+	assert.Len(t, u.GetDomainEvents(), 1)
+}
+
+func TestUser_ChangeMetadata_RejectsOversizedBag(t *testing.T) {
+	u := newTestUser(t)
+	u.ClearDomainEvents()
+
+	err := u.ChangeMetadata(map[string]any{"blob": strings.Repeat("x", auth.MaxMetadataSize)}, FakeClockAt(time.Now()))
+
+	assert.ErrorIs(t, err, auth.ErrMetadataTooLarge)
+	assert.Empty(t, u.Metadata)
+	assert.Empty(t, u.GetDomainEvents())
+}
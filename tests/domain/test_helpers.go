@@ -19,3 +19,4 @@ func (c FakeClock) Now() time.Time {
 // Expose constructors used by integration tests
 func NewMockPasswordHasher() FakeHasher { return FakeHasher{} }
 func NewMockClock() FakeClock           { return FakeClock{} }
+func FakeClockAt(t time.Time) FakeClock { return FakeClock{t: t} }
@@ -0,0 +1,107 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for account deletion
+
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+func TestUser_Delete_RaisesUserDeleted(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+	u.ClearDomainEvents()
+	assert.False(t, u.IsDeleted())
+
+	require.NoError(t, u.Delete(FakeClockAt(now)))
+
+	assert.True(t, u.IsDeleted())
+	require.NotNil(t, u.DeletedAt)
+	assert.Equal(t, now, *u.DeletedAt)
+	assert.Equal(t, now, u.UpdatedAt)
+
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	deleted, ok := events[0].(auth.UserDeleted)
+	require.True(t, ok)
+	assert.Equal(t, "UserDeleted", deleted.GetName())
+	assert.Equal(t, u.ID(), deleted.UserID)
+	assert.Equal(t, now, deleted.At)
+}
+
+func TestUser_Delete_Twice(t *testing.T) {
+	u := newTestUser(t)
+	require.NoError(t, u.Delete(FakeClockAt(time.Now())))
+	u.ClearDomainEvents()
+
+	err := u.Delete(FakeClockAt(time.Now()))
+
+	assert.True(t, errors.Is(err, auth.ErrUserAlreadyDeleted))
+	assert.Empty(t, u.GetDomainEvents())
+}
+
+func TestUser_RequestDeletion_SchedulesAfterGracePeriod(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+	u.ClearDomainEvents()
+
+	require.NoError(t, u.RequestDeletion(14*24*time.Hour, FakeClockAt(now)))
+
+	assert.True(t, u.IsDeletionScheduled())
+	assert.False(t, u.IsDeleted())
+	assert.True(t, u.IsActive())
+	require.NotNil(t, u.DeletionScheduledAt)
+	assert.Equal(t, now.Add(14*24*time.Hour), *u.DeletionScheduledAt)
+	assert.False(t, u.IsDeletionDue(now.Add(13*24*time.Hour)))
+	assert.True(t, u.IsDeletionDue(now.Add(14*24*time.Hour)))
+
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	requested, ok := events[0].(auth.UserDeletionRequested)
+	require.True(t, ok)
+	assert.Equal(t, "UserDeletionRequested", requested.GetName())
+	assert.Equal(t, u.ID(), requested.UserID)
+	assert.Equal(t, *u.DeletionScheduledAt, requested.ScheduledAt)
+
+	err := u.RequestDeletion(time.Hour, FakeClockAt(now))
+	assert.True(t, errors.Is(err, auth.ErrDeletionAlreadyScheduled))
+}
+
+func TestUser_CancelDeletion(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+
+	err := u.CancelDeletion(FakeClockAt(now))
+	assert.True(t, errors.Is(err, auth.ErrDeletionNotScheduled))
+
+	require.NoError(t, u.RequestDeletion(time.Hour, FakeClockAt(now)))
+	u.ClearDomainEvents()
+
+	require.NoError(t, u.CancelDeletion(FakeClockAt(now)))
+
+	assert.False(t, u.IsDeletionScheduled())
+	assert.False(t, u.IsDeletionDue(now.Add(2*time.Hour)))
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	cancelled, ok := events[0].(auth.UserDeletionCancelled)
+	require.True(t, ok)
+	assert.Equal(t, "UserDeletionCancelled", cancelled.GetName())
+}
+
+func TestUser_Delete_ClearsScheduledDeletion(t *testing.T) {
+	u := newTestUser(t)
+	require.NoError(t, u.RequestDeletion(time.Hour, FakeClockAt(time.Now())))
+
+	require.NoError(t, u.Delete(FakeClockAt(time.Now())))
+
+	assert.True(t, u.IsDeleted())
+	assert.False(t, u.IsDeletionScheduled())
+	assert.True(t, errors.Is(u.RequestDeletion(time.Hour, FakeClockAt(time.Now())), auth.ErrUserAlreadyDeleted))
+}
@@ -13,7 +13,7 @@ import (
 
 func TestUser_Events_OnNewUser(t *testing.T) {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 
 	u, err := auth.NewUser(email, phone, "John Doe", "password123", FakeHasher{}, FakeClock{})
 	require.NoError(t, err)
@@ -26,11 +26,11 @@ func TestUser_Events_OnNewUser(t *testing.T) {
 
 func TestUser_Events_OnChangePhone(t *testing.T) {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 	u, _ := auth.NewUser(email, phone, "John Doe", "password123", FakeHasher{}, FakeClock{})
 	u.ClearDomainEvents()
 
-	newPhone, _ := kernel.NewPhone("+1234567899")
+	newPhone, _ := kernel.NewPhone("+12015550109")
 	u.ChangePhone(newPhone, FakeClock{})
 
 	events := u.GetDomainEvents()
@@ -40,7 +40,7 @@ func TestUser_Events_OnChangePhone(t *testing.T) {
 
 func TestUser_Events_OnChangeName(t *testing.T) {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 	u, _ := auth.NewUser(email, phone, "John Doe", "password123", FakeHasher{}, FakeClock{})
 	u.ClearDomainEvents()
 
@@ -54,11 +54,11 @@ func TestUser_Events_OnChangeName(t *testing.T) {
 
 func TestUser_Events_OnSetPassword(t *testing.T) {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 	u, _ := auth.NewUser(email, phone, "John Doe", "password123", FakeHasher{}, FakeClock{})
 	u.ClearDomainEvents()
 
-	err := u.SetPassword("newpassword456", FakeHasher{}, FakeClock{})
+	err := u.SetPassword("newpassword456", auth.NewPasswordHistory(), FakeHasher{}, FakeClock{})
 	require.NoError(t, err)
 
 	events := u.GetDomainEvents()
@@ -68,7 +68,7 @@ func TestUser_Events_OnSetPassword(t *testing.T) {
 
 func TestUser_Events_OnLoggedIn(t *testing.T) {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 	u, _ := auth.NewUser(email, phone, "John Doe", "password123", FakeHasher{}, FakeClock{})
 	u.ClearDomainEvents()
 
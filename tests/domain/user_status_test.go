@@ -0,0 +1,98 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for the account status state machine
+
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+func TestNewUser_IsActive(t *testing.T) {
+	u := newTestUser(t)
+
+	assert.Equal(t, auth.UserStatusActive, u.Status)
+	assert.True(t, u.IsActive())
+	assert.Nil(t, u.StatusChangedAt)
+}
+
+func TestUser_Suspend_RaisesStatusChanged(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+	u.ClearDomainEvents()
+
+	require.NoError(t, u.Suspend("chargeback review", FakeClockAt(now)))
+
+	assert.Equal(t, auth.UserStatusSuspended, u.Status)
+	assert.False(t, u.IsActive())
+	assert.Equal(t, "chargeback review", u.StatusReason)
+	require.NotNil(t, u.StatusChangedAt)
+	assert.Equal(t, now, *u.StatusChangedAt)
+
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	changed, ok := events[0].(auth.UserStatusChanged)
+	require.True(t, ok)
+	assert.Equal(t, "UserStatusChanged", changed.GetName())
+	assert.Equal(t, u.ID(), changed.UserID)
+	assert.Equal(t, "active", changed.From)
+	assert.Equal(t, "suspended", changed.To)
+	assert.Equal(t, "chargeback review", changed.Reason)
+}
+
+func TestUser_ChangeStatus_Transitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    []auth.UserStatus
+		to      auth.UserStatus
+		allowed bool
+	}{
+		{"active to blocked", nil, auth.UserStatusBlocked, true},
+		{"active to deactivated", nil, auth.UserStatusDeactivated, true},
+		{"active to active", nil, auth.UserStatusActive, false},
+		{"suspended to active", []auth.UserStatus{auth.UserStatusSuspended}, auth.UserStatusActive, true},
+		{"blocked to active", []auth.UserStatus{auth.UserStatusBlocked}, auth.UserStatusActive, true},
+		{"blocked to deactivated", []auth.UserStatus{auth.UserStatusBlocked}, auth.UserStatusDeactivated, false},
+		{"blocked to suspended", []auth.UserStatus{auth.UserStatusBlocked}, auth.UserStatusSuspended, false},
+		{"deactivated to blocked", []auth.UserStatus{auth.UserStatusDeactivated}, auth.UserStatusBlocked, true},
+		{"deactivated to suspended", []auth.UserStatus{auth.UserStatusDeactivated}, auth.UserStatusSuspended, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := newTestUser(t)
+			for _, status := range tt.from {
+				require.NoError(t, u.ChangeStatus(status, "", FakeClockAt(time.Now())))
+			}
+			before := u.Status
+
+			err := u.ChangeStatus(tt.to, "", FakeClockAt(time.Now()))
+
+			if tt.allowed {
+				require.NoError(t, err)
+				assert.Equal(t, tt.to, u.Status)
+				return
+			}
+			assert.True(t, errors.Is(err, auth.ErrInvalidStatusTransition))
+			assert.Equal(t, before, u.Status)
+		})
+	}
+}
+
+func TestParseUserStatus(t *testing.T) {
+	status, err := auth.ParseUserStatus("blocked")
+	require.NoError(t, err)
+	assert.Equal(t, auth.UserStatusBlocked, status)
+
+	_, err = auth.ParseUserStatus("banned")
+	assert.True(t, errors.Is(err, auth.ErrUnknownUserStatus))
+
+	u := newTestUser(t)
+	assert.True(t, errors.Is(u.ChangeStatus("banned", "", FakeClockAt(time.Now())), auth.ErrUnknownUserStatus))
+}
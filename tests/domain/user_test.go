@@ -16,7 +16,7 @@ import (
 
 func TestUser_NewUser_Success(t *testing.T) {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 
 	u, err := auth.NewUser(email, phone, "John Doe", "password123", FakeHasher{}, FakeClock{})
 	require.NoError(t, err)
@@ -28,7 +28,7 @@ func TestUser_NewUser_Success(t *testing.T) {
 
 func TestUser_NewUser_Invalid(t *testing.T) {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 
 	_, err := auth.NewUser(email, phone, "", "password123", FakeHasher{}, FakeClock{})
 	require.Error(t, err)
@@ -39,7 +39,7 @@ func TestUser_NewUser_Invalid(t *testing.T) {
 
 func TestUser_ChangeName(t *testing.T) {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 	u, _ := auth.NewUser(email, phone, "John Doe", "password123", FakeHasher{}, FakeClock{})
 
 	err := u.ChangeName("Jane Smith", FakeClock{})
@@ -49,9 +49,9 @@ func TestUser_ChangeName(t *testing.T) {
 
 func TestUser_ChangePhone(t *testing.T) {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 	u, _ := auth.NewUser(email, phone, "John Doe", "password123", FakeHasher{}, FakeClock{})
-	newPhone, _ := kernel.NewPhone("+1234567899")
+	newPhone, _ := kernel.NewPhone("+12015550109")
 
 	u.ChangePhone(newPhone, FakeClock{})
 	assert.Equal(t, newPhone, u.Phone)
@@ -59,11 +59,11 @@ func TestUser_ChangePhone(t *testing.T) {
 
 func TestUser_SetPassword(t *testing.T) {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 	u, _ := auth.NewUser(email, phone, "John Doe", "password123", FakeHasher{}, FakeClock{})
 	oldHash := u.PasswordHash
 
-	err := u.SetPassword("newpassword456", FakeHasher{}, FakeClock{})
+	err := u.SetPassword("newpassword456", auth.NewPasswordHistory(), FakeHasher{}, FakeClock{})
 	require.NoError(t, err)
 	assert.NotEqual(t, oldHash, u.PasswordHash)
 	assert.True(t, u.VerifyPassword("newpassword456", FakeHasher{}))
@@ -71,7 +71,7 @@ func TestUser_SetPassword(t *testing.T) {
 
 func TestUser_DomainEvents(t *testing.T) {
 	email, _ := kernel.NewEmail("user@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 	u, _ := auth.NewUser(email, phone, "John Doe", "password123", FakeHasher{}, FakeClock{})
 
 	events := u.GetDomainEvents()
@@ -0,0 +1,109 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for username normalization and look-alike checks
+
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
+)
+
+func TestNewUsername_Normalizes(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"alice", "alice"},
+		{"  Alice_99 ", "alice_99"},
+		{"ALICE.Smith", "alice.smith"},
+		{"Ａｌｉｃｅ", "alice"}, // fullwidth letters
+		{"Straße", "strasse"},
+		{"Иван", "иван"},
+		{"Ωμέγα", "ωμέγα"},
+		{"たなか太郎", "たなか太郎"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			u, err := kernel.NewUsername(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, u.String())
+		})
+	}
+}
+
+func TestNewUsername_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want error
+	}{
+		{"empty", "  ", kernel.ErrUsernameEmpty},
+		{"too short", "ab", kernel.ErrUsernameInvalid},
+		{"too long", strings.Repeat("a", kernel.MaxUsernameLength+1), kernel.ErrUsernameInvalid},
+		{"starts with digit", "1alice", kernel.ErrUsernameInvalid},
+		{"starts with separator", "_alice", kernel.ErrUsernameInvalid},
+		{"ends with separator", "alice.", kernel.ErrUsernameInvalid},
+		{"double separator", "alice..smith", kernel.ErrUsernameInvalid},
+		{"space inside", "alice smith", kernel.ErrUsernameInvalid},
+		{"at sign", "alice@home", kernel.ErrUsernameInvalid},
+		{"zero width joiner", "ali\u200dce", kernel.ErrUsernameInvalid},
+		{"non-ASCII digits", "alice\u0663", kernel.ErrUsernameInvalid},
+		{"reserved", "Admin", kernel.ErrUsernameReserved},
+		{"reserved with separator", "ad.min", kernel.ErrUsernameReserved},
+		{"reserved in fullwidth", "ｒｏｏｔ", kernel.ErrUsernameReserved},
+		{"mixed Latin and Cyrillic", "p\u0430ypal", kernel.ErrUsernameConfusable},
+		{"Cyrillic that reads as Latin", "рсо", kernel.ErrUsernameConfusable},
+		{"Greek that reads as Latin", "νικο", kernel.ErrUsernameConfusable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := kernel.NewUsername(tt.in)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestUsername_EqualsAndZero(t *testing.T) {
+	u1, _ := kernel.NewUsername("John.Doe")
+	u2, _ := kernel.NewUsername("john.doe")
+
+	assert.True(t, u1.Equals(u2))
+	assert.False(t, u1.IsZero())
+	assert.True(t, kernel.Username{}.IsZero())
+}
+
+func TestUser_ChangeUsername_RaisesEvent(t *testing.T) {
+	now := time.Now()
+	u := newTestUser(t)
+	u.ClearDomainEvents()
+	username, err := kernel.NewUsername("john.doe")
+	require.NoError(t, err)
+
+	u.ChangeUsername(username, FakeClockAt(now))
+
+	assert.Equal(t, username, u.Username)
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	changed, ok := events[0].(auth.UserUsernameChanged)
+	require.True(t, ok)
+	assert.Equal(t, "UserUsernameChanged", changed.GetName())
+	assert.Empty(t, changed.Old)
+	assert.Equal(t, "john.doe", changed.New)
+
+	// Same username again is a no-op
+	u.ClearDomainEvents()
+	u.ChangeUsername(username, FakeClockAt(now))
+	assert.Empty(t, u.GetDomainEvents())
+
+	// Removing it
+	u.ChangeUsername(kernel.Username{}, FakeClockAt(now))
+	assert.True(t, u.Username.IsZero())
+	assert.Len(t, u.GetDomainEvents(), 1)
+}
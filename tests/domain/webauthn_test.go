@@ -0,0 +1,115 @@
+// DOMAIN LAYER UNIT TESTS
+// Tests for WebAuthn credential registration, passkey login and passkeys as a second factor
+
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+func newTestCredential(t *testing.T, u *auth.User, signCount uint32) auth.WebAuthnCredential {
+	t.Helper()
+	cred, err := u.RegisterWebAuthnCredential([]byte("credential-id"), []byte("cose-key"), signCount, "", FakeClock{})
+	require.NoError(t, err)
+	u.ClearDomainEvents()
+	return cred
+}
+
+func TestUser_RegisterWebAuthnCredential(t *testing.T) {
+	u := newTestUser(t)
+
+	cred, err := u.RegisterWebAuthnCredential([]byte("credential-id"), []byte("cose-key"), 0, "  MacBook ", FakeClock{})
+
+	require.NoError(t, err)
+	assert.Equal(t, u.ID(), cred.UserID)
+	assert.Equal(t, "MacBook", cred.Name)
+	assert.Nil(t, cred.LastUsedAt)
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "UserWebAuthnCredentialRegistered", events[0].GetName())
+	assert.Equal(t, "Y3JlZGVudGlhbC1pZA", events[0].(auth.UserWebAuthnCredentialRegistered).CredentialID)
+}
+
+func TestUser_RegisterWebAuthnCredential_Name(t *testing.T) {
+	u := newTestUser(t)
+
+	cred, err := u.RegisterWebAuthnCredential([]byte("id"), []byte("key"), 0, "", FakeClock{})
+	require.NoError(t, err)
+	assert.Equal(t, auth.DefaultWebAuthnCredentialName, cred.Name)
+
+	_, err = u.RegisterWebAuthnCredential([]byte("id"), []byte("key"), 0, strings.Repeat("я", 65), FakeClock{})
+	require.ErrorIs(t, err, auth.ErrWebAuthnCredentialNameLong)
+}
+
+func TestUser_AuthenticateWithWebAuthn(t *testing.T) {
+	u := newTestUser(t)
+	cred := newTestCredential(t, &u, 5)
+	now := time.Now()
+
+	err := u.AuthenticateWithWebAuthn(&cred, 6, FakeClockAt(now))
+
+	require.NoError(t, err)
+	assert.Equal(t, uint32(6), cred.SignCount)
+	require.NotNil(t, cred.LastUsedAt)
+	assert.Equal(t, now, *cred.LastUsedAt)
+	assert.Empty(t, u.GetDomainEvents(), "login event is raised by MarkLoggedIn")
+}
+
+func TestUser_AuthenticateWithWebAuthn_CounterMustIncrease(t *testing.T) {
+	u := newTestUser(t)
+	cred := newTestCredential(t, &u, 5)
+
+	err := u.AuthenticateWithWebAuthn(&cred, 5, FakeClock{})
+
+	require.ErrorIs(t, err, auth.ErrWebAuthnCredentialCloned)
+	assert.Equal(t, uint32(5), cred.SignCount)
+	assert.Nil(t, cred.LastUsedAt)
+}
+
+func TestUser_AuthenticateWithWebAuthn_CounterNotSupported(t *testing.T) {
+	u := newTestUser(t)
+	cred := newTestCredential(t, &u, 0)
+
+	// Синхронизируемые passkey не ведут счётчик и всегда присылают 0
+	require.NoError(t, u.AuthenticateWithWebAuthn(&cred, 0, FakeClock{}))
+	require.NoError(t, u.AuthenticateWithWebAuthn(&cred, 0, FakeClock{}))
+}
+
+func TestUser_AuthenticateWithWebAuthn_OtherUser(t *testing.T) {
+	owner := newTestUser(t)
+	cred := newTestCredential(t, &owner, 0)
+	other := newTestUser(t)
+
+	err := other.AuthenticateWithWebAuthn(&cred, 1, FakeClock{})
+
+	require.ErrorIs(t, err, auth.ErrWebAuthnCredentialInvalid)
+}
+
+func TestUser_VerifyWebAuthn(t *testing.T) {
+	u := newMFAUser(t, time.Now())
+	cred := newTestCredential(t, &u, 0)
+
+	err := u.VerifyWebAuthn(&cred, 1, FakeClock{})
+
+	require.NoError(t, err)
+	events := u.GetDomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, auth.MFAMethodWebAuthn, events[0].(auth.UserMFAVerified).Method)
+}
+
+func TestUser_VerifyWebAuthn_MFANotEnabled(t *testing.T) {
+	u := newTestUser(t)
+	cred := newTestCredential(t, &u, 0)
+
+	err := u.VerifyWebAuthn(&cred, 1, FakeClock{})
+
+	require.ErrorIs(t, err, auth.ErrMFANotEnabled)
+	assert.Empty(t, u.GetDomainEvents())
+}
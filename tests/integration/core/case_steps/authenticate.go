@@ -10,8 +10,13 @@ import (
 )
 
 // AuthenticateByTokenStep invokes the gRPC Authenticate handler using provided JWT service and token
-func AuthenticateByTokenStep(ctx context.Context, jwtService ports.JWTService, token string) (*authpb.AuthenticateResponse, error) {
-	authenticateByToken := queries.NewAuthenticateByTokenHandler(jwtService)
-	handler := grpcin.NewAuthHandler(authenticateByToken)
+func AuthenticateByTokenStep(
+	ctx context.Context,
+	jwtService ports.JWTService,
+	txManager ports.TransactionManager,
+	token string,
+) (*authpb.AuthenticateResponse, error) {
+	authenticateByToken := queries.NewAuthenticateByTokenHandler(jwtService, txManager)
+	handler := grpcin.NewAuthHandler(authenticateByToken, nil, nil, nil, nil)
 	return handler.Authenticate(ctx, &authpb.AuthenticateRequest{JwtToken: token})
 }
@@ -0,0 +1,24 @@
+package casesteps
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/google/uuid"
+)
+
+// ChangePasswordStep changes user password through the command handler
+func ChangePasswordStep(
+	ctx context.Context,
+	handler *commands.ChangePasswordHandler,
+	userID uuid.UUID,
+	currentPassword, newPassword string,
+) error {
+	cmd := commands.ChangePasswordCommand{
+		UserID:          userID,
+		CurrentPassword: currentPassword,
+		NewPassword:     newPassword,
+	}
+	return handler.Handle(ctx, cmd)
+}
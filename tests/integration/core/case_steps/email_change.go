@@ -0,0 +1,28 @@
+package casesteps
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/google/uuid"
+)
+
+// RequestEmailChangeStep requests an email change on behalf of the user
+func RequestEmailChangeStep(
+	ctx context.Context,
+	handler *commands.RequestEmailChangeHandler,
+	userID uuid.UUID,
+	currentPassword, newEmail string,
+) error {
+	return handler.Handle(ctx, commands.RequestEmailChangeCommand{
+		UserID:          userID,
+		CurrentPassword: currentPassword,
+		NewEmail:        newEmail,
+	})
+}
+
+// ConfirmEmailChangeStep confirms email change with the token from the email sent to the new address
+func ConfirmEmailChangeStep(ctx context.Context, handler *commands.ConfirmEmailChangeHandler, token string) error {
+	return handler.Handle(ctx, commands.ConfirmEmailChangeCommand{Token: token})
+}
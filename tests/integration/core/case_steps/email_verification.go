@@ -0,0 +1,35 @@
+package casesteps
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+)
+
+var verificationLinkToken = regexp.MustCompile(`token=(\S+)`)
+
+// SendEmailVerificationStep requests a new email verification link
+func SendEmailVerificationStep(ctx context.Context, handler *commands.SendEmailVerificationHandler, email string) error {
+	return handler.Handle(ctx, commands.SendEmailVerificationCommand{Email: email})
+}
+
+// VerifyEmailStep confirms email with the token from the verification email
+func VerifyEmailStep(ctx context.Context, handler *commands.VerifyEmailHandler, token string) error {
+	return handler.Handle(ctx, commands.VerifyEmailCommand{Token: token})
+}
+
+// VerificationTokenFromEmail extracts the token from the verification link in the email body
+func VerificationTokenFromEmail(msg ports.EmailMessage) string {
+	match := verificationLinkToken.FindStringSubmatch(msg.Body)
+	if match == nil {
+		return ""
+	}
+	token, err := url.QueryUnescape(match[1])
+	if err != nil {
+		return ""
+	}
+	return token
+}
@@ -79,6 +79,322 @@ func RegisterHTTPRequest(body interface{}) HTTPRequest {
 	}
 }
 
+// ChangePasswordHTTPRequest builds request for password change on behalf of the token owner
+func ChangePasswordHTTPRequest(accessToken string, body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/password/change",
+		Body:        body,
+		Headers:     map[string]string{"Authorization": "Bearer " + accessToken},
+		ContentType: "application/json",
+	}
+}
+
+// RequestAccountDeletionHTTPRequest builds request scheduling deletion of the caller's account
+func RequestAccountDeletionHTTPRequest(accessToken string, body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodDelete,
+		URL:         "/api/v1/me",
+		Body:        body,
+		Headers:     map[string]string{"Authorization": "Bearer " + accessToken},
+		ContentType: "application/json",
+	}
+}
+
+// CancelAccountDeletionHTTPRequest builds request cancelling the scheduled deletion of the caller's account
+func CancelAccountDeletionHTTPRequest(accessToken string) HTTPRequest {
+	return HTTPRequest{
+		Method:  http.MethodPost,
+		URL:     "/api/v1/me/deletion/cancel",
+		Headers: map[string]string{"Authorization": "Bearer " + accessToken},
+	}
+}
+
+// GetMeHTTPRequest builds request reading the caller's profile
+func GetMeHTTPRequest(accessToken string) HTTPRequest {
+	return HTTPRequest{
+		Method:  http.MethodGet,
+		URL:     "/api/v1/me",
+		Headers: map[string]string{"Authorization": "Bearer " + accessToken},
+	}
+}
+
+// UpdateProfileHTTPRequest builds request changing the caller's profile
+func UpdateProfileHTTPRequest(accessToken string, body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPatch,
+		URL:         "/api/v1/me",
+		Body:        body,
+		Headers:     map[string]string{"Authorization": "Bearer " + accessToken},
+		ContentType: "application/json",
+	}
+}
+
+// ExportMyDataHTTPRequest builds request exporting the caller's personal data
+func ExportMyDataHTTPRequest(accessToken string) HTTPRequest {
+	return HTTPRequest{
+		Method:  http.MethodGet,
+		URL:     "/api/v1/me/export",
+		Headers: map[string]string{"Authorization": "Bearer " + accessToken},
+	}
+}
+
+// GetMyDataExportHTTPRequest builds request fetching a background export of the caller's data
+func GetMyDataExportHTTPRequest(accessToken, exportID string) HTTPRequest {
+	return HTTPRequest{
+		Method:  http.MethodGet,
+		URL:     "/api/v1/me/exports/" + exportID,
+		Headers: map[string]string{"Authorization": "Bearer " + accessToken},
+	}
+}
+
+// ChangeExpiredPasswordHTTPRequest builds request for changing an expired password
+func ChangeExpiredPasswordHTTPRequest(body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/password/expired",
+		Body:        body,
+		ContentType: "application/json",
+	}
+}
+
+// RequirePasswordChangeHTTPRequest builds admin request forcing a password change
+func RequirePasswordChangeHTTPRequest(adminAPIKey, userID string) HTTPRequest {
+	return HTTPRequest{
+		Method:  http.MethodPost,
+		URL:     "/api/v1/admin/users/" + userID + "/password/require-change",
+		Headers: map[string]string{"X-Admin-Api-Key": adminAPIKey},
+	}
+}
+
+// UnlockUserHTTPRequest builds admin request lifting the login lock
+func UnlockUserHTTPRequest(adminAPIKey, userID string) HTTPRequest {
+	return HTTPRequest{
+		Method:  http.MethodPost,
+		URL:     "/api/v1/admin/users/" + userID + "/unlock",
+		Headers: map[string]string{"X-Admin-Api-Key": adminAPIKey},
+	}
+}
+
+// ChangeUserStatusHTTPRequest builds admin request changing the account status
+func ChangeUserStatusHTTPRequest(adminAPIKey, userID string, body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/admin/users/" + userID + "/status",
+		Body:        body,
+		Headers:     map[string]string{"X-Admin-Api-Key": adminAPIKey},
+		ContentType: "application/json",
+	}
+}
+
+// DeleteUserHTTPRequest builds admin request deleting the account
+func DeleteUserHTTPRequest(adminAPIKey, userID string) HTTPRequest {
+	return HTTPRequest{
+		Method:  http.MethodDelete,
+		URL:     "/api/v1/admin/users/" + userID,
+		Headers: map[string]string{"X-Admin-Api-Key": adminAPIKey},
+	}
+}
+
+// ExportUserDataHTTPRequest builds admin request exporting a user's personal data
+func ExportUserDataHTTPRequest(adminAPIKey, userID string) HTTPRequest {
+	return HTTPRequest{
+		Method:  http.MethodGet,
+		URL:     "/api/v1/admin/users/" + userID + "/export",
+		Headers: map[string]string{"X-Admin-Api-Key": adminAPIKey},
+	}
+}
+
+// GetUserDataExportHTTPRequest builds admin request fetching a background export of a user's data
+func GetUserDataExportHTTPRequest(adminAPIKey, userID, exportID string) HTTPRequest {
+	return HTTPRequest{
+		Method:  http.MethodGet,
+		URL:     "/api/v1/admin/users/" + userID + "/exports/" + exportID,
+		Headers: map[string]string{"X-Admin-Api-Key": adminAPIKey},
+	}
+}
+
+// RefreshTokensHTTPRequest builds request exchanging a refresh token for a new token pair
+func RefreshTokensHTTPRequest(body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/refresh",
+		Body:        body,
+		ContentType: "application/json",
+	}
+}
+
+// SendEmailVerificationHTTPRequest builds request for sending an email verification link
+func SendEmailVerificationHTTPRequest(body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/email/verification",
+		Body:        body,
+		ContentType: "application/json",
+	}
+}
+
+// RequestMagicLinkHTTPRequest builds request for sending a sign-in link
+func RequestMagicLinkHTTPRequest(body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/magic-link",
+		Body:        body,
+		ContentType: "application/json",
+	}
+}
+
+// RedeemMagicLinkHTTPRequest builds request for logging in with a sign-in link
+func RedeemMagicLinkHTTPRequest(body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/magic-link/redeem",
+		Body:        body,
+		ContentType: "application/json",
+	}
+}
+
+// VerifyEmailHTTPRequest builds request for confirming email
+func VerifyEmailHTTPRequest(body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/email/verify",
+		Body:        body,
+		ContentType: "application/json",
+	}
+}
+
+// RequestEmailChangeHTTPRequest builds request for changing the token owner's email
+func RequestEmailChangeHTTPRequest(accessToken string, body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/email/change",
+		Body:        body,
+		Headers:     map[string]string{"Authorization": "Bearer " + accessToken},
+		ContentType: "application/json",
+	}
+}
+
+// ConfirmEmailChangeHTTPRequest builds request for confirming email change
+func ConfirmEmailChangeHTTPRequest(body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/email/change/confirm",
+		Body:        body,
+		ContentType: "application/json",
+	}
+}
+
+// SendPhoneVerificationHTTPRequest builds request for sending a phone verification code to the token owner
+func SendPhoneVerificationHTTPRequest(accessToken string) HTTPRequest {
+	return HTTPRequest{
+		Method:  http.MethodPost,
+		URL:     "/api/v1/auth/phone/verification",
+		Headers: map[string]string{"Authorization": "Bearer " + accessToken},
+	}
+}
+
+// VerifyPhoneHTTPRequest builds request for confirming the token owner's phone
+func VerifyPhoneHTTPRequest(accessToken string, body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/phone/verify",
+		Body:        body,
+		Headers:     map[string]string{"Authorization": "Bearer " + accessToken},
+		ContentType: "application/json",
+	}
+}
+
+// RequestPhoneChangeHTTPRequest builds request for changing the token owner's phone
+func RequestPhoneChangeHTTPRequest(accessToken string, body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/phone/change",
+		Body:        body,
+		Headers:     map[string]string{"Authorization": "Bearer " + accessToken},
+		ContentType: "application/json",
+	}
+}
+
+// ConfirmPhoneChangeHTTPRequest builds request for confirming the token owner's phone change
+func ConfirmPhoneChangeHTTPRequest(accessToken string, body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/phone/change/confirm",
+		Body:        body,
+		Headers:     map[string]string{"Authorization": "Bearer " + accessToken},
+		ContentType: "application/json",
+	}
+}
+
+// EnrollTOTPHTTPRequest builds request for starting TOTP enrollment of the token owner
+func EnrollTOTPHTTPRequest(accessToken string) HTTPRequest {
+	return HTTPRequest{
+		Method:  http.MethodPost,
+		URL:     "/api/v1/auth/mfa/totp/enroll",
+		Headers: map[string]string{"Authorization": "Bearer " + accessToken},
+	}
+}
+
+// ConfirmTOTPHTTPRequest builds request for enabling MFA of the token owner
+func ConfirmTOTPHTTPRequest(accessToken string, body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/mfa/totp/confirm",
+		Body:        body,
+		Headers:     map[string]string{"Authorization": "Bearer " + accessToken},
+		ContentType: "application/json",
+	}
+}
+
+// VerifyMFAHTTPRequest builds request for the second login step
+func VerifyMFAHTTPRequest(body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/mfa/verify",
+		Body:        body,
+		ContentType: "application/json",
+	}
+}
+
+// BeginWebAuthnRegistrationHTTPRequest builds request for passkey registration options of the token owner
+func BeginWebAuthnRegistrationHTTPRequest(accessToken string) HTTPRequest {
+	return HTTPRequest{
+		Method:  http.MethodPost,
+		URL:     "/api/v1/auth/webauthn/register/begin",
+		Headers: map[string]string{"Authorization": "Bearer " + accessToken},
+	}
+}
+
+// FinishWebAuthnRegistrationHTTPRequest builds request for storing a passkey of the token owner
+func FinishWebAuthnRegistrationHTTPRequest(accessToken string, body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/webauthn/register/finish",
+		Body:        body,
+		Headers:     map[string]string{"Authorization": "Bearer " + accessToken},
+		ContentType: "application/json",
+	}
+}
+
+// BeginWebAuthnLoginHTTPRequest builds request for passkey login options
+func BeginWebAuthnLoginHTTPRequest() HTTPRequest {
+	return HTTPRequest{
+		Method: http.MethodPost,
+		URL:    "/api/v1/auth/webauthn/login/begin",
+	}
+}
+
+// FinishWebAuthnLoginHTTPRequest builds request for passwordless passkey login
+func FinishWebAuthnLoginHTTPRequest(body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/webauthn/login/finish",
+		Body:        body,
+		ContentType: "application/json",
+	}
+}
+
 // LoginHTTPRequest builds request for user login
 func LoginHTTPRequest(body interface{}) HTTPRequest {
 	return HTTPRequest{
@@ -88,3 +404,23 @@ func LoginHTTPRequest(body interface{}) HTTPRequest {
 		ContentType: "application/json",
 	}
 }
+
+// RequestPhoneLoginCodeHTTPRequest builds request for sending a sign-in code by SMS
+func RequestPhoneLoginCodeHTTPRequest(body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/login/phone/code",
+		Body:        body,
+		ContentType: "application/json",
+	}
+}
+
+// LoginWithPhoneCodeHTTPRequest builds request for logging in with an SMS code
+func LoginWithPhoneCodeHTTPRequest(body interface{}) HTTPRequest {
+	return HTTPRequest{
+		Method:      http.MethodPost,
+		URL:         "/api/v1/auth/login/phone",
+		Body:        body,
+		ContentType: "application/json",
+	}
+}
@@ -0,0 +1,14 @@
+package casesteps
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+)
+
+// UnlockUserStep lifts the login lock as an administrator
+func UnlockUserStep(ctx context.Context, handler *commands.UnlockUserHandler, userID uuid.UUID) error {
+	return handler.Handle(ctx, commands.UnlockUserCommand{UserID: userID})
+}
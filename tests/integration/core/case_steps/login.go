@@ -9,8 +9,8 @@ import (
 // LoginUserStep logs user in through the command handler
 func LoginUserStep(ctx context.Context, handler *commands.LoginUserHandler, email, password string) (commands.LoginUserResult, error) {
 	cmd := commands.LoginUserCommand{
-		Email:    email,
-		Password: password,
+		Identifier: email,
+		Password:   password,
 	}
 	return handler.Handle(ctx, cmd)
 }
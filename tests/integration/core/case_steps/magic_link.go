@@ -0,0 +1,21 @@
+package casesteps
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+)
+
+// RequestMagicLinkStep requests a sign-in link for the email
+func RequestMagicLinkStep(ctx context.Context, handler *commands.RequestMagicLinkHandler, email string) error {
+	return handler.Handle(ctx, commands.RequestMagicLinkCommand{Email: email})
+}
+
+// RedeemMagicLinkStep logs in with the token from the sign-in link
+func RedeemMagicLinkStep(
+	ctx context.Context,
+	handler *commands.RedeemMagicLinkHandler,
+	token string,
+) (commands.LoginUserResult, error) {
+	return handler.Handle(ctx, commands.RedeemMagicLinkCommand{Token: token})
+}
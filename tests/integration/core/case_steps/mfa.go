@@ -0,0 +1,63 @@
+package casesteps
+
+import (
+	"context"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/pkg/totp"
+
+	"github.com/google/uuid"
+)
+
+// EnrollTOTPStep starts TOTP enrollment and returns the secret
+func EnrollTOTPStep(ctx context.Context, handler *commands.EnrollTOTPHandler, userID uuid.UUID) (commands.EnrollTOTPResult, error) {
+	return handler.Handle(ctx, commands.EnrollTOTPCommand{UserID: userID})
+}
+
+// ConfirmTOTPStep enables MFA with a code and returns recovery codes
+func ConfirmTOTPStep(
+	ctx context.Context,
+	handler *commands.ConfirmTOTPHandler,
+	userID uuid.UUID,
+	code string,
+) (commands.ConfirmTOTPResult, error) {
+	return handler.Handle(ctx, commands.ConfirmTOTPCommand{UserID: userID, Code: code})
+}
+
+// EnableMFAStep enrolls TOTP and confirms it with the current code
+func EnableMFAStep(
+	ctx context.Context,
+	enroll *commands.EnrollTOTPHandler,
+	confirm *commands.ConfirmTOTPHandler,
+	userID uuid.UUID,
+) (totpSecret string, recoveryCodes []string, err error) {
+	enrollment, err := EnrollTOTPStep(ctx, enroll, userID)
+	if err != nil {
+		return "", nil, err
+	}
+	result, err := ConfirmTOTPStep(ctx, confirm, userID, TOTPCode(enrollment.Secret, 0))
+	if err != nil {
+		return "", nil, err
+	}
+	return enrollment.Secret, result.RecoveryCodes, nil
+}
+
+// VerifyMFAStep completes login with a TOTP code or a recovery code
+func VerifyMFAStep(
+	ctx context.Context,
+	handler *commands.VerifyMFAHandler,
+	mfaToken, code, recoveryCode string,
+) (commands.LoginUserResult, error) {
+	return handler.Handle(ctx, commands.VerifyMFACommand{MFAToken: mfaToken, Code: code, RecoveryCode: recoveryCode})
+}
+
+// TOTPCode returns the code offset steps away from now.
+// Codes are single-use: after enrollment with offset 0 log in with offset 1.
+func TOTPCode(secret string, offset int64) string {
+	code, err := totp.Code(secret, totp.Step(time.Now())+offset)
+	if err != nil {
+		return ""
+	}
+	return code
+}
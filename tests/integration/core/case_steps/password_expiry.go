@@ -0,0 +1,27 @@
+package casesteps
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+)
+
+// RequirePasswordChangeStep forces user to change password on next login
+func RequirePasswordChangeStep(ctx context.Context, handler *commands.RequirePasswordChangeHandler, userID uuid.UUID) error {
+	return handler.Handle(ctx, commands.RequirePasswordChangeCommand{UserID: userID})
+}
+
+// ChangeExpiredPasswordStep changes expired password using password change token
+func ChangeExpiredPasswordStep(
+	ctx context.Context,
+	handler *commands.ChangeExpiredPasswordHandler,
+	passwordChangeToken, newPassword string,
+) (commands.LoginUserResult, error) {
+	cmd := commands.ChangeExpiredPasswordCommand{
+		PasswordChangeToken: passwordChangeToken,
+		NewPassword:         newPassword,
+	}
+	return handler.Handle(ctx, cmd)
+}
@@ -0,0 +1,29 @@
+package casesteps
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+
+	"github.com/google/uuid"
+)
+
+// RequestPhoneChangeStep requests a phone change on behalf of the user
+func RequestPhoneChangeStep(
+	ctx context.Context,
+	handler *commands.RequestPhoneChangeHandler,
+	userID uuid.UUID,
+	newPhone string,
+) (commands.RequestPhoneChangeResult, error) {
+	return handler.Handle(ctx, commands.RequestPhoneChangeCommand{UserID: userID, NewPhone: newPhone})
+}
+
+// ConfirmPhoneChangeStep confirms phone change with the code sent to the new number
+func ConfirmPhoneChangeStep(
+	ctx context.Context,
+	handler *commands.ConfirmPhoneChangeHandler,
+	userID uuid.UUID,
+	code string,
+) (commands.ConfirmPhoneChangeResult, error) {
+	return handler.Handle(ctx, commands.ConfirmPhoneChangeCommand{UserID: userID, Code: code})
+}
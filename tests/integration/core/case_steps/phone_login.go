@@ -0,0 +1,21 @@
+package casesteps
+
+import (
+	"context"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+)
+
+// RequestPhoneLoginCodeStep requests a sign-in code by SMS
+func RequestPhoneLoginCodeStep(ctx context.Context, handler *commands.RequestPhoneLoginCodeHandler, phone string) error {
+	return handler.Handle(ctx, commands.RequestPhoneLoginCodeCommand{Phone: phone})
+}
+
+// LoginWithPhoneCodeStep logs in with the phone number and the code from the SMS
+func LoginWithPhoneCodeStep(
+	ctx context.Context,
+	handler *commands.LoginWithPhoneCodeHandler,
+	phone, code string,
+) (commands.LoginUserResult, error) {
+	return handler.Handle(ctx, commands.LoginWithPhoneCodeCommand{Phone: phone, Code: code})
+}
@@ -0,0 +1,36 @@
+package casesteps
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/ports"
+
+	"github.com/google/uuid"
+)
+
+var verificationSMSCode = regexp.MustCompile(`\b(\d{6})\b`)
+
+// SendPhoneVerificationStep requests a new phone verification code
+func SendPhoneVerificationStep(
+	ctx context.Context,
+	handler *commands.SendPhoneVerificationHandler,
+	userID uuid.UUID,
+) (commands.SendPhoneVerificationResult, error) {
+	return handler.Handle(ctx, commands.SendPhoneVerificationCommand{UserID: userID})
+}
+
+// VerifyPhoneStep confirms phone with the code from the SMS
+func VerifyPhoneStep(ctx context.Context, handler *commands.VerifyPhoneHandler, userID uuid.UUID, code string) error {
+	return handler.Handle(ctx, commands.VerifyPhoneCommand{UserID: userID, Code: code})
+}
+
+// VerificationCodeFromSMS extracts the one-time code from the SMS text
+func VerificationCodeFromSMS(msg ports.SMSMessage) string {
+	match := verificationSMSCode.FindStringSubmatch(msg.Text)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
@@ -0,0 +1,19 @@
+package casesteps
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+)
+
+// ChangeUserStatusStep changes the account status as an administrator
+func ChangeUserStatusStep(
+	ctx context.Context,
+	handler *commands.ChangeUserStatusHandler,
+	userID uuid.UUID,
+	status, reason string,
+) error {
+	return handler.Handle(ctx, commands.ChangeUserStatusCommand{UserID: userID, Status: status, Reason: reason})
+}
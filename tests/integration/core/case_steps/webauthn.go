@@ -0,0 +1,100 @@
+package casesteps
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/pkg/webauthn/webauthntest"
+
+	"github.com/google/uuid"
+)
+
+// NewAuthenticator creates a software authenticator answering for the relying party of the policy
+func NewAuthenticator(policy commands.WebAuthnPolicy) *webauthntest.Authenticator {
+	return webauthntest.New(policy.RelyingParty.ID, policy.RelyingParty.Origins[0])
+}
+
+// RegisterPasskeyStep runs both registration ceremonies with the authenticator
+func RegisterPasskeyStep(
+	ctx context.Context,
+	begin *commands.BeginWebAuthnRegistrationHandler,
+	finish *commands.FinishWebAuthnRegistrationHandler,
+	authenticator *webauthntest.Authenticator,
+	userID uuid.UUID,
+	name string,
+) (commands.FinishWebAuthnRegistrationResult, error) {
+	options, err := begin.Handle(ctx, commands.BeginWebAuthnRegistrationCommand{UserID: userID})
+	if err != nil {
+		return commands.FinishWebAuthnRegistrationResult{}, err
+	}
+
+	reg, err := authenticator.Register(options.Options.Challenge, options.Options.UserID)
+	if err != nil {
+		return commands.FinishWebAuthnRegistrationResult{}, err
+	}
+
+	return finish.Handle(ctx, commands.FinishWebAuthnRegistrationCommand{
+		UserID:            userID,
+		Name:              name,
+		ClientDataJSON:    reg.ClientDataJSON,
+		AttestationObject: reg.AttestationObject,
+	})
+}
+
+// PasskeyLoginStep runs both passwordless login ceremonies with the authenticator
+func PasskeyLoginStep(
+	ctx context.Context,
+	begin *commands.BeginWebAuthnLoginHandler,
+	finish *commands.FinishWebAuthnLoginHandler,
+	authenticator *webauthntest.Authenticator,
+) (commands.LoginUserResult, error) {
+	options, err := begin.Handle(ctx, commands.BeginWebAuthnLoginCommand{})
+	if err != nil {
+		return commands.LoginUserResult{}, err
+	}
+
+	assertion, err := authenticator.Login(options.Options.Challenge, options.Options.AllowCredentials)
+	if err != nil {
+		return commands.LoginUserResult{}, err
+	}
+
+	return finish.Handle(ctx, commands.FinishWebAuthnLoginCommand{Assertion: ToWebAuthnAssertion(assertion)})
+}
+
+// ToWebAuthnAssertion converts an authenticator response to the command form
+func ToWebAuthnAssertion(assertion webauthntest.Assertion) commands.WebAuthnAssertion {
+	return commands.WebAuthnAssertion{
+		CredentialID:      assertion.CredentialID,
+		ClientDataJSON:    assertion.ClientDataJSON,
+		AuthenticatorData: assertion.AuthenticatorData,
+		Signature:         assertion.Signature,
+		UserHandle:        assertion.UserHandle,
+	}
+}
+
+// AttestationJSON serializes a registration response like PublicKeyCredential.toJSON()
+func AttestationJSON(reg webauthntest.Registration) map[string]any {
+	return map[string]any{
+		"id":   base64.RawURLEncoding.EncodeToString(reg.CredentialID),
+		"type": "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(reg.ClientDataJSON),
+			"attestationObject": base64.RawURLEncoding.EncodeToString(reg.AttestationObject),
+		},
+	}
+}
+
+// AssertionJSON serializes a login response like PublicKeyCredential.toJSON()
+func AssertionJSON(assertion webauthntest.Assertion) map[string]any {
+	return map[string]any{
+		"id":   base64.RawURLEncoding.EncodeToString(assertion.CredentialID),
+		"type": "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(assertion.ClientDataJSON),
+			"authenticatorData": base64.RawURLEncoding.EncodeToString(assertion.AuthenticatorData),
+			"signature":         base64.RawURLEncoding.EncodeToString(assertion.Signature),
+			"userHandle":        base64.RawURLEncoding.EncodeToString(assertion.UserHandle),
+		},
+	}
+}
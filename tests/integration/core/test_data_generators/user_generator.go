@@ -79,15 +79,15 @@ func (u UserTestData) ToRegisterRequest() v1.RegisterRequest {
 
 func (u UserTestData) ToLoginHTTPRequest() map[string]any {
 	return map[string]any{
-		"email":    u.Email,
-		"password": u.Password,
+		"identifier": u.Email,
+		"password":   u.Password,
 	}
 }
 
 func (u UserTestData) ToLoginRequest() v1.LoginRequest {
 	return v1.LoginRequest{
-		Email:    openapi_types.Email(u.Email),
-		Password: u.Password,
+		Identifier: &u.Email,
+		Password:   u.Password,
 	}
 }
 
@@ -100,7 +100,7 @@ type Option func(*UserTestData, *rand.Rand)
 func NewUser(opts ...Option) UserTestData {
 	data := UserTestData{
 		Email:    fmt.Sprintf("testuser_%d@example.com", defaultRng.Int63()),
-		Phone:    "+1234567",
+		Phone:    "+12015550100",
 		Name:     "Test User",
 		Password: "securepassword123",
 	}
@@ -113,19 +113,24 @@ func NewUser(opts ...Option) UserTestData {
 func WithRandom() Option {
 	names := []string{"Alice", "Bob", "Charlie", "Diana", "Eve", "Mallory", "Trent"}
 	domains := []string{"example.com", "mail.com", "test.org"}
-	phones := []string{"+1234567890", "+1234567891", "+1234567892", "+19991234567"}
 	return func(u *UserTestData, r *rand.Rand) {
 		u.Name = pick(r, names) + fmt.Sprintf(" %d", r.Intn(1000))
 		u.Email = fmt.Sprintf("%s_%d@%s", pick(r, names), r.Int63(), pick(r, domains))
-		u.Phone = pick(r, phones)
+		u.Phone = randomPhone(r)
 		// keep password constant but valid length
 		u.Password = "securepassword123"
 	}
 }
 
+// randomPhone returns a valid +1 201 number so users created in one test do not collide on phone
+func randomPhone(r *rand.Rand) string {
+	return fmt.Sprintf("+1201%d%06d", 2+r.Intn(8), r.Intn(1_000_000))
+}
+
 // ============================
 // Backward-compatible helpers
 // ============================
 
 func DefaultUserData() UserTestData { return NewUser() }
 func RandomUserData() UserTestData  { return NewUser(WithRandom()) }
+func RandomPhone() string           { return randomPhone(defaultRng) }
@@ -23,8 +23,8 @@ func (s *Suite) TestAuthenticateThroughGRPC_RandomUser() {
 	s.Require().NoError(err)
 
 	// 2) Build gRPC auth handler and call Authenticate (real gRPC server method)
-	authByToken := queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService)
-	handler := grpcin.NewAuthHandler(authByToken)
+	authByToken := queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService, s.TestDIContainer.TransactionManager)
+	handler := grpcin.NewAuthHandler(authByToken, nil, nil, nil, nil)
 	resp, err := handler.Authenticate(ctx, &authpb.AuthenticateRequest{JwtToken: reg.AccessToken})
 	s.Require().NoError(err)
 	s.Require().NotNil(resp)
@@ -40,8 +40,8 @@ func (s *Suite) TestAuthenticateThroughGRPC_RandomUser() {
 // Validation: nil request should return InvalidArgument
 func (s *Suite) TestAuthenticateThroughGRPC_NilRequest() {
 	ctx := context.Background()
-	authByToken := queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService)
-	handler := grpcin.NewAuthHandler(authByToken)
+	authByToken := queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService, s.TestDIContainer.TransactionManager)
+	handler := grpcin.NewAuthHandler(authByToken, nil, nil, nil, nil)
 	resp, err := handler.Authenticate(ctx, nil)
 	s.Require().Error(err)
 	s.Nil(resp)
@@ -53,8 +53,8 @@ func (s *Suite) TestAuthenticateThroughGRPC_NilRequest() {
 // Validation: empty jwt_token should return InvalidArgument
 func (s *Suite) TestAuthenticateThroughGRPC_EmptyToken() {
 	ctx := context.Background()
-	authByToken := queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService)
-	handler := grpcin.NewAuthHandler(authByToken)
+	authByToken := queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService, s.TestDIContainer.TransactionManager)
+	handler := grpcin.NewAuthHandler(authByToken, nil, nil, nil, nil)
 	resp, err := handler.Authenticate(ctx, &authpb.AuthenticateRequest{JwtToken: "   "})
 	s.Require().Error(err)
 	s.Nil(resp)
@@ -66,8 +66,8 @@ func (s *Suite) TestAuthenticateThroughGRPC_EmptyToken() {
 // Domain-level: invalid token should surface as Unauthenticated at gRPC
 func (s *Suite) TestAuthenticateThroughGRPC_InvalidToken_DomainError() {
 	ctx := context.Background()
-	authByToken := queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService)
-	handler := grpcin.NewAuthHandler(authByToken)
+	authByToken := queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService, s.TestDIContainer.TransactionManager)
+	handler := grpcin.NewAuthHandler(authByToken, nil, nil, nil, nil)
 	// malformed/invalid JWT (non-empty) to bypass handler empty-check and trigger lower-layer validation
 	resp, err := handler.Authenticate(ctx, &authpb.AuthenticateRequest{JwtToken: "invalid.jwt.token"})
 	s.Require().Error(err)
@@ -0,0 +1,72 @@
+package auth_grpc_tests
+
+import (
+	"context"
+
+	authpb "github.com/Vi-72/quest-auth/api/grpc/sdk/go/auth/v1"
+
+	grpcin "github.com/Vi-72/quest-auth/internal/adapters/in/grpc"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/queries"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Suite) newPhoneChangeGRPCHandler() *grpcin.AuthHandler {
+	return grpcin.NewAuthHandler(
+		queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService, s.TestDIContainer.TransactionManager),
+		s.TestDIContainer.RequestPhoneChangeHandler,
+		s.TestDIContainer.ConfirmPhoneChangeHandler,
+		nil,
+		nil,
+	)
+}
+
+// GRPC: change phone with OTP confirmation
+func (s *Suite) TestPhoneChangeThroughGRPC() {
+	ctx := context.Background()
+	handler := s.newPhoneChangeGRPCHandler()
+
+	userData := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, userData)
+	s.Require().NoError(err)
+	newPhone := testdatagenerators.RandomPhone()
+
+	// Request code
+	sent, err := handler.RequestPhoneChange(ctx, &authpb.RequestPhoneChangeRequest{JwtToken: reg.AccessToken, NewPhone: newPhone})
+	s.Require().NoError(err)
+	s.Equal(int64(300), sent.ExpiresIn)
+
+	// Confirm
+	msg, ok := s.TestDIContainer.SMSSender.LastTo(newPhone)
+	s.Require().True(ok)
+	resp, err := handler.ConfirmPhoneChange(ctx, &authpb.ConfirmPhoneChangeRequest{
+		JwtToken: reg.AccessToken,
+		Code:     casesteps.VerificationCodeFromSMS(msg),
+	})
+	s.Require().NoError(err)
+	s.Equal(reg.User.ID.String(), resp.User.Id)
+	s.Equal(newPhone, resp.User.Phone)
+	s.Equal("fixed_line_or_mobile", resp.User.PhoneType)
+	s.True(resp.User.PhoneVerified)
+
+	// Immediate new request is throttled
+	_, err = handler.RequestPhoneChange(ctx, &authpb.RequestPhoneChangeRequest{JwtToken: reg.AccessToken, NewPhone: testdatagenerators.RandomPhone()})
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Equal(codes.ResourceExhausted, st.Code())
+}
+
+// Validation: invalid token is rejected
+func (s *Suite) TestPhoneChangeThroughGRPC_InvalidToken() {
+	ctx := context.Background()
+	handler := s.newPhoneChangeGRPCHandler()
+
+	_, err := handler.RequestPhoneChange(ctx, &authpb.RequestPhoneChangeRequest{JwtToken: "invalid", NewPhone: "+12025550143"})
+
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Equal(codes.Unauthenticated, st.Code())
+}
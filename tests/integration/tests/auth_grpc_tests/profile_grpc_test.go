@@ -0,0 +1,115 @@
+package auth_grpc_tests
+
+import (
+	"context"
+
+	authpb "github.com/Vi-72/quest-auth/api/grpc/sdk/go/auth/v1"
+
+	grpcin "github.com/Vi-72/quest-auth/internal/adapters/in/grpc"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/queries"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func (s *Suite) newProfileGRPCHandler() *grpcin.AuthHandler {
+	return grpcin.NewAuthHandler(
+		queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService, s.TestDIContainer.TransactionManager),
+		nil,
+		nil,
+		s.TestDIContainer.GetMeHandler,
+		s.TestDIContainer.UpdateProfileHandler,
+	)
+}
+
+// GRPC: read and rename the profile of the token owner
+func (s *Suite) TestProfileThroughGRPC() {
+	ctx := context.Background()
+	handler := s.newProfileGRPCHandler()
+
+	userData := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, userData)
+	s.Require().NoError(err)
+
+	// Read
+	me, err := handler.GetMe(ctx, &authpb.GetMeRequest{JwtToken: reg.AccessToken})
+	s.Require().NoError(err)
+	s.Equal(reg.User.ID.String(), me.User.Id)
+	s.Equal(userData.Name, me.User.Name)
+	s.Equal("active", me.User.Status)
+
+	// Update
+	name := "Renamed User"
+	updated, err := handler.UpdateProfile(ctx, &authpb.UpdateProfileRequest{JwtToken: reg.AccessToken, Name: &name})
+	s.Require().NoError(err)
+	s.Equal(name, updated.User.Name)
+
+	me, err = handler.GetMe(ctx, &authpb.GetMeRequest{JwtToken: reg.AccessToken})
+	s.Require().NoError(err)
+	s.Equal(name, me.User.Name)
+
+	// Blank name is rejected
+	blank := " "
+	_, err = handler.UpdateProfile(ctx, &authpb.UpdateProfileRequest{JwtToken: reg.AccessToken, Name: &blank})
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Equal(codes.InvalidArgument, st.Code())
+}
+
+// Validation: invalid token is rejected
+func (s *Suite) TestProfileThroughGRPC_InvalidToken() {
+	ctx := context.Background()
+	handler := s.newProfileGRPCHandler()
+
+	_, err := handler.GetMe(ctx, &authpb.GetMeRequest{JwtToken: "invalid"})
+
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Equal(codes.Unauthenticated, st.Code())
+}
+
+// GRPC: profile attributes, username and metadata round-trip (metadata through google.protobuf.Struct)
+func (s *Suite) TestProfileThroughGRPC_AttributesAndMetadata() {
+	ctx := context.Background()
+	handler := s.newProfileGRPCHandler()
+
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	metadata, err := structpb.NewStruct(map[string]any{"plan": "free", "seats": 2})
+	s.Require().NoError(err)
+	locale, timezone := "fr", "Europe/Paris"
+	updated, err := handler.UpdateProfile(ctx, &authpb.UpdateProfileRequest{
+		JwtToken: reg.AccessToken,
+		Locale:   &locale,
+		Timezone: &timezone,
+		Metadata: metadata,
+	})
+	s.Require().NoError(err)
+	s.Equal("fr", updated.User.Locale)
+	s.Equal("Europe/Paris", updated.User.Timezone)
+
+	username := "Marie.Curie"
+	updated, err = handler.UpdateProfile(ctx, &authpb.UpdateProfileRequest{JwtToken: reg.AccessToken, Username: &username})
+	s.Require().NoError(err)
+	s.Equal("marie.curie", updated.User.Username)
+
+	me, err := handler.GetMe(ctx, &authpb.GetMeRequest{JwtToken: reg.AccessToken})
+	s.Require().NoError(err)
+	s.Equal("fr", me.User.Locale)
+	s.Equal("marie.curie", me.User.Username)
+	s.Empty(me.User.BirthDate)
+	s.Require().NotNil(me.User.Metadata)
+	s.Equal(map[string]any{"plan": "free", "seats": float64(2)}, me.User.Metadata.AsMap())
+
+	// Metadata outside the configured schema is rejected
+	invalid, err := structpb.NewStruct(map[string]any{"plan": "enterprise"})
+	s.Require().NoError(err)
+	_, err = handler.UpdateProfile(ctx, &authpb.UpdateProfileRequest{JwtToken: reg.AccessToken, Metadata: invalid})
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Equal(codes.InvalidArgument, st.Code())
+}
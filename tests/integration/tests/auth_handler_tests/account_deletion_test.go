@@ -0,0 +1,125 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for RequestAccountDeletionHandler, CancelAccountDeletionHandler and DeleteScheduledUsersHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	domainhelpers "github.com/Vi-72/quest-auth/tests/domain"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+// deleteScheduledUsersAt runs the scheduled deletion as if the clock showed at
+func (s *Suite) deleteScheduledUsersAt(ctx context.Context, at time.Time) int {
+	handler := commands.NewDeleteScheduledUsersHandler(
+		s.TestDIContainer.TransactionManager,
+		domainhelpers.FakeClockAt(at),
+		commands.UserPurgePolicy{},
+	)
+	deleted, err := handler.Handle(ctx)
+	s.Require().NoError(err)
+	return deleted
+}
+
+func (s *Suite) TestAccountDeletion_UserCanLoginAndCancelDuringGracePeriod() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act
+	result, err := s.TestDIContainer.RequestAccountDeletionHandler.Handle(ctx, commands.RequestAccountDeletionCommand{
+		UserID:          reg.User.ID,
+		CurrentPassword: data.Password,
+	})
+	s.Require().NoError(err)
+	s.WithinDuration(time.Now().Add(time.Hour), result.ScheduledAt, time.Minute)
+
+	// Assert: login still works and reports the scheduled deletion
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+	s.Require().NotNil(login.User.DeletionScheduledAt)
+	s.WithinDuration(result.ScheduledAt, *login.User.DeletionScheduledAt, time.Millisecond)
+
+	// Nothing is deleted before the grace period ends
+	s.Zero(s.deleteScheduledUsersAt(ctx, time.Now()))
+
+	// Act: cancel
+	err = s.TestDIContainer.CancelAccountDeletionHandler.Handle(ctx, commands.CancelAccountDeletionCommand{UserID: reg.User.ID})
+	s.Require().NoError(err)
+
+	// Assert: the account survives the end of the grace period
+	s.Zero(s.deleteScheduledUsersAt(ctx, result.ScheduledAt.Add(time.Minute)))
+	login, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+	s.Nil(login.User.DeletionScheduledAt)
+
+	for _, eventType := range []string{"UserDeletionRequested", "UserDeletionCancelled"} {
+		events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, eventType)
+		s.Require().NoError(err)
+		s.Len(events, 1, eventType)
+	}
+}
+
+func (s *Suite) TestAccountDeletion_PurgedWhenGracePeriodEnds() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	result, err := s.TestDIContainer.RequestAccountDeletionHandler.Handle(ctx, commands.RequestAccountDeletionCommand{
+		UserID:          reg.User.ID,
+		CurrentPassword: data.Password,
+	})
+	s.Require().NoError(err)
+
+	// Act
+	deleted := s.deleteScheduledUsersAt(ctx, result.ScheduledAt.Add(time.Minute))
+
+	// Assert: the account is erased right away, without the retention period
+	s.Equal(1, deleted)
+	var count int64
+	s.Require().NoError(s.TestDIContainer.DB.Table("users").Where("id = ?", reg.User.ID).Count(&count).Error)
+	s.Zero(count)
+
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().Error(err)
+
+	events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, "UserDeleted")
+	s.Require().NoError(err)
+	s.Len(events, 1)
+}
+
+func (s *Suite) TestAccountDeletion_Validation() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Wrong password
+	_, err = s.TestDIContainer.RequestAccountDeletionHandler.Handle(ctx, commands.RequestAccountDeletionCommand{
+		UserID:          reg.User.ID,
+		CurrentPassword: "wrongpassword",
+	})
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+	s.Equal("current_password", validationErr.Field)
+
+	// Nothing to cancel
+	err = s.TestDIContainer.CancelAccountDeletionHandler.Handle(ctx, commands.CancelAccountDeletionCommand{UserID: reg.User.ID})
+	s.Require().True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+	s.Equal("deletion", validationErr.Field)
+
+	// Already scheduled
+	cmd := commands.RequestAccountDeletionCommand{UserID: reg.User.ID, CurrentPassword: data.Password}
+	_, err = s.TestDIContainer.RequestAccountDeletionHandler.Handle(ctx, cmd)
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.RequestAccountDeletionHandler.Handle(ctx, cmd)
+	s.Require().True(errors.As(err, &validationErr), "expected DomainValidationError, got %v", err)
+	s.Equal("deletion", validationErr.Field)
+}
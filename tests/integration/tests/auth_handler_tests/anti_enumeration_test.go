@@ -0,0 +1,165 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for anti-enumeration mode in LoginUserHandler and RegisterUserHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"time"
+
+	bcryptadapter "github.com/Vi-72/quest-auth/internal/adapters/out/bcrypt"
+	timeadapter "github.com/Vi-72/quest-auth/internal/adapters/out/time"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+// antiEnumerationHandlers builds login and registration handlers with anti-enumeration mode on
+func (s *Suite) antiEnumerationHandlers() (*commands.LoginUserHandler, *commands.RegisterUserHandler) {
+	policy := commands.AntiEnumerationPolicy{Enabled: true}
+	verificationPolicy := commands.EmailVerificationPolicy{TokenTTL: time.Hour}
+
+	login := commands.NewLoginUserHandler(
+		s.TestDIContainer.TransactionManager,
+		s.TestDIContainer.JWTService,
+		bcryptadapter.NewHasher(),
+		timeadapter.NewClock(),
+		commands.PasswordExpiryPolicy{ChangeTokenTTL: 10 * time.Minute},
+		verificationPolicy,
+		commands.MFAPolicy{ChallengeTTL: 5 * time.Minute},
+		s.TestDIContainer.WebAuthnPolicy,
+		commands.LockoutPolicy{Threshold: 2, BaseDuration: time.Minute, MaxDuration: time.Hour},
+		policy,
+		commands.CaptchaPolicy{},
+		commands.LoginRiskPolicy{},
+		s.TestDIContainer.EmailCanonicalization,
+		s.TestDIContainer.PhoneNumbering,
+	)
+	register := commands.NewRegisterUserHandler(
+		s.TestDIContainer.TransactionManager,
+		s.TestDIContainer.JWTService,
+		bcryptadapter.NewHasher(),
+		timeadapter.NewClock(),
+		s.TestDIContainer.EmailSender,
+		verificationPolicy,
+		policy,
+		commands.CaptchaPolicy{},
+		s.TestDIContainer.EmailCanonicalization,
+		s.TestDIContainer.PhoneNumbering,
+	)
+	return login, register
+}
+
+func (s *Suite) TestAntiEnumeration_RegisterAnswersTheSame() {
+	ctx := context.Background()
+	_, register := s.antiEnumerationHandlers()
+	data := testdatagenerators.RandomUserData()
+
+	// Act: a new account
+	created, err := casesteps.RegisterUserStepData(ctx, register, data)
+
+	// Assert: accepted without tokens, verification email sent
+	s.Require().NoError(err)
+	s.True(created.Accepted)
+	s.Empty(created.AccessToken)
+	s.Equal(1, s.TestDIContainer.EmailSender.CountTo(data.Email))
+
+	// Act: the same email again
+	again := testdatagenerators.RandomUserData()
+	again.Email = data.Email
+	repeated, err := casesteps.RegisterUserStepData(ctx, register, again)
+
+	// Assert: the same answer, the account holder gets a notice
+	s.Require().NoError(err)
+	s.Equal(created, repeated)
+	msg, ok := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Require().True(ok)
+	s.Contains(msg.Subject, "tried to sign up")
+}
+
+func (s *Suite) TestAntiEnumeration_RegisterWithTakenPhoneNotifiesHolder() {
+	ctx := context.Background()
+	_, register := s.antiEnumerationHandlers()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, register, data)
+	s.Require().NoError(err)
+
+	// Act: a new email with the phone of the existing account
+	again := testdatagenerators.RandomUserData()
+	again.Phone = data.Phone
+	result, err := casesteps.RegisterUserStepData(ctx, register, again)
+
+	// Assert: nothing is created for the new email, the holder of the phone is notified
+	s.Require().NoError(err)
+	s.True(result.Accepted)
+	s.Equal(0, s.TestDIContainer.EmailSender.CountTo(again.Email))
+	msg, ok := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Require().True(ok)
+	s.Contains(msg.Subject, "tried to sign up")
+}
+
+func (s *Suite) TestAntiEnumeration_RegisterRejectsBadInputForTakenAndFreeEmail() {
+	ctx := context.Background()
+	_, register := s.antiEnumerationHandlers()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, register, data)
+	s.Require().NoError(err)
+
+	taken := testdatagenerators.RandomUserData()
+	taken.Email = data.Email
+	free := testdatagenerators.RandomUserData()
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*testdatagenerators.UserTestData)
+	}{
+		{"weak password", func(d *testdatagenerators.UserTestData) { d.Password = "short" }},
+		{"blank name", func(d *testdatagenerators.UserTestData) { d.Name = "   " }},
+	} {
+		s.Run(tc.name, func() {
+			takenData, freeData := taken, free
+			tc.modify(&takenData)
+			tc.modify(&freeData)
+
+			// Act
+			_, takenErr := casesteps.RegisterUserStepData(ctx, register, takenData)
+			_, freeErr := casesteps.RegisterUserStepData(ctx, register, freeData)
+
+			// Assert: the same rejection whether the email is taken or not, and no notice to the holder
+			s.Require().Error(takenErr)
+			s.Require().Error(freeErr)
+			s.Equal(freeErr.Error(), takenErr.Error())
+		})
+	}
+	s.Equal(1, s.TestDIContainer.EmailSender.CountTo(data.Email))
+}
+
+func (s *Suite) TestAntiEnumeration_LoginHidesUnknownAndLockedAccounts() {
+	ctx := context.Background()
+	login, _ := s.antiEnumerationHandlers()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act: an unknown account
+	_, unknownErr := casesteps.LoginUserStep(ctx, login, testdatagenerators.RandomUserData().Email, "wrong-password")
+
+	// Act: wrong passwords up to and past the lockout
+	var lockedErrs []error
+	for i := 0; i < 3; i++ {
+		_, err = casesteps.LoginUserStep(ctx, login, data.Email, "wrong-password")
+		lockedErrs = append(lockedErrs, err)
+	}
+
+	// Assert: every answer is the same credentials error
+	s.Require().Error(unknownErr)
+	for _, err := range lockedErrs {
+		s.Require().Error(err)
+		s.Equal(unknownErr.Error(), err.Error())
+	}
+
+	// Assert: the lock still applies, even to the right password
+	_, err = casesteps.LoginUserStep(ctx, login, data.Email, data.Password)
+	s.Require().Error(err)
+	s.Equal(unknownErr.Error(), err.Error())
+}
@@ -27,7 +27,7 @@ func (s *Suite) TestAuthenticateHandler_Success_UsingSteps() {
 	s.Require().NoError(err)
 
 	// Act: call Authenticate handler via case step
-	resp, err := casesteps.AuthenticateByTokenStep(ctx, s.TestDIContainer.JWTService, reg.AccessToken)
+	resp, err := casesteps.AuthenticateByTokenStep(ctx, s.TestDIContainer.JWTService, s.TestDIContainer.TransactionManager, reg.AccessToken)
 	// Assert
 	s.Require().NoError(err)
 	s.Require().NotNil(resp)
@@ -43,8 +43,8 @@ func (s *Suite) TestAuthenticateHandler_Success_UsingSteps() {
 func (s *Suite) TestAuthenticateHandler_Validation_NilRequest() {
 	ctx := context.Background()
 	// Pre-condition: build handler
-	authByToken := queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService)
-	handler := grpcin.NewAuthHandler(authByToken)
+	authByToken := queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService, s.TestDIContainer.TransactionManager)
+	handler := grpcin.NewAuthHandler(authByToken, nil, nil, nil, nil)
 	// Act
 	resp, err := handler.Authenticate(ctx, nil)
 	// Assert
@@ -59,8 +59,8 @@ func (s *Suite) TestAuthenticateHandler_Validation_NilRequest() {
 func (s *Suite) TestAuthenticateHandler_Validation_EmptyToken() {
 	ctx := context.Background()
 	// Pre-condition: build handler
-	authByToken := queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService)
-	handler := grpcin.NewAuthHandler(authByToken)
+	authByToken := queries.NewAuthenticateByTokenHandler(s.TestDIContainer.JWTService, s.TestDIContainer.TransactionManager)
+	handler := grpcin.NewAuthHandler(authByToken, nil, nil, nil, nil)
 	// Act
 	resp, err := handler.Authenticate(ctx, &authv1.AuthenticateRequest{JwtToken: "   "})
 	// Assert
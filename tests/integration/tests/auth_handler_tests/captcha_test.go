@@ -0,0 +1,157 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for the CAPTCHA challenge in LoginUserHandler and RegisterUserHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	bcryptadapter "github.com/Vi-72/quest-auth/internal/adapters/out/bcrypt"
+	captchaadapter "github.com/Vi-72/quest-auth/internal/adapters/out/captcha"
+	timeadapter "github.com/Vi-72/quest-auth/internal/adapters/out/time"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	"github.com/Vi-72/quest-auth/internal/pkg/ratelimit"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+const testClientIP = "203.0.113.7"
+
+// captchaHandlers builds login and registration handlers that require a CAPTCHA after 2 failures
+func (s *Suite) captchaHandlers() (*commands.LoginUserHandler, *commands.RegisterUserHandler) {
+	clock := timeadapter.NewClock()
+	policy := commands.CaptchaPolicy{
+		Verifier:  captchaadapter.NewNoopVerifier(),
+		Attempts:  ratelimit.NewCounter(ratelimit.NewMemoryStore(), clock, "captcha", time.Hour),
+		Threshold: 2,
+	}
+	verificationPolicy := commands.EmailVerificationPolicy{TokenTTL: time.Hour}
+
+	login := commands.NewLoginUserHandler(
+		s.TestDIContainer.TransactionManager,
+		s.TestDIContainer.JWTService,
+		bcryptadapter.NewHasher(),
+		clock,
+		commands.PasswordExpiryPolicy{ChangeTokenTTL: 10 * time.Minute},
+		verificationPolicy,
+		commands.MFAPolicy{ChallengeTTL: 5 * time.Minute},
+		s.TestDIContainer.WebAuthnPolicy,
+		commands.LockoutPolicy{},
+		commands.AntiEnumerationPolicy{},
+		policy,
+		commands.LoginRiskPolicy{},
+		s.TestDIContainer.EmailCanonicalization,
+		s.TestDIContainer.PhoneNumbering,
+	)
+	register := commands.NewRegisterUserHandler(
+		s.TestDIContainer.TransactionManager,
+		s.TestDIContainer.JWTService,
+		bcryptadapter.NewHasher(),
+		clock,
+		s.TestDIContainer.EmailSender,
+		verificationPolicy,
+		commands.AntiEnumerationPolicy{},
+		policy,
+		s.TestDIContainer.EmailCanonicalization,
+		s.TestDIContainer.PhoneNumbering,
+	)
+	return login, register
+}
+
+func (s *Suite) login(handler *commands.LoginUserHandler, email, password, ip, captchaToken string) error {
+	_, err := handler.Handle(context.Background(), commands.LoginUserCommand{
+		Identifier:   email,
+		Password:     password,
+		ClientIP:     ip,
+		CaptchaToken: captchaToken,
+	})
+	return err
+}
+
+func (s *Suite) TestCaptcha_RequiredAfterFailedLoginsForAccount() {
+	ctx := context.Background()
+	login, _ := s.captchaHandlers()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Pre-condition: two wrong passwords from different addresses
+	s.Require().Error(s.login(login, data.Email, "wrong-password", "198.51.100.1", ""))
+	s.Require().Error(s.login(login, data.Email, "wrong-password", "198.51.100.2", ""))
+
+	// Act: the right password without a CAPTCHA
+	err = s.login(login, data.Email, data.Password, "198.51.100.3", "")
+
+	// Assert: a challenge is required for the account
+	var captchaErr *errs.CaptchaRequiredError
+	s.Require().True(errors.As(err, &captchaErr), "got %v", err)
+
+	// Act & Assert: with a solved CAPTCHA the login succeeds and clears the requirement
+	s.Require().NoError(s.login(login, data.Email, data.Password, "198.51.100.3", "solved"))
+	s.Require().NoError(s.login(login, data.Email, data.Password, "198.51.100.3", ""))
+}
+
+func (s *Suite) TestCaptcha_RequiredAfterFailedLoginsFromIP() {
+	ctx := context.Background()
+	login, _ := s.captchaHandlers()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Pre-condition: the same address guesses passwords of unknown accounts
+	for i := 0; i < 2; i++ {
+		other := testdatagenerators.RandomUserData()
+		s.Require().Error(s.login(login, other.Email, "wrong-password", testClientIP, ""))
+	}
+
+	// Act: another account from the same address
+	err = s.login(login, data.Email, data.Password, testClientIP, "")
+
+	// Assert
+	var captchaErr *errs.CaptchaRequiredError
+	s.Require().True(errors.As(err, &captchaErr), "got %v", err)
+
+	// Other addresses are not affected
+	s.Require().NoError(s.login(login, data.Email, data.Password, "198.51.100.9", ""))
+}
+
+func (s *Suite) TestCaptcha_RegistrationRequiredAfterTakenAccounts() {
+	ctx := context.Background()
+	_, register := s.captchaHandlers()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	registerFrom := func(u testdatagenerators.UserTestData, captchaToken string) error {
+		_, err := register.Handle(ctx, commands.RegisterUserCommand{
+			Email:        u.Email,
+			Phone:        u.Phone,
+			Name:         u.Name,
+			Password:     u.Password,
+			ClientIP:     testClientIP,
+			CaptchaToken: captchaToken,
+		})
+		return err
+	}
+
+	// Pre-condition: two attempts to register a taken email from one address
+	for i := 0; i < 2; i++ {
+		taken := testdatagenerators.RandomUserData()
+		taken.Email = data.Email
+		err := registerFrom(taken, "")
+		s.Require().ErrorIs(err, auth.ErrEmailTaken)
+	}
+
+	// Act: a new account from the same address
+	fresh := testdatagenerators.RandomUserData()
+	err = registerFrom(fresh, "")
+
+	// Assert
+	var captchaErr *errs.CaptchaRequiredError
+	s.Require().True(errors.As(err, &captchaErr), "got %v", err)
+	s.Require().NoError(registerFrom(fresh, "solved"))
+}
@@ -0,0 +1,105 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for ChangePasswordHandler orchestration logic (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestChangePasswordHandler_Success() {
+	ctx := context.Background()
+
+	// Pre-condition: registered user
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act
+	err = casesteps.ChangePasswordStep(ctx, s.TestDIContainer.ChangePasswordHandler, reg.User.ID, data.Password, "brandnewpassword1")
+
+	// Assert: old password no longer works, new one does
+	s.Require().NoError(err)
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().Error(err)
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, "brandnewpassword1")
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestChangePasswordHandler_WrongCurrentPassword() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	err = casesteps.ChangePasswordStep(ctx, s.TestDIContainer.ChangePasswordHandler, reg.User.ID, "wrongpassword", "brandnewpassword1")
+	s.Require().Error(err)
+	s.Contains(err.Error(), "current_password")
+}
+
+func (s *Suite) TestChangePasswordHandler_RejectsRecentPassword() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	s.Require().NoError(casesteps.ChangePasswordStep(ctx, s.TestDIContainer.ChangePasswordHandler, reg.User.ID, data.Password, "secondpassword1"))
+
+	// Act: try to return to the registration password
+	err = casesteps.ChangePasswordStep(ctx, s.TestDIContainer.ChangePasswordHandler, reg.User.ID, "secondpassword1", data.Password)
+
+	// Assert
+	s.Require().Error(err)
+	s.Contains(err.Error(), "recently used")
+}
+
+func (s *Suite) TestChangePasswordHandler_AllowsPasswordOutsideHistoryDepth() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Pre-condition: push the registration password out of the history
+	// (depth = 3 in tests: the current password and the three before it)
+	current := data.Password
+	for _, next := range []string{"secondpassword1", "thirdpassword1", "fourthpassword1", "fifthpassword1"} {
+		s.Require().NoError(casesteps.ChangePasswordStep(ctx, s.TestDIContainer.ChangePasswordHandler, reg.User.ID, current, next))
+		current = next
+	}
+
+	// Act & Assert
+	err = casesteps.ChangePasswordStep(ctx, s.TestDIContainer.ChangePasswordHandler, reg.User.ID, current, data.Password)
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestChangePasswordHandler_RejectsPasswordAtHistoryDepth() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Pre-condition: the registration password is the third one before the current (depth = 3 in tests)
+	current := data.Password
+	for _, next := range []string{"secondpassword1", "thirdpassword1", "fourthpassword1"} {
+		s.Require().NoError(casesteps.ChangePasswordStep(ctx, s.TestDIContainer.ChangePasswordHandler, reg.User.ID, current, next))
+		current = next
+	}
+
+	// Act
+	err = casesteps.ChangePasswordStep(ctx, s.TestDIContainer.ChangePasswordHandler, reg.User.ID, current, data.Password)
+
+	// Assert: depth counts previous passwords, not the current one
+	s.Require().Error(err)
+	s.Contains(err.Error(), "recently used")
+}
+
+func (s *Suite) TestChangePasswordHandler_UserNotFound() {
+	ctx := context.Background()
+	err := casesteps.ChangePasswordStep(ctx, s.TestDIContainer.ChangePasswordHandler, uuid.New(), "whatever1", "brandnewpassword1")
+	s.Require().Error(err)
+}
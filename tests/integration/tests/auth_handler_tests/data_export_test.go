@@ -0,0 +1,124 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for ExportUserDataHandler, GenerateDataExportsHandler and GetDataExportHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/queries"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	domainhelpers "github.com/Vi-72/quest-auth/tests/domain"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestDataExport_SmallExportIsReturnedRightAway() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+	_, err = casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Act
+	result, err := s.TestDIContainer.ExportUserDataHandler.Handle(ctx, commands.ExportUserDataCommand{UserID: reg.User.ID})
+
+	// Assert: the archive holds the profile, logins and events
+	s.Require().NoError(err)
+	s.Nil(result.Export)
+	var archive commands.UserDataArchive
+	s.Require().NoError(json.Unmarshal(result.Archive, &archive))
+	s.Equal(reg.User.ID, archive.Profile.ID)
+	s.Equal(reg.User.Email, archive.Profile.Email)
+	s.Equal("active", archive.Profile.Status)
+	s.Len(archive.Logins, 1)
+	s.Empty(archive.Passkeys)
+
+	types := make(map[string]bool)
+	for _, event := range archive.Events {
+		types[event.Type] = true
+	}
+	s.True(types["UserRegistered"])
+	s.True(types["UserLoggedIn"])
+	s.NotContains(string(result.Archive), "PasswordHash")
+}
+
+func (s *Suite) TestDataExport_LargeExportIsGeneratedInBackground() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+
+	// Act: every user with more than one event gets a background export
+	handler := commands.NewExportUserDataHandler(
+		s.TestDIContainer.TransactionManager,
+		domainhelpers.NewMockClock(),
+		commands.DataExportPolicy{TTL: time.Hour, MaxSyncEvents: 1},
+	)
+	result, err := handler.Handle(ctx, commands.ExportUserDataCommand{UserID: reg.User.ID})
+
+	// Assert: queued, and asking again returns the same export
+	s.Require().NoError(err)
+	s.Require().NotNil(result.Export)
+	s.Empty(result.Archive)
+	s.Equal(auth.DataExportPending, result.Export.Status)
+
+	again, err := handler.Handle(ctx, commands.ExportUserDataCommand{UserID: reg.User.ID})
+	s.Require().NoError(err)
+	s.Require().NotNil(again.Export)
+	s.Equal(result.Export.ID, again.Export.ID)
+
+	query := queries.GetDataExportQuery{UserID: reg.User.ID, ExportID: result.Export.ID}
+	export, err := s.TestDIContainer.GetDataExportHandler.Handle(ctx, query)
+	s.Require().NoError(err)
+	s.False(export.IsReady())
+
+	// Act: background generation
+	generated, err := s.TestDIContainer.GenerateDataExportsHandler.Handle(ctx)
+	s.Require().NoError(err)
+	s.Equal(1, generated)
+
+	export, err = s.TestDIContainer.GetDataExportHandler.Handle(ctx, query)
+	s.Require().NoError(err)
+	s.True(export.IsReady())
+	var archive commands.UserDataArchive
+	s.Require().NoError(json.Unmarshal(export.Archive, &archive))
+	s.Equal(reg.User.ID, archive.Profile.ID)
+	s.NotEmpty(archive.Events)
+
+	// Another user cannot download it
+	_, err = s.TestDIContainer.GetDataExportHandler.Handle(ctx, queries.GetDataExportQuery{UserID: uuid.New(), ExportID: export.ID})
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+
+	// The archive is removed once it expires
+	expiredAt := export.ExpiresAt.Add(time.Minute)
+	cleanup := commands.NewGenerateDataExportsHandler(
+		s.TestDIContainer.TransactionManager,
+		domainhelpers.FakeClockAt(expiredAt),
+		commands.DataExportPolicy{TTL: time.Hour},
+	)
+	_, err = cleanup.Handle(ctx)
+	s.Require().NoError(err)
+	_, err = s.TestDIContainer.GetDataExportHandler.Handle(ctx, query)
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *Suite) TestDataExport_UnknownUser() {
+	_, err := s.TestDIContainer.ExportUserDataHandler.Handle(context.Background(), commands.ExportUserDataCommand{UserID: uuid.New()})
+
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
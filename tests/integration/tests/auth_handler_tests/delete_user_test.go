@@ -0,0 +1,115 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for DeleteUserHandler and PurgeDeletedUsersHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestDeleteUser_DeletedUserCannotLogin() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act
+	err = s.TestDIContainer.DeleteUserHandler.Handle(ctx, commands.DeleteUserCommand{UserID: reg.User.ID})
+	s.Require().NoError(err)
+
+	// Assert: the account is gone for login, tokens and a second deletion
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().Error(err)
+
+	_, err = casesteps.AuthenticateByTokenStep(ctx, s.TestDIContainer.JWTService, s.TestDIContainer.TransactionManager, reg.AccessToken)
+	s.Require().Error(err)
+
+	err = s.TestDIContainer.DeleteUserHandler.Handle(ctx, commands.DeleteUserCommand{UserID: reg.User.ID})
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+
+	// Email and phone stay reserved until the account is purged
+	_, err = casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().Error(err)
+
+	events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, "UserDeleted")
+	s.Require().NoError(err)
+	found := 0
+	for _, event := range events {
+		if event.AggregateID == reg.User.ID.String() {
+			found++
+		}
+	}
+	s.Equal(1, found)
+}
+
+func (s *Suite) TestDeleteUser_NotFound() {
+	err := s.TestDIContainer.DeleteUserHandler.Handle(context.Background(), commands.DeleteUserCommand{UserID: uuid.New()})
+
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *Suite) TestPurgeDeletedUsers_RemovesDataAndAnonymizesEvents() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	kept, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.TestDIContainer.DeleteUserHandler.Handle(ctx, commands.DeleteUserCommand{UserID: reg.User.ID}))
+
+	// Act: the test container keeps deleted accounts for zero time
+	purged, err := s.TestDIContainer.PurgeDeletedUsersHandler.Handle(ctx)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(1, purged)
+
+	var count int64
+	s.Require().NoError(s.TestDIContainer.DB.Table("users").Where("id = ?", reg.User.ID).Count(&count).Error)
+	s.Zero(count)
+	s.Require().NoError(s.TestDIContainer.DB.Table("login_history").Where("user_id = ?", reg.User.ID).Count(&count).Error)
+	s.Zero(count)
+
+	_, err = s.TestDIContainer.UserRepository.GetByID(kept.User.ID)
+	s.Require().NoError(err)
+
+	// Event history is kept without personal data
+	events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, "UserRegistered")
+	s.Require().NoError(err)
+	anonymized := 0
+	for _, event := range events {
+		if event.AggregateID != reg.User.ID.String() {
+			continue
+		}
+		var payload map[string]any
+		s.Require().NoError(json.Unmarshal([]byte(event.Data), &payload))
+		s.Equal("[redacted]", payload["Email"])
+		s.Equal("[redacted]", payload["Phone"])
+		s.NotContains(event.Data, data.Email)
+		anonymized++
+	}
+	s.Equal(1, anonymized)
+
+	// Email and phone are free again
+	_, err = casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Nothing left to purge
+	purged, err = s.TestDIContainer.PurgeDeletedUsersHandler.Handle(ctx)
+	s.Require().NoError(err)
+	s.Zero(purged)
+}
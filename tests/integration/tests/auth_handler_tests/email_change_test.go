@@ -0,0 +1,115 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for RequestEmailChangeHandler and ConfirmEmailChangeHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestEmailChange_RequestAndConfirm() {
+	ctx := context.Background()
+
+	// Pre-condition: registered user
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	newEmail := testdatagenerators.RandomUserData().Email
+
+	// Act: request change
+	err = casesteps.RequestEmailChangeStep(ctx, s.TestDIContainer.RequestEmailChangeHandler, reg.User.ID, data.Password, newEmail)
+	s.Require().NoError(err)
+
+	// Assert: confirmation goes to the new address, notice to the old one, email is not changed yet
+	confirmation, ok := s.TestDIContainer.EmailSender.LastTo(newEmail)
+	s.Require().True(ok)
+	notice, ok := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Require().True(ok)
+	s.Contains(notice.Body, newEmail)
+
+	user, err := s.TestDIContainer.UserRepository.GetByID(reg.User.ID)
+	s.Require().NoError(err)
+	s.Equal(data.Email, user.Email.String())
+
+	// Act: confirm
+	err = casesteps.ConfirmEmailChangeStep(ctx, s.TestDIContainer.ConfirmEmailChangeHandler, casesteps.VerificationTokenFromEmail(confirmation))
+	s.Require().NoError(err)
+
+	// Assert: login works with the new email only, and it is verified
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, newEmail, data.Password)
+	s.Require().NoError(err)
+	s.Equal(newEmail, login.User.Email)
+	s.True(login.User.EmailVerified)
+
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().Error(err)
+}
+
+func (s *Suite) TestEmailChange_WrongPassword() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	newEmail := testdatagenerators.RandomUserData().Email
+
+	err = casesteps.RequestEmailChangeStep(ctx, s.TestDIContainer.RequestEmailChangeHandler, reg.User.ID, "wrong-password", newEmail)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "current_password")
+	s.Equal(0, s.TestDIContainer.EmailSender.CountTo(newEmail))
+}
+
+func (s *Suite) TestEmailChange_EmailTaken() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	other := testdatagenerators.RandomUserData()
+	_, err = casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, other)
+	s.Require().NoError(err)
+
+	err = casesteps.RequestEmailChangeStep(ctx, s.TestDIContainer.RequestEmailChangeHandler, reg.User.ID, data.Password, other.Email)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "email already exists")
+}
+
+func (s *Suite) TestEmailChange_TakenBeforeConfirmation() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	other := testdatagenerators.RandomUserData()
+
+	s.Require().NoError(casesteps.RequestEmailChangeStep(ctx, s.TestDIContainer.RequestEmailChangeHandler, reg.User.ID, data.Password, other.Email))
+	confirmation, _ := s.TestDIContainer.EmailSender.LastTo(other.Email)
+
+	// Another account registers the address while the email is in flight
+	_, err = casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, other)
+	s.Require().NoError(err)
+
+	err = casesteps.ConfirmEmailChangeStep(ctx, s.TestDIContainer.ConfirmEmailChangeHandler, casesteps.VerificationTokenFromEmail(confirmation))
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "email already exists")
+}
+
+func (s *Suite) TestEmailChange_TokenIsSingleUse() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	newEmail := testdatagenerators.RandomUserData().Email
+	s.Require().NoError(casesteps.RequestEmailChangeStep(ctx, s.TestDIContainer.RequestEmailChangeHandler, reg.User.ID, data.Password, newEmail))
+	confirmation, _ := s.TestDIContainer.EmailSender.LastTo(newEmail)
+	token := casesteps.VerificationTokenFromEmail(confirmation)
+
+	s.Require().NoError(casesteps.ConfirmEmailChangeStep(ctx, s.TestDIContainer.ConfirmEmailChangeHandler, token))
+
+	err = casesteps.ConfirmEmailChangeStep(ctx, s.TestDIContainer.ConfirmEmailChangeHandler, token)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "invalid or expired")
+}
@@ -0,0 +1,201 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for SendEmailVerificationHandler and VerifyEmailHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"time"
+
+	bcryptadapter "github.com/Vi-72/quest-auth/internal/adapters/out/bcrypt"
+	timeadapter "github.com/Vi-72/quest-auth/internal/adapters/out/time"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestEmailVerification_RegisterSendsEmailAndVerify() {
+	ctx := context.Background()
+
+	// Pre-condition: registration sends a verification email
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.False(reg.User.EmailVerified)
+
+	msg, ok := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Require().True(ok)
+	token := casesteps.VerificationTokenFromEmail(msg)
+	s.Require().NotEmpty(token)
+
+	// Act
+	err = casesteps.VerifyEmailStep(ctx, s.TestDIContainer.VerifyEmailHandler, token)
+
+	// Assert: email is verified and exposed in tokens
+	s.Require().NoError(err)
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+	s.True(login.User.EmailVerified)
+
+	claims, err := s.TestDIContainer.JWTService.ValidateAccessToken(login.AccessToken)
+	s.Require().NoError(err)
+	s.True(claims.EmailVerified)
+}
+
+func (s *Suite) TestEmailVerification_TokenIsSingleUse() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	msg, _ := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	token := casesteps.VerificationTokenFromEmail(msg)
+
+	s.Require().NoError(casesteps.VerifyEmailStep(ctx, s.TestDIContainer.VerifyEmailHandler, token))
+
+	// Act
+	err = casesteps.VerifyEmailStep(ctx, s.TestDIContainer.VerifyEmailHandler, token)
+
+	// Assert
+	s.Require().Error(err)
+	s.Contains(err.Error(), "invalid or expired")
+}
+
+func (s *Suite) TestEmailVerification_ResendInvalidatesPreviousToken() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	first, _ := s.TestDIContainer.EmailSender.LastTo(data.Email)
+
+	// Act: request a new link
+	s.Require().NoError(casesteps.SendEmailVerificationStep(ctx, s.TestDIContainer.SendEmailVerificationHandler, data.Email))
+	second, _ := s.TestDIContainer.EmailSender.LastTo(data.Email)
+
+	// Assert: only the latest link works
+	s.Require().Error(casesteps.VerifyEmailStep(ctx, s.TestDIContainer.VerifyEmailHandler, casesteps.VerificationTokenFromEmail(first)))
+	s.Require().NoError(casesteps.VerifyEmailStep(ctx, s.TestDIContainer.VerifyEmailHandler, casesteps.VerificationTokenFromEmail(second)))
+}
+
+func (s *Suite) TestEmailVerification_ResendForUnknownOrVerifiedEmailIsSilent() {
+	ctx := context.Background()
+
+	// Unknown email: no error, nothing sent
+	unknown := testdatagenerators.RandomUserData().Email
+	s.Require().NoError(casesteps.SendEmailVerificationStep(ctx, s.TestDIContainer.SendEmailVerificationHandler, unknown))
+	s.Equal(0, s.TestDIContainer.EmailSender.CountTo(unknown))
+
+	// Verified email: no error, nothing sent
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	msg, _ := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Require().NoError(casesteps.VerifyEmailStep(ctx, s.TestDIContainer.VerifyEmailHandler, casesteps.VerificationTokenFromEmail(msg)))
+
+	s.Require().NoError(casesteps.SendEmailVerificationStep(ctx, s.TestDIContainer.SendEmailVerificationHandler, data.Email))
+	s.Equal(1, s.TestDIContainer.EmailSender.CountTo(data.Email))
+}
+
+func (s *Suite) TestEmailVerification_InvalidToken() {
+	ctx := context.Background()
+
+	err := casesteps.VerifyEmailStep(ctx, s.TestDIContainer.VerifyEmailHandler, "not-a-real-token")
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "invalid or expired")
+}
+
+func (s *Suite) TestEmailVerification_LoginBlockedUntilVerified() {
+	ctx := context.Background()
+
+	// Pre-condition: login handler that requires verified email
+	strictLogin := commands.NewLoginUserHandler(
+		s.TestDIContainer.TransactionManager,
+		s.TestDIContainer.JWTService,
+		bcryptadapter.NewHasher(),
+		timeadapter.NewClock(),
+		commands.PasswordExpiryPolicy{ChangeTokenTTL: 10 * time.Minute},
+		commands.EmailVerificationPolicy{TokenTTL: time.Hour, RequiredForLogin: true},
+		commands.MFAPolicy{ChallengeTTL: 5 * time.Minute},
+		s.TestDIContainer.WebAuthnPolicy,
+		commands.LockoutPolicy{},
+		commands.AntiEnumerationPolicy{},
+		commands.CaptchaPolicy{},
+		commands.LoginRiskPolicy{},
+		s.TestDIContainer.EmailCanonicalization,
+		s.TestDIContainer.PhoneNumbering,
+	)
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act & Assert: refused before verification
+	_, err = casesteps.LoginUserStep(ctx, strictLogin, data.Email, data.Password)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "email-not-verified")
+
+	// Act & Assert: allowed after verification
+	msg, _ := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Require().NoError(casesteps.VerifyEmailStep(ctx, s.TestDIContainer.VerifyEmailHandler, casesteps.VerificationTokenFromEmail(msg)))
+	login, err := casesteps.LoginUserStep(ctx, strictLogin, data.Email, data.Password)
+	s.Require().NoError(err)
+	s.NotEmpty(login.AccessToken)
+}
+
+func (s *Suite) TestEmailVerification_RegisterIssuesNoTokensUntilVerified() {
+	ctx := context.Background()
+
+	// Pre-condition: registration handler that requires verified email
+	strictRegister := commands.NewRegisterUserHandler(
+		s.TestDIContainer.TransactionManager,
+		s.TestDIContainer.JWTService,
+		bcryptadapter.NewHasher(),
+		timeadapter.NewClock(),
+		s.TestDIContainer.EmailSender,
+		commands.EmailVerificationPolicy{TokenTTL: time.Hour, RequiredForLogin: true},
+		commands.AntiEnumerationPolicy{},
+		commands.CaptchaPolicy{},
+		s.TestDIContainer.EmailCanonicalization,
+		s.TestDIContainer.PhoneNumbering,
+	)
+	data := testdatagenerators.RandomUserData()
+
+	// Act
+	reg, err := casesteps.RegisterUserStepData(ctx, strictRegister, data)
+
+	// Assert: the account exists and the email is sent, but no tokens are issued
+	s.Require().NoError(err)
+	s.True(reg.VerificationPending)
+	s.Empty(reg.AccessToken)
+	s.Empty(reg.RefreshToken)
+	s.NotEmpty(reg.User.ID)
+	s.Equal(1, s.TestDIContainer.EmailSender.CountTo(data.Email))
+}
+
+func (s *Suite) TestEmailVerification_RefreshBlockedUntilVerified() {
+	ctx := context.Background()
+
+	// Pre-condition: tokens issued while verification was not required
+	strictRefresh := commands.NewRefreshTokensHandler(
+		s.TestDIContainer.TransactionManager,
+		s.TestDIContainer.JWTService,
+		timeadapter.NewClock(),
+		commands.PasswordExpiryPolicy{ChangeTokenTTL: 10 * time.Minute},
+		commands.EmailVerificationPolicy{TokenTTL: time.Hour, RequiredForLogin: true},
+	)
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(reg.RefreshToken)
+
+	// Act & Assert: refused before verification
+	_, err = strictRefresh.Handle(ctx, commands.RefreshTokensCommand{RefreshToken: reg.RefreshToken})
+	s.Require().Error(err)
+	s.Contains(err.Error(), "email-not-verified")
+
+	// Act & Assert: allowed after verification
+	msg, _ := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Require().NoError(casesteps.VerifyEmailStep(ctx, s.TestDIContainer.VerifyEmailHandler, casesteps.VerificationTokenFromEmail(msg)))
+	refreshed, err := strictRefresh.Handle(ctx, commands.RefreshTokensCommand{RefreshToken: reg.RefreshToken})
+	s.Require().NoError(err)
+	s.NotEmpty(refreshed.AccessToken)
+}
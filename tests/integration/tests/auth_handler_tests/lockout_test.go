@@ -0,0 +1,111 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for login lockout in LoginUserHandler and UnlockUserHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+// failLogins tries n wrong passwords and returns the last error
+func (s *Suite) failLogins(ctx context.Context, email string, n int) error {
+	var err error
+	for i := 0; i < n; i++ {
+		_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, email, "wrong-password")
+		s.Require().Error(err)
+	}
+	return err
+}
+
+func (s *Suite) TestLockout_LocksAfterFailedAttempts() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act: the test config locks after 3 wrong passwords
+	err = s.failLogins(ctx, data.Email, 3)
+
+	// Assert: the third attempt locks the account for the base duration
+	var lockedErr *errs.LockedError
+	s.Require().True(errors.As(err, &lockedErr))
+	s.Greater(lockedErr.RetryAfter, time.Duration(0))
+	s.LessOrEqual(lockedErr.RetryAfter, time.Minute)
+
+	// Even the right password is refused while locked
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().True(errors.As(err, &lockedErr))
+
+	for eventType, count := range map[string]int{"UserLoginFailed": 3, "UserLockedOut": 1} {
+		events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, eventType)
+		s.Require().NoError(err)
+		found := 0
+		for _, event := range events {
+			if event.AggregateID == reg.User.ID.String() {
+				found++
+			}
+		}
+		s.Equal(count, found, eventType)
+	}
+}
+
+func (s *Suite) TestLockout_SuccessResetsAttempts() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act: two wrong passwords, a right one, then two wrong ones again
+	err = s.failLogins(ctx, data.Email, 2)
+	_, loginErr := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(loginErr)
+	err = s.failLogins(ctx, data.Email, 2)
+
+	// Assert: the counter started over, so the account is not locked
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("credentials", validationErr.Field)
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestLockout_AdminUnlock() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	_ = s.failLogins(ctx, data.Email, 3)
+
+	// Act
+	err = casesteps.UnlockUserStep(ctx, s.TestDIContainer.UnlockUserHandler, reg.User.ID)
+
+	// Assert
+	s.Require().NoError(err)
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+	events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, "UserUnlocked")
+	s.Require().NoError(err)
+	s.GreaterOrEqual(len(events), 1)
+}
+
+func (s *Suite) TestLockout_PasswordlessLoginStillWorks() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	_ = s.failLogins(ctx, data.Email, 3)
+
+	// Act: the lock only stops password guessing, the owner can still use a sign-in link
+	token := s.requestMagicLink(ctx, data.Email)
+	result, err := casesteps.RedeemMagicLinkStep(ctx, s.TestDIContainer.RedeemMagicLinkHandler, token)
+
+	// Assert
+	s.Require().NoError(err)
+	s.NotEmpty(result.AccessToken)
+}
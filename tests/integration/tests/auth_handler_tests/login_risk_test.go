@@ -0,0 +1,174 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for new-device and unusual-location login detection (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"time"
+
+	bcryptadapter "github.com/Vi-72/quest-auth/internal/adapters/out/bcrypt"
+	emailadapter "github.com/Vi-72/quest-auth/internal/adapters/out/email"
+	timeadapter "github.com/Vi-72/quest-auth/internal/adapters/out/time"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+const (
+	testDesktopUA = "Mozilla/5.0 (X11; Linux x86_64) Firefox/130.0"
+	testPhoneUA   = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) Safari/604.1"
+)
+
+// fakeGeoLocator resolves addresses from a fixed table
+type fakeGeoLocator map[string]auth.Location
+
+func (l fakeGeoLocator) Locate(ip string) (auth.Location, error) {
+	return l[ip], nil
+}
+
+// riskLoginHandler builds a login handler with the given login risk policy
+func (s *Suite) riskLoginHandler(policy commands.LoginRiskPolicy) *commands.LoginUserHandler {
+	return commands.NewLoginUserHandler(
+		s.TestDIContainer.TransactionManager,
+		s.TestDIContainer.JWTService,
+		bcryptadapter.NewHasher(),
+		timeadapter.NewClock(),
+		commands.PasswordExpiryPolicy{ChangeTokenTTL: 10 * time.Minute},
+		commands.EmailVerificationPolicy{TokenTTL: time.Hour},
+		commands.MFAPolicy{ChallengeTTL: 5 * time.Minute},
+		s.TestDIContainer.WebAuthnPolicy,
+		commands.LockoutPolicy{},
+		commands.AntiEnumerationPolicy{},
+		commands.CaptchaPolicy{},
+		policy,
+		s.TestDIContainer.EmailCanonicalization,
+		s.TestDIContainer.PhoneNumbering,
+	)
+}
+
+func (s *Suite) loginFrom(
+	handler *commands.LoginUserHandler,
+	data testdatagenerators.UserTestData,
+	userAgent, ip string,
+) commands.LoginUserResult {
+	result, err := handler.Handle(context.Background(), commands.LoginUserCommand{
+		Identifier: data.Email,
+		Password:   data.Password,
+		UserAgent:  userAgent,
+		ClientIP:   ip,
+	})
+	s.Require().NoError(err)
+	return result
+}
+
+func (s *Suite) TestLoginRisk_NewDeviceIsReported() {
+	ctx := context.Background()
+	login := s.TestDIContainer.LoginUserHandler
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Pre-condition: the first login has nothing to compare with
+	s.loginFrom(login, data, testDesktopUA, testClientIP)
+	sent := s.TestDIContainer.EmailSender.CountTo(data.Email)
+
+	// Act: login from another device
+	result := s.loginFrom(login, data, testPhoneUA, testClientIP)
+
+	// Assert: the login succeeds, the user is told about it
+	s.NotEmpty(result.AccessToken)
+	s.Equal(sent+1, s.TestDIContainer.EmailSender.CountTo(data.Email))
+	msg, ok := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Require().True(ok)
+	s.Equal("New sign-in to your account", msg.Subject)
+	s.Contains(msg.Body, "from a new device")
+	s.Contains(msg.Body, testPhoneUA)
+
+	events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, "SuspiciousLoginDetected")
+	s.Require().NoError(err)
+	s.Len(events, 1)
+
+	// Known devices are not reported again
+	s.loginFrom(login, data, testPhoneUA, testClientIP)
+	s.loginFrom(login, data, testDesktopUA, testClientIP)
+	s.Equal(sent+1, s.TestDIContainer.EmailSender.CountTo(data.Email))
+}
+
+func (s *Suite) TestLoginRisk_UnusualLocationIsReported() {
+	ctx := context.Background()
+	login := s.riskLoginHandler(commands.LoginRiskPolicy{
+		Locator: fakeGeoLocator{
+			"198.51.100.1": {Country: "DE", City: "Berlin"},
+			"198.51.100.2": {Country: "DE", City: "Munich"},
+			"203.0.113.9":  {Country: "BR", City: "Sao Paulo"},
+		},
+		Notifier:    emailadapter.NewSecurityNotifier(s.TestDIContainer.EmailSender),
+		HistorySize: 20,
+	})
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Pre-condition: logins from one country
+	s.loginFrom(login, data, testDesktopUA, "198.51.100.1")
+	s.loginFrom(login, data, testDesktopUA, "198.51.100.2")
+	sent := s.TestDIContainer.EmailSender.CountTo(data.Email)
+
+	// Act: the same device from another country
+	s.loginFrom(login, data, testDesktopUA, "203.0.113.9")
+
+	// Assert
+	s.Equal(sent+1, s.TestDIContainer.EmailSender.CountTo(data.Email))
+	msg, _ := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Contains(msg.Body, "from an unusual location")
+	s.Contains(msg.Body, "Sao Paulo, BR")
+}
+
+func (s *Suite) TestLoginRisk_StepUpRequiresEmailConfirmation() {
+	ctx := context.Background()
+	login := s.riskLoginHandler(commands.LoginRiskPolicy{
+		Notifier:    emailadapter.NewSecurityNotifier(s.TestDIContainer.EmailSender),
+		HistorySize: 20,
+		StepUp:      true,
+		StepUpLink: commands.MagicLinkPolicy{
+			TokenTTL:       10 * time.Minute,
+			LinkURL:        "http://localhost:3000/magic-link",
+			ResendInterval: time.Minute,
+		},
+	})
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.loginFrom(login, data, testDesktopUA, testClientIP)
+
+	// Act: the right password from a new device
+	result := s.loginFrom(login, data, testPhoneUA, testClientIP)
+
+	// Assert: no tokens until the login is confirmed by the link from the email
+	s.True(result.LoginVerificationRequired)
+	s.Empty(result.AccessToken)
+	msg, ok := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Require().True(ok)
+	s.Equal("Confirm the sign-in to your account", msg.Subject)
+	token := casesteps.VerificationTokenFromEmail(msg)
+	s.Require().NotEmpty(token)
+
+	// An unconfirmed device stays unknown; the link was just sent, so no new email
+	sent := s.TestDIContainer.EmailSender.CountTo(data.Email)
+	s.True(s.loginFrom(login, data, testPhoneUA, testClientIP).LoginVerificationRequired)
+	s.Equal(sent, s.TestDIContainer.EmailSender.CountTo(data.Email))
+
+	// Act: confirm from the new device
+	confirmed, err := s.TestDIContainer.RedeemMagicLinkHandler.Handle(ctx, commands.RedeemMagicLinkCommand{
+		Token:     token,
+		UserAgent: testPhoneUA,
+		ClientIP:  testClientIP,
+	})
+
+	// Assert: the link completes the login and the device becomes known
+	s.Require().NoError(err)
+	s.NotEmpty(confirmed.AccessToken)
+	s.NotEmpty(s.loginFrom(login, data, testPhoneUA, testClientIP).AccessToken)
+}
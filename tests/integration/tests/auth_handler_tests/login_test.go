@@ -5,7 +5,6 @@ package auth_handler_tests
 
 import (
 	"context"
-	"strings"
 
 	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
 	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
@@ -31,7 +30,7 @@ func (s *Suite) TestLoginHandler_Success() {
 	s.Assert().Greater(int(loginRes.ExpiresIn), 0)
 
 	s.Assert().Equal(regRes.User.ID, loginRes.User.ID)
-	s.Assert().Equal(strings.ToLower(data.Email), loginRes.User.Email)
+	s.Assert().Equal(data.Email, loginRes.User.Email)
 	s.Assert().Equal(data.Name, loginRes.User.Name)
 	s.Assert().Equal(data.Phone, loginRes.User.Phone)
 }
@@ -68,3 +67,19 @@ func (s *Suite) TestLoginHandler_Validation_WrongPassword() {
 	// Assert
 	s.Require().Error(err)
 }
+
+func (s *Suite) TestLoginHandler_EmailVariant() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	data.Email = "Jane.Roe+signup@gmail.com"
+	regRes, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act: log in with another spelling of the same mailbox
+	loginRes, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, "janeroe@googlemail.com", data.Password)
+
+	// Assert: the user is found and the address is shown as registered
+	s.Require().NoError(err)
+	s.Equal(regRes.User.ID, loginRes.User.ID)
+	s.Equal(data.Email, loginRes.User.Email)
+}
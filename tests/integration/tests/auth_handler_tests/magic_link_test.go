@@ -0,0 +1,138 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for RequestMagicLinkHandler and RedeemMagicLinkHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+// requestMagicLink sends a sign-in link and returns the token from the email
+func (s *Suite) requestMagicLink(ctx context.Context, email string) string {
+	s.Require().NoError(casesteps.RequestMagicLinkStep(ctx, s.TestDIContainer.RequestMagicLinkHandler, email))
+	msg, ok := s.TestDIContainer.EmailSender.LastTo(email)
+	s.Require().True(ok)
+	s.Require().Contains(msg.Subject, "sign-in link")
+	token := casesteps.VerificationTokenFromEmail(msg)
+	s.Require().NotEmpty(token)
+	return token
+}
+
+func (s *Suite) TestMagicLink_LoginWithLink() {
+	ctx := context.Background()
+
+	// Pre-condition: registered user who never confirmed the email
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	token := s.requestMagicLink(ctx, data.Email)
+
+	// Act
+	result, err := casesteps.RedeemMagicLinkStep(ctx, s.TestDIContainer.RedeemMagicLinkHandler, token)
+
+	// Assert: logged in, and the link proved control of the mailbox
+	s.Require().NoError(err)
+	s.NotEmpty(result.AccessToken)
+	s.Equal(reg.User.ID, result.User.ID)
+	s.True(result.User.EmailVerified)
+
+	for _, eventType := range []string{"UserEmailVerified", "user.login"} {
+		events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, eventType)
+		s.Require().NoError(err)
+		s.GreaterOrEqual(len(events), 1, eventType)
+	}
+}
+
+func (s *Suite) TestMagicLink_IsSingleUse() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	token := s.requestMagicLink(ctx, data.Email)
+	_, err = casesteps.RedeemMagicLinkStep(ctx, s.TestDIContainer.RedeemMagicLinkHandler, token)
+	s.Require().NoError(err)
+
+	// Act
+	_, err = casesteps.RedeemMagicLinkStep(ctx, s.TestDIContainer.RedeemMagicLinkHandler, token)
+
+	// Assert
+	s.Require().Error(err)
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("credentials", validationErr.Field)
+}
+
+func (s *Suite) TestMagicLink_UnknownEmailSendsNothing() {
+	ctx := context.Background()
+	email := testdatagenerators.RandomUserData().Email
+
+	// Act
+	err := casesteps.RequestMagicLinkStep(ctx, s.TestDIContainer.RequestMagicLinkHandler, email)
+
+	// Assert: same result as for a registered email, but no email is sent
+	s.Require().NoError(err)
+	s.Equal(0, s.TestDIContainer.EmailSender.CountTo(email))
+}
+
+func (s *Suite) TestMagicLink_ResendIsThrottled() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	token := s.requestMagicLink(ctx, data.Email)
+	sent := s.TestDIContainer.EmailSender.CountTo(data.Email)
+
+	// Act: request again right away
+	err = casesteps.RequestMagicLinkStep(ctx, s.TestDIContainer.RequestMagicLinkHandler, data.Email)
+
+	// Assert: no error that would reveal the account, no new email, the first link still works
+	s.Require().NoError(err)
+	s.Equal(sent, s.TestDIContainer.EmailSender.CountTo(data.Email))
+	_, err = casesteps.RedeemMagicLinkStep(ctx, s.TestDIContainer.RedeemMagicLinkHandler, token)
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestMagicLink_RequiresSecondFactor() {
+	ctx := context.Background()
+
+	// Pre-condition: user with MFA enabled
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	secret, _, err := casesteps.EnableMFAStep(ctx,
+		s.TestDIContainer.EnrollTOTPHandler, s.TestDIContainer.ConfirmTOTPHandler, reg.User.ID)
+	s.Require().NoError(err)
+	token := s.requestMagicLink(ctx, data.Email)
+
+	// Act: the link replaces the password only
+	login, err := casesteps.RedeemMagicLinkStep(ctx, s.TestDIContainer.RedeemMagicLinkHandler, token)
+	s.Require().NoError(err)
+	s.Require().True(login.MFARequired)
+	s.Empty(login.AccessToken)
+
+	result, err := s.TestDIContainer.VerifyMFAHandler.Handle(ctx, commands.VerifyMFACommand{
+		MFAToken: login.MFAToken,
+		Code:     casesteps.TOTPCode(secret, 1),
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.NotEmpty(result.AccessToken)
+}
+
+func (s *Suite) TestMagicLink_InvalidToken() {
+	ctx := context.Background()
+
+	_, err := casesteps.RedeemMagicLinkStep(ctx, s.TestDIContainer.RedeemMagicLinkHandler, "not-a-real-token")
+
+	s.Require().Error(err)
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("credentials", validationErr.Field)
+}
@@ -0,0 +1,156 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for TOTP enrollment and the second login step (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestMFA_LoginRequiresSecondFactor() {
+	ctx := context.Background()
+
+	// Pre-condition: user with MFA enabled
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	secret, recoveryCodes, err := casesteps.EnableMFAStep(ctx,
+		s.TestDIContainer.EnrollTOTPHandler, s.TestDIContainer.ConfirmTOTPHandler, reg.User.ID)
+	s.Require().NoError(err)
+	s.Len(recoveryCodes, auth.RecoveryCodeCount)
+
+	// Act: password step
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+
+	// Assert: challenge instead of tokens
+	s.Require().NoError(err)
+	s.True(login.MFARequired)
+	s.NotEmpty(login.MFAToken)
+	s.Empty(login.AccessToken)
+	s.Empty(login.RefreshToken)
+
+	// Act: second step
+	result, err := casesteps.VerifyMFAStep(ctx, s.TestDIContainer.VerifyMFAHandler,
+		login.MFAToken, casesteps.TOTPCode(secret, 1), "")
+
+	// Assert: login completed, events recorded
+	s.Require().NoError(err)
+	s.NotEmpty(result.AccessToken)
+	s.Equal(reg.User.ID, result.User.ID)
+
+	for _, eventType := range []string{"UserMFAEnabled", "UserMFAVerified", "user.login"} {
+		events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, eventType)
+		s.Require().NoError(err)
+		s.GreaterOrEqual(len(events), 1, eventType)
+	}
+}
+
+func (s *Suite) TestMFA_TokenIsSingleUse() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	_, recoveryCodes, err := casesteps.EnableMFAStep(ctx,
+		s.TestDIContainer.EnrollTOTPHandler, s.TestDIContainer.ConfirmTOTPHandler, reg.User.ID)
+	s.Require().NoError(err)
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+
+	_, err = casesteps.VerifyMFAStep(ctx, s.TestDIContainer.VerifyMFAHandler, login.MFAToken, "", recoveryCodes[0])
+	s.Require().NoError(err)
+
+	// Act: the same MFA token again
+	_, err = casesteps.VerifyMFAStep(ctx, s.TestDIContainer.VerifyMFAHandler, login.MFAToken, "", recoveryCodes[1])
+
+	// Assert
+	s.Require().Error(err)
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("credentials", validationErr.Field)
+}
+
+func (s *Suite) TestMFA_RecoveryCodeIsSingleUse() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	_, recoveryCodes, err := casesteps.EnableMFAStep(ctx,
+		s.TestDIContainer.EnrollTOTPHandler, s.TestDIContainer.ConfirmTOTPHandler, reg.User.ID)
+	s.Require().NoError(err)
+
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+	_, err = casesteps.VerifyMFAStep(ctx, s.TestDIContainer.VerifyMFAHandler, login.MFAToken, "", recoveryCodes[0])
+	s.Require().NoError(err)
+
+	// Act: new login with the already used recovery code
+	login, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+	_, err = casesteps.VerifyMFAStep(ctx, s.TestDIContainer.VerifyMFAHandler, login.MFAToken, "", recoveryCodes[0])
+
+	// Assert
+	s.Require().Error(err)
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("recovery_code", validationErr.Field)
+}
+
+func (s *Suite) TestMFA_AttemptsAreLimited() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	secret, _, err := casesteps.EnableMFAStep(ctx,
+		s.TestDIContainer.EnrollTOTPHandler, s.TestDIContainer.ConfirmTOTPHandler, reg.User.ID)
+	s.Require().NoError(err)
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+
+	// Act: exhaust attempts (3 in test config) with wrong codes
+	for i := 0; i < 3; i++ {
+		_, err = casesteps.VerifyMFAStep(ctx, s.TestDIContainer.VerifyMFAHandler, login.MFAToken, "000000", "")
+		s.Require().Error(err)
+	}
+
+	// Assert: even the right code is refused now
+	_, err = casesteps.VerifyMFAStep(ctx, s.TestDIContainer.VerifyMFAHandler,
+		login.MFAToken, casesteps.TOTPCode(secret, 1), "")
+	s.Require().Error(err)
+	s.Contains(err.Error(), "too many invalid attempts")
+}
+
+func (s *Suite) TestMFA_EnrollWhenAlreadyEnabled() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+	_, _, err = casesteps.EnableMFAStep(ctx,
+		s.TestDIContainer.EnrollTOTPHandler, s.TestDIContainer.ConfirmTOTPHandler, reg.User.ID)
+	s.Require().NoError(err)
+
+	_, err = casesteps.EnrollTOTPStep(ctx, s.TestDIContainer.EnrollTOTPHandler, reg.User.ID)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), auth.ErrMFAAlreadyEnabled.Error())
+}
+
+func (s *Suite) TestMFA_ConfirmWithWrongCode() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+	enrollment, err := casesteps.EnrollTOTPStep(ctx, s.TestDIContainer.EnrollTOTPHandler, reg.User.ID)
+	s.Require().NoError(err)
+	s.Contains(enrollment.URI, "otpauth://totp/")
+
+	_, err = casesteps.ConfirmTOTPStep(ctx, s.TestDIContainer.ConfirmTOTPHandler, reg.User.ID, casesteps.TOTPCode(enrollment.Secret, 5))
+
+	s.Require().Error(err)
+	user, err := s.TestDIContainer.UserRepository.GetByID(reg.User.ID)
+	s.Require().NoError(err)
+	s.False(user.IsMFAEnabled())
+}
@@ -0,0 +1,123 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for forced password change and ChangeExpiredPasswordHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestPasswordExpiry_ForcedChangeFlow() {
+	ctx := context.Background()
+
+	// Pre-condition: registered user forced to change password
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.Require().NoError(casesteps.RequirePasswordChangeStep(ctx, s.TestDIContainer.RequirePasswordChangeHandler, reg.User.ID))
+
+	// Act: login returns only a password change token
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+	s.True(login.PasswordExpired)
+	s.NotEmpty(login.PasswordChangeToken)
+	s.Empty(login.AccessToken)
+	s.Empty(login.RefreshToken)
+
+	// Act: change expired password
+	result, err := casesteps.ChangeExpiredPasswordStep(ctx, s.TestDIContainer.ChangeExpiredPasswordHandler, login.PasswordChangeToken, "brandnewpassword1")
+
+	// Assert: full session issued, regular login works with new password
+	s.Require().NoError(err)
+	s.NotEmpty(result.AccessToken)
+	s.NotEmpty(result.RefreshToken)
+	s.Equal(reg.User.ID, result.User.ID)
+
+	relogin, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, "brandnewpassword1")
+	s.Require().NoError(err)
+	s.False(relogin.PasswordExpired)
+	s.NotEmpty(relogin.AccessToken)
+}
+
+func (s *Suite) TestPasswordExpiry_RefreshRefusedAfterForcedChange() {
+	ctx := context.Background()
+
+	// Pre-condition: a live session, then an administrator forces a password change
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(reg.RefreshToken)
+	s.Require().NoError(casesteps.RequirePasswordChangeStep(ctx, s.TestDIContainer.RequirePasswordChangeHandler, reg.User.ID))
+
+	// Act
+	refreshed, err := s.TestDIContainer.RefreshTokensHandler.Handle(ctx, commands.RefreshTokensCommand{RefreshToken: reg.RefreshToken})
+
+	// Assert: no new session, only a password change token
+	s.Require().NoError(err)
+	s.True(refreshed.PasswordExpired)
+	s.NotEmpty(refreshed.PasswordChangeToken)
+	s.Empty(refreshed.AccessToken)
+	s.Empty(refreshed.RefreshToken)
+}
+
+func (s *Suite) TestPasswordExpiry_TokenCannotBeReused() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.Require().NoError(casesteps.RequirePasswordChangeStep(ctx, s.TestDIContainer.RequirePasswordChangeHandler, reg.User.ID))
+
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+	_, err = casesteps.ChangeExpiredPasswordStep(ctx, s.TestDIContainer.ChangeExpiredPasswordHandler, login.PasswordChangeToken, "brandnewpassword1")
+	s.Require().NoError(err)
+
+	// Act: reuse the same token
+	_, err = casesteps.ChangeExpiredPasswordStep(ctx, s.TestDIContainer.ChangeExpiredPasswordHandler, login.PasswordChangeToken, "anothernewpassword2")
+
+	// Assert
+	s.Require().Error(err)
+}
+
+func (s *Suite) TestPasswordExpiry_RejectsCurrentPassword() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.Require().NoError(casesteps.RequirePasswordChangeStep(ctx, s.TestDIContainer.RequirePasswordChangeHandler, reg.User.ID))
+
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+
+	// Act: "change" to the same password
+	_, err = casesteps.ChangeExpiredPasswordStep(ctx, s.TestDIContainer.ChangeExpiredPasswordHandler, login.PasswordChangeToken, data.Password)
+
+	// Assert
+	s.Require().Error(err)
+	s.Contains(err.Error(), "recently used")
+}
+
+func (s *Suite) TestPasswordExpiry_AccessTokenIsNotAChangeToken() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	_, err = casesteps.ChangeExpiredPasswordStep(ctx, s.TestDIContainer.ChangeExpiredPasswordHandler, reg.AccessToken, "brandnewpassword1")
+	s.Require().Error(err)
+}
+
+func (s *Suite) TestRequirePasswordChange_UserNotFound() {
+	ctx := context.Background()
+
+	err := casesteps.RequirePasswordChangeStep(ctx, s.TestDIContainer.RequirePasswordChangeHandler, uuid.New())
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "not found")
+}
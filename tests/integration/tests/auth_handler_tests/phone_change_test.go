@@ -0,0 +1,118 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for RequestPhoneChangeHandler and ConfirmPhoneChangeHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestPhoneChange_RequestAndConfirm() {
+	ctx := context.Background()
+
+	// Pre-condition: registered user
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	newPhone := testdatagenerators.RandomPhone()
+
+	// Act: request change
+	_, err = casesteps.RequestPhoneChangeStep(ctx, s.TestDIContainer.RequestPhoneChangeHandler, reg.User.ID, newPhone)
+	s.Require().NoError(err)
+
+	// Assert: code goes to the new number, phone is not changed yet
+	msg, ok := s.TestDIContainer.SMSSender.LastTo(newPhone)
+	s.Require().True(ok)
+	user, err := s.TestDIContainer.UserRepository.GetByID(reg.User.ID)
+	s.Require().NoError(err)
+	s.Equal(data.Phone, user.Phone.String())
+
+	// Act: confirm
+	result, err := casesteps.ConfirmPhoneChangeStep(ctx, s.TestDIContainer.ConfirmPhoneChangeHandler, reg.User.ID, casesteps.VerificationCodeFromSMS(msg))
+
+	// Assert: phone changed and verified, events recorded
+	s.Require().NoError(err)
+	s.Equal(newPhone, result.User.Phone)
+	s.True(result.User.PhoneVerified)
+
+	user, err = s.TestDIContainer.UserRepository.GetByID(reg.User.ID)
+	s.Require().NoError(err)
+	s.Equal(newPhone, user.Phone.String())
+	s.True(user.IsPhoneVerified())
+
+	for _, eventType := range []string{"UserPhoneChanged", "UserPhoneVerified"} {
+		events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, eventType)
+		s.Require().NoError(err)
+		s.GreaterOrEqual(len(events), 1, eventType)
+	}
+}
+
+func (s *Suite) TestPhoneChange_PhoneTaken() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	other := testdatagenerators.RandomUserData()
+	_, err = casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, other)
+	s.Require().NoError(err)
+
+	_, err = casesteps.RequestPhoneChangeStep(ctx, s.TestDIContainer.RequestPhoneChangeHandler, reg.User.ID, other.Phone)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "phone already exists")
+	s.Equal(0, s.TestDIContainer.SMSSender.CountTo(other.Phone))
+}
+
+func (s *Suite) TestPhoneChange_SamePhone() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	_, err = casesteps.RequestPhoneChangeStep(ctx, s.TestDIContainer.RequestPhoneChangeHandler, reg.User.ID, data.Phone)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "must differ")
+}
+
+func (s *Suite) TestPhoneChange_ResendIsThrottled() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	_, err = casesteps.RequestPhoneChangeStep(ctx, s.TestDIContainer.RequestPhoneChangeHandler, reg.User.ID, testdatagenerators.RandomPhone())
+	s.Require().NoError(err)
+
+	_, err = casesteps.RequestPhoneChangeStep(ctx, s.TestDIContainer.RequestPhoneChangeHandler, reg.User.ID, testdatagenerators.RandomPhone())
+
+	var tooMany *errs.TooManyRequestsError
+	s.Require().True(errors.As(err, &tooMany))
+}
+
+func (s *Suite) TestPhoneChange_WrongCodeKeepsPhone() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	newPhone := testdatagenerators.RandomPhone()
+	_, err = casesteps.RequestPhoneChangeStep(ctx, s.TestDIContainer.RequestPhoneChangeHandler, reg.User.ID, newPhone)
+	s.Require().NoError(err)
+	msg, _ := s.TestDIContainer.SMSSender.LastTo(newPhone)
+	wrong := "000000"
+	if casesteps.VerificationCodeFromSMS(msg) == wrong {
+		wrong = "111111"
+	}
+
+	_, err = casesteps.ConfirmPhoneChangeStep(ctx, s.TestDIContainer.ConfirmPhoneChangeHandler, reg.User.ID, wrong)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "code is invalid")
+	user, err := s.TestDIContainer.UserRepository.GetByID(reg.User.ID)
+	s.Require().NoError(err)
+	s.Equal(data.Phone, user.Phone.String())
+}
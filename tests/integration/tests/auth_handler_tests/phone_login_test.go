@@ -0,0 +1,178 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for login by phone: phone as identifier, RequestPhoneLoginCodeHandler and LoginWithPhoneCodeHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+
+	"github.com/google/uuid"
+)
+
+// verifyPhone confirms the user's phone with the code from the SMS
+func (s *Suite) verifyPhone(ctx context.Context, userID uuid.UUID, phone string) {
+	_, err := casesteps.SendPhoneVerificationStep(ctx, s.TestDIContainer.SendPhoneVerificationHandler, userID)
+	s.Require().NoError(err)
+	msg, ok := s.TestDIContainer.SMSSender.LastTo(phone)
+	s.Require().True(ok)
+	s.Require().NoError(casesteps.VerifyPhoneStep(ctx, s.TestDIContainer.VerifyPhoneHandler, userID,
+		casesteps.VerificationCodeFromSMS(msg)))
+}
+
+// requestPhoneLoginCode sends a sign-in code and returns it from the SMS
+func (s *Suite) requestPhoneLoginCode(ctx context.Context, phone string) string {
+	sent := s.TestDIContainer.SMSSender.CountTo(phone)
+	s.Require().NoError(casesteps.RequestPhoneLoginCodeStep(ctx, s.TestDIContainer.RequestPhoneLoginCodeHandler, phone))
+	s.Require().Equal(sent+1, s.TestDIContainer.SMSSender.CountTo(phone))
+	msg, _ := s.TestDIContainer.SMSSender.LastTo(phone)
+	code := casesteps.VerificationCodeFromSMS(msg)
+	s.Require().Len(code, 6)
+	return code
+}
+
+func (s *Suite) TestPhoneLogin_PhoneAsIdentifier() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act: the password login accepts the phone instead of the email
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Phone, data.Password)
+
+	// Assert
+	s.Require().NoError(err)
+	s.NotEmpty(login.AccessToken)
+	s.Equal(reg.User.ID, login.User.ID)
+}
+
+func (s *Suite) TestPhoneLogin_NationalFormat() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	data.Phone = "+12015550177"
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.verifyPhone(ctx, reg.User.ID, data.Phone)
+
+	// Act & Assert: the default region (US) lets users type the number without the country code
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, "(201) 555-0177", data.Password)
+	s.Require().NoError(err)
+	s.Equal(reg.User.ID, login.User.ID)
+
+	s.Require().NoError(casesteps.RequestPhoneLoginCodeStep(ctx, s.TestDIContainer.RequestPhoneLoginCodeHandler, "201-555-0177"))
+	msg, ok := s.TestDIContainer.SMSSender.LastTo(data.Phone)
+	s.Require().True(ok, "the code is sent to the E.164 number")
+	login, err = casesteps.LoginWithPhoneCodeStep(ctx, s.TestDIContainer.LoginWithPhoneCodeHandler,
+		"201 555 0177", casesteps.VerificationCodeFromSMS(msg))
+	s.Require().NoError(err)
+	s.Equal(reg.User.ID, login.User.ID)
+}
+
+func (s *Suite) TestPhoneLogin_InvalidIdentifier() {
+	ctx := context.Background()
+
+	_, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, "12345", "somepassword")
+
+	s.Require().Error(err)
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("identifier", validationErr.Field)
+}
+
+func (s *Suite) TestPhoneLogin_WithSMSCode() {
+	ctx := context.Background()
+
+	// Pre-condition: user with a verified phone
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.verifyPhone(ctx, reg.User.ID, data.Phone)
+	code := s.requestPhoneLoginCode(ctx, data.Phone)
+
+	// Act
+	login, err := casesteps.LoginWithPhoneCodeStep(ctx, s.TestDIContainer.LoginWithPhoneCodeHandler, data.Phone, code)
+
+	// Assert
+	s.Require().NoError(err)
+	s.NotEmpty(login.AccessToken)
+	s.Equal(reg.User.ID, login.User.ID)
+
+	// The code works once
+	_, err = casesteps.LoginWithPhoneCodeStep(ctx, s.TestDIContainer.LoginWithPhoneCodeHandler, data.Phone, code)
+	s.Require().Error(err)
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("credentials", validationErr.Field)
+}
+
+func (s *Suite) TestPhoneLogin_UnverifiedPhoneSendsNothing() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act
+	err = casesteps.RequestPhoneLoginCodeStep(ctx, s.TestDIContainer.RequestPhoneLoginCodeHandler, data.Phone)
+
+	// Assert: same result as for a verified phone, but no SMS is sent
+	s.Require().NoError(err)
+	s.Equal(0, s.TestDIContainer.SMSSender.CountTo(data.Phone))
+}
+
+func (s *Suite) TestPhoneLogin_WrongCode() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.verifyPhone(ctx, reg.User.ID, data.Phone)
+	code := s.requestPhoneLoginCode(ctx, data.Phone)
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+
+	// Act
+	_, err = casesteps.LoginWithPhoneCodeStep(ctx, s.TestDIContainer.LoginWithPhoneCodeHandler, data.Phone, wrong)
+
+	// Assert: a wrong code looks like wrong credentials, the right one still works
+	s.Require().Error(err)
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("credentials", validationErr.Field)
+	_, err = casesteps.LoginWithPhoneCodeStep(ctx, s.TestDIContainer.LoginWithPhoneCodeHandler, data.Phone, code)
+	s.Require().NoError(err)
+}
+
+func (s *Suite) TestPhoneLogin_RequiresSecondFactor() {
+	ctx := context.Background()
+
+	// Pre-condition: user with a verified phone and MFA enabled
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.verifyPhone(ctx, reg.User.ID, data.Phone)
+	secret, _, err := casesteps.EnableMFAStep(ctx,
+		s.TestDIContainer.EnrollTOTPHandler, s.TestDIContainer.ConfirmTOTPHandler, reg.User.ID)
+	s.Require().NoError(err)
+	code := s.requestPhoneLoginCode(ctx, data.Phone)
+
+	// Act: the SMS code replaces the password only
+	login, err := casesteps.LoginWithPhoneCodeStep(ctx, s.TestDIContainer.LoginWithPhoneCodeHandler, data.Phone, code)
+	s.Require().NoError(err)
+	s.Require().True(login.MFARequired)
+	s.Empty(login.AccessToken)
+
+	result, err := s.TestDIContainer.VerifyMFAHandler.Handle(ctx, commands.VerifyMFACommand{
+		MFAToken: login.MFAToken,
+		Code:     casesteps.TOTPCode(secret, 1),
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.NotEmpty(result.AccessToken)
+}
@@ -0,0 +1,115 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for SendPhoneVerificationHandler and VerifyPhoneHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestPhoneVerification_SendAndVerify() {
+	ctx := context.Background()
+
+	// Pre-condition: registered user with unverified phone
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.False(reg.User.PhoneVerified)
+
+	result, err := casesteps.SendPhoneVerificationStep(ctx, s.TestDIContainer.SendPhoneVerificationHandler, reg.User.ID)
+	s.Require().NoError(err)
+	s.Equal(5*time.Minute, result.ExpiresIn)
+	s.Equal(time.Minute, result.ResendAfter)
+
+	msg, ok := s.TestDIContainer.SMSSender.LastTo(data.Phone)
+	s.Require().True(ok)
+	code := casesteps.VerificationCodeFromSMS(msg)
+	s.Require().Len(code, 6)
+
+	// Act
+	err = casesteps.VerifyPhoneStep(ctx, s.TestDIContainer.VerifyPhoneHandler, reg.User.ID, code)
+
+	// Assert: phone is verified and exposed in tokens
+	s.Require().NoError(err)
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+	s.True(login.User.PhoneVerified)
+
+	claims, err := s.TestDIContainer.JWTService.ValidateAccessToken(login.AccessToken)
+	s.Require().NoError(err)
+	s.True(claims.PhoneVerified)
+
+	// Already verified phone cannot request a new code
+	_, err = casesteps.SendPhoneVerificationStep(ctx, s.TestDIContainer.SendPhoneVerificationHandler, reg.User.ID)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "already verified")
+}
+
+func (s *Suite) TestPhoneVerification_ResendIsThrottled() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	_, err = casesteps.SendPhoneVerificationStep(ctx, s.TestDIContainer.SendPhoneVerificationHandler, reg.User.ID)
+	s.Require().NoError(err)
+
+	// Act: immediate resend
+	_, err = casesteps.SendPhoneVerificationStep(ctx, s.TestDIContainer.SendPhoneVerificationHandler, reg.User.ID)
+
+	// Assert
+	var tooMany *errs.TooManyRequestsError
+	s.Require().True(errors.As(err, &tooMany))
+	s.Greater(tooMany.RetryAfter, time.Duration(0))
+	s.LessOrEqual(tooMany.RetryAfter, time.Minute)
+	s.Equal(1, s.TestDIContainer.SMSSender.CountTo(data.Phone))
+}
+
+func (s *Suite) TestPhoneVerification_AttemptsLimit() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	_, err = casesteps.SendPhoneVerificationStep(ctx, s.TestDIContainer.SendPhoneVerificationHandler, reg.User.ID)
+	s.Require().NoError(err)
+	msg, _ := s.TestDIContainer.SMSSender.LastTo(data.Phone)
+	code := casesteps.VerificationCodeFromSMS(msg)
+
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+
+	// Act: exhaust attempts (test config allows 3)
+	for i := 0; i < 3; i++ {
+		err = casesteps.VerifyPhoneStep(ctx, s.TestDIContainer.VerifyPhoneHandler, reg.User.ID, wrong)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "code is invalid")
+	}
+
+	// Assert: failed attempts were persisted, the correct code is no longer accepted
+	err = casesteps.VerifyPhoneStep(ctx, s.TestDIContainer.VerifyPhoneHandler, reg.User.ID, code)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "too many invalid attempts")
+
+	user, err := s.TestDIContainer.UserRepository.GetByID(reg.User.ID)
+	s.Require().NoError(err)
+	s.False(user.IsPhoneVerified())
+}
+
+func (s *Suite) TestPhoneVerification_WithoutCode() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	err = casesteps.VerifyPhoneStep(ctx, s.TestDIContainer.VerifyPhoneHandler, reg.User.ID, "123456")
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "invalid or expired")
+}
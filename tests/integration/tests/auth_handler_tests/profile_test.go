@@ -0,0 +1,230 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for GetMeHandler and UpdateProfileHandler (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/queries"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestUpdateProfile_ChangesName() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act
+	name := "  Renamed User "
+	result, err := s.TestDIContainer.UpdateProfileHandler.Handle(ctx, commands.UpdateProfileCommand{
+		UserID: reg.User.ID,
+		Name:   &name,
+	})
+
+	// Assert: the name is trimmed, stored and announced
+	s.Require().NoError(err)
+	s.Equal("Renamed User", result.User.Name)
+	s.Equal(reg.User.Email, result.User.Email)
+
+	user, err := s.TestDIContainer.GetMeHandler.Handle(ctx, queries.GetMeQuery{UserID: reg.User.ID})
+	s.Require().NoError(err)
+	s.Equal("Renamed User", user.Name)
+
+	events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, "UserNameChanged")
+	s.Require().NoError(err)
+	found := false
+	for _, event := range events {
+		if event.AggregateID == reg.User.ID.String() {
+			found = true
+		}
+	}
+	s.True(found, "expected UserNameChanged event")
+}
+
+func (s *Suite) TestUpdateProfile_EmptyBodyKeepsProfile() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	result, err := s.TestDIContainer.UpdateProfileHandler.Handle(ctx, commands.UpdateProfileCommand{UserID: reg.User.ID})
+
+	s.Require().NoError(err)
+	s.Equal(data.Name, result.User.Name)
+}
+
+func (s *Suite) TestUpdateProfile_Errors() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Blank name
+	blank := "   "
+	_, err = s.TestDIContainer.UpdateProfileHandler.Handle(ctx, commands.UpdateProfileCommand{UserID: reg.User.ID, Name: &blank})
+	var validation *errs.DomainValidationError
+	s.True(errors.As(err, &validation), "expected DomainValidationError, got %v", err)
+
+	// Unknown user
+	name := "Somebody"
+	_, err = s.TestDIContainer.UpdateProfileHandler.Handle(ctx, commands.UpdateProfileCommand{UserID: uuid.New(), Name: &name})
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+
+	_, err = s.TestDIContainer.GetMeHandler.Handle(ctx, queries.GetMeQuery{UserID: uuid.New()})
+	s.True(errors.As(err, &notFound), "expected NotFoundError, got %v", err)
+}
+
+func (s *Suite) TestUpdateProfile_SetsAndClearsProfileAttributes() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Act: set every optional attribute
+	locale, timezone, avatar, birthDate := "en-gb", "Europe/London", "https://cdn.example.com/me.png", "1990-04-21"
+	result, err := s.TestDIContainer.UpdateProfileHandler.Handle(ctx, commands.UpdateProfileCommand{
+		UserID:    reg.User.ID,
+		Locale:    &locale,
+		Timezone:  &timezone,
+		AvatarURL: &avatar,
+		BirthDate: &birthDate,
+		Metadata:  map[string]any{"plan": "pro", "seats": 3},
+	})
+
+	// Assert: values are normalized and persisted
+	s.Require().NoError(err)
+	s.Equal("en-GB", result.User.Locale)
+	s.Equal(timezone, result.User.Timezone)
+	s.Equal(avatar, result.User.AvatarURL)
+	s.Require().NotNil(result.User.BirthDate)
+	s.Equal(birthDate, result.User.BirthDate.Format("2006-01-02"))
+
+	user, err := s.TestDIContainer.GetMeHandler.Handle(ctx, queries.GetMeQuery{UserID: reg.User.ID})
+	s.Require().NoError(err)
+	s.Equal("en-GB", user.Profile.Locale)
+	s.Equal(birthDate, user.Profile.BirthDateString())
+	s.Equal(map[string]any{"plan": "pro", "seats": float64(3)}, user.Metadata)
+
+	// Act: an empty string clears a single attribute, the rest stays
+	empty := ""
+	result, err = s.TestDIContainer.UpdateProfileHandler.Handle(ctx, commands.UpdateProfileCommand{
+		UserID:    reg.User.ID,
+		AvatarURL: &empty,
+	})
+	s.Require().NoError(err)
+	s.Empty(result.User.AvatarURL)
+	s.Equal("en-GB", result.User.Locale)
+	s.Equal("pro", result.User.Metadata["plan"])
+
+	events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, "UserProfileChanged")
+	s.Require().NoError(err)
+	count := 0
+	for _, event := range events {
+		if event.AggregateID == reg.User.ID.String() {
+			count++
+		}
+	}
+	s.Equal(2, count, "expected one UserProfileChanged event per change")
+}
+
+func (s *Suite) TestUpdateProfile_RejectsInvalidAttributes() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	timezone := "Mars/Olympus"
+	birthDate := "2999-01-01"
+	tests := []struct {
+		name  string
+		cmd   commands.UpdateProfileCommand
+		field string
+	}{
+		{"unknown timezone", commands.UpdateProfileCommand{UserID: reg.User.ID, Timezone: &timezone}, "timezone"},
+		{"future birth date", commands.UpdateProfileCommand{UserID: reg.User.ID, BirthDate: &birthDate}, "birth_date"},
+		{"metadata outside the schema", commands.UpdateProfileCommand{UserID: reg.User.ID, Metadata: map[string]any{"plan": "enterprise"}}, "metadata"},
+		{"metadata with unknown key", commands.UpdateProfileCommand{UserID: reg.User.ID, Metadata: map[string]any{"color": "red"}}, "metadata"},
+	}
+
+	for _, tt := range tests {
+		_, err := s.TestDIContainer.UpdateProfileHandler.Handle(ctx, tt.cmd)
+		var validation *errs.DomainValidationError
+		s.Require().True(errors.As(err, &validation), "%s: expected DomainValidationError, got %v", tt.name, err)
+		s.Equal(tt.field, validation.Field, tt.name)
+	}
+
+	// Nothing was stored
+	user, err := s.TestDIContainer.GetMeHandler.Handle(ctx, queries.GetMeQuery{UserID: reg.User.ID})
+	s.Require().NoError(err)
+	s.Empty(user.Profile.Timezone)
+	s.Empty(user.Metadata)
+}
+
+func (s *Suite) TestUpdateProfile_UsernameIsUniqueAndUsableForLogin() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	other, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Act: set a username
+	username := "John.Doe"
+	result, err := s.TestDIContainer.UpdateProfileHandler.Handle(ctx, commands.UpdateProfileCommand{UserID: reg.User.ID, Username: &username})
+
+	// Assert: stored case-folded
+	s.Require().NoError(err)
+	s.Equal("john.doe", result.User.Username)
+
+	// Login with the username in any case
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, "JOHN.DOE", data.Password)
+	s.Require().NoError(err)
+	s.Equal(reg.User.ID, login.User.ID)
+
+	// Another account cannot take it, whatever the case
+	taken := "john.DOE"
+	_, err = s.TestDIContainer.UpdateProfileHandler.Handle(ctx, commands.UpdateProfileCommand{UserID: other.User.ID, Username: &taken})
+	var validation *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validation), "expected DomainValidationError, got %v", err)
+	s.Equal("username", validation.Field)
+
+	// Setting the same username again is fine
+	_, err = s.TestDIContainer.UpdateProfileHandler.Handle(ctx, commands.UpdateProfileCommand{UserID: reg.User.ID, Username: &taken})
+	s.Require().NoError(err)
+
+	// Removing it frees it and stops the login
+	empty := ""
+	result, err = s.TestDIContainer.UpdateProfileHandler.Handle(ctx, commands.UpdateProfileCommand{UserID: reg.User.ID, Username: &empty})
+	s.Require().NoError(err)
+	s.Empty(result.User.Username)
+
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, "john.doe", data.Password)
+	s.Require().Error(err)
+
+	result, err = s.TestDIContainer.UpdateProfileHandler.Handle(ctx, commands.UpdateProfileCommand{UserID: other.User.ID, Username: &taken})
+	s.Require().NoError(err)
+	s.Equal("john.doe", result.User.Username)
+
+	events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, "UserUsernameChanged")
+	s.Require().NoError(err)
+	s.Len(events, 3)
+}
+
+func (s *Suite) TestUpdateProfile_RejectsInvalidUsername() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	for _, username := range []string{"ab", "admin", "p\u0430ypal", "john doe"} {
+		_, err := s.TestDIContainer.UpdateProfileHandler.Handle(ctx, commands.UpdateProfileCommand{UserID: reg.User.ID, Username: &username})
+		var validation *errs.DomainValidationError
+		s.Require().True(errors.As(err, &validation), "%q: expected DomainValidationError, got %v", username, err)
+		s.Equal("username", validation.Field)
+	}
+}
@@ -5,7 +5,6 @@ package auth_handler_tests
 
 import (
 	"context"
-	"strings"
 
 	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
 	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
@@ -22,7 +21,7 @@ func (s *Suite) TestRegisterHandler_Success() {
 	s.Require().NoError(err)
 
 	s.Assert().NotEmpty(res.User.ID)
-	s.Assert().Equal(strings.ToLower(data.Email), res.User.Email)
+	s.Assert().Equal(data.Email, res.User.Email)
 	s.Assert().Equal(data.Name, res.User.Name)
 	s.Assert().Equal(data.Phone, res.User.Phone)
 	s.Assert().NotEmpty(res.AccessToken)
@@ -86,3 +85,65 @@ func (s *Suite) TestRegisterHandler_Validation_PhoneAlreadyExists() {
 	_, err = casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, second)
 	s.Require().Error(err)
 }
+
+func (s *Suite) TestRegisterHandler_Validation_CanonicalEmailAlreadyExists() {
+	ctx := context.Background()
+	first := testdatagenerators.RandomUserData()
+	first.Email = "johndoe@gmail.com"
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, first)
+	s.Require().NoError(err)
+
+	// The test container ignores plus tags, Gmail dots and googlemail.com
+	for _, variant := range []string{"John.Doe+news@gmail.com", "j.o.h.n.d.o.e@GMAIL.COM", "johndoe@googlemail.com"} {
+		second := testdatagenerators.RandomUserData()
+		second.Email = variant
+		_, err = casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, second)
+		s.Require().Error(err, variant)
+		s.Contains(err.Error(), "email already exists", variant)
+	}
+}
+
+func (s *Suite) TestRegisterHandler_InternationalizedEmail() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	data.Email = "Пользователь@Пример.рф"
+
+	res, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	// The local part keeps its case, the domain is shown in Unicode
+	s.Equal("Пользователь@пример.рф", res.User.Email)
+
+	// The punycode spelling of the same domain is the same mailbox
+	duplicate := testdatagenerators.RandomUserData()
+	duplicate.Email = "пользователь@xn--e1afmkfd.xn--p1ai"
+	_, err = casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, duplicate)
+	s.Require().Error(err)
+}
+
+func (s *Suite) TestRegisterHandler_PhoneNormalizedToE164() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	data.Phone = "(201) 555-0142" // national format of the default region (US)
+
+	res, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.Equal("+12015550142", res.User.Phone)
+	s.Equal("fixed_line_or_mobile", res.User.PhoneType)
+
+	// The same number in another format is taken
+	duplicate := testdatagenerators.RandomUserData()
+	duplicate.Phone = "+1 201.555.0142"
+	_, err = casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, duplicate)
+	s.Require().Error(err)
+	s.Contains(err.Error(), "phone already exists")
+}
+
+func (s *Suite) TestRegisterHandler_Validation_PhoneOutsideNumberingPlan() {
+	ctx := context.Background()
+	for _, phone := range []string{"+1 234 567 890", "+1 (201) 555-01", "555-0142"} {
+		data := testdatagenerators.RandomUserData()
+		data.Phone = phone
+		_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+		s.Require().Error(err, phone)
+	}
+}
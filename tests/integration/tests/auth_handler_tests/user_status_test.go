@@ -0,0 +1,114 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for account status in ChangeUserStatusHandler, LoginUserHandler, RefreshTokensHandler and Authenticate (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requireForbidden checks that err refuses an inactive account with the given problem type
+func (s *Suite) requireForbidden(err error, reason string) {
+	var forbiddenErr *errs.ForbiddenError
+	s.Require().True(errors.As(err, &forbiddenErr), "expected ForbiddenError, got %v", err)
+	s.Equal(reason, forbiddenErr.Reason)
+}
+
+func (s *Suite) TestUserStatus_SuspendedUserCannotLoginOrRefresh() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.Equal("active", reg.User.Status)
+
+	// Act
+	err = casesteps.ChangeUserStatusStep(ctx, s.TestDIContainer.ChangeUserStatusHandler, reg.User.ID, "suspended", "review")
+	s.Require().NoError(err)
+
+	// Assert: no new login, no refresh, and the access token is no longer accepted
+	_, err = casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.requireForbidden(err, "account-suspended")
+
+	_, err = s.TestDIContainer.RefreshTokensHandler.Handle(ctx, commands.RefreshTokensCommand{RefreshToken: reg.RefreshToken})
+	s.requireForbidden(err, "account-suspended")
+
+	_, err = casesteps.AuthenticateByTokenStep(ctx, s.TestDIContainer.JWTService, s.TestDIContainer.TransactionManager, reg.AccessToken)
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Equal(codes.PermissionDenied, st.Code())
+
+	events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, "UserStatusChanged")
+	s.Require().NoError(err)
+	found := 0
+	for _, event := range events {
+		if event.AggregateID == reg.User.ID.String() {
+			found++
+		}
+	}
+	s.Equal(1, found)
+
+	// Act: reactivation restores access
+	err = casesteps.ChangeUserStatusStep(ctx, s.TestDIContainer.ChangeUserStatusHandler, reg.User.ID, "active", "")
+	s.Require().NoError(err)
+
+	result, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+	s.Equal("active", result.User.Status)
+
+	resp, err := casesteps.AuthenticateByTokenStep(ctx, s.TestDIContainer.JWTService, s.TestDIContainer.TransactionManager, reg.AccessToken)
+	s.Require().NoError(err)
+	s.Equal("active", resp.User.Status)
+}
+
+func (s *Suite) TestUserStatus_RefreshUsesCurrentUserData() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Act
+	result, err := s.TestDIContainer.RefreshTokensHandler.Handle(ctx, commands.RefreshTokensCommand{RefreshToken: reg.RefreshToken})
+
+	// Assert
+	s.Require().NoError(err)
+	s.NotEmpty(result.AccessToken)
+	s.NotEmpty(result.RefreshToken)
+	s.Equal(reg.User.ID, result.User.ID)
+
+	// An access token is not a refresh token
+	_, err = s.TestDIContainer.RefreshTokensHandler.Handle(ctx, commands.RefreshTokensCommand{RefreshToken: reg.AccessToken})
+	var jwtErr *errs.JWTValidationError
+	s.True(errors.As(err, &jwtErr))
+}
+
+func (s *Suite) TestUserStatus_InvalidTransitionIsRejected() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+	s.Require().NoError(casesteps.ChangeUserStatusStep(ctx, s.TestDIContainer.ChangeUserStatusHandler, reg.User.ID, "blocked", "spam"))
+
+	// Act: a blocked account can only be activated
+	err = casesteps.ChangeUserStatusStep(ctx, s.TestDIContainer.ChangeUserStatusHandler, reg.User.ID, "deactivated", "")
+
+	// Assert
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("status", validationErr.Field)
+
+	err = casesteps.ChangeUserStatusStep(ctx, s.TestDIContainer.ChangeUserStatusHandler, reg.User.ID, "banned", "")
+	s.Require().True(errors.As(err, &validationErr))
+
+	err = casesteps.ChangeUserStatusStep(ctx, s.TestDIContainer.ChangeUserStatusHandler, uuid.New(), "blocked", "")
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound))
+}
@@ -0,0 +1,168 @@
+// HANDLER LAYER INTEGRATION TESTS
+// Tests for passkey registration, passwordless login and passkeys as a second factor (no HTTP)
+
+package auth_handler_tests
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestWebAuthn_RegisterAndLoginWithPasskey() {
+	ctx := context.Background()
+
+	// Pre-condition: registered user with a passkey
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+	authenticator := casesteps.NewAuthenticator(s.TestDIContainer.WebAuthnPolicy)
+	cred, err := casesteps.RegisterPasskeyStep(ctx,
+		s.TestDIContainer.BeginWebAuthnRegistrationHandler, s.TestDIContainer.FinishWebAuthnRegistrationHandler,
+		authenticator, reg.User.ID, "Laptop")
+	s.Require().NoError(err)
+	s.Equal("Laptop", cred.Name)
+
+	// Act: login without email and password
+	result, err := casesteps.PasskeyLoginStep(ctx,
+		s.TestDIContainer.BeginWebAuthnLoginHandler, s.TestDIContainer.FinishWebAuthnLoginHandler, authenticator)
+
+	// Assert
+	s.Require().NoError(err)
+	s.NotEmpty(result.AccessToken)
+	s.Equal(reg.User.ID, result.User.ID)
+
+	for _, eventType := range []string{"UserWebAuthnCredentialRegistered", "user.login"} {
+		events, err := s.TestDIContainer.EventStorage.GetEventsByType(ctx, eventType)
+		s.Require().NoError(err)
+		s.GreaterOrEqual(len(events), 1, eventType)
+	}
+}
+
+func (s *Suite) TestWebAuthn_LoginChallengeIsSingleUse() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+	authenticator := casesteps.NewAuthenticator(s.TestDIContainer.WebAuthnPolicy)
+	_, err = casesteps.RegisterPasskeyStep(ctx,
+		s.TestDIContainer.BeginWebAuthnRegistrationHandler, s.TestDIContainer.FinishWebAuthnRegistrationHandler,
+		authenticator, reg.User.ID, "")
+	s.Require().NoError(err)
+
+	options, err := s.TestDIContainer.BeginWebAuthnLoginHandler.Handle(ctx, commands.BeginWebAuthnLoginCommand{})
+	s.Require().NoError(err)
+	assertion, err := authenticator.Login(options.Options.Challenge, nil)
+	s.Require().NoError(err)
+	cmd := commands.FinishWebAuthnLoginCommand{Assertion: casesteps.ToWebAuthnAssertion(assertion)}
+	_, err = s.TestDIContainer.FinishWebAuthnLoginHandler.Handle(ctx, cmd)
+	s.Require().NoError(err)
+
+	// Act: replay the same signed response
+	_, err = s.TestDIContainer.FinishWebAuthnLoginHandler.Handle(ctx, cmd)
+
+	// Assert
+	s.Require().Error(err)
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("credentials", validationErr.Field)
+}
+
+func (s *Suite) TestWebAuthn_LoginRequiresUserVerification() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+	authenticator := casesteps.NewAuthenticator(s.TestDIContainer.WebAuthnPolicy)
+	_, err = casesteps.RegisterPasskeyStep(ctx,
+		s.TestDIContainer.BeginWebAuthnRegistrationHandler, s.TestDIContainer.FinishWebAuthnRegistrationHandler,
+		authenticator, reg.User.ID, "")
+	s.Require().NoError(err)
+
+	// Act: security key without PIN or biometrics
+	authenticator.SkipUserVerification = true
+	_, err = casesteps.PasskeyLoginStep(ctx,
+		s.TestDIContainer.BeginWebAuthnLoginHandler, s.TestDIContainer.FinishWebAuthnLoginHandler, authenticator)
+
+	// Assert
+	s.Require().Error(err)
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("credentials", validationErr.Field)
+}
+
+func (s *Suite) TestWebAuthn_RegistrationChallengeIsBoundToUser() {
+	ctx := context.Background()
+	owner, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+	other, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	options, err := s.TestDIContainer.BeginWebAuthnRegistrationHandler.Handle(ctx,
+		commands.BeginWebAuthnRegistrationCommand{UserID: owner.User.ID})
+	s.Require().NoError(err)
+	registration, err := casesteps.NewAuthenticator(s.TestDIContainer.WebAuthnPolicy).
+		Register(options.Options.Challenge, options.Options.UserID)
+	s.Require().NoError(err)
+
+	// Act: another user submits the owner's challenge
+	_, err = s.TestDIContainer.FinishWebAuthnRegistrationHandler.Handle(ctx, commands.FinishWebAuthnRegistrationCommand{
+		UserID:            other.User.ID,
+		ClientDataJSON:    registration.ClientDataJSON,
+		AttestationObject: registration.AttestationObject,
+	})
+
+	// Assert
+	s.Require().Error(err)
+	var validationErr *errs.DomainValidationError
+	s.Require().True(errors.As(err, &validationErr))
+	s.Equal("credential", validationErr.Field)
+}
+
+func (s *Suite) TestWebAuthn_PasskeyAsSecondFactor() {
+	ctx := context.Background()
+
+	// Pre-condition: user with MFA enabled and a registered passkey
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	_, _, err = casesteps.EnableMFAStep(ctx,
+		s.TestDIContainer.EnrollTOTPHandler, s.TestDIContainer.ConfirmTOTPHandler, reg.User.ID)
+	s.Require().NoError(err)
+	authenticator := casesteps.NewAuthenticator(s.TestDIContainer.WebAuthnPolicy)
+	_, err = casesteps.RegisterPasskeyStep(ctx,
+		s.TestDIContainer.BeginWebAuthnRegistrationHandler, s.TestDIContainer.FinishWebAuthnRegistrationHandler,
+		authenticator, reg.User.ID, "")
+	s.Require().NoError(err)
+
+	// Act: password step
+	login, err := casesteps.LoginUserStep(ctx, s.TestDIContainer.LoginUserHandler, data.Email, data.Password)
+	s.Require().NoError(err)
+	s.Require().True(login.MFARequired)
+	s.Contains(login.MFAMethods, auth.MFAMethodWebAuthn)
+	s.Require().NotNil(login.MFAWebAuthnOptions)
+	s.Len(login.MFAWebAuthnOptions.AllowCredentials, 1)
+
+	// Act: sign the MFA challenge with the passkey
+	assertion, err := authenticator.Login(login.MFAWebAuthnOptions.Challenge, login.MFAWebAuthnOptions.AllowCredentials)
+	s.Require().NoError(err)
+	webAuthn := casesteps.ToWebAuthnAssertion(assertion)
+	result, err := s.TestDIContainer.VerifyMFAHandler.Handle(ctx, commands.VerifyMFACommand{
+		MFAToken: login.MFAToken,
+		WebAuthn: &webAuthn,
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.NotEmpty(result.AccessToken)
+	s.Equal(reg.User.ID, result.User.ID)
+
+	// Passwordless login with the passkey does not ask for the TOTP step
+	direct, err := casesteps.PasskeyLoginStep(ctx,
+		s.TestDIContainer.BeginWebAuthnLoginHandler, s.TestDIContainer.FinishWebAuthnLoginHandler, authenticator)
+	s.Require().NoError(err)
+	s.False(direct.MFARequired)
+	s.NotEmpty(direct.AccessToken)
+}
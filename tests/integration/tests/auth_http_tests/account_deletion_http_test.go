@@ -0,0 +1,87 @@
+// API LAYER TESTS
+// Tests for DELETE /me and POST /me/deletion/cancel
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"time"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestAccountDeletionHTTP_ScheduleAndCancel() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RequestAccountDeletionHTTPRequest(reg.AccessToken, map[string]any{"current_password": data.Password}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusAccepted, resp.StatusCode, resp.Body)
+
+	var scheduled v1.AccountDeletionResponse
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &scheduled))
+	s.WithinDuration(time.Now().Add(14*24*time.Hour), scheduled.DeletionScheduledAt, time.Minute)
+
+	// Assert: login reports the scheduled deletion
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.LoginHTTPRequest(data.ToLoginHTTPRequest()))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode)
+	var login v1.LoginResponse
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &login))
+	s.Require().NotNil(login.User.DeletionScheduledAt)
+
+	// A second request is rejected
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RequestAccountDeletionHTTPRequest(reg.AccessToken, map[string]any{"current_password": data.Password}))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusBadRequest, resp.StatusCode)
+
+	// Act: cancel
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.CancelAccountDeletionHTTPRequest(login.AccessToken))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusNoContent, resp.StatusCode)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.CancelAccountDeletionHTTPRequest(login.AccessToken))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *Suite) TestAccountDeletionHTTP_Errors() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Wrong password
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RequestAccountDeletionHTTPRequest(reg.AccessToken, map[string]any{"current_password": "wrongpassword"}))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusBadRequest, resp.StatusCode)
+
+	// Missing password is rejected by the OpenAPI schema
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RequestAccountDeletionHTTPRequest(reg.AccessToken, map[string]any{}))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusBadRequest, resp.StatusCode)
+
+	// No bearer token
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RequestAccountDeletionHTTPRequest("", map[string]any{"current_password": "whatever"}))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.CancelAccountDeletionHTTPRequest(""))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+}
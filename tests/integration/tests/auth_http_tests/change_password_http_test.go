@@ -0,0 +1,73 @@
+// API LAYER TESTS
+// Tests for POST /auth/password/change (bearer authentication and validation)
+
+package auth_http_tests
+
+import (
+	"context"
+	stdhttp "net/http"
+
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestChangePasswordHTTP_Success() {
+	ctx := context.Background()
+
+	// Pre-condition: registered user with access token
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act
+	req := casesteps.ChangePasswordHTTPRequest(reg.AccessToken, map[string]any{
+		"current_password": data.Password,
+		"new_password":     "brandnewpassword1",
+	})
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusNoContent, resp.StatusCode)
+}
+
+func (s *Suite) TestChangePasswordHTTP_MissingToken() {
+	ctx := context.Background()
+	req := casesteps.ChangePasswordHTTPRequest("", map[string]any{
+		"current_password": "securepassword123",
+		"new_password":     "brandnewpassword1",
+	})
+	req.Headers = nil
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, stdhttp.StatusUnauthorized, "bearer token")
+}
+
+func (s *Suite) TestChangePasswordHTTP_InvalidToken() {
+	ctx := context.Background()
+	req := casesteps.ChangePasswordHTTPRequest("not-a-jwt", map[string]any{
+		"current_password": "securepassword123",
+		"new_password":     "brandnewpassword1",
+	})
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, stdhttp.StatusUnauthorized, "")
+}
+
+func (s *Suite) TestChangePasswordHTTP_ReusedPassword() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	req := casesteps.ChangePasswordHTTPRequest(reg.AccessToken, map[string]any{
+		"current_password": data.Password,
+		"new_password":     data.Password,
+	})
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, stdhttp.StatusBadRequest, "recently used")
+}
@@ -0,0 +1,87 @@
+// API LAYER TESTS
+// Tests for GET /me/export, GET /me/exports/{export_id} and their admin counterparts
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+
+	"github.com/google/uuid"
+
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestDataExportHTTP_OwnData() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ExportMyDataHTTPRequest(reg.AccessToken))
+
+	// Assert: small archives are returned right away
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode, resp.Body)
+	var archive struct {
+		Profile struct {
+			ID    string `json:"id"`
+			Email string `json:"email"`
+		} `json:"profile"`
+		Events []struct {
+			Type string `json:"type"`
+		} `json:"events"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &archive))
+	s.Equal(reg.User.ID.String(), archive.Profile.ID)
+	s.Equal(reg.User.Email, archive.Profile.Email)
+	s.NotEmpty(archive.Events)
+	s.NotContains(resp.Body, "PasswordHash")
+
+	// Without a token
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ExportMyDataHTTPRequest(""))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+
+	// Unknown export
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.GetMyDataExportHTTPRequest(reg.AccessToken, uuid.New().String()))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusNotFound, resp.StatusCode)
+}
+
+func (s *Suite) TestDataExportHTTP_Admin() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ExportUserDataHTTPRequest(testAdminAPIKey, reg.User.ID.String()))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode, resp.Body)
+	s.Contains(resp.Body, reg.User.Email)
+
+	// Missing or wrong admin API key
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ExportUserDataHTTPRequest("wrong-key", reg.User.ID.String()))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+
+	// Unknown user and unknown export
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ExportUserDataHTTPRequest(testAdminAPIKey, uuid.New().String()))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusNotFound, resp.StatusCode)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.GetUserDataExportHTTPRequest(testAdminAPIKey, reg.User.ID.String(), uuid.New().String()))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusNotFound, resp.StatusCode)
+}
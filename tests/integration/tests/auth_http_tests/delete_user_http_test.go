@@ -0,0 +1,66 @@
+// API LAYER TESTS
+// Tests for DELETE /admin/users/{user_id}
+
+package auth_http_tests
+
+import (
+	"context"
+	stdhttp "net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestDeleteUserHTTP_DeletedUserIsRefused() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.DeleteUserHTTPRequest(testAdminAPIKey, reg.User.ID.String()))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusNoContent, resp.StatusCode)
+
+	// Assert: credentials and tokens of the deleted account no longer work
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.LoginHTTPRequest(data.ToLoginHTTPRequest()))
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "")
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RefreshTokensHTTPRequest(map[string]any{"refresh_token": reg.RefreshToken}))
+	s.Require().NoError(err)
+	s.NotEqual(stdhttp.StatusOK, resp.StatusCode)
+
+	// A second deletion finds nothing
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.DeleteUserHTTPRequest(testAdminAPIKey, reg.User.ID.String()))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusNotFound, resp.StatusCode)
+}
+
+func (s *Suite) TestDeleteUserHTTP_Errors() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Missing or wrong admin API key
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.DeleteUserHTTPRequest("wrong-key", reg.User.ID.String()))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+
+	// Unknown user
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.DeleteUserHTTPRequest(testAdminAPIKey, uuid.New().String()))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusNotFound, resp.StatusCode)
+
+	// The account was not touched
+	_, err = s.TestDIContainer.UserRepository.GetByID(reg.User.ID)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,86 @@
+// API LAYER TESTS
+// Tests for POST /auth/email/change and POST /auth/email/change/confirm
+
+package auth_http_tests
+
+import (
+	"context"
+	stdhttp "net/http"
+
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestEmailChangeHTTP_Flow() {
+	ctx := context.Background()
+	httpAsserts := assertions.NewAuthHTTPAssertions(s.Assert())
+
+	// Pre-condition: registered user
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	newEmail := testdatagenerators.RandomUserData().Email
+
+	// Act: request change
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.RequestEmailChangeHTTPRequest(
+		reg.AccessToken,
+		map[string]any{"current_password": data.Password, "new_email": newEmail},
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusAccepted, resp.StatusCode)
+
+	// Act: confirm
+	msg, ok := s.TestDIContainer.EmailSender.LastTo(newEmail)
+	s.Require().True(ok)
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ConfirmEmailChangeHTTPRequest(
+		map[string]any{"token": casesteps.VerificationTokenFromEmail(msg)},
+	))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusNoContent, resp.StatusCode)
+
+	// Assert
+	login := data
+	login.Email = newEmail
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.LoginHTTPRequest(login.ToLoginHTTPRequest()))
+	user := httpAsserts.LoginHTTPSuccess(resp, err)
+	s.Equal(newEmail, string(user.User.Email))
+}
+
+func (s *Suite) TestEmailChangeHTTP_Conflict() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	other := testdatagenerators.RandomUserData()
+	_, err = casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, other)
+	s.Require().NoError(err)
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.RequestEmailChangeHTTPRequest(
+		reg.AccessToken,
+		map[string]any{"current_password": data.Password, "new_email": other.Email},
+	))
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 409, "already exists")
+}
+
+func (s *Suite) TestEmailChangeHTTP_RequiresToken() {
+	ctx := context.Background()
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.RequestEmailChangeHTTPRequest(
+		"invalid-token",
+		map[string]any{"current_password": "password123", "new_email": "new@example.com"},
+	))
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "")
+}
+
+func (s *Suite) TestEmailChangeHTTP_ConfirmInvalidToken() {
+	ctx := context.Background()
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.ConfirmEmailChangeHTTPRequest(
+		map[string]any{"token": "not-a-real-token"},
+	))
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 400, "invalid or expired")
+}
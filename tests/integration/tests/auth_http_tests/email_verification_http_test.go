@@ -0,0 +1,68 @@
+// API LAYER TESTS
+// Tests for POST /auth/email/verification and POST /auth/email/verify
+
+package auth_http_tests
+
+import (
+	"context"
+	stdhttp "net/http"
+
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestEmailVerificationHTTP_VerifyFlow() {
+	ctx := context.Background()
+	httpAsserts := assertions.NewAuthHTTPAssertions(s.Assert())
+
+	// Pre-condition: registered user with a verification email
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	msg, ok := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Require().True(ok)
+	token := casesteps.VerificationTokenFromEmail(msg)
+
+	// Act
+	req := casesteps.VerifyEmailHTTPRequest(map[string]any{"token": token})
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusNoContent, resp.StatusCode)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.LoginHTTPRequest(data.ToLoginHTTPRequest()))
+	login := httpAsserts.LoginHTTPSuccess(resp, err)
+	s.True(login.User.EmailVerified)
+
+	// Reusing the token is rejected
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+	httpAsserts.HTTPErrorResponse(resp, err, 400, "invalid or expired")
+}
+
+func (s *Suite) TestEmailVerificationHTTP_SendAlwaysAccepted() {
+	ctx := context.Background()
+
+	// Unknown email gets the same response as a registered one
+	req := casesteps.SendEmailVerificationHTTPRequest(map[string]any{"email": "nobody-here@example.com"})
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusAccepted, resp.StatusCode)
+	s.Equal(0, s.TestDIContainer.EmailSender.CountTo("nobody-here@example.com"))
+}
+
+func (s *Suite) TestEmailVerificationHTTP_SendInvalidEmail() {
+	ctx := context.Background()
+	req := casesteps.SendEmailVerificationHTTPRequest(map[string]any{"email": "notanemail"})
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 400, "")
+}
+
+func (s *Suite) TestEmailVerificationHTTP_VerifyMissingToken() {
+	ctx := context.Background()
+	req := casesteps.VerifyEmailHTTPRequest(map[string]any{})
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 400, "")
+}
@@ -0,0 +1,66 @@
+// API LAYER TESTS
+// Tests for login lockout on POST /auth/login and POST /admin/users/{user_id}/unlock
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestLockoutHTTP_LockedAndUnlocked() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act: the test config locks after 3 wrong passwords
+	var resp *casesteps.HTTPResponse
+	for i := 0; i < 3; i++ {
+		resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+			casesteps.LoginHTTPRequest(map[string]any{"identifier": data.Email, "password": "wrong-password"}))
+		s.Require().NoError(err)
+	}
+
+	// Assert: 423 with the time left in the body and in Retry-After
+	s.Require().Equal(stdhttp.StatusLocked, resp.StatusCode)
+	var locked v1.AccountLocked
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &locked))
+	s.Equal("account-locked", locked.Type)
+	s.Greater(locked.RetryAfter, 0)
+	s.Equal(strconv.Itoa(locked.RetryAfter), resp.Headers.Get("Retry-After"))
+
+	// Act: an administrator lifts the lock
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UnlockUserHTTPRequest(testAdminAPIKey, reg.User.ID.String()))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusNoContent, resp.StatusCode)
+
+	// Assert: the right password works again
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.LoginHTTPRequest(data.ToLoginHTTPRequest()))
+	assertions.NewAuthHTTPAssertions(s.Assert()).LoginHTTPSuccess(resp, err)
+}
+
+func (s *Suite) TestLockoutHTTP_UnlockRequiresAdminKey() {
+	ctx := context.Background()
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UnlockUserHTTPRequest("wrong-key", uuid.New().String()))
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "")
+}
+
+func (s *Suite) TestLockoutHTTP_UnlockUserNotFound() {
+	ctx := context.Background()
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UnlockUserHTTPRequest(testAdminAPIKey, uuid.New().String()))
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 404, "")
+}
@@ -0,0 +1,42 @@
+// API LAYER TESTS
+// Tests for new-device login detection behind the HTTP API
+
+package auth_http_tests
+
+import (
+	"context"
+	stdhttp "net/http"
+
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+// loginWithUserAgent logs in over HTTP from the given browser
+func (s *Suite) loginWithUserAgent(data testdatagenerators.UserTestData, userAgent string) {
+	req := casesteps.LoginHTTPRequest(data.ToLoginHTTPRequest())
+	req.Headers = map[string]string{"User-Agent": userAgent}
+	resp, err := casesteps.ExecuteHTTPRequest(context.Background(), s.TestDIContainer.HTTPRouter, req)
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode, resp.Body)
+}
+
+func (s *Suite) TestLoginRiskHTTP_NewBrowserIsReported() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Pre-condition: the first login from a desktop browser
+	s.loginWithUserAgent(data, "Mozilla/5.0 (X11; Linux x86_64) Firefox/130.0")
+	sent := s.TestDIContainer.EmailSender.CountTo(data.Email)
+
+	// Act: the User-Agent header of another browser
+	s.loginWithUserAgent(data, "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) Safari/604.1")
+
+	// Assert: the user is emailed about the new device
+	s.Equal(sent+1, s.TestDIContainer.EmailSender.CountTo(data.Email))
+	msg, ok := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Require().True(ok)
+	s.Equal("New sign-in to your account", msg.Subject)
+	s.Contains(msg.Body, "iPhone")
+}
@@ -0,0 +1,84 @@
+// API LAYER TESTS
+// Tests for POST /auth/magic-link and POST /auth/magic-link/redeem
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestMagicLinkHTTP_RequestAndRedeem() {
+	ctx := context.Background()
+
+	// Pre-condition: registered user
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act: request a link
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RequestMagicLinkHTTPRequest(map[string]any{"email": data.Email}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusAccepted, resp.StatusCode)
+	msg, ok := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	s.Require().True(ok)
+	s.Contains(msg.Body, "http://localhost:3000/magic-link?token=")
+	token := casesteps.VerificationTokenFromEmail(msg)
+
+	// Act: redeem it
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RedeemMagicLinkHTTPRequest(map[string]any{"token": token}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode)
+	var login v1.LoginResponse
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &login))
+	s.NotEmpty(login.AccessToken)
+	s.Equal(data.Email, login.User.Email)
+	s.True(login.User.EmailVerified)
+
+	// The link works once
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RedeemMagicLinkHTTPRequest(map[string]any{"token": token}))
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "sign-in link")
+}
+
+func (s *Suite) TestMagicLinkHTTP_UnknownEmailIsAccepted() {
+	ctx := context.Background()
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RequestMagicLinkHTTPRequest(map[string]any{"email": testdatagenerators.RandomUserData().Email}))
+
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusAccepted, resp.StatusCode)
+}
+
+func (s *Suite) TestMagicLinkHTTP_RedeemIgnoresGET() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	s.Require().NoError(casesteps.RequestMagicLinkStep(ctx, s.TestDIContainer.RequestMagicLinkHandler, data.Email))
+	msg, _ := s.TestDIContainer.EmailSender.LastTo(data.Email)
+	token := casesteps.VerificationTokenFromEmail(msg)
+
+	// Act: a link scanner prefetches the redeem URL
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.HTTPRequest{
+		Method: stdhttp.MethodGet,
+		URL:    "/api/v1/auth/magic-link/redeem?token=" + token,
+	})
+
+	// Assert: nothing is consumed, the user can still sign in
+	s.Require().NoError(err)
+	s.GreaterOrEqual(resp.StatusCode, stdhttp.StatusBadRequest)
+	_, err = casesteps.RedeemMagicLinkStep(ctx, s.TestDIContainer.RedeemMagicLinkHandler, token)
+	s.Require().NoError(err)
+}
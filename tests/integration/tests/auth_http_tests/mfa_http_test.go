@@ -0,0 +1,83 @@
+// API LAYER TESTS
+// Tests for POST /auth/mfa/totp/enroll, POST /auth/mfa/totp/confirm and POST /auth/mfa/verify
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestMFAHTTP_EnrollAndLogin() {
+	ctx := context.Background()
+
+	// Pre-condition: registered user
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act: enroll
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.EnrollTOTPHTTPRequest(reg.AccessToken))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode)
+	var enrollment v1.TOTPEnrollment
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &enrollment))
+	s.Contains(enrollment.OtpauthUri, "secret="+enrollment.Secret)
+
+	// Act: confirm
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ConfirmTOTPHTTPRequest(reg.AccessToken, map[string]any{"code": casesteps.TOTPCode(enrollment.Secret, 0)}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode)
+	var codes v1.RecoveryCodes
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &codes))
+	s.NotEmpty(codes.RecoveryCodes)
+
+	// Act: login with password
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.LoginHTTPRequest(map[string]any{"email": data.Email, "password": data.Password}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusAccepted, resp.StatusCode)
+	var challenge v1.MFARequired
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &challenge))
+	s.NotEmpty(challenge.MfaToken)
+
+	// Act: second step
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.VerifyMFAHTTPRequest(map[string]any{
+			"mfa_token": challenge.MfaToken,
+			"code":      casesteps.TOTPCode(enrollment.Secret, 1),
+		}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode)
+	var login v1.LoginResponse
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &login))
+	s.NotEmpty(login.AccessToken)
+	s.Equal(data.Email, login.User.Email)
+}
+
+func (s *Suite) TestMFAHTTP_VerifyWithInvalidToken() {
+	ctx := context.Background()
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.VerifyMFAHTTPRequest(map[string]any{"mfa_token": "not-a-real-token", "code": "123456"}))
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "invalid or expired")
+}
+
+func (s *Suite) TestMFAHTTP_EnrollRequiresAuthentication() {
+	ctx := context.Background()
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.EnrollTOTPHTTPRequest("invalid"))
+
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+}
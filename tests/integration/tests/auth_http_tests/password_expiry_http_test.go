@@ -0,0 +1,87 @@
+// API LAYER TESTS
+// Tests for expired password login, POST /auth/password/expired and admin forced change
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+const testAdminAPIKey = "test-admin-api-key"
+
+func (s *Suite) TestPasswordExpiryHTTP_FullFlow() {
+	ctx := context.Background()
+	httpAsserts := assertions.NewAuthHTTPAssertions(s.Assert())
+
+	// Pre-condition: registered user
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act: admin forces password change
+	req := casesteps.RequirePasswordChangeHTTPRequest(testAdminAPIKey, reg.User.ID.String())
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusNoContent, resp.StatusCode)
+
+	// Act: login returns 403 with password change token
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.LoginHTTPRequest(data.ToLoginHTTPRequest()))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusForbidden, resp.StatusCode)
+	var expired v1.LoginForbidden
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &expired))
+	s.Equal("password-expired", expired.Type)
+	s.Require().NotNil(expired.PasswordChangeToken)
+	s.Require().NotNil(expired.ExpiresIn)
+	s.Greater(*expired.ExpiresIn, 0)
+
+	// Act: change expired password
+	req = casesteps.ChangeExpiredPasswordHTTPRequest(map[string]any{
+		"password_change_token": *expired.PasswordChangeToken,
+		"new_password":          "brandnewpassword1",
+	})
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+	// Assert: full session issued
+	httpAsserts.LoginHTTPSuccess(resp, err)
+}
+
+func (s *Suite) TestPasswordExpiryHTTP_InvalidChangeToken() {
+	ctx := context.Background()
+	req := casesteps.ChangeExpiredPasswordHTTPRequest(map[string]any{
+		"password_change_token": "not-a-token",
+		"new_password":          "brandnewpassword1",
+	})
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "")
+}
+
+func (s *Suite) TestRequirePasswordChangeHTTP_InvalidAdminKey() {
+	ctx := context.Background()
+	req := casesteps.RequirePasswordChangeHTTPRequest("wrong-key", uuid.New().String())
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "")
+}
+
+func (s *Suite) TestRequirePasswordChangeHTTP_MissingAdminKey() {
+	ctx := context.Background()
+	req := casesteps.RequirePasswordChangeHTTPRequest("", uuid.New().String())
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "")
+}
+
+func (s *Suite) TestRequirePasswordChangeHTTP_UserNotFound() {
+	ctx := context.Background()
+	req := casesteps.RequirePasswordChangeHTTPRequest(testAdminAPIKey, uuid.New().String())
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 404, "")
+}
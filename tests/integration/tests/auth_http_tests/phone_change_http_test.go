@@ -0,0 +1,82 @@
+// API LAYER TESTS
+// Tests for POST /auth/phone/change and POST /auth/phone/change/confirm
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestPhoneChangeHTTP_Flow() {
+	ctx := context.Background()
+
+	// Pre-condition: registered user
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	newPhone := testdatagenerators.RandomPhone()
+
+	// Act: request change
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RequestPhoneChangeHTTPRequest(reg.AccessToken, map[string]any{"new_phone": newPhone}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusAccepted, resp.StatusCode)
+
+	// Act: confirm with the code from SMS
+	msg, ok := s.TestDIContainer.SMSSender.LastTo(newPhone)
+	s.Require().True(ok)
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ConfirmPhoneChangeHTTPRequest(reg.AccessToken, map[string]any{"code": casesteps.VerificationCodeFromSMS(msg)}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode)
+	var user v1.User
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &user))
+	s.Require().NotNil(user.Phone)
+	s.Equal(newPhone, *user.Phone)
+	s.True(user.PhoneVerified)
+}
+
+func (s *Suite) TestPhoneChangeHTTP_Conflict() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	other := testdatagenerators.RandomUserData()
+	_, err = casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, other)
+	s.Require().NoError(err)
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RequestPhoneChangeHTTPRequest(reg.AccessToken, map[string]any{"new_phone": other.Phone}))
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 409, "already exists")
+}
+
+func (s *Suite) TestPhoneChangeHTTP_InvalidPhone() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RequestPhoneChangeHTTPRequest(reg.AccessToken, map[string]any{"new_phone": "12345"}))
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 400, "")
+}
+
+func (s *Suite) TestPhoneChangeHTTP_RequiresToken() {
+	ctx := context.Background()
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ConfirmPhoneChangeHTTPRequest("invalid-token", map[string]any{"code": "123456"}))
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "")
+}
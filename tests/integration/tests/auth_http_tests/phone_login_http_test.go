@@ -0,0 +1,102 @@
+// API LAYER TESTS
+// Tests for login by phone: POST /auth/login with a phone identifier, POST /auth/login/phone/code and POST /auth/login/phone
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestPhoneLoginHTTP_PhoneAsIdentifier() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.LoginHTTPRequest(map[string]any{"identifier": data.Phone, "password": data.Password}))
+
+	// Assert
+	login := assertions.NewAuthHTTPAssertions(s.Assert()).LoginHTTPSuccess(resp, err)
+	s.NotEmpty(login.AccessToken)
+}
+
+func (s *Suite) TestPhoneLoginHTTP_DeprecatedEmailField() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	_, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act: old clients still send "email"
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.LoginHTTPRequest(map[string]any{"email": data.Email, "password": data.Password}))
+
+	// Assert
+	assertions.NewAuthHTTPAssertions(s.Assert()).LoginHTTPSuccess(resp, err)
+}
+
+func (s *Suite) TestPhoneLoginHTTP_MissingIdentifier() {
+	ctx := context.Background()
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.LoginHTTPRequest(map[string]any{"password": "password123"}))
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 400, "identifier")
+}
+
+func (s *Suite) TestPhoneLoginHTTP_RequestCodeAndLogin() {
+	ctx := context.Background()
+
+	// Pre-condition: user with a verified phone
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	_, err = casesteps.SendPhoneVerificationStep(ctx, s.TestDIContainer.SendPhoneVerificationHandler, reg.User.ID)
+	s.Require().NoError(err)
+	msg, _ := s.TestDIContainer.SMSSender.LastTo(data.Phone)
+	s.Require().NoError(casesteps.VerifyPhoneStep(ctx, s.TestDIContainer.VerifyPhoneHandler, reg.User.ID,
+		casesteps.VerificationCodeFromSMS(msg)))
+
+	// Act: request a code
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RequestPhoneLoginCodeHTTPRequest(map[string]any{"phone": data.Phone}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusAccepted, resp.StatusCode)
+	msg, _ = s.TestDIContainer.SMSSender.LastTo(data.Phone)
+	code := casesteps.VerificationCodeFromSMS(msg)
+
+	// Act: log in with it
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.LoginWithPhoneCodeHTTPRequest(map[string]any{"phone": data.Phone, "code": code}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode)
+	var login v1.LoginResponse
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &login))
+	s.NotEmpty(login.AccessToken)
+	s.Equal(reg.User.ID, login.User.Id)
+
+	// The code works once
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.LoginWithPhoneCodeHTTPRequest(map[string]any{"phone": data.Phone, "code": code}))
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "invalid")
+}
+
+func (s *Suite) TestPhoneLoginHTTP_UnknownPhoneIsAccepted() {
+	ctx := context.Background()
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RequestPhoneLoginCodeHTTPRequest(map[string]any{"phone": testdatagenerators.RandomPhone()}))
+
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusAccepted, resp.StatusCode)
+}
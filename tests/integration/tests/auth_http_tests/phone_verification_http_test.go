@@ -0,0 +1,101 @@
+// API LAYER TESTS
+// Tests for POST /auth/phone/verification and POST /auth/phone/verify
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestPhoneVerificationHTTP_VerifyFlow() {
+	ctx := context.Background()
+	httpAsserts := assertions.NewAuthHTTPAssertions(s.Assert())
+
+	// Pre-condition: registered user
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act: request a code
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.SendPhoneVerificationHTTPRequest(reg.AccessToken))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusAccepted, resp.StatusCode)
+
+	var sent v1.PhoneVerificationSent
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &sent))
+	s.Equal(300, sent.ExpiresIn)
+	s.Equal(60, sent.ResendAfter)
+
+	msg, ok := s.TestDIContainer.SMSSender.LastTo(data.Phone)
+	s.Require().True(ok)
+	code := casesteps.VerificationCodeFromSMS(msg)
+
+	// Act: confirm phone
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.VerifyPhoneHTTPRequest(reg.AccessToken, map[string]any{"code": code}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusNoContent, resp.StatusCode)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.LoginHTTPRequest(data.ToLoginHTTPRequest()))
+	login := httpAsserts.LoginHTTPSuccess(resp, err)
+	s.True(login.User.PhoneVerified)
+}
+
+func (s *Suite) TestPhoneVerificationHTTP_ResendThrottled() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	req := casesteps.SendPhoneVerificationHTTPRequest(reg.AccessToken)
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusAccepted, resp.StatusCode)
+
+	// Act
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusTooManyRequests, resp.StatusCode)
+	var problem v1.TooManyRequests
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &problem))
+	s.Equal("too-many-requests", problem.Type)
+	s.Greater(problem.RetryAfter, 0)
+}
+
+func (s *Suite) TestPhoneVerificationHTTP_WrongCode() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	_, err = casesteps.SendPhoneVerificationStep(ctx, s.TestDIContainer.SendPhoneVerificationHandler, reg.User.ID)
+	s.Require().NoError(err)
+	msg, _ := s.TestDIContainer.SMSSender.LastTo(data.Phone)
+	wrong := "000000"
+	if casesteps.VerificationCodeFromSMS(msg) == wrong {
+		wrong = "111111"
+	}
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.VerifyPhoneHTTPRequest(reg.AccessToken, map[string]any{"code": wrong}))
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 400, "code is invalid")
+}
+
+func (s *Suite) TestPhoneVerificationHTTP_RequiresToken() {
+	ctx := context.Background()
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.SendPhoneVerificationHTTPRequest("invalid-token"))
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "")
+}
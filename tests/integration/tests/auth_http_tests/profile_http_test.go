@@ -0,0 +1,172 @@
+// API LAYER TESTS
+// Tests for GET /me and PATCH /me
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestProfileHTTP_GetAndUpdate() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act: read the profile
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.GetMeHTTPRequest(reg.AccessToken))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode, resp.Body)
+	var me v1.User
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &me))
+	s.Equal(reg.User.ID, me.Id)
+	s.Equal(data.Name, me.Name)
+	s.Equal(v1.Active, me.Status)
+
+	// Act: rename
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateProfileHTTPRequest(reg.AccessToken, map[string]any{"name": "Renamed User"}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode, resp.Body)
+	var updated v1.User
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &updated))
+	s.Equal("Renamed User", updated.Name)
+
+	// The same access token sees the change without a refresh
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.GetMeHTTPRequest(reg.AccessToken))
+	s.Require().NoError(err)
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &me))
+	s.Equal("Renamed User", me.Name)
+}
+
+func (s *Suite) TestProfileHTTP_Errors() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Without a token
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.GetMeHTTPRequest(""))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateProfileHTTPRequest("", map[string]any{"name": "Renamed User"}))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+
+	// Blank name
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateProfileHTTPRequest(reg.AccessToken, map[string]any{"name": "   "}))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusBadRequest, resp.StatusCode)
+}
+
+func (s *Suite) TestProfileHTTP_ProfileAttributesAndMetadata() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateProfileHTTPRequest(reg.AccessToken, map[string]any{
+			"locale":     "de-de",
+			"timezone":   "Europe/Berlin",
+			"avatar_url": "https://cdn.example.com/avatar.png",
+			"birth_date": "1985-12-01",
+			"metadata":   map[string]any{"theme": "dark", "beta": true},
+		}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode, resp.Body)
+
+	// Assert: GET /me returns the stored values
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.GetMeHTTPRequest(reg.AccessToken))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode, resp.Body)
+	var me v1.User
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &me))
+	s.Require().NotNil(me.Locale)
+	s.Equal("de-DE", *me.Locale)
+	s.Require().NotNil(me.Timezone)
+	s.Equal("Europe/Berlin", *me.Timezone)
+	s.Require().NotNil(me.AvatarUrl)
+	s.Equal("https://cdn.example.com/avatar.png", *me.AvatarUrl)
+	s.Require().NotNil(me.BirthDate)
+	s.Equal("1985-12-01", me.BirthDate.String())
+	s.Require().NotNil(me.Metadata)
+	s.Equal(v1.UserMetadata{"theme": "dark", "beta": true}, *me.Metadata)
+
+	// Clearing the birth date with an empty string
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateProfileHTTPRequest(reg.AccessToken, map[string]any{"birth_date": ""}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode, resp.Body)
+	var updated v1.User
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &updated))
+	s.Nil(updated.BirthDate)
+	s.Require().NotNil(updated.Locale)
+	s.Equal("de-DE", *updated.Locale)
+
+	// Invalid values are rejected
+	for _, body := range []map[string]any{
+		{"timezone": "Nowhere/City"},
+		{"locale": "not a locale"},
+		{"avatar_url": "ftp://example.com/a.png"},
+		{"birth_date": "1985-13-01"},
+	} {
+		resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+			casesteps.UpdateProfileHTTPRequest(reg.AccessToken, body))
+		s.Require().NoError(err)
+		s.Equal(stdhttp.StatusBadRequest, resp.StatusCode, "%v: %s", body, resp.Body)
+	}
+}
+
+func (s *Suite) TestProfileHTTP_UsernameLogin() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	other, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Act: set a username
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateProfileHTTPRequest(reg.AccessToken, map[string]any{"username": "Jane_Doe"}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode, resp.Body)
+	var updated v1.User
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &updated))
+	s.Require().NotNil(updated.Username)
+	s.Equal("jane_doe", *updated.Username)
+
+	// Assert: the username works as a login identifier
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.LoginHTTPRequest(map[string]any{"identifier": "Jane_Doe", "password": data.Password}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode, resp.Body)
+	var login v1.LoginResponse
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &login))
+	s.Equal(reg.User.ID, login.User.Id)
+
+	// A taken username is rejected
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateProfileHTTPRequest(other.AccessToken, map[string]any{"username": "JANE_DOE"}))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusBadRequest, resp.StatusCode, resp.Body)
+
+	// Reserved names are rejected
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.UpdateProfileHTTPRequest(other.AccessToken, map[string]any{"username": "support"}))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusBadRequest, resp.StatusCode, resp.Body)
+}
@@ -0,0 +1,127 @@
+// API LAYER TESTS
+// Tests for the rate limit middleware in front of the HTTP API
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"strconv"
+	"strings"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	httpmiddleware "github.com/Vi-72/quest-auth/internal/adapters/in/http/middleware"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/pkg/ratelimit"
+	domainhelpers "github.com/Vi-72/quest-auth/tests/domain"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+// rateLimitedRouter wraps the test router with the given limits (the test config has none)
+func (s *Suite) rateLimitedRouter(spec string) stdhttp.Handler {
+	rules, err := ratelimit.ParseRules(spec)
+	s.Require().NoError(err)
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), domainhelpers.NewMockClock(), rules)
+	accountKeys := commands.AccountKeys{
+		EmailRules: s.TestDIContainer.EmailCanonicalization,
+		PhoneRules: s.TestDIContainer.PhoneNumbering,
+	}
+	return httpmiddleware.NewRateLimitMiddleware(limiter, false, accountKeys).Limit(s.TestDIContainer.HTTPRouter)
+}
+
+func (s *Suite) TestRateLimitHTTP_LoginLimitedPerAccount() {
+	ctx := context.Background()
+	router := s.rateLimitedRouter("POST /api/v1/auth/login account 2/15m sliding_window")
+	data := testdatagenerators.RandomUserData()
+	login := map[string]any{"identifier": data.Email, "password": "wrong-password"}
+
+	// Pre-condition: the account has used its attempts
+	for i := 0; i < 2; i++ {
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, router, casesteps.LoginHTTPRequest(login))
+		s.Require().NoError(err)
+		s.Require().Equal(stdhttp.StatusUnauthorized, resp.StatusCode, "the handler must still see the body")
+		s.Equal(strconv.Itoa(1-i), resp.Headers.Get("X-RateLimit-Remaining"))
+	}
+
+	// Act: same account, different letter case
+	login["identifier"] = strings.ToUpper(data.Email)
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, router, casesteps.LoginHTTPRequest(login))
+
+	// Assert: 429 with the wait time in the body and in Retry-After
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusTooManyRequests, resp.StatusCode)
+	var problem v1.TooManyRequests
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &problem))
+	s.Equal("too-many-requests", problem.Type)
+	s.Greater(problem.RetryAfter, 0)
+	s.Equal(strconv.Itoa(problem.RetryAfter), resp.Headers.Get("Retry-After"))
+
+	// Another account is not affected
+	other := testdatagenerators.RandomUserData()
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, router,
+		casesteps.LoginHTTPRequest(map[string]any{"identifier": other.Email, "password": "wrong-password"}))
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+}
+
+func (s *Suite) TestRateLimitHTTP_EmailSpellingsShareAccountLimit() {
+	ctx := context.Background()
+	router := s.rateLimitedRouter("POST /api/v1/auth/login account 2/15m sliding_window")
+	local := "jane.doe"
+
+	// Pre-condition: the attempts are spent under the canonical spelling
+	for i := 0; i < 2; i++ {
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, router, casesteps.LoginHTTPRequest(
+			map[string]any{"identifier": local + "@gmail.com", "password": "wrong-password"}))
+		s.Require().NoError(err)
+		s.Require().Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+	}
+
+	// Act & Assert: the same mailbox with a +tag, without dots or under the alias domain is still limited
+	for _, identifier := range []string{
+		local + "+promo@gmail.com",
+		strings.ReplaceAll(local, ".", "") + "@gmail.com",
+		strings.ToUpper(local) + "@googlemail.com",
+	} {
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, router, casesteps.LoginHTTPRequest(
+			map[string]any{"identifier": identifier, "password": "wrong-password"}))
+		s.Require().NoError(err)
+		s.Equal(stdhttp.StatusTooManyRequests, resp.StatusCode, identifier)
+	}
+}
+
+func (s *Suite) TestRateLimitHTTP_PhoneFormatsShareAccountLimit() {
+	ctx := context.Background()
+	router := s.rateLimitedRouter("POST /api/v1/auth/login account 2/15m sliding_window")
+
+	// Pre-condition: the attempts are spent under the E.164 form
+	for i := 0; i < 2; i++ {
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, router, casesteps.LoginHTTPRequest(
+			map[string]any{"identifier": "+12015550123", "password": "wrong-password"}))
+		s.Require().NoError(err)
+		s.Require().Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+	}
+
+	// Act & Assert: the same number written with spaces, dashes, brackets or in the national form is still limited
+	for _, identifier := range []string{"+1 201-555-0123", "(201) 555-0123", "201.555.0123"} {
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, router, casesteps.LoginHTTPRequest(
+			map[string]any{"identifier": identifier, "password": "wrong-password"}))
+		s.Require().NoError(err)
+		s.Equal(stdhttp.StatusTooManyRequests, resp.StatusCode, identifier)
+	}
+}
+
+func (s *Suite) TestRateLimitHTTP_OtherRoutesNotLimited() {
+	ctx := context.Background()
+	router := s.rateLimitedRouter("POST /api/v1/auth/login route 1/1h")
+
+	for i := 0; i < 3; i++ {
+		resp, err := casesteps.ExecuteHTTPRequest(ctx, router,
+			casesteps.RegisterHTTPRequest(testdatagenerators.RandomUserData().ToRegisterHTTPRequest()))
+		s.Require().NoError(err)
+		s.Equal(stdhttp.StatusCreated, resp.StatusCode)
+		s.Empty(resp.Headers.Get("X-RateLimit-Limit"))
+	}
+}
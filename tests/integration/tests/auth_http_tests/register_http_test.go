@@ -6,6 +6,7 @@ package auth_http_tests
 import (
 	"context"
 
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
 	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
 	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
 	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
@@ -30,6 +31,25 @@ func (s *Suite) TestRegisterHTTP_Success() {
 	tokenAsserts.VerifyTokensPresent(reg.TokenType, reg.AccessToken, reg.RefreshToken, reg.ExpiresIn)
 }
 
+func (s *Suite) TestRegisterHTTP_PhoneNormalized() {
+	ctx := context.Background()
+	httpAsserts := assertions.NewAuthHTTPAssertions(s.Assert())
+
+	// Pre-condition: a mobile number typed with separators
+	body := testdatagenerators.RandomUserData().ToRegisterHTTPRequest()
+	body["phone"] = "+7 (999) 123-45-67"
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.RegisterHTTPRequest(body))
+
+	// Assert: stored and returned in E.164 with its type
+	reg := httpAsserts.RegisterHTTPCreatedSuccessfully(resp, err)
+	s.Require().NotNil(reg.User.Phone)
+	s.Equal("+79991234567", *reg.User.Phone)
+	s.Require().NotNil(reg.User.PhoneType)
+	s.Equal(v1.Mobile, *reg.User.PhoneType)
+}
+
 func (s *Suite) TestRegisterHTTP_Validation_EmptyBody() {
 	ctx := context.Background()
 	// Pre-condition: empty body
@@ -56,10 +76,10 @@ func (s *Suite) TestRegisterHTTP_Validation_EmptyFields() {
 	ctx := context.Background()
 	// Pre-condition: different empty field cases
 	cases := []map[string]any{
-		{"email": "", "phone": "+1234567890", "name": "A", "password": "securepassword123"},
+		{"email": "", "phone": "+12015550100", "name": "A", "password": "securepassword123"},
 		{"email": "user@example.com", "phone": "", "name": "A", "password": "securepassword123"},
-		{"email": "user@example.com", "phone": "+1234567890", "name": "", "password": "securepassword123"},
-		{"email": "user@example.com", "phone": "+1234567890", "name": "A", "password": ""},
+		{"email": "user@example.com", "phone": "+12015550100", "name": "", "password": "securepassword123"},
+		{"email": "user@example.com", "phone": "+12015550100", "name": "A", "password": ""},
 	}
 	for _, b := range cases {
 		// Act
@@ -0,0 +1,95 @@
+// API LAYER TESTS
+// Tests for POST /admin/users/{user_id}/status and POST /auth/refresh
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestUserStatusHTTP_BlockedUserIsRefused() {
+	ctx := context.Background()
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ChangeUserStatusHTTPRequest(testAdminAPIKey, reg.User.ID.String(),
+			map[string]any{"status": "blocked", "reason": "spam"}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusNoContent, resp.StatusCode)
+
+	// Assert: login, refresh and bearer-secured operations answer 403 with the status as problem type
+	for _, req := range []casesteps.HTTPRequest{
+		casesteps.LoginHTTPRequest(data.ToLoginHTTPRequest()),
+		casesteps.RefreshTokensHTTPRequest(map[string]any{"refresh_token": reg.RefreshToken}),
+		casesteps.ChangePasswordHTTPRequest(reg.AccessToken, map[string]any{
+			"current_password": data.Password,
+			"new_password":     "brandnewpassword1",
+		}),
+	} {
+		resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, req)
+		s.Require().NoError(err)
+		s.Require().Equal(stdhttp.StatusForbidden, resp.StatusCode, req.URL)
+		var problem v1.LoginForbidden
+		s.Require().NoError(json.Unmarshal([]byte(resp.Body), &problem))
+		s.Equal("account-blocked", problem.Type, req.URL)
+	}
+}
+
+func (s *Suite) TestUserStatusHTTP_Refresh() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	// Act
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RefreshTokensHTTPRequest(map[string]any{"refresh_token": reg.RefreshToken}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode)
+	var body v1.LoginResponse
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &body))
+	s.NotEmpty(body.AccessToken)
+	s.Equal(v1.Active, body.User.Status)
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.RefreshTokensHTTPRequest(map[string]any{"refresh_token": "invalid.jwt.token"}))
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "")
+}
+
+func (s *Suite) TestUserStatusHTTP_Validation() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+	userID := reg.User.ID.String()
+
+	// Unknown status is rejected by the OpenAPI schema
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ChangeUserStatusHTTPRequest(testAdminAPIKey, userID, map[string]any{"status": "banned"}))
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 400, "")
+
+	// Active account cannot be activated again
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ChangeUserStatusHTTPRequest(testAdminAPIKey, userID, map[string]any{"status": "active"}))
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 400, "")
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ChangeUserStatusHTTPRequest("wrong-key", userID, map[string]any{"status": "blocked"}))
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 401, "")
+
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.ChangeUserStatusHTTPRequest(testAdminAPIKey, uuid.New().String(), map[string]any{"status": "blocked"}))
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 404, "")
+}
@@ -0,0 +1,132 @@
+// API LAYER TESTS
+// Tests for POST /auth/webauthn/register/{begin,finish} and POST /auth/webauthn/login/{begin,finish}
+
+package auth_http_tests
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	stdhttp "net/http"
+
+	v1 "github.com/Vi-72/quest-auth/api/http/auth/v1"
+	"github.com/Vi-72/quest-auth/tests/integration/core/assertions"
+	casesteps "github.com/Vi-72/quest-auth/tests/integration/core/case_steps"
+	testdatagenerators "github.com/Vi-72/quest-auth/tests/integration/core/test_data_generators"
+)
+
+func (s *Suite) TestWebAuthnHTTP_RegisterAndLogin() {
+	ctx := context.Background()
+
+	// Pre-condition: registered user
+	data := testdatagenerators.RandomUserData()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, data)
+	s.Require().NoError(err)
+	authenticator := casesteps.NewAuthenticator(s.TestDIContainer.WebAuthnPolicy)
+
+	// Act: begin registration
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.BeginWebAuthnRegistrationHTTPRequest(reg.AccessToken))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode)
+	var creation v1.WebAuthnCreationOptions
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &creation))
+	s.Equal(s.TestDIContainer.WebAuthnPolicy.RelyingParty.ID, creation.Rp.Id)
+	s.Equal(data.Email, creation.User.Name)
+
+	// Act: finish registration
+	challenge, err := base64.RawURLEncoding.DecodeString(creation.Challenge)
+	s.Require().NoError(err)
+	userHandle, err := base64.RawURLEncoding.DecodeString(creation.User.Id)
+	s.Require().NoError(err)
+	registration, err := authenticator.Register(challenge, userHandle)
+	s.Require().NoError(err)
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.FinishWebAuthnRegistrationHTTPRequest(reg.AccessToken, map[string]any{
+			"name":       "Phone",
+			"credential": casesteps.AttestationJSON(registration),
+		}))
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusCreated, resp.StatusCode)
+	var cred v1.WebAuthnCredential
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &cred))
+	s.Equal("Phone", cred.Name)
+	s.Equal(base64.RawURLEncoding.EncodeToString(registration.CredentialID), cred.CredentialId)
+
+	// Act: begin login
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter, casesteps.BeginWebAuthnLoginHTTPRequest())
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode)
+	var request v1.WebAuthnRequestOptions
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &request))
+	s.Equal("required", request.UserVerification)
+
+	// Act: finish login
+	challenge, err = base64.RawURLEncoding.DecodeString(request.Challenge)
+	s.Require().NoError(err)
+	assertion, err := authenticator.Login(challenge, nil)
+	s.Require().NoError(err)
+	resp, err = casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.FinishWebAuthnLoginHTTPRequest(map[string]any{"credential": casesteps.AssertionJSON(assertion)}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(stdhttp.StatusOK, resp.StatusCode)
+	var login v1.LoginResponse
+	s.Require().NoError(json.Unmarshal([]byte(resp.Body), &login))
+	s.NotEmpty(login.AccessToken)
+	s.Equal(data.Email, login.User.Email)
+}
+
+func (s *Suite) TestWebAuthnHTTP_FinishLoginWithUnknownChallenge() {
+	ctx := context.Background()
+
+	// Pre-condition: user with a passkey
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+	authenticator := casesteps.NewAuthenticator(s.TestDIContainer.WebAuthnPolicy)
+	_, err = casesteps.RegisterPasskeyStep(ctx,
+		s.TestDIContainer.BeginWebAuthnRegistrationHandler, s.TestDIContainer.FinishWebAuthnRegistrationHandler,
+		authenticator, reg.User.ID, "")
+	s.Require().NoError(err)
+
+	// Act: sign a challenge the server never issued
+	assertion, err := authenticator.Login([]byte("forged-challenge"), nil)
+	s.Require().NoError(err)
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.FinishWebAuthnLoginHTTPRequest(map[string]any{"credential": casesteps.AssertionJSON(assertion)}))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+}
+
+func (s *Suite) TestWebAuthnHTTP_FinishRegistrationWithMalformedCredential() {
+	ctx := context.Background()
+	reg, err := casesteps.RegisterUserStepData(ctx, s.TestDIContainer.RegisterUserHandler, testdatagenerators.RandomUserData())
+	s.Require().NoError(err)
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.FinishWebAuthnRegistrationHTTPRequest(reg.AccessToken, map[string]any{
+			"credential": map[string]any{
+				"id":   "AAAA",
+				"type": "public-key",
+				"response": map[string]any{
+					"clientDataJSON":    "not base64url!",
+					"attestationObject": "AAAA",
+				},
+			},
+		}))
+
+	assertions.NewAuthHTTPAssertions(s.Assert()).HTTPErrorResponse(resp, err, 400, "base64url")
+}
+
+func (s *Suite) TestWebAuthnHTTP_BeginRegistrationRequiresAuthentication() {
+	ctx := context.Background()
+
+	resp, err := casesteps.ExecuteHTTPRequest(ctx, s.TestDIContainer.HTTPRouter,
+		casesteps.BeginWebAuthnRegistrationHTTPRequest("invalid"))
+
+	s.Require().NoError(err)
+	s.Equal(stdhttp.StatusUnauthorized, resp.StatusCode)
+}
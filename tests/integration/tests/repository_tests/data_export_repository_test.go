@@ -0,0 +1,74 @@
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for repository implementations and database interactions
+
+//go:build integration
+
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/dataexportrepo"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	"github.com/Vi-72/quest-auth/internal/pkg/errs"
+	domainhelpers "github.com/Vi-72/quest-auth/tests/domain"
+)
+
+func (s *Suite) TestDataExportRepository_CreateCompleteAndGet() {
+	// Pre-condition: queued export
+	repo := dataexportrepo.NewRepository(s.TestDIContainer.DB)
+	now := time.Now().Truncate(time.Microsecond)
+	export := auth.NewDataExport(uuid.New(), time.Hour, domainhelpers.FakeClockAt(now))
+	s.Require().NoError(repo.Create(&export))
+
+	pending, err := repo.ListPending(10)
+	s.Require().NoError(err)
+	s.Require().Len(pending, 1)
+	s.Equal(export.ID, pending[0].ID)
+
+	// Act: store the archive
+	s.Require().NoError(export.Complete([]byte(`{"events":[]}`), time.Hour, domainhelpers.FakeClockAt(now)))
+	s.Require().NoError(repo.Update(&export))
+
+	// Assert
+	found, err := repo.GetByID(export.ID)
+	s.Require().NoError(err)
+	s.Equal(auth.DataExportReady, found.Status)
+	s.JSONEq(`{"events":[]}`, string(found.Archive))
+	s.Require().NotNil(found.ReadyAt)
+	s.WithinDuration(now.Add(time.Hour), found.ExpiresAt, time.Millisecond)
+
+	latest, err := repo.GetLatest(export.UserID)
+	s.Require().NoError(err)
+	s.Equal(export.ID, latest.ID)
+
+	pending, err = repo.ListPending(10)
+	s.Require().NoError(err)
+	s.Empty(pending)
+}
+
+func (s *Suite) TestDataExportRepository_DeleteExpiredAndByUser() {
+	repo := dataexportrepo.NewRepository(s.TestDIContainer.DB)
+	now := time.Now()
+	expired := auth.NewDataExport(uuid.New(), time.Minute, domainhelpers.FakeClockAt(now.Add(-time.Hour)))
+	fresh := auth.NewDataExport(uuid.New(), time.Hour, domainhelpers.FakeClockAt(now))
+	s.Require().NoError(repo.Create(&expired))
+	s.Require().NoError(repo.Create(&fresh))
+
+	// Act
+	s.Require().NoError(repo.DeleteExpired(now))
+
+	// Assert
+	_, err := repo.GetByID(expired.ID)
+	var notFound *errs.NotFoundError
+	s.True(errors.As(err, &notFound))
+	_, err = repo.GetByID(fresh.ID)
+	s.Require().NoError(err)
+
+	s.Require().NoError(repo.DeleteByUser(fresh.UserID))
+	_, err = repo.GetLatest(fresh.UserID)
+	s.True(errors.As(err, &notFound))
+}
@@ -22,7 +22,7 @@ func (s *Suite) TestEventRepository_Publish_SavesEvent() {
 	repo := eventrepo.NewRepository(s.TestDIContainer.DB)
 
 	userID := uuid.New()
-	ev := auth.NewUserRegistered(userID, "repo-event@example.com", "+1234567890", time.Now())
+	ev := auth.NewUserRegistered(userID, "repo-event@example.com", "+12015550100", time.Now())
 
 	// Act: publish sync
 	err := repo.Publish(ctx, ev)
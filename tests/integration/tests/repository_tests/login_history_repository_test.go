@@ -0,0 +1,56 @@
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for repository implementations and database interactions
+
+//go:build integration
+
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/loginhistoryrepo"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	domainhelpers "github.com/Vi-72/quest-auth/tests/domain"
+)
+
+func (s *Suite) TestLoginHistoryRepository_ListRecent_NewestFirst() {
+	// Pre-condition: three logins of one user and one of another
+	repo := loginhistoryrepo.NewRepository(s.TestDIContainer.DB)
+	userID := uuid.New()
+	base := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	location := auth.Location{Country: "DE", City: "Berlin"}
+	for i, userAgent := range []string{"device-1", "device-2", "device-3"} {
+		record := auth.NewLoginRecord(
+			userID, userAgent, "203.0.113.7", location, domainhelpers.FakeClockAt(base.Add(time.Duration(i)*time.Minute)),
+		)
+		s.Require().NoError(repo.Add(&record))
+	}
+	other := auth.NewLoginRecord(uuid.New(), "device-1", "198.51.100.1", auth.Location{}, domainhelpers.FakeClockAt(base))
+	s.Require().NoError(repo.Add(&other))
+
+	// Act
+	records, err := repo.ListRecent(userID, 2)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(records, 2)
+	s.Equal("device-3", records[0].UserAgent)
+	s.Equal("device-2", records[1].UserAgent)
+	s.Equal(auth.HashDevice("device-3"), records[0].DeviceHash)
+	s.Equal("203.0.113.7", records[0].IP)
+	s.Equal("DE", records[0].Country)
+	s.Equal("Berlin", records[0].City)
+	s.Equal(userID, records[0].UserID)
+	s.WithinDuration(base.Add(2*time.Minute), records[0].CreatedAt, time.Millisecond)
+}
+
+func (s *Suite) TestLoginHistoryRepository_ListRecent_Empty() {
+	repo := loginhistoryrepo.NewRepository(s.TestDIContainer.DB)
+
+	records, err := repo.ListRecent(uuid.New(), 10)
+
+	s.Require().NoError(err)
+	s.Empty(records)
+}
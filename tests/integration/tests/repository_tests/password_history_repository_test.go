@@ -0,0 +1,63 @@
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for repository implementations and database interactions
+
+//go:build integration
+
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/passwordhistoryrepo"
+)
+
+func (s *Suite) TestPasswordHistoryRepository_GetRecent_NewestFirst() {
+	// Pre-condition: three entries for one user
+	repo := passwordhistoryrepo.NewRepository(s.TestDIContainer.DB)
+	userID := uuid.New()
+	base := time.Now().Add(-time.Hour)
+	s.Require().NoError(repo.Add(userID, "hash-1", base))
+	s.Require().NoError(repo.Add(userID, "hash-2", base.Add(time.Minute)))
+	s.Require().NoError(repo.Add(userID, "hash-3", base.Add(2*time.Minute)))
+	s.Require().NoError(repo.Add(uuid.New(), "other-user", base))
+
+	// Act
+	hashes, err := repo.GetRecent(userID, 2, time.Time{})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal([]string{"hash-3", "hash-2"}, hashes)
+}
+
+func (s *Suite) TestPasswordHistoryRepository_GetRecent_Since() {
+	repo := passwordhistoryrepo.NewRepository(s.TestDIContainer.DB)
+	userID := uuid.New()
+	now := time.Now()
+	s.Require().NoError(repo.Add(userID, "old", now.Add(-48*time.Hour)))
+	s.Require().NoError(repo.Add(userID, "fresh", now.Add(-time.Hour)))
+
+	hashes, err := repo.GetRecent(userID, 10, now.Add(-24*time.Hour))
+
+	s.Require().NoError(err)
+	s.Equal([]string{"fresh"}, hashes)
+}
+
+func (s *Suite) TestPasswordHistoryRepository_Prune() {
+	repo := passwordhistoryrepo.NewRepository(s.TestDIContainer.DB)
+	userID := uuid.New()
+	now := time.Now()
+	s.Require().NoError(repo.Add(userID, "expired", now.Add(-72*time.Hour)))
+	s.Require().NoError(repo.Add(userID, "hash-1", now.Add(-3*time.Minute)))
+	s.Require().NoError(repo.Add(userID, "hash-2", now.Add(-2*time.Minute)))
+	s.Require().NoError(repo.Add(userID, "hash-3", now.Add(-time.Minute)))
+
+	// Act: keep two newest, drop everything older than a day
+	s.Require().NoError(repo.Prune(userID, 2, now.Add(-24*time.Hour)))
+
+	// Assert
+	hashes, err := repo.GetRecent(userID, 10, time.Time{})
+	s.Require().NoError(err)
+	s.Equal([]string{"hash-3", "hash-2"}, hashes)
+}
@@ -0,0 +1,76 @@
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for repository implementations and database interactions
+
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/ratelimitrepo"
+	"github.com/Vi-72/quest-auth/internal/pkg/ratelimit"
+	domainhelpers "github.com/Vi-72/quest-auth/tests/domain"
+)
+
+func (s *Suite) newRateLimiter(route string, at time.Time) *ratelimit.Limiter {
+	rules, err := ratelimit.ParseRules(route + " ip 2/1m")
+	s.Require().NoError(err)
+	return ratelimit.NewLimiter(ratelimitrepo.NewStore(s.TestDIContainer.DB), domainhelpers.FakeClockAt(at), rules)
+}
+
+func (s *Suite) TestRateLimitStore_CountsAcrossInstances() {
+	ctx := context.Background()
+	route := "POST /" + uuid.NewString()
+	now := time.Now()
+	keys := map[ratelimit.KeyKind]string{ratelimit.KeyIP: "203.0.113.7"}
+
+	// Pre-condition: the budget is used up through one store instance
+	first := s.newRateLimiter(route, now)
+	for i := 0; i < 2; i++ {
+		result, err := first.Allow(ctx, route, keys)
+		s.Require().NoError(err)
+		s.Require().True(result.Allowed)
+	}
+
+	// Act: another instance (another replica) shares the counters
+	result, err := s.newRateLimiter(route, now).Allow(ctx, route, keys)
+
+	// Assert
+	s.Require().NoError(err)
+	s.False(result.Allowed)
+	s.Greater(result.RetryAfter, time.Duration(0))
+
+	// Another IP has its own budget
+	result, err = first.Allow(ctx, route, map[ratelimit.KeyKind]string{ratelimit.KeyIP: "203.0.113.8"})
+	s.Require().NoError(err)
+	s.True(result.Allowed)
+
+	var rows []ratelimitrepo.RateLimitDTO
+	s.Require().NoError(s.TestDIContainer.DB.Where("key LIKE ?", "%"+route+"%").Find(&rows).Error)
+	s.Len(rows, 2)
+}
+
+func (s *Suite) TestRateLimitStore_RefillsAfterPeriod() {
+	ctx := context.Background()
+	route := "POST /" + uuid.NewString()
+	now := time.Now()
+	keys := map[ratelimit.KeyKind]string{ratelimit.KeyIP: "203.0.113.7"}
+
+	limiter := s.newRateLimiter(route, now)
+	for i := 0; i < 3; i++ {
+		_, err := limiter.Allow(ctx, route, keys)
+		s.Require().NoError(err)
+	}
+
+	// Act: a full period later the bucket is full again
+	result, err := s.newRateLimiter(route, now.Add(time.Minute)).Allow(ctx, route, keys)
+
+	// Assert
+	s.Require().NoError(err)
+	s.True(result.Allowed)
+	s.Equal(1, result.Remaining)
+}
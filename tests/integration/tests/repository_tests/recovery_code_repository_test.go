@@ -0,0 +1,67 @@
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for repository implementations and database interactions
+
+//go:build integration
+
+package repository
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/recoverycoderepo"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	domainhelpers "github.com/Vi-72/quest-auth/tests/domain"
+)
+
+func (s *Suite) TestRecoveryCodeRepository_Replace_And_GetUnused() {
+	// Pre-condition: issued codes
+	repo := recoverycoderepo.NewRepository(s.TestDIContainer.DB)
+	userID := uuid.New()
+	codes, raw, err := auth.NewRecoveryCodes(userID, 3, domainhelpers.NewMockClock())
+	s.Require().NoError(err)
+
+	// Act
+	s.Require().NoError(repo.Replace(userID, codes))
+	found, err := repo.GetUnused(userID, auth.HashRecoveryCode(raw[1]))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(codes[1].ID, found.ID)
+	s.Nil(found.UsedAt)
+
+	_, err = repo.GetUnused(uuid.New(), auth.HashRecoveryCode(raw[1]))
+	s.Require().Error(err, "codes of another user must not match")
+}
+
+func (s *Suite) TestRecoveryCodeRepository_Replace_DropsOldCodes() {
+	repo := recoverycoderepo.NewRepository(s.TestDIContainer.DB)
+	userID := uuid.New()
+	oldCodes, oldRaw, err := auth.NewRecoveryCodes(userID, 2, domainhelpers.NewMockClock())
+	s.Require().NoError(err)
+	s.Require().NoError(repo.Replace(userID, oldCodes))
+	newCodes, _, err := auth.NewRecoveryCodes(userID, 2, domainhelpers.NewMockClock())
+	s.Require().NoError(err)
+
+	// Act
+	s.Require().NoError(repo.Replace(userID, newCodes))
+
+	// Assert
+	_, err = repo.GetUnused(userID, auth.HashRecoveryCode(oldRaw[0]))
+	s.Require().Error(err)
+}
+
+func (s *Suite) TestRecoveryCodeRepository_Update_HidesUsedCode() {
+	repo := recoverycoderepo.NewRepository(s.TestDIContainer.DB)
+	userID := uuid.New()
+	codes, raw, err := auth.NewRecoveryCodes(userID, 1, domainhelpers.NewMockClock())
+	s.Require().NoError(err)
+	s.Require().NoError(repo.Replace(userID, codes))
+
+	// Act
+	s.Require().NoError(codes[0].Use(domainhelpers.NewMockClock()))
+	s.Require().NoError(repo.Update(&codes[0]))
+
+	// Assert
+	_, err = repo.GetUnused(userID, auth.HashRecoveryCode(raw[0]))
+	s.Require().Error(err)
+}
@@ -6,6 +6,11 @@
 package repository
 
 import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/userrepo"
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
 	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
 	domainhelpers "github.com/Vi-72/quest-auth/tests/domain"
@@ -14,7 +19,7 @@ import (
 func (s *Suite) TestUserRepository_Create_And_GetByID() {
 	// Pre-condition: build user aggregate
 	email, _ := kernel.NewEmail("user.repo1@example.com")
-	phone, _ := kernel.NewPhone("+1234567890")
+	phone, _ := kernel.NewPhone("+12015550100")
 
 	hasher := domainhelpers.NewMockPasswordHasher()
 	clock := domainhelpers.NewMockClock()
@@ -33,12 +38,13 @@ func (s *Suite) TestUserRepository_Create_And_GetByID() {
 	s.Equal(u.Email.String(), found.Email.String())
 	s.Equal(u.Phone.String(), found.Phone.String())
 	s.Equal(u.Name, found.Name)
+	s.Equal(auth.UserStatusActive, found.Status)
 }
 
 func (s *Suite) TestUserRepository_GetByEmail_And_Phone() {
 	// Pre-condition: existing user
 	email, _ := kernel.NewEmail("user.repo2@example.com")
-	phone, _ := kernel.NewPhone("+1234567891")
+	phone, _ := kernel.NewPhone("+12015550101")
 	hasher := domainhelpers.NewMockPasswordHasher()
 	clock := domainhelpers.NewMockClock()
 	u, err := auth.NewUser(email, phone, "Repo User 2", "securepassword123", hasher, clock)
@@ -59,7 +65,7 @@ func (s *Suite) TestUserRepository_GetByEmail_And_Phone() {
 func (s *Suite) TestUserRepository_Update() {
 	// Pre-condition: existing user
 	email, _ := kernel.NewEmail("user.repo3@example.com")
-	phone, _ := kernel.NewPhone("+1234567892")
+	phone, _ := kernel.NewPhone("+12015550102")
 	hasher := domainhelpers.NewMockPasswordHasher()
 	clock := domainhelpers.NewMockClock()
 	u, err := auth.NewUser(email, phone, "Repo User 3", "securepassword123", hasher, clock)
@@ -68,7 +74,7 @@ func (s *Suite) TestUserRepository_Update() {
 
 	// Act: update fields
 	_ = u.ChangeName("Updated Name", clock)
-	newPhone, _ := kernel.NewPhone("+19991234567")
+	newPhone, _ := kernel.NewPhone("+12125550199")
 	u.ChangePhone(newPhone, clock)
 
 	s.Require().NoError(s.TestDIContainer.UserRepository.Update(&u))
@@ -80,10 +86,102 @@ func (s *Suite) TestUserRepository_Update() {
 	s.Equal(newPhone.String(), found.Phone.String())
 }
 
+func (s *Suite) TestUserRepository_Update_Status() {
+	// Pre-condition: existing user
+	email, _ := kernel.NewEmail("user.repo.status@example.com")
+	phone, _ := kernel.NewPhone("+12015550109")
+	hasher := domainhelpers.NewMockPasswordHasher()
+	clock := domainhelpers.NewMockClock()
+	u, err := auth.NewUser(email, phone, "Repo User Status", "securepassword123", hasher, clock)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.UserRepository.Create(&u))
+
+	// Act: suspend
+	s.Require().NoError(u.Suspend("review", clock))
+	s.Require().NoError(s.TestDIContainer.UserRepository.Update(&u))
+
+	// Assert: status, reason and time are persisted
+	found, err := s.TestDIContainer.UserRepository.GetByID(u.ID())
+	s.Require().NoError(err)
+	s.Equal(auth.UserStatusSuspended, found.Status)
+	s.Equal("review", found.StatusReason)
+	s.NotNil(found.StatusChangedAt)
+}
+
+func (s *Suite) TestUserRepository_Update_ProfileAndMetadata() {
+	// Pre-condition: existing user without profile attributes
+	email, _ := kernel.NewEmail("user.repo.profile@example.com")
+	phone, _ := kernel.NewPhone("+12015550108")
+	hasher := domainhelpers.NewMockPasswordHasher()
+	clock := domainhelpers.NewMockClock()
+	u, err := auth.NewUser(email, phone, "Repo User Profile", "securepassword123", hasher, clock)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.UserRepository.Create(&u))
+
+	found, err := s.TestDIContainer.UserRepository.GetByID(u.ID())
+	s.Require().NoError(err)
+	s.Equal(auth.Profile{}, found.Profile)
+	s.Empty(found.Metadata)
+
+	// Act: set profile and metadata
+	profile, err := auth.NewProfile("pt-BR", "America/Sao_Paulo", "https://cdn.example.com/p.png", "1992-07-15", time.Now())
+	s.Require().NoError(err)
+	u.ChangeProfile(profile, clock)
+	s.Require().NoError(u.ChangeMetadata(map[string]any{"plan": "pro", "tags": []any{"a", "b"}}, clock))
+	s.Require().NoError(s.TestDIContainer.UserRepository.Update(&u))
+
+	// Assert: values survive the round-trip
+	found, err = s.TestDIContainer.UserRepository.GetByID(u.ID())
+	s.Require().NoError(err)
+	s.Equal("pt-BR", found.Profile.Locale)
+	s.Equal("America/Sao_Paulo", found.Profile.Timezone)
+	s.Equal("https://cdn.example.com/p.png", found.Profile.AvatarURL)
+	s.Equal("1992-07-15", found.Profile.BirthDateString())
+	s.Equal(map[string]any{"plan": "pro", "tags": []any{"a", "b"}}, found.Metadata)
+}
+
+func (s *Suite) TestUserRepository_Username() {
+	// Pre-condition: two users, one with a username
+	hasher := domainhelpers.NewMockPasswordHasher()
+	clock := domainhelpers.NewMockClock()
+	email, _ := kernel.NewEmail("user.repo.username@example.com")
+	phone, _ := kernel.NewPhone("+12015550107")
+	u, err := auth.NewUser(email, phone, "Repo User Username", "securepassword123", hasher, clock)
+	s.Require().NoError(err)
+	username, _ := kernel.NewUsername("repo.user")
+	u.ChangeUsername(username, clock)
+	s.Require().NoError(s.TestDIContainer.UserRepository.Create(&u))
+
+	otherEmail, _ := kernel.NewEmail("user.repo.nousername@example.com")
+	otherPhone, _ := kernel.NewPhone("+12015550106")
+	other, err := auth.NewUser(otherEmail, otherPhone, "Repo User Without Username", "securepassword123", hasher, clock)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.UserRepository.Create(&other))
+
+	// Act & Assert: lookup by username
+	found, err := s.TestDIContainer.UserRepository.GetByUsername(username)
+	s.Require().NoError(err)
+	s.Equal(u.ID(), found.ID())
+	s.Equal(username, found.Username)
+
+	exists, err := s.TestDIContainer.UserRepository.UsernameExists(username)
+	s.Require().NoError(err)
+	s.True(exists)
+
+	// Users without a username have none
+	found, err = s.TestDIContainer.UserRepository.GetByID(other.ID())
+	s.Require().NoError(err)
+	s.True(found.Username.IsZero())
+
+	// The unique index rejects a duplicate
+	other.ChangeUsername(username, clock)
+	s.Error(s.TestDIContainer.UserRepository.Update(&other))
+}
+
 func (s *Suite) TestUserRepository_Delete() {
 	// Pre-condition: existing user
 	email, _ := kernel.NewEmail("user.repo4@example.com")
-	phone, _ := kernel.NewPhone("+1234567893")
+	phone, _ := kernel.NewPhone("+12015550103")
 	hasher := domainhelpers.NewMockPasswordHasher()
 	clock := domainhelpers.NewMockClock()
 	u, err := auth.NewUser(email, phone, "Repo User 4", "securepassword123", hasher, clock)
@@ -98,10 +196,52 @@ func (s *Suite) TestUserRepository_Delete() {
 	s.Require().Error(err)
 }
 
+func (s *Suite) TestUserRepository_SoftDelete_ListAndPurge() {
+	// Pre-condition: user marked deleted by the domain
+	email, _ := kernel.NewEmail("user.repo6@example.com")
+	phone, _ := kernel.NewPhone("+12015550105")
+	hasher := domainhelpers.NewMockPasswordHasher()
+	clock := domainhelpers.NewMockClock()
+	u, err := auth.NewUser(email, phone, "Repo User 6", "securepassword123", hasher, clock)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.UserRepository.Create(&u))
+	s.Require().NoError(u.Delete(clock))
+
+	// Act: persist deletion
+	s.Require().NoError(s.TestDIContainer.UserRepository.Update(&u))
+
+	// Assert: hidden from lookups, identifiers still reserved
+	_, err = s.TestDIContainer.UserRepository.GetByID(u.ID())
+	s.Require().Error(err)
+	_, err = s.TestDIContainer.UserRepository.GetByEmail(email)
+	s.Require().Error(err)
+	exists, err := s.TestDIContainer.UserRepository.EmailExists(email)
+	s.Require().NoError(err)
+	s.True(exists)
+
+	// Listed only once the retention period has passed
+	ids, err := s.TestDIContainer.UserRepository.ListDeletedBefore(u.DeletedAt.Add(-time.Second), 10)
+	s.Require().NoError(err)
+	s.Empty(ids)
+	ids, err = s.TestDIContainer.UserRepository.ListDeletedBefore(u.DeletedAt.Add(time.Second), 10)
+	s.Require().NoError(err)
+	s.Equal([]uuid.UUID{u.ID()}, ids)
+
+	// Act: purge
+	s.Require().NoError(s.TestDIContainer.UserRepository.Purge(u.ID()))
+
+	ids, err = s.TestDIContainer.UserRepository.ListDeletedBefore(u.DeletedAt.Add(time.Second), 10)
+	s.Require().NoError(err)
+	s.Empty(ids)
+	exists, err = s.TestDIContainer.UserRepository.EmailExists(email)
+	s.Require().NoError(err)
+	s.False(exists)
+}
+
 func (s *Suite) TestUserRepository_EmailAndPhoneExists() {
 	// Pre-condition: existing user
 	email, _ := kernel.NewEmail("user.repo5@example.com")
-	phone, _ := kernel.NewPhone("+1234567894")
+	phone, _ := kernel.NewPhone("+12015550104")
 	hasher := domainhelpers.NewMockPasswordHasher()
 	clock := domainhelpers.NewMockClock()
 	u, err := auth.NewUser(email, phone, "Repo User 5", "securepassword123", hasher, clock)
@@ -118,9 +258,96 @@ func (s *Suite) TestUserRepository_EmailAndPhoneExists() {
 	s.True(existsPhone)
 
 	otherEmail, _ := kernel.NewEmail("other@example.com")
-	otherPhone, _ := kernel.NewPhone("+15551234567")
+	otherPhone, _ := kernel.NewPhone("+14155550123")
 	notEmail, _ := s.TestDIContainer.UserRepository.EmailExists(otherEmail)
 	notPhone, _ := s.TestDIContainer.UserRepository.PhoneExists(otherPhone)
 	s.False(notEmail)
 	s.False(notPhone)
 }
+
+func (s *Suite) TestUserRepository_CanonicalEmail() {
+	// Pre-condition: a user stored before plus tags were ignored
+	hasher := domainhelpers.NewMockPasswordHasher()
+	clock := domainhelpers.NewMockClock()
+	email, _ := kernel.NewEmail("Repo.Canonical+old@example.com")
+	phone, _ := kernel.NewPhone("+12015550105")
+	u, err := auth.NewUser(email, phone, "Repo Canonical", "securepassword123", hasher, clock)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.UserRepository.Create(&u))
+
+	rules := kernel.EmailCanonicalization{StripPlusTags: true}
+	variant, _ := rules.NewEmail("repo.canonical@example.com")
+	exists, err := s.TestDIContainer.UserRepository.EmailExists(variant)
+	s.Require().NoError(err)
+	s.False(exists, "the stored canonical form still has the tag")
+
+	// Act: recompute canonical forms under the new rules
+	updated, err := userrepo.CanonicalizeEmails(s.TestDIContainer.DB, rules)
+	s.Require().NoError(err)
+	s.Equal(1, updated)
+
+	// Assert: the variant finds the user, the display form is kept
+	found, err := s.TestDIContainer.UserRepository.GetByEmail(variant)
+	s.Require().NoError(err)
+	s.Equal(u.ID(), found.ID())
+	s.Equal("Repo.Canonical+old@example.com", found.Email.String())
+	s.Equal("repo.canonical@example.com", found.Email.Canonical())
+
+	// A second run has nothing to do
+	updated, err = userrepo.CanonicalizeEmails(s.TestDIContainer.DB, rules)
+	s.Require().NoError(err)
+	s.Zero(updated)
+}
+
+func (s *Suite) TestUserRepository_CanonicalEmailIsUnique() {
+	// Pre-condition: a user; the second one skips the EmailExists check, as a concurrent request would
+	hasher := domainhelpers.NewMockPasswordHasher()
+	clock := domainhelpers.NewMockClock()
+	email, _ := kernel.NewEmail("Repo.Unique@example.com")
+	phone, _ := kernel.NewPhone("+12015550106")
+	u, err := auth.NewUser(email, phone, "Repo Unique", "securepassword123", hasher, clock)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.UserRepository.Create(&u))
+
+	variant, _ := kernel.NewEmail("repo.unique@example.com")
+	otherPhone, _ := kernel.NewPhone("+12015550107")
+	dup, err := auth.NewUser(variant, otherPhone, "Repo Unique 2", "securepassword123", hasher, clock)
+	s.Require().NoError(err)
+
+	// Act
+	err = s.TestDIContainer.UserRepository.Create(&dup)
+
+	// Assert: the unique index rejects the same mailbox in another case
+	s.Require().ErrorIs(err, auth.ErrEmailTaken)
+}
+
+func (s *Suite) TestUserRepository_CanonicalizeEmailsReportsCollisions() {
+	// Pre-condition: two accounts that differ only by a plus tag
+	hasher := domainhelpers.NewMockPasswordHasher()
+	clock := domainhelpers.NewMockClock()
+	first, _ := kernel.NewEmail("repo.collide@example.com")
+	second, _ := kernel.NewEmail("repo.collide+work@example.com")
+	phone1, _ := kernel.NewPhone("+12015550108")
+	phone2, _ := kernel.NewPhone("+12015550109")
+	u1, err := auth.NewUser(first, phone1, "Repo Collide", "securepassword123", hasher, clock)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.UserRepository.Create(&u1))
+	u2, err := auth.NewUser(second, phone2, "Repo Collide 2", "securepassword123", hasher, clock)
+	s.Require().NoError(err)
+	s.Require().NoError(s.TestDIContainer.UserRepository.Create(&u2))
+
+	// Act: rules under which both are the same mailbox
+	rules := kernel.EmailCanonicalization{StripPlusTags: true}
+	updated, err := userrepo.CanonicalizeEmails(s.TestDIContainer.DB, rules)
+
+	// Assert: the collision is reported and nothing is changed
+	var collisions *userrepo.EmailCollisionError
+	s.Require().ErrorAs(err, &collisions)
+	s.Zero(updated)
+	s.ElementsMatch([]uuid.UUID{u1.ID(), u2.ID()}, collisions.Collisions["repo.collide@example.com"])
+
+	found, err := s.TestDIContainer.UserRepository.GetByEmail(second)
+	s.Require().NoError(err)
+	s.Equal(u2.ID(), found.ID())
+	s.Equal("repo.collide+work@example.com", found.Email.Canonical())
+}
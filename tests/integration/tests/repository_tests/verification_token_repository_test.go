@@ -0,0 +1,111 @@
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for repository implementations and database interactions
+
+//go:build integration
+
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/verificationtokenrepo"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+	domainhelpers "github.com/Vi-72/quest-auth/tests/domain"
+)
+
+func (s *Suite) TestVerificationTokenRepository_Create_And_GetBySecretHash() {
+	// Pre-condition: issued token
+	repo := verificationtokenrepo.NewRepository(s.TestDIContainer.DB)
+	token, secret, err := auth.NewVerificationToken(uuid.New(), auth.VerificationPurposeEmail, "token.repo1@example.com", time.Hour, domainhelpers.NewMockClock())
+	s.Require().NoError(err)
+
+	// Act
+	s.Require().NoError(repo.Create(&token))
+	found, err := repo.GetBySecretHash(auth.VerificationPurposeEmail, auth.HashVerificationSecret(secret))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(token.ID, found.ID)
+	s.Equal(token.UserID, found.UserID)
+	s.Equal("token.repo1@example.com", found.Target)
+	s.Nil(found.UsedAt)
+}
+
+func (s *Suite) TestVerificationTokenRepository_GetBySecretHash_WrongPurpose() {
+	repo := verificationtokenrepo.NewRepository(s.TestDIContainer.DB)
+	token, secret, err := auth.NewVerificationToken(uuid.New(), auth.VerificationPurposeEmail, "token.repo2@example.com", time.Hour, domainhelpers.NewMockClock())
+	s.Require().NoError(err)
+	s.Require().NoError(repo.Create(&token))
+
+	_, err = repo.GetBySecretHash(auth.VerificationPurpose("other"), auth.HashVerificationSecret(secret))
+	s.Require().Error(err)
+}
+
+func (s *Suite) TestVerificationTokenRepository_Update_PersistsUsedAt() {
+	repo := verificationtokenrepo.NewRepository(s.TestDIContainer.DB)
+	token, secret, err := auth.NewVerificationToken(uuid.New(), auth.VerificationPurposeEmail, "token.repo3@example.com", time.Hour, domainhelpers.NewMockClock())
+	s.Require().NoError(err)
+	s.Require().NoError(repo.Create(&token))
+
+	// Act
+	s.Require().NoError(token.Consume(domainhelpers.NewMockClock()))
+	s.Require().NoError(repo.Update(&token))
+
+	// Assert
+	found, err := repo.GetBySecretHash(auth.VerificationPurposeEmail, auth.HashVerificationSecret(secret))
+	s.Require().NoError(err)
+	s.NotNil(found.UsedAt)
+}
+
+func (s *Suite) TestVerificationTokenRepository_InvalidateActive() {
+	repo := verificationtokenrepo.NewRepository(s.TestDIContainer.DB)
+	userID := uuid.New()
+	first, firstSecret, err := auth.NewVerificationToken(userID, auth.VerificationPurposeEmail, "token.repo4@example.com", time.Hour, domainhelpers.NewMockClock())
+	s.Require().NoError(err)
+	other, otherSecret, err := auth.NewVerificationToken(uuid.New(), auth.VerificationPurposeEmail, "token.repo5@example.com", time.Hour, domainhelpers.NewMockClock())
+	s.Require().NoError(err)
+	s.Require().NoError(repo.Create(&first))
+	s.Require().NoError(repo.Create(&other))
+
+	// Act
+	s.Require().NoError(repo.InvalidateActive(userID, auth.VerificationPurposeEmail, time.Now()))
+
+	// Assert: only the user's token is invalidated
+	found, err := repo.GetBySecretHash(auth.VerificationPurposeEmail, auth.HashVerificationSecret(firstSecret))
+	s.Require().NoError(err)
+	s.NotNil(found.UsedAt)
+
+	untouched, err := repo.GetBySecretHash(auth.VerificationPurposeEmail, auth.HashVerificationSecret(otherSecret))
+	s.Require().NoError(err)
+	s.Nil(untouched.UsedAt)
+}
+
+func (s *Suite) TestVerificationTokenRepository_GetLatest_And_Attempts() {
+	repo := verificationtokenrepo.NewRepository(s.TestDIContainer.DB)
+	userID := uuid.New()
+	now := time.Now()
+
+	older, _, err := auth.NewVerificationCode(userID, auth.VerificationPurposePhone, "+12015550100", time.Hour,
+		domainhelpers.NewMockPasswordHasher(), domainhelpers.FakeClockAt(now.Add(-time.Minute)))
+	s.Require().NoError(err)
+	s.Require().NoError(repo.Create(&older))
+	latest, _, err := auth.NewVerificationCode(userID, auth.VerificationPurposePhone, "+12015550100", time.Hour,
+		domainhelpers.NewMockPasswordHasher(), domainhelpers.FakeClockAt(now))
+	s.Require().NoError(err)
+	s.Require().NoError(repo.Create(&latest))
+
+	// Act
+	latest.Attempts = 2
+	s.Require().NoError(repo.Update(&latest))
+	found, err := repo.GetLatest(userID, auth.VerificationPurposePhone)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(latest.ID, found.ID)
+	s.Equal(2, found.Attempts)
+
+	_, err = repo.GetLatest(userID, auth.VerificationPurposeEmail)
+	s.Require().Error(err)
+}
@@ -0,0 +1,99 @@
+// REPOSITORY LAYER INTEGRATION TESTS
+// Tests for repository implementations and database interactions
+
+//go:build integration
+
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/webauthncredentialrepo"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/auth"
+)
+
+func newWebAuthnCredential(userID uuid.UUID, createdAt time.Time) auth.WebAuthnCredential {
+	return auth.WebAuthnCredential{
+		ID:           uuid.New(),
+		UserID:       userID,
+		CredentialID: []byte(uuid.NewString()),
+		PublicKey:    []byte("cose-key"),
+		Name:         auth.DefaultWebAuthnCredentialName,
+		CreatedAt:    createdAt.UTC().Truncate(time.Microsecond),
+	}
+}
+
+func (s *Suite) TestWebAuthnCredentialRepository_Create_And_GetByCredentialID() {
+	repo := webauthncredentialrepo.NewRepository(s.TestDIContainer.DB)
+	cred := newWebAuthnCredential(uuid.New(), time.Now())
+
+	// Act
+	s.Require().NoError(repo.Create(&cred))
+	found, err := repo.GetByCredentialID(cred.CredentialID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Equal(cred.ID, found.ID)
+	s.Equal(cred.UserID, found.UserID)
+	s.Equal(cred.PublicKey, found.PublicKey)
+	s.Nil(found.LastUsedAt)
+
+	_, err = repo.GetByCredentialID([]byte("unknown"))
+	s.Require().Error(err)
+}
+
+func (s *Suite) TestWebAuthnCredentialRepository_Create_DuplicateCredentialID() {
+	repo := webauthncredentialrepo.NewRepository(s.TestDIContainer.DB)
+	first := newWebAuthnCredential(uuid.New(), time.Now())
+	s.Require().NoError(repo.Create(&first))
+
+	// Act: same authenticator credential for another user
+	second := newWebAuthnCredential(uuid.New(), time.Now())
+	second.CredentialID = first.CredentialID
+	err := repo.Create(&second)
+
+	// Assert
+	s.Require().Error(err)
+}
+
+func (s *Suite) TestWebAuthnCredentialRepository_ListByUser() {
+	repo := webauthncredentialrepo.NewRepository(s.TestDIContainer.DB)
+	userID := uuid.New()
+	now := time.Now()
+	older := newWebAuthnCredential(userID, now.Add(-time.Hour))
+	newer := newWebAuthnCredential(userID, now)
+	foreign := newWebAuthnCredential(uuid.New(), now)
+	for _, cred := range []*auth.WebAuthnCredential{&newer, &older, &foreign} {
+		s.Require().NoError(repo.Create(cred))
+	}
+
+	// Act
+	creds, err := repo.ListByUser(userID)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(creds, 2)
+	s.Equal(older.ID, creds[0].ID)
+	s.Equal(newer.ID, creds[1].ID)
+}
+
+func (s *Suite) TestWebAuthnCredentialRepository_Update_StoresSignCount() {
+	repo := webauthncredentialrepo.NewRepository(s.TestDIContainer.DB)
+	cred := newWebAuthnCredential(uuid.New(), time.Now())
+	s.Require().NoError(repo.Create(&cred))
+
+	// Act
+	usedAt := time.Now().UTC().Truncate(time.Microsecond)
+	cred.SignCount = 42
+	cred.LastUsedAt = &usedAt
+	s.Require().NoError(repo.Update(&cred))
+
+	// Assert
+	found, err := repo.GetByCredentialID(cred.CredentialID)
+	s.Require().NoError(err)
+	s.Equal(uint32(42), found.SignCount)
+	s.Require().NotNil(found.LastUsedAt)
+	s.True(usedAt.Equal(*found.LastUsedAt))
+}
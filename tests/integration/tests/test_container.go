@@ -8,12 +8,18 @@ import (
 
 	"github.com/Vi-72/quest-auth/cmd"
 	bcryptadapter "github.com/Vi-72/quest-auth/internal/adapters/out/bcrypt"
+	emailadapter "github.com/Vi-72/quest-auth/internal/adapters/out/email"
+	jsonschemaadapter "github.com/Vi-72/quest-auth/internal/adapters/out/jsonschema"
 	"github.com/Vi-72/quest-auth/internal/adapters/out/jwt"
 	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres"
 	"github.com/Vi-72/quest-auth/internal/adapters/out/postgres/userrepo"
+	smsadapter "github.com/Vi-72/quest-auth/internal/adapters/out/sms"
 	timeadapter "github.com/Vi-72/quest-auth/internal/adapters/out/time"
 	"github.com/Vi-72/quest-auth/internal/core/application/usecases/commands"
+	"github.com/Vi-72/quest-auth/internal/core/application/usecases/queries"
+	"github.com/Vi-72/quest-auth/internal/core/domain/model/kernel"
 	"github.com/Vi-72/quest-auth/internal/core/ports"
+	"github.com/Vi-72/quest-auth/internal/pkg/webauthn"
 	stor "github.com/Vi-72/quest-auth/tests/integration/core/storage"
 
 	"gorm.io/gorm"
@@ -34,6 +40,54 @@ func getTestConfig() cmd.Config {
 		JWTSecretKey:            getTestEnv("JWT_SECRET_KEY", "test-secret-key-for-testing-only"),
 		JWTAccessTokenDuration:  1,  // 1 minute for tests
 		JWTRefreshTokenDuration: 24, // 24 hours for tests
+
+		PasswordHistoryDepth:      3,
+		PasswordHistoryMaxAgeDays: 365,
+
+		PasswordMaxAgeDays:            0,
+		PasswordChangeTokenTTLMinutes: 10,
+
+		AdminAPIKey: getTestEnv("ADMIN_API_KEY", "test-admin-api-key"),
+
+		LoginLockoutThreshold:   3,
+		LoginLockoutBaseSeconds: 60,
+		LoginLockoutMaxSeconds:  3600,
+
+		EmailVerificationTokenTTLMinutes: 60,
+		EmailVerificationURL:             "http://localhost:3000/verify-email",
+		EmailVerificationRequired:        false,
+
+		EmailChangeTokenTTLMinutes: 60,
+		EmailChangeURL:             "http://localhost:3000/confirm-email-change",
+
+		PhoneOTPTTLSeconds:            300,
+		PhoneOTPMaxAttempts:           3,
+		PhoneOTPResendIntervalSeconds: 60,
+
+		MFAIssuer:              "Quest Auth Test",
+		MFAChallengeTTLSeconds: 300,
+		MFAMaxAttempts:         3,
+
+		WebAuthnRPID:                "localhost",
+		WebAuthnRPName:              "Quest Auth Test",
+		WebAuthnOrigins:             "http://localhost:8080",
+		WebAuthnChallengeTTLSeconds: 300,
+
+		MagicLinkTTLSeconds:            600,
+		MagicLinkURL:                   "http://localhost:3000/magic-link",
+		MagicLinkResendIntervalSeconds: 60,
+
+		LoginRiskHistorySize: 20,
+
+		UserDeletionGraceDays: 14,
+
+		EmailStripPlusTags:         true,
+		EmailDotInsensitiveDomains: "gmail.com",
+		EmailDomainAliases:         "googlemail.com=gmail.com",
+
+		PhoneDefaultRegion: "US",
+
+		DataExportTTLHours: 72,
 	}
 }
 
@@ -58,8 +112,67 @@ type TestDIContainer struct {
 	JWTService     ports.JWTService
 
 	// Use Case Handlers
-	LoginUserHandler    *commands.LoginUserHandler
-	RegisterUserHandler *commands.RegisterUserHandler
+	LoginUserHandler      *commands.LoginUserHandler
+	RegisterUserHandler   *commands.RegisterUserHandler
+	RefreshTokensHandler  *commands.RefreshTokensHandler
+	ChangePasswordHandler *commands.ChangePasswordHandler
+
+	ChangeExpiredPasswordHandler *commands.ChangeExpiredPasswordHandler
+	RequirePasswordChangeHandler *commands.RequirePasswordChangeHandler
+	UnlockUserHandler            *commands.UnlockUserHandler
+	ChangeUserStatusHandler      *commands.ChangeUserStatusHandler
+
+	DeleteUserHandler        *commands.DeleteUserHandler
+	PurgeDeletedUsersHandler *commands.PurgeDeletedUsersHandler
+
+	GetMeHandler         *queries.GetMeHandler
+	UpdateProfileHandler *commands.UpdateProfileHandler
+
+	RequestAccountDeletionHandler *commands.RequestAccountDeletionHandler
+	CancelAccountDeletionHandler  *commands.CancelAccountDeletionHandler
+	DeleteScheduledUsersHandler   *commands.DeleteScheduledUsersHandler
+
+	ExportUserDataHandler      *commands.ExportUserDataHandler
+	GenerateDataExportsHandler *commands.GenerateDataExportsHandler
+	GetDataExportHandler       *queries.GetDataExportHandler
+
+	SendEmailVerificationHandler *commands.SendEmailVerificationHandler
+	VerifyEmailHandler           *commands.VerifyEmailHandler
+
+	SendPhoneVerificationHandler *commands.SendPhoneVerificationHandler
+	VerifyPhoneHandler           *commands.VerifyPhoneHandler
+
+	RequestEmailChangeHandler *commands.RequestEmailChangeHandler
+	ConfirmEmailChangeHandler *commands.ConfirmEmailChangeHandler
+
+	RequestPhoneChangeHandler *commands.RequestPhoneChangeHandler
+	ConfirmPhoneChangeHandler *commands.ConfirmPhoneChangeHandler
+
+	EnrollTOTPHandler  *commands.EnrollTOTPHandler
+	ConfirmTOTPHandler *commands.ConfirmTOTPHandler
+	VerifyMFAHandler   *commands.VerifyMFAHandler
+
+	BeginWebAuthnRegistrationHandler  *commands.BeginWebAuthnRegistrationHandler
+	FinishWebAuthnRegistrationHandler *commands.FinishWebAuthnRegistrationHandler
+	BeginWebAuthnLoginHandler         *commands.BeginWebAuthnLoginHandler
+	FinishWebAuthnLoginHandler        *commands.FinishWebAuthnLoginHandler
+
+	RequestMagicLinkHandler *commands.RequestMagicLinkHandler
+	RedeemMagicLinkHandler  *commands.RedeemMagicLinkHandler
+
+	RequestPhoneLoginCodeHandler *commands.RequestPhoneLoginCodeHandler
+	LoginWithPhoneCodeHandler    *commands.LoginWithPhoneCodeHandler
+
+	// Relying party the software authenticators in tests must answer for
+	WebAuthnPolicy commands.WebAuthnPolicy
+
+	// Email canonicalization rules and phone numbering the handlers were built with
+	EmailCanonicalization kernel.EmailCanonicalization
+	PhoneNumbering        kernel.PhoneNumbering
+
+	// Outgoing emails and SMS captured in memory
+	EmailSender *emailadapter.MemorySender
+	SMSSender   *smsadapter.MemorySender
 
 	// HTTP Router for API testing
 	HTTPRouter http.Handler
@@ -69,6 +182,16 @@ type TestDIContainer struct {
 }
 
 // NewTestDIContainer создает новый TestDIContainer для тестов
+// testMetadataSchema — схема metadata пользователя в тестах
+const testMetadataSchema = `{
+  "type": "object",
+  "properties": {
+    "plan": {"type": "string", "enum": ["free", "pro"]},
+    "seats": {"type": "integer", "minimum": 1}
+  },
+  "additionalProperties": false
+}`
+
 func NewTestDIContainer(suiteContainer SuiteDIContainer) TestDIContainer {
 	// Используем тот же подход что и в основном приложении
 	testConfig := getTestConfig()
@@ -118,11 +241,149 @@ func NewTestDIContainer(suiteContainer SuiteDIContainer) TestDIContainer {
 	clock := timeadapter.NewClock()
 
 	// Создание обработчиков use cases
-	loginUserHandler := commands.NewLoginUserHandler(txManager, jwtService, passwordHasher, clock)
-	registerUserHandler := commands.NewRegisterUserHandler(txManager, jwtService, passwordHasher, clock)
+	lockoutPolicy := commands.LockoutPolicy{
+		Threshold:    testConfig.LoginLockoutThreshold,
+		BaseDuration: time.Duration(testConfig.LoginLockoutBaseSeconds) * time.Second,
+		MaxDuration:  time.Duration(testConfig.LoginLockoutMaxSeconds) * time.Second,
+	}
+	enumerationPolicy := commands.AntiEnumerationPolicy{Enabled: testConfig.AntiEnumeration}
+	// CAPTCHA выключена: тесты CAPTCHA собирают обработчики со своей политикой
+	captchaPolicy := commands.CaptchaPolicy{}
+	historyPolicy := commands.PasswordHistoryPolicy{
+		Depth:  testConfig.PasswordHistoryDepth,
+		MaxAge: time.Duration(testConfig.PasswordHistoryMaxAgeDays) * 24 * time.Hour,
+	}
+	expiryPolicy := commands.PasswordExpiryPolicy{
+		MaxAge:         time.Duration(testConfig.PasswordMaxAgeDays) * 24 * time.Hour,
+		ChangeTokenTTL: time.Duration(testConfig.PasswordChangeTokenTTLMinutes) * time.Minute,
+	}
+
+	verificationPolicy := commands.EmailVerificationPolicy{
+		TokenTTL: time.Duration(testConfig.EmailVerificationTokenTTLMinutes) * time.Minute,
+		LinkURL:  testConfig.EmailVerificationURL,
+	}
+
+	emailChangePolicy := commands.EmailChangePolicy{
+		TokenTTL: time.Duration(testConfig.EmailChangeTokenTTLMinutes) * time.Minute,
+		LinkURL:  testConfig.EmailChangeURL,
+	}
+	phoneVerificationPolicy := commands.PhoneVerificationPolicy{
+		CodeTTL:        time.Duration(testConfig.PhoneOTPTTLSeconds) * time.Second,
+		MaxAttempts:    testConfig.PhoneOTPMaxAttempts,
+		ResendInterval: time.Duration(testConfig.PhoneOTPResendIntervalSeconds) * time.Second,
+	}
+	mfaPolicy := commands.MFAPolicy{
+		Issuer:       testConfig.MFAIssuer,
+		ChallengeTTL: time.Duration(testConfig.MFAChallengeTTLSeconds) * time.Second,
+		MaxAttempts:  testConfig.MFAMaxAttempts,
+	}
+	webAuthnPolicy := commands.WebAuthnPolicy{
+		RelyingParty: webauthn.RelyingParty{
+			ID:      testConfig.WebAuthnRPID,
+			Name:    testConfig.WebAuthnRPName,
+			Origins: []string{testConfig.WebAuthnOrigins},
+		},
+		ChallengeTTL: time.Duration(testConfig.WebAuthnChallengeTTLSeconds) * time.Second,
+	}
+	magicLinkPolicy := commands.MagicLinkPolicy{
+		TokenTTL:       time.Duration(testConfig.MagicLinkTTLSeconds) * time.Second,
+		LinkURL:        testConfig.MagicLinkURL,
+		ResendInterval: time.Duration(testConfig.MagicLinkResendIntervalSeconds) * time.Second,
+	}
+
+	// Правила сравнения email как у сервиса с включёнными метками «+» и точками Gmail
+	emailRules, err := kernel.NewEmailCanonicalization(
+		testConfig.EmailStripPlusTags,
+		[]string{testConfig.EmailDotInsensitiveDomains},
+		map[string]string{"googlemail.com": testConfig.EmailDotInsensitiveDomains},
+	)
+	suiteContainer.Require().NoError(err, "Failed to build email canonicalization rules")
+	phoneRules, err := kernel.NewPhoneNumbering(testConfig.PhoneDefaultRegion)
+	suiteContainer.Require().NoError(err, "Failed to build phone numbering rules")
+
+	// Письма и SMS складываются в память, чтобы тесты могли достать из них токены и коды
+	emailSender := emailadapter.NewMemorySender()
+	smsSender := smsadapter.NewMemorySender()
+
+	// Без базы MaxMind: место входа не учитывается, подтверждение входа выключено
+	riskPolicy := commands.LoginRiskPolicy{
+		Notifier:    emailadapter.NewSecurityNotifier(emailSender),
+		HistorySize: testConfig.LoginRiskHistorySize,
+		StepUpLink:  magicLinkPolicy,
+	}
+
+	loginUserHandler := commands.NewLoginUserHandler(
+		txManager, jwtService, passwordHasher, clock, expiryPolicy, verificationPolicy, mfaPolicy, webAuthnPolicy, lockoutPolicy,
+		enumerationPolicy, captchaPolicy, riskPolicy, emailRules, phoneRules,
+	)
+	registerUserHandler := commands.NewRegisterUserHandler(
+		txManager, jwtService, passwordHasher, clock, emailSender, verificationPolicy, enumerationPolicy, captchaPolicy, emailRules,
+		phoneRules,
+	)
+	changePasswordHandler := commands.NewChangePasswordHandler(txManager, passwordHasher, clock, historyPolicy)
+	changeExpiredPasswordHandler := commands.NewChangeExpiredPasswordHandler(
+		txManager, jwtService, passwordHasher, clock, historyPolicy, expiryPolicy,
+	)
+	requirePasswordChangeHandler := commands.NewRequirePasswordChangeHandler(txManager, clock)
+	unlockUserHandler := commands.NewUnlockUserHandler(txManager, clock)
+	changeUserStatusHandler := commands.NewChangeUserStatusHandler(txManager, clock)
+	deleteUserHandler := commands.NewDeleteUserHandler(txManager, clock)
+	// Нулевой срок хранения: тесты очищают удалённые аккаунты сразу; отсрочка удаления по запросу — час
+	purgePolicy := commands.UserPurgePolicy{DeletionGracePeriod: time.Hour}
+	purgeDeletedUsersHandler := commands.NewPurgeDeletedUsersHandler(txManager, clock, purgePolicy)
+	getMeHandler := queries.NewGetMeHandler(txManager)
+	metadataValidator, err := jsonschemaadapter.NewMetadataValidator([]byte(testMetadataSchema))
+	suiteContainer.Require().NoError(err, "Failed to parse test metadata schema")
+	updateProfileHandler := commands.NewUpdateProfileHandler(txManager, clock, metadataValidator)
+	requestAccountDeletionHandler := commands.NewRequestAccountDeletionHandler(txManager, passwordHasher, clock, purgePolicy)
+	cancelAccountDeletionHandler := commands.NewCancelAccountDeletionHandler(txManager, clock)
+	deleteScheduledUsersHandler := commands.NewDeleteScheduledUsersHandler(txManager, clock, purgePolicy)
+	dataExportPolicy := commands.DataExportPolicy{TTL: time.Hour}
+	exportUserDataHandler := commands.NewExportUserDataHandler(txManager, clock, dataExportPolicy)
+	generateDataExportsHandler := commands.NewGenerateDataExportsHandler(txManager, clock, dataExportPolicy)
+	getDataExportHandler := queries.NewGetDataExportHandler(txManager, clock)
+	refreshTokensHandler := commands.NewRefreshTokensHandler(txManager, jwtService, clock, expiryPolicy, verificationPolicy)
+	sendEmailVerificationHandler := commands.NewSendEmailVerificationHandler(
+		txManager, emailSender, clock, verificationPolicy, emailRules,
+	)
+	verifyEmailHandler := commands.NewVerifyEmailHandler(txManager, clock)
+	sendPhoneVerificationHandler := commands.NewSendPhoneVerificationHandler(
+		txManager, smsSender, passwordHasher, clock, phoneVerificationPolicy,
+	)
+	verifyPhoneHandler := commands.NewVerifyPhoneHandler(txManager, passwordHasher, clock, phoneVerificationPolicy)
+	requestEmailChangeHandler := commands.NewRequestEmailChangeHandler(
+		txManager, emailSender, passwordHasher, clock, emailChangePolicy, emailRules,
+	)
+	confirmEmailChangeHandler := commands.NewConfirmEmailChangeHandler(txManager, clock, emailRules)
+	requestPhoneChangeHandler := commands.NewRequestPhoneChangeHandler(
+		txManager, smsSender, passwordHasher, clock, phoneVerificationPolicy, phoneRules,
+	)
+	confirmPhoneChangeHandler := commands.NewConfirmPhoneChangeHandler(txManager, passwordHasher, clock, phoneVerificationPolicy)
+	enrollTOTPHandler := commands.NewEnrollTOTPHandler(txManager, clock, mfaPolicy)
+	confirmTOTPHandler := commands.NewConfirmTOTPHandler(txManager, clock)
+	verifyMFAHandler := commands.NewVerifyMFAHandler(txManager, jwtService, clock, mfaPolicy, expiryPolicy, webAuthnPolicy, riskPolicy)
+	beginWebAuthnRegistrationHandler := commands.NewBeginWebAuthnRegistrationHandler(txManager, clock, webAuthnPolicy)
+	finishWebAuthnRegistrationHandler := commands.NewFinishWebAuthnRegistrationHandler(txManager, clock, webAuthnPolicy)
+	beginWebAuthnLoginHandler := commands.NewBeginWebAuthnLoginHandler(txManager, clock, webAuthnPolicy)
+	finishWebAuthnLoginHandler := commands.NewFinishWebAuthnLoginHandler(
+		txManager, jwtService, clock, webAuthnPolicy, expiryPolicy, verificationPolicy, riskPolicy,
+	)
+	requestMagicLinkHandler := commands.NewRequestMagicLinkHandler(txManager, emailSender, clock, magicLinkPolicy, emailRules)
+	redeemMagicLinkHandler := commands.NewRedeemMagicLinkHandler(
+		txManager, jwtService, clock, expiryPolicy, mfaPolicy, webAuthnPolicy, riskPolicy,
+	)
+	requestPhoneLoginCodeHandler := commands.NewRequestPhoneLoginCodeHandler(
+		txManager, smsSender, passwordHasher, clock, phoneVerificationPolicy, phoneRules,
+	)
+	loginWithPhoneCodeHandler := commands.NewLoginWithPhoneCodeHandler(
+		txManager, jwtService, passwordHasher, clock, expiryPolicy,
+		verificationPolicy, phoneVerificationPolicy, mfaPolicy, webAuthnPolicy, riskPolicy, phoneRules,
+	)
 
 	// Create HTTP Router for API testing
-	compositionRoot := cmd.NewCompositionRoot(testConfig, db)
+	compositionRoot := cmd.NewCompositionRoot(testConfig, db).
+		WithEmailSender(emailSender).
+		WithSMSSender(smsSender)
 	httpRouter := cmd.NewRouter(compositionRoot)
 
 	// Event storage helper
@@ -143,8 +404,63 @@ func NewTestDIContainer(suiteContainer SuiteDIContainer) TestDIContainer {
 		EventPublisher: eventPublisher,
 		JWTService:     jwtService,
 
-		LoginUserHandler:    loginUserHandler,
-		RegisterUserHandler: registerUserHandler,
+		LoginUserHandler:      loginUserHandler,
+		RegisterUserHandler:   registerUserHandler,
+		RefreshTokensHandler:  refreshTokensHandler,
+		ChangePasswordHandler: changePasswordHandler,
+
+		ChangeExpiredPasswordHandler: changeExpiredPasswordHandler,
+		RequirePasswordChangeHandler: requirePasswordChangeHandler,
+		UnlockUserHandler:            unlockUserHandler,
+		ChangeUserStatusHandler:      changeUserStatusHandler,
+
+		DeleteUserHandler:        deleteUserHandler,
+		PurgeDeletedUsersHandler: purgeDeletedUsersHandler,
+
+		GetMeHandler:         getMeHandler,
+		UpdateProfileHandler: updateProfileHandler,
+
+		RequestAccountDeletionHandler: requestAccountDeletionHandler,
+		CancelAccountDeletionHandler:  cancelAccountDeletionHandler,
+		DeleteScheduledUsersHandler:   deleteScheduledUsersHandler,
+
+		ExportUserDataHandler:      exportUserDataHandler,
+		GenerateDataExportsHandler: generateDataExportsHandler,
+		GetDataExportHandler:       getDataExportHandler,
+
+		SendEmailVerificationHandler: sendEmailVerificationHandler,
+		VerifyEmailHandler:           verifyEmailHandler,
+
+		SendPhoneVerificationHandler: sendPhoneVerificationHandler,
+		VerifyPhoneHandler:           verifyPhoneHandler,
+
+		RequestEmailChangeHandler: requestEmailChangeHandler,
+		ConfirmEmailChangeHandler: confirmEmailChangeHandler,
+
+		RequestPhoneChangeHandler: requestPhoneChangeHandler,
+		ConfirmPhoneChangeHandler: confirmPhoneChangeHandler,
+
+		EnrollTOTPHandler:  enrollTOTPHandler,
+		ConfirmTOTPHandler: confirmTOTPHandler,
+		VerifyMFAHandler:   verifyMFAHandler,
+
+		BeginWebAuthnRegistrationHandler:  beginWebAuthnRegistrationHandler,
+		FinishWebAuthnRegistrationHandler: finishWebAuthnRegistrationHandler,
+		BeginWebAuthnLoginHandler:         beginWebAuthnLoginHandler,
+		FinishWebAuthnLoginHandler:        finishWebAuthnLoginHandler,
+
+		RequestMagicLinkHandler: requestMagicLinkHandler,
+		RedeemMagicLinkHandler:  redeemMagicLinkHandler,
+
+		RequestPhoneLoginCodeHandler: requestPhoneLoginCodeHandler,
+		LoginWithPhoneCodeHandler:    loginWithPhoneCodeHandler,
+
+		WebAuthnPolicy:        webAuthnPolicy,
+		EmailCanonicalization: emailRules,
+		PhoneNumbering:        phoneRules,
+
+		EmailSender: emailSender,
+		SMSSender:   smsSender,
 
 		HTTPRouter:   httpRouter,
 		EventStorage: eventStorage,
@@ -164,9 +480,30 @@ func (c *TestDIContainer) CleanupDatabase() error {
 	if err := c.DB.Exec("TRUNCATE TABLE events CASCADE").Error; err != nil {
 		return err
 	}
+	if err := c.DB.Exec("TRUNCATE TABLE password_history CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE verification_tokens CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE recovery_codes CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE webauthn_credentials CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE login_history CASCADE").Error; err != nil {
+		return err
+	}
+	if err := c.DB.Exec("TRUNCATE TABLE data_exports CASCADE").Error; err != nil {
+		return err
+	}
 	if err := c.DB.Exec("TRUNCATE TABLE users CASCADE").Error; err != nil {
 		return err
 	}
+	if err := c.DB.Exec("TRUNCATE TABLE rate_limits").Error; err != nil {
+		return err
+	}
 	return nil
 }
 